@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/bosun-monitor/bosun/conf"
+)
+
+// client calls the HTTP API of the bosun instance configured by c.
+type client struct {
+	base  *url.URL
+	token string
+}
+
+func newClient(c *conf.Conf) *client {
+	u := &url.URL{
+		Scheme: "http",
+		Host:   c.HttpListen,
+	}
+	if strings.HasPrefix(u.Host, ":") {
+		u.Host = "localhost" + u.Host
+	}
+	return &client{base: u, token: c.APIToken}
+}
+
+// post sends v as JSON to path and decodes the JSON response, if any, into
+// res.
+func (c *client) post(path string, v, res interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	u := *c.base
+	u.Path = path
+	req, err := http.NewRequest("POST", u.String(), bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("%s: %s: %s", path, resp.Status, bytes.TrimSpace(body))
+	}
+	if res == nil || len(body) == 0 {
+		return nil
+	}
+	return json.Unmarshal(body, res)
+}
+
+func currentUser() string {
+	if u := os.Getenv("USER"); u != "" {
+		return u
+	}
+	return "bosun"
+}
+
+// runCommand runs the subcommand named by args[0] against the instance
+// configured by c. It returns false if there is no such subcommand.
+func runCommand(c *conf.Conf, args []string) bool {
+	var f func(*client, []string) error
+	switch args[0] {
+	case "silence":
+		f = cmdSilence
+	case "ack":
+		f = cmdAck
+	default:
+		return false
+	}
+	if err := f(newClient(c), args[1:]); err != nil {
+		log.Fatal(err)
+	}
+	return true
+}
+
+// cmdSilence adds a silence: bosun silence -tags host=x -duration 2h.
+func cmdSilence(c *client, args []string) error {
+	fs := flag.NewFlagSet("silence", flag.ExitOnError)
+	alert := fs.String("alert", "", "alert name to silence; all alerts if empty")
+	tags := fs.String("tags", "", "tags to silence, such as host=ny-web01,dc=ny")
+	duration := fs.String("duration", "1h", "length of the silence")
+	start := fs.String("start", "", "start time; now if empty")
+	recurrence := fs.String("recurrence", "", `cron-like schedule within the silence when it applies, such as "0 2 * * 0"`)
+	length := fs.String("length", "", "length of each recurrence, such as 2h")
+	message := fs.String("message", "", "reason for the silence")
+	user := fs.String("user", currentUser(), "user creating the silence")
+	forget := fs.Bool("forget", false, "forget silenced alerts that become unknown")
+	preview := fs.Bool("preview", false, "print the affected alert keys without adding the silence")
+	fs.Parse(args)
+	if *alert == "" && *tags == "" {
+		return fmt.Errorf("silence: -alert or -tags required")
+	}
+	data := map[string]string{
+		"alert":      *alert,
+		"tags":       *tags,
+		"duration":   *duration,
+		"start":      *start,
+		"recurrence": *recurrence,
+		"length":     *length,
+		"message":    *message,
+		"user":       *user,
+	}
+	if *forget {
+		data["forget"] = "true"
+	}
+	if !*preview {
+		data["confirm"] = "true"
+	}
+	var keys map[string]bool
+	if err := c.post("/api/silence/set", data, &keys); err != nil {
+		return err
+	}
+	var sorted []string
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+	for _, k := range sorted {
+		fmt.Println(k)
+	}
+	if *preview {
+		fmt.Printf("%d alerts would be silenced\n", len(sorted))
+	} else {
+		fmt.Printf("silenced %d alerts for %s\n", len(sorted), *duration)
+	}
+	return nil
+}
+
+// cmdAck acknowledges alerts: bosun ack <alertkey>...
+func cmdAck(c *client, args []string) error {
+	fs := flag.NewFlagSet("ack", flag.ExitOnError)
+	message := fs.String("message", "", "acknowledgement message")
+	user := fs.String("user", currentUser(), "user acknowledging the alerts")
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		return fmt.Errorf("ack: alert key required")
+	}
+	data := struct {
+		Type    string
+		User    string
+		Message string
+		Keys    []string
+	}{"ack", *user, *message, fs.Args()}
+	if err := c.post("/api/action", &data, nil); err != nil {
+		return err
+	}
+	fmt.Printf("acknowledged %d alerts\n", fs.NArg())
+	return nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/bosun-monitor/bosun/conf"
+)
+
+// apiRecorder records the requests sent to it and answers with status and
+// body.
+type apiRecorder struct {
+	status int
+	body   string
+	path   string
+	auth   string
+	data   map[string]interface{}
+}
+
+func (a *apiRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.path = r.URL.Path
+	a.auth = r.Header.Get("Authorization")
+	b, _ := ioutil.ReadAll(r.Body)
+	a.data = nil
+	json.Unmarshal(b, &a.data)
+	w.WriteHeader(a.status)
+	w.Write([]byte(a.body))
+}
+
+func testClient(t *testing.T, a *apiRecorder) (*client, func()) {
+	ts := httptest.NewServer(a)
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &client{base: u, token: "token"}, ts.Close
+}
+
+func TestNewClient(t *testing.T) {
+	c := newClient(&conf.Conf{HttpListen: ":8070", APIToken: "token"})
+	if c.base.String() != "http://localhost:8070" || c.token != "token" {
+		t.Errorf("unexpected client: %v, %q", c.base, c.token)
+	}
+}
+
+func TestCmdAck(t *testing.T) {
+	a := &apiRecorder{status: http.StatusOK}
+	c, done := testClient(t, a)
+	defer done()
+	if err := cmdAck(c, []string{"-user", "alice", "-message", "rebooting", "a{host=x}", "b{host=x}"}); err != nil {
+		t.Fatal(err)
+	}
+	if a.path != "/api/action" || a.auth != "Bearer token" {
+		t.Errorf("unexpected request: %s, %q", a.path, a.auth)
+	}
+	keys, _ := a.data["Keys"].([]interface{})
+	if a.data["Type"] != "ack" || a.data["User"] != "alice" || a.data["Message"] != "rebooting" || len(keys) != 2 {
+		t.Errorf("unexpected body: %v", a.data)
+	}
+	if err := cmdAck(c, nil); err == nil {
+		t.Error("expected error without alert keys")
+	}
+	a.status, a.body = http.StatusInternalServerError, "unknown alert key\n"
+	if err := cmdAck(c, []string{"a{host=y}"}); err == nil || !strings.Contains(err.Error(), "unknown alert key") {
+		t.Errorf("expected server error, got %v", err)
+	}
+}
+
+func TestCmdSilence(t *testing.T) {
+	a := &apiRecorder{status: http.StatusOK, body: `{"a{host=x}": true}`}
+	c, done := testClient(t, a)
+	defer done()
+	if err := cmdSilence(c, []string{"-tags", "host=x", "-duration", "2h", "-message", "reboot", "-forget"}); err != nil {
+		t.Fatal(err)
+	}
+	if a.path != "/api/silence/set" || a.auth != "Bearer token" {
+		t.Errorf("unexpected request: %s, %q", a.path, a.auth)
+	}
+	if a.data["tags"] != "host=x" || a.data["duration"] != "2h" || a.data["message"] != "reboot" || a.data["forget"] != "true" || a.data["confirm"] != "true" {
+		t.Errorf("unexpected body: %v", a.data)
+	}
+	if err := cmdSilence(c, []string{"-alert", "a", "-preview"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := a.data["confirm"]; ok {
+		t.Errorf("preview confirmed the silence: %v", a.data)
+	}
+	if err := cmdSilence(c, nil); err == nil {
+		t.Error("expected error without alert or tags")
+	}
+}
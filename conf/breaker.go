@@ -0,0 +1,124 @@
+package conf
+
+import (
+	"log"
+	"sort"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/collect"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+)
+
+// breaker tracks consecutive failures of one notification transport. After
+// BreakerFailures failures in a row the circuit opens and the transport is
+// not used until BreakerCooldown passes. A single trial send is then let
+// through: success closes the circuit, failure opens it again.
+type breaker struct {
+	failures int
+	open     bool
+	until    time.Time
+}
+
+type breakerKey struct {
+	name, transport string
+}
+
+// Circuit describes the breaker state of a notification transport.
+type Circuit struct {
+	Notification string
+	Transport    string
+	Failures     int
+	Open         bool
+	Until        time.Time `json:",omitempty"`
+}
+
+func (c *Conf) breaker(name, transport string) *breaker {
+	if c.breakers == nil {
+		c.breakers = make(map[breakerKey]*breaker)
+	}
+	k := breakerKey{name, transport}
+	b := c.breakers[k]
+	if b == nil {
+		b = new(breaker)
+		c.breakers[k] = b
+	}
+	return b
+}
+
+// allow reports whether a message may be sent through transport of the named
+// notification.
+func (c *Conf) allow(name, transport string) bool {
+	c.breakerLock.Lock()
+	defer c.breakerLock.Unlock()
+	b := c.breaker(name, transport)
+	if !b.open {
+		return true
+	}
+	now := time.Now()
+	if now.Before(b.until) {
+		collect.Add("notification.circuit_skipped", opentsdb.TagSet{"notification": name, "transport": transport}, 1)
+		return false
+	}
+	// Half open: hold the circuit for another cooldown so only this
+	// trial goes through until its result is recorded.
+	b.until = now.Add(c.BreakerCooldown)
+	return true
+}
+
+// record notes the result of a send through transport of the named
+// notification.
+func (c *Conf) record(name, transport string, err error) {
+	c.breakerLock.Lock()
+	defer c.breakerLock.Unlock()
+	b := c.breaker(name, transport)
+	if err == nil {
+		if b.open {
+			log.Printf("notification %s: %s circuit closed", name, transport)
+		}
+		*b = breaker{}
+		return
+	}
+	b.failures++
+	if b.open || b.failures >= c.BreakerFailures {
+		if !b.open {
+			slog.Warningf("notification %s: %s circuit opened after %d failures", name, transport, b.failures)
+			collect.Add("notification.circuit_open", opentsdb.TagSet{"notification": name, "transport": transport}, 1)
+		}
+		b.open = true
+		b.until = time.Now().Add(c.BreakerCooldown)
+	}
+}
+
+// Circuits returns the breaker state of every notification transport that
+// has been used, sorted by notification and transport.
+func (c *Conf) Circuits() []Circuit {
+	c.breakerLock.Lock()
+	defer c.breakerLock.Unlock()
+	var cs []Circuit
+	for k, b := range c.breakers {
+		ci := Circuit{
+			Notification: k.name,
+			Transport:    k.transport,
+			Failures:     b.failures,
+			Open:         b.open,
+		}
+		if b.open {
+			ci.Until = b.until
+		}
+		cs = append(cs, ci)
+	}
+	sort.Sort(circuitsByName(cs))
+	return cs
+}
+
+type circuitsByName []Circuit
+
+func (c circuitsByName) Len() int      { return len(c) }
+func (c circuitsByName) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+func (c circuitsByName) Less(i, j int) bool {
+	if c[i].Notification != c[j].Notification {
+		return c[i].Notification < c[j].Notification
+	}
+	return c[i].Transport < c[j].Transport
+}
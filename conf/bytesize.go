@@ -6,6 +6,8 @@ package conf
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 type ByteSize float64
@@ -43,3 +45,24 @@ func (b ByteSize) String() string {
 	}
 	return fmt.Sprintf("%.2fB", b)
 }
+
+// ParseByteSize parses a size such as 512MB or 2GB. A number without a unit
+// is in bytes.
+func ParseByteSize(s string) (ByteSize, error) {
+	v := strings.ToUpper(strings.TrimSpace(s))
+	mult := ByteSize(1)
+	for _, u := range []struct {
+		suffix string
+		size   ByteSize
+	}{{"KB", KB}, {"MB", MB}, {"GB", GB}, {"TB", TB}, {"B", 1}} {
+		if strings.HasSuffix(v, u.suffix) {
+			v, mult = strings.TrimSpace(strings.TrimSuffix(v, u.suffix)), u.size
+			break
+		}
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("bad byte size: %s", s)
+	}
+	return ByteSize(f) * mult, nil
+}
@@ -1,57 +1,143 @@
 package conf
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	htemplate "html/template"
 	"io/ioutil"
-	"log"
 	"net/mail"
 	"net/url"
 	"os"
 	"regexp"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	ttemplate "text/template"
 	tparse "text/template/parse"
 	"time"
 
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
 	"github.com/bosun-monitor/bosun/conf/parse"
 	"github.com/bosun-monitor/bosun/expr"
 	eparse "github.com/bosun-monitor/bosun/expr/parse"
+	"github.com/bosun-monitor/bosun/graphite"
 )
 
 type Conf struct {
 	Vars
-	Name            string        // Config file name
-	CheckFrequency  time.Duration // Time between alert checks: 5m
-	TsdbHost        string        // OpenTSDB relay and query destination: ny-devtsdb04:4242
-	HttpListen      string        // Web server listen address: :80
-	RelayListen     string        // OpenTSDB relay listen address: :4242
-	SmtpHost        string        // SMTP address: ny-mail:25
-	Ping            bool
-	EmailFrom       string
-	StateFile       string
-	TimeAndDate     []int // timeanddate.com cities list
-	ResponseLimit   int64
-	UnknownTemplate *Template
-	Templates       map[string]*Template
-	Alerts          map[string]*Alert
-	Notifications   map[string]*Notification `json:"-"`
-	RawText         string
-	Macros          map[string]*Macro
-	Lookups         map[string]*Lookup
-	Squelch         Squelches `json:"-"`
-	Quiet           bool
+	Name              string        // Config file name
+	CheckFrequency    time.Duration // Time between alert checks: 5m
+	TsdbHost          string        // OpenTSDB relay and query destination: ny-devtsdb04:4242
+	GraphiteHost      string        // Graphite render API host queried by graphite(): graphite:80
+	HttpListen        string        // Web server listen address: :80
+	RelayListen       string        // OpenTSDB relay listen address: :4242
+	SmtpHost          string        // SMTP address: ny-mail:25
+	SmtpUsername      string        // SMTP PLAIN auth username, if any; requires SmtpTLS starttls or tls
+	SmtpPassword      string        `json:"-"`
+	SmtpTLS           string        // One of "", starttls, tls or none
+	SmtpPoolSize      int           // Idle SMTP connections to keep open
+	Ping              bool
+	AlertMetrics      bool     // Emit per-alert group counts by status
+	ShardMembers      []string // Names of all evaluators sharing alert checks, if not registered in Redis
+	ShardName         string   // Name of this evaluator among the shard members
+	ShardAlert        string   // Notification sent when shard members stop or resume checking
+	EmailFrom         string
+	BreakerFailures   int           // Consecutive failures that open a circuit
+	BreakerCooldown   time.Duration // How long an open circuit stays open
+	EventHook         *url.URL      // URL to POST lifecycle events to
+	EvaluatorURL      *url.URL      // Evaluator that web-only instances forward changes to
+	APIToken          string        `json:"-"` // Bearer token of the command line client; required to sign embeds and change state outside the web UI
+	ChangeURL         string        // Change-management lookup URL template
+	ChangeCache       time.Duration // How long change lookups are cached
+	ChangeAnnotate    bool          // Annotate, rather than suppress, alerts under change
+	EmbedKey          string        `json:"-"` // HMAC key for signed embed URLs
+	EmbedAncestors    string        // CSP frame-ancestors sources for embeds
+	EmbedOrigin       string        // Access-Control-Allow-Origin for embeds
+	EmbedRefresh      time.Duration // Default embed refresh interval
+	StateFile         string
+	RedisHost         string // Shared state in Redis instead of StateFile
+	RedisPassword     string `json:"-"`
+	SnapshotURL       string // S3-compatible bucket/prefix for state snapshots
+	SnapshotRegion    string
+	SnapshotAccessKey string
+	SnapshotSecretKey string        `json:"-"`
+	SnapshotInterval  time.Duration // How often to upload a snapshot
+	SnapshotRetain    int           // Number of snapshots to keep
+	HeartbeatMetric   string        // Metric whose datapoints mark a source as seen
+	HeartbeatTag      string        // Tag of HeartbeatMetric naming the source
+	HeartbeatTimeout  time.Duration // Sources not seen for this long are stale
+	ProvisionGrace    time.Duration // Notifications are withheld this long for new ProvisionTag values
+	ProvisionTag      string        // Tag naming provisioned hosts: host
+	ArchiveDuration   time.Duration // How long to keep state of removed alerts
+	QualityFuture     time.Duration // Relayed points further ahead of now are flagged; 0 disables
+	QualityPast       time.Duration // Relayed points further behind now are flagged; 0 disables
+	QualityAlert      string        // Notification of the built-in data quality alert, if any
+	MemoryLimit       ByteSize      // Resident memory that trips the watchdog; 0 disables
+	MemoryProfileDir  string        // Where the watchdog writes heap profiles
+	MemoryDropCaches  bool          // Drop the search read replica when the watchdog trips
+	MemoryAlert       string        // Notification sent when the watchdog trips, if any
+	MailListen        string        // Inbound SMTP address for email replies to notifications
+	MailKey           string        `json:"-"` // HMAC key for notification Message-IDs
+	StartupSuppress   int           // Check cycles after start whose notifications are withheld
+	ReloadThreshold   int           // Notifications a reload may send without confirmation
+	NotifyPerMinute   int           // Cap on notifications sent per minute; 0 is unlimited
+	TimeAndDate       []int         // timeanddate.com cities list
+	ResponseLimit     int64
+	MaxGroups         int // Default limit on groups an alert expression may return
+	RuleTestWorkers   int // Rule test intervals evaluated at once across all users
+	RuleTestPerUser   int // Rule test intervals of one user evaluated at once
+	RuleTestQueue     int // Rule tests running or waiting before new ones are refused
+	UnknownTemplate   *Template
+	Templates         map[string]*Template
+	Alerts            map[string]*Alert
+	Notifications     map[string]*Notification `json:"-"`
+	Providers         map[string]*ExecProvider `json:"-"`
+	LogSinks          map[string]*LogSink
+	RawText           string
+	Macros            map[string]*Macro
+	Lookups           map[string]*Lookup
+	Rosters           map[string]*Roster
+	Aliases           map[string]*Alias
+	RelayRules        []*RelayRule
+	InhibitRules      []*InhibitRule
+	TSDBRoutes        []*TSDBRoute
+	Exclusions        []expr.TimeRange // Ranges ignored by baseline functions
+	Calendar          *expr.Calendar   // Business days for "bd" durations
+	Squelch           Squelches        `json:"-"`
+	Quiet             bool
+	Warnings          []string // Deprecated constructs found while parsing
 
 	tree            *parse.Tree
 	node            parse.Node
 	unknownTemplate string
 	bodies          *htemplate.Template
 	subjects        *ttemplate.Template
+	textBodies      *ttemplate.Template
 	squelch         []string
+	edits           []edit
+	key             []byte
+	smtpOnce        sync.Once
+	smtpPool        *smtpPool
+	breakerLock     sync.Mutex
+	breakers        map[breakerKey]*breaker
+	changeURL       *ttemplate.Template
+}
+
+// ChangeRequest returns the changeURL executed with tags, or "" if changeURL
+// is not set.
+func (c *Conf) ChangeRequest(tags opentsdb.TagSet) (string, error) {
+	if c.changeURL == nil {
+		return "", nil
+	}
+	buf := new(bytes.Buffer)
+	if err := c.changeURL.Execute(buf, tags); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
 }
 
 type Squelch map[string]*regexp.Regexp
@@ -177,6 +263,45 @@ func (c *Conf) GetLookups() map[string]*expr.Lookup {
 	return lookups
 }
 
+// Alias maps the values of a tag key to display names. Raw tag values are
+// still used for queries and alert keys.
+type Alias struct {
+	Def    string
+	Name   string // tag key
+	Values map[string]string
+}
+
+// Alias returns the display name of tag value tagv for tag key tagk, or tagv
+// if no alias is defined.
+func (c *Conf) Alias(tagk, tagv string) string {
+	if a := c.Aliases[tagk]; a != nil {
+		if v, ok := a.Values[tagv]; ok {
+			return v
+		}
+	}
+	return tagv
+}
+
+// AliasTags returns a copy of tags with aliased values replaced by their
+// display names.
+func (c *Conf) AliasTags(tags opentsdb.TagSet) opentsdb.TagSet {
+	t := make(opentsdb.TagSet)
+	for k, v := range tags {
+		t[k] = c.Alias(k, v)
+	}
+	return t
+}
+
+// AliasValues returns all aliases as a map of tag key to tag value to display
+// name.
+func (c *Conf) AliasValues() map[string]map[string]string {
+	m := make(map[string]map[string]string)
+	for k, a := range c.Aliases {
+		m[k] = a.Values
+	}
+	return m
+}
+
 type Macro struct {
 	Def    string
 	Pairs  []nodePair
@@ -187,23 +312,61 @@ type Macro struct {
 type Alert struct {
 	Def string
 	Vars
-	*Template        `json:"-"`
-	Name             string
-	Crit             *expr.Expr `json:",omitempty"`
-	Warn             *expr.Expr `json:",omitempty"`
-	Squelch          Squelches  `json:"-"`
-	CritNotification *Notifications
-	WarnNotification *Notifications
-	Unknown          time.Duration
-	IgnoreUnknown    bool
-	Macros           []string `json:"-"`
-	UnjoinedOK       bool     `json:",omitempty"`
+	*Template         `json:"-"`
+	Name              string
+	Crit              *expr.Expr `json:",omitempty"`
+	Warn              *expr.Expr `json:",omitempty"`
+	Info              *expr.Expr `json:",omitempty"` // Less severe than warn
+	Severity          *expr.Expr `json:",omitempty"` // 0 normal, 1 warn, 2 crit, 3 fatal
+	Squelch           Squelches  `json:"-"`
+	CritNotification  *Notifications
+	WarnNotification  *Notifications
+	InfoNotification  *Notifications
+	FatalNotification *Notifications // CritNotification is used if empty
+	Unknown           time.Duration
+	IgnoreUnknown     bool
+	Macros            []string `json:"-"`
+	UnjoinedOK        bool     `json:",omitempty"`
+	MaxGroups         int      `json:",omitempty"` // Overrides the global maxGroups
+	// Labels are arbitrary key-value metadata from "label key = value"
+	// lines, such as the environment or service tier.
+	Labels map[string]string `json:",omitempty"`
+	// Depends names parent alerts. While a parent alert key whose tags are
+	// a subset of a group's is warning or critical, the group is not
+	// evaluated or notified.
+	Depends []string `json:",omitempty"`
+	// Dedup is a key whose {tagk} placeholders are replaced by the tags of a
+	// group, like "host-down-{host}". Groups of any alert with the same key
+	// collapse into a single notification per notification section.
+	Dedup string `json:",omitempty"`
+	// AnchorPeriod and AnchorOffset restrict evaluation to the first check
+	// at or after each multiple of AnchorPeriod since the unix epoch, plus
+	// AnchorOffset. Zero AnchorPeriod evaluates every check.
+	AnchorPeriod time.Duration `json:",omitempty"`
+	AnchorOffset time.Duration `json:",omitempty"`
 
 	crit, warn string
+	info       string
+	severity   string
 	template   string
 	squelch    []string
 }
 
+// LastAnchor returns the latest anchor time of a at or before now. It is
+// the zero time if a has no anchor.
+func (a *Alert) LastAnchor(now time.Time) time.Time {
+	if a.AnchorPeriod <= 0 {
+		return time.Time{}
+	}
+	p := int64(a.AnchorPeriod / time.Second)
+	t := now.Unix() - int64(a.AnchorOffset/time.Second)
+	m := t % p
+	if m < 0 {
+		m += p
+	}
+	return time.Unix(t-m+int64(a.AnchorOffset/time.Second), 0)
+}
+
 type Notifications struct {
 	Notifications map[string]*Notification `json:"-"`
 	// Table key -> table
@@ -252,11 +415,13 @@ func (c *Conf) parseNotifications(v string) (map[string]*Notification, error) {
 type Template struct {
 	Def string
 	Vars
-	Name    string
-	Body    *htemplate.Template `json:"-"`
-	Subject *ttemplate.Template `json:"-"`
+	Name     string
+	Body     *htemplate.Template `json:"-"`
+	Subject  *ttemplate.Template `json:"-"`
+	TextBody *ttemplate.Template `json:"-"` // Plain text body for providers
 
-	body, subject string
+	body, subject, textBody string
+	inherit                 string
 }
 
 type Notification struct {
@@ -264,16 +429,42 @@ type Notification struct {
 	Vars
 	Name      string
 	Email     []*mail.Address
+	Roster    *Roster // Adds the addresses on call when sending
+	From      string  // Overrides the global emailFrom
+	ReplyTo   string
 	Post, Get *url.URL
 	Body      *ttemplate.Template
+	Slack     *url.URL            // Slack incoming webhook
+	SlackText *ttemplate.Template // Text of Slack messages; the subject if nil
+	Channel   string              // Overrides the Slack webhook's channel
 	Print     bool
 	Next      *Notification
+	Fallback  *Notification // Used while one of this notification's circuits is open
+	Provider  Provider      // Plugin that delivers the notification
 	Timeout   time.Duration
+	Location  *time.Location // Timezone of timestamps in templates; UTC if nil
+	Locale    string         // Selects the date layout of FormatTime
+	// MaxSubject and MaxBody limit the rendered subject and bodies in bytes.
+	// Longer text is truncated, and HTML is cut between elements; zero means
+	// no limit.
+	MaxSubject, MaxBody int
+	// Retries, RetryDelay and RequestTimeout apply to post, get and Slack
+	// requests, and ContentType to posts. Failed requests are retried with
+	// the delay doubling each time.
+	ContentType    string
+	Retries        int
+	RetryDelay     time.Duration
+	RequestTimeout time.Duration
 
 	next      string
+	fallback  string
+	provider  string
 	email     string
+	roster    string
 	post, get string
 	body      string
+	slack     string
+	slackText string
 }
 
 func (n *Notification) MarshalJSON() ([]byte, error) {
@@ -293,20 +484,38 @@ func ParseFile(fname string) (*Conf, error) {
 func New(name, text string) (c *Conf, err error) {
 	defer errRecover(&err)
 	c = &Conf{
-		Name:           name,
-		CheckFrequency: time.Minute * 5,
-		HttpListen:     ":8070",
-		StateFile:      "bosun.state",
-		ResponseLimit:  1 << 20, // 1MB
-		Vars:           make(map[string]string),
-		Templates:      make(map[string]*Template),
-		Alerts:         make(map[string]*Alert),
-		Notifications:  make(map[string]*Notification),
-		RawText:        text,
-		bodies:         htemplate.New(name).Funcs(htemplate.FuncMap(defaultFuncs)),
-		subjects:       ttemplate.New(name).Funcs(defaultFuncs),
-		Lookups:        make(map[string]*Lookup),
-		Macros:         make(map[string]*Macro),
+		Name:             name,
+		CheckFrequency:   time.Minute * 5,
+		HttpListen:       ":8070",
+		StateFile:        "bosun.state",
+		ResponseLimit:    1 << 20, // 1MB
+		RuleTestWorkers:  4,
+		RuleTestPerUser:  2,
+		RuleTestQueue:    20,
+		BreakerFailures:  5,
+		BreakerCooldown:  time.Minute * 5,
+		ArchiveDuration:  time.Hour * 24 * 7,
+		QualityFuture:    time.Minute * 10,
+		HeartbeatTag:     "host",
+		ProvisionTag:     "host",
+		ChangeCache:      time.Minute * 5,
+		HeartbeatTimeout: time.Minute * 10,
+		SnapshotInterval: time.Hour,
+		SnapshotRetain:   24,
+		Vars:             make(map[string]string),
+		Templates:        make(map[string]*Template),
+		Alerts:           make(map[string]*Alert),
+		Notifications:    make(map[string]*Notification),
+		Providers:        make(map[string]*ExecProvider),
+		LogSinks:         make(map[string]*LogSink),
+		RawText:          text,
+		bodies:           htemplate.New(name).Funcs(htemplate.FuncMap(defaultFuncs)),
+		subjects:         ttemplate.New(name).Funcs(defaultFuncs),
+		textBodies:       ttemplate.New(name).Funcs(defaultFuncs),
+		Lookups:          make(map[string]*Lookup),
+		Rosters:          make(map[string]*Roster),
+		Aliases:          make(map[string]*Alias),
+		Macros:           make(map[string]*Macro),
 	}
 	c.tree, err = parse.Parse(name, text)
 	if err != nil {
@@ -317,7 +526,7 @@ func New(name, text string) (c *Conf, err error) {
 		c.at(n)
 		switch n := n.(type) {
 		case *parse.PairNode:
-			c.seen(n.Key.Text, saw)
+			c.seen(c.deprecatedKey("", n), saw)
 			c.loadGlobal(n)
 		case *parse.SectionNode:
 			c.loadSection(n)
@@ -325,16 +534,148 @@ func New(name, text string) (c *Conf, err error) {
 			c.errorf("unexpected parse node %s", n)
 		}
 	}
+	if c.QualityAlert != "" {
+		c.loadQualityAlert()
+	}
+	if c.MemoryAlert != "" {
+		c.at(nil)
+		if _, ok := c.Notifications[c.MemoryAlert]; !ok {
+			c.errorf("memoryAlert: unknown notification %s", c.MemoryAlert)
+		}
+	}
+	if c.ShardAlert != "" {
+		c.at(nil)
+		if _, ok := c.Notifications[c.ShardAlert]; !ok {
+			c.errorf("shardAlert: unknown notification %s", c.ShardAlert)
+		}
+	}
+	if _, err := c.AlertsByDependency(); err != nil {
+		c.at(nil)
+		c.error(err)
+	}
+	if c.MailListen != "" && c.MailKey == "" {
+		c.at(nil)
+		c.errorf("mailListen requires mailKey")
+	}
 	if c.TsdbHost == "" {
 		c.at(nil)
 		c.errorf("tsdbHost required")
 	}
+	if c.SmtpUsername != "" && c.SmtpTLS != SmtpTLSStartTLS && c.SmtpTLS != SmtpTLSImplicit {
+		// Credentials are only sent over a verified TLS connection.
+		c.at(nil)
+		c.errorf("smtpUsername requires smtpTLS %s or %s", SmtpTLSStartTLS, SmtpTLSImplicit)
+	}
+	if len(c.ShardMembers) > 0 {
+		c.at(nil)
+		found := false
+		for _, m := range c.ShardMembers {
+			found = found || m == c.ShardName
+		}
+		if !found {
+			c.errorf("shardName %q not in shardMembers", c.ShardName)
+		}
+		if c.RedisHost != "" {
+			c.errorf("shardMembers cannot be used with redisHost: members register in Redis")
+		}
+	} else if c.ShardName != "" && c.RedisHost == "" {
+		c.at(nil)
+		c.errorf("shardName requires shardMembers or redisHost")
+	}
 	return
 }
 
+// AlertsByDependency returns the alerts ordered by name, except that each
+// follows the alerts it depends on. It returns an error if an alert depends
+// on an unknown alert or, through a cycle, on itself.
+func (c *Conf) AlertsByDependency() ([]*Alert, error) {
+	names := make([]string, 0, len(c.Alerts))
+	for name := range c.Alerts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	const (
+		visiting = iota + 1
+		visited
+	)
+	marks := make(map[string]int)
+	order := make([]*Alert, 0, len(names))
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		path = append(path, name)
+		switch marks[name] {
+		case visiting:
+			return fmt.Errorf("alert dependency cycle: %s", strings.Join(path, " -> "))
+		case visited:
+			return nil
+		}
+		marks[name] = visiting
+		a := c.Alerts[name]
+		for _, d := range a.Depends {
+			if c.Alerts[d] == nil {
+				return fmt.Errorf("alert %s depends on unknown alert %s", name, d)
+			}
+			if err := visit(d, path); err != nil {
+				return err
+			}
+		}
+		marks[name] = visited
+		order = append(order, a)
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// GraphiteContext returns the context graphite() queries, or nil if
+// graphiteHost is not set.
+func (c *Conf) GraphiteContext() graphite.Context {
+	if c.GraphiteHost == "" {
+		return nil
+	}
+	return graphite.Host(c.GraphiteHost)
+}
+
+// qualityAlertName is the name of the alert and template added by
+// qualityAlert.
+const qualityAlertName = "bosun.dataquality"
+
+// loadQualityAlert adds the built-in data quality alert, which warns for
+// each metric and problem found in relayed data points in the last 15
+// minutes.
+func (c *Conf) loadQualityAlert() {
+	c.at(nil)
+	if _, ok := c.Notifications[c.QualityAlert]; !ok {
+		c.errorf("qualityAlert: unknown notification %s", c.QualityAlert)
+	}
+	text := fmt.Sprintf(`template %[1]s {
+	subject = {{.Last.Status}}: {{.Group.problem}} data points for {{.Group.metric}}
+}
+
+alert %[1]s {
+	template = %[1]s
+	warn = quality("15m") > 0
+	warnNotification = %[2]s
+	ignoreUnknown = true
+}
+`, qualityAlertName, c.QualityAlert)
+	t, err := parse.Parse(qualityAlertName, text)
+	if err != nil {
+		c.error(err)
+	}
+	for _, n := range t.Root.Nodes {
+		c.at(n)
+		c.loadSection(n.(*parse.SectionNode))
+	}
+}
+
 func (c *Conf) loadGlobal(p *parse.PairNode) {
 	v := c.Expand(p.Val.Text, nil, false)
-	switch k := p.Key.Text; k {
+	switch k := c.deprecatedKey("", p); k {
 	case "checkFrequency":
 		od, err := opentsdb.ParseDuration(v)
 		if err != nil {
@@ -347,18 +688,242 @@ func (c *Conf) loadGlobal(p *parse.PairNode) {
 		c.CheckFrequency = d
 	case "tsdbHost":
 		c.TsdbHost = v
+	case "graphiteHost":
+		c.GraphiteHost = v
 	case "httpListen":
 		c.HttpListen = v
 	case "relayListen":
 		c.RelayListen = v
 	case "smtpHost":
 		c.SmtpHost = v
+	case "smtpUsername":
+		c.SmtpUsername = v
+	case "smtpPassword":
+		c.SmtpPassword = v
+	case "smtpTLS":
+		switch v {
+		case SmtpTLSStartTLS, SmtpTLSImplicit, SmtpTLSNone:
+			c.SmtpTLS = v
+		default:
+			c.errorf("smtpTLS must be one of %s, %s or %s", SmtpTLSStartTLS, SmtpTLSImplicit, SmtpTLSNone)
+		}
+	case "smtpPoolSize":
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			c.error(err)
+		}
+		if i < 0 {
+			c.errorf("smtpPoolSize must be >= 0")
+		}
+		c.SmtpPoolSize = i
 	case "emailFrom":
 		c.EmailFrom = v
+	case "breakerFailures":
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			c.error(err)
+		}
+		if i < 1 {
+			c.errorf("breakerFailures must be >= 1")
+		}
+		c.BreakerFailures = i
+	case "breakerCooldown":
+		d, err := opentsdb.ParseDuration(v)
+		if err != nil {
+			c.error(err)
+		}
+		c.BreakerCooldown = time.Duration(d)
 	case "stateFile":
 		c.StateFile = v
+	case "redisHost":
+		c.RedisHost = v
+	case "redisPassword":
+		c.RedisPassword = v
+	case "snapshotURL":
+		u, err := url.Parse(v)
+		if err != nil {
+			c.error(err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			c.errorf("snapshotURL must be an http or https URL")
+		}
+		c.SnapshotURL = v
+	case "snapshotRegion":
+		c.SnapshotRegion = v
+	case "snapshotAccessKey":
+		c.SnapshotAccessKey = v
+	case "snapshotSecretKey":
+		c.SnapshotSecretKey = v
+	case "snapshotInterval":
+		d, err := opentsdb.ParseDuration(v)
+		if err != nil {
+			c.error(err)
+		}
+		if time.Duration(d) < time.Minute {
+			c.errorf("snapshotInterval must be at least 1m")
+		}
+		c.SnapshotInterval = time.Duration(d)
+	case "snapshotRetain":
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			c.error(err)
+		}
+		if i < 1 {
+			c.errorf("snapshotRetain must be at least 1")
+		}
+		c.SnapshotRetain = i
+	case "notificationsPerMinute":
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			c.error(err)
+		}
+		if i < 0 {
+			c.errorf("notificationsPerMinute must not be negative")
+		}
+		c.NotifyPerMinute = i
+	case "reloadThreshold":
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			c.error(err)
+		}
+		if i < 0 {
+			c.errorf("reloadThreshold must not be negative")
+		}
+		c.ReloadThreshold = i
+	case "startupSuppress":
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			c.error(err)
+		}
+		if i < 0 {
+			c.errorf("startupSuppress must not be negative")
+		}
+		c.StartupSuppress = i
+	case "heartbeatMetric":
+		c.HeartbeatMetric = v
+	case "heartbeatTag":
+		c.HeartbeatTag = v
+	case "heartbeatTimeout":
+		d, err := opentsdb.ParseDuration(v)
+		if err != nil {
+			c.error(err)
+		}
+		c.HeartbeatTimeout = time.Duration(d)
+	case "provisionGrace":
+		d, err := opentsdb.ParseDuration(v)
+		if err != nil {
+			c.error(err)
+		}
+		c.ProvisionGrace = time.Duration(d)
+	case "provisionTag":
+		c.ProvisionTag = v
+	case "archiveDuration":
+		d, err := opentsdb.ParseDuration(v)
+		if err != nil {
+			c.error(err)
+		}
+		c.ArchiveDuration = time.Duration(d)
 	case "ping":
 		c.Ping = true
+	case "alertMetrics":
+		c.AlertMetrics = true
+	case "shardMembers":
+		// Membership is fixed: the alerts of a member that stops checking
+		// are not taken over by the others, which only report it with the
+		// shardAlert notification once it has missed three check cycles.
+		// Each member keeps its own state file. With redisHost, members
+		// instead register in Redis by shardName and share their state.
+		for _, m := range strings.Split(v, ",") {
+			if m = strings.TrimSpace(m); m != "" {
+				c.ShardMembers = append(c.ShardMembers, m)
+			}
+		}
+	case "shardName":
+		c.ShardName = v
+	case "shardAlert":
+		c.ShardAlert = v
+	case "embedKey":
+		c.EmbedKey = v
+	case "embedAncestors":
+		c.EmbedAncestors = v
+	case "embedOrigin":
+		c.EmbedOrigin = v
+	case "embedRefresh":
+		od, err := opentsdb.ParseDuration(v)
+		if err != nil {
+			c.error(err)
+		}
+		if d := time.Duration(od); d < time.Second*10 {
+			c.errorf("embedRefresh must be at least 10s")
+		}
+		c.EmbedRefresh = time.Duration(od)
+	case "apiToken":
+		c.APIToken = v
+	case "qualityFuture", "qualityPast":
+		d, err := opentsdb.ParseDuration(v)
+		if err != nil {
+			c.error(err)
+		}
+		if k == "qualityFuture" {
+			c.QualityFuture = time.Duration(d)
+		} else {
+			c.QualityPast = time.Duration(d)
+		}
+	case "qualityAlert":
+		c.QualityAlert = v
+	case "memoryLimit":
+		b, err := ParseByteSize(v)
+		if err != nil {
+			c.error(err)
+		}
+		c.MemoryLimit = b
+	case "memoryProfileDir":
+		c.MemoryProfileDir = v
+	case "memoryDropCaches":
+		c.MemoryDropCaches = true
+	case "memoryAlert":
+		c.MemoryAlert = v
+	case "mailListen":
+		c.MailListen = v
+	case "mailKey":
+		c.MailKey = v
+	case "evaluatorURL":
+		u, err := url.Parse(v)
+		if err != nil {
+			c.error(err)
+		}
+		if u.Host == "" {
+			c.errorf("evaluatorURL must be an absolute URL")
+		}
+		c.EvaluatorURL = u
+	case "eventHook":
+		u, err := url.Parse(v)
+		if err != nil {
+			c.error(err)
+		}
+		c.EventHook = u
+	case "changeURL":
+		t, err := ttemplate.New("changeURL").Parse(v)
+		if err != nil {
+			c.error(err)
+		}
+		c.ChangeURL = v
+		c.changeURL = t
+	case "changeCache":
+		d, err := opentsdb.ParseDuration(v)
+		if err != nil {
+			c.error(err)
+		}
+		c.ChangeCache = time.Duration(d)
+	case "changeAction":
+		switch v {
+		case "suppress":
+			c.ChangeAnnotate = false
+		case "annotate":
+			c.ChangeAnnotate = true
+		default:
+			c.errorf("changeAction must be suppress or annotate")
+		}
 	case "timeAndDate":
 		sp := strings.Split(v, ",")
 		var t []int
@@ -379,6 +944,24 @@ func (c *Conf) loadGlobal(p *parse.PairNode) {
 			c.errorf("responseLimit must be > 0")
 		}
 		c.ResponseLimit = i
+	case "maxGroups":
+		c.MaxGroups = c.parseMaxGroups(v)
+	case "ruleTestWorkers", "ruleTestPerUser", "ruleTestQueue":
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			c.error(err)
+		}
+		if i < 1 {
+			c.errorf("%s must be >= 1", k)
+		}
+		switch k {
+		case "ruleTestWorkers":
+			c.RuleTestWorkers = i
+		case "ruleTestPerUser":
+			c.RuleTestPerUser = i
+		case "ruleTestQueue":
+			c.RuleTestQueue = i
+		}
 	case "unknownTemplate":
 		c.unknownTemplate = v
 		t, ok := c.Templates[c.unknownTemplate]
@@ -386,6 +969,38 @@ func (c *Conf) loadGlobal(p *parse.PairNode) {
 			c.errorf("template not found: %s", c.unknownTemplate)
 		}
 		c.UnknownTemplate = t
+	case "holidays":
+		cal := c.calendar()
+		for _, d := range strings.Split(v, ",") {
+			d = strings.TrimSpace(d)
+			if _, err := time.Parse("2006-01-02", d); err != nil {
+				c.errorf("bad holiday %q: must be formatted as 2006-01-02", d)
+			}
+			cal.Holidays[d] = true
+		}
+	case "businessTimezone":
+		loc, err := time.LoadLocation(v)
+		if err != nil {
+			c.error(err)
+		}
+		c.calendar().Location = loc
+	case "exclude":
+		sp := strings.Split(v, ",")
+		if len(sp) != 2 {
+			c.errorf("exclude must be two times separated by a comma")
+		}
+		var r expr.TimeRange
+		var err error
+		if r.Start, err = parseExcludeTime(sp[0]); err != nil {
+			c.error(err)
+		}
+		if r.End, err = parseExcludeTime(sp[1]); err != nil {
+			c.error(err)
+		}
+		if r.End.Before(r.Start) {
+			c.errorf("exclude end before start")
+		}
+		c.Exclusions = append(c.Exclusions, r)
 	case "squelch":
 		c.squelch = append(c.squelch, v)
 		if err := c.Squelch.Add(v); err != nil {
@@ -395,11 +1010,24 @@ func (c *Conf) loadGlobal(p *parse.PairNode) {
 		if !strings.HasPrefix(k, "$") {
 			c.errorf("unknown key %s", k)
 		}
-		c.Vars[k] = v
+		// Variables keep encrypted values encrypted; they are decrypted
+		// where they are expanded into a setting.
+		c.Vars[k] = c.expand(p.Val.Text, nil, false, false)
 		c.Vars[k[1:]] = c.Vars[k]
 	}
 }
 
+// parseExcludeTime parses a UTC time in one of the formats 2006-01-02 15:04 or
+// 2006-01-02.
+func parseExcludeTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	t, err := time.Parse("2006-01-02 15:04", s)
+	if err != nil {
+		t, err = time.Parse("2006-01-02", s)
+	}
+	return t, err
+}
+
 func (c *Conf) loadSection(s *parse.SectionNode) {
 	switch s.SectionType.Text {
 	case "template":
@@ -412,6 +1040,20 @@ func (c *Conf) loadSection(s *parse.SectionNode) {
 		c.loadMacro(s)
 	case "lookup":
 		c.loadLookup(s)
+	case "roster":
+		c.loadRoster(s)
+	case "alias":
+		c.loadAlias(s)
+	case "relay":
+		c.loadRelay(s)
+	case "inhibit":
+		c.loadInhibit(s)
+	case "provider":
+		c.loadProvider(s)
+	case "log":
+		c.loadLog(s)
+	case "tsdb":
+		c.loadTSDBRoute(s)
 	default:
 		c.errorf("unknown section type: %s", s.SectionType.Text)
 	}
@@ -453,8 +1095,9 @@ func (c *Conf) getPairs(s *parse.SectionNode, vars Vars, st sectionType, used *[
 		c.at(n)
 		switch n := n.(type) {
 		case *parse.PairNode:
-			v := c.Expand(n.Val.Text, vars, ignoreBadExpand)
-			switch k := n.Key.Text; k {
+			v := c.expand(n.Val.Text, vars, ignoreBadExpand, !strings.HasPrefix(n.Key.Text, "$"))
+			v = c.deprecatedFuncs(n, v)
+			switch k := c.deprecatedKey(s.SectionType.Text, n); k {
 			case "macro":
 				m, ok := c.Macros[v]
 				if !ok {
@@ -464,7 +1107,7 @@ func (c *Conf) getPairs(s *parse.SectionNode, vars Vars, st sectionType, used *[
 					*used = append(*used, v)
 				}
 				for _, p := range m.Pairs {
-					add(p.node, p.key, c.Expand(p.val, vars, ignoreBadExpand))
+					add(p.node, p.key, c.expand(p.val, vars, ignoreBadExpand, !strings.HasPrefix(p.key, "$")))
 				}
 			default:
 				add(n, k, v)
@@ -543,6 +1186,24 @@ func (c *Conf) loadLookup(s *parse.SectionNode) {
 	c.Lookups[name] = &l
 }
 
+func (c *Conf) loadAlias(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Aliases[name]; ok {
+		c.errorf("duplicate alias name: %s", name)
+	}
+	a := Alias{
+		Def:    s.RawText,
+		Name:   name,
+		Values: make(map[string]string),
+	}
+	for _, p := range c.getPairs(s, nil, sNormal, nil) {
+		c.at(p.node)
+		a.Values[p.key] = p.val
+	}
+	c.at(s)
+	c.Aliases[name] = &a
+}
+
 func (c *Conf) loadMacro(s *parse.SectionNode) {
 	name := s.Name.Text
 	if _, ok := c.Macros[name]; ok {
@@ -595,9 +1256,11 @@ func (c *Conf) loadTemplate(s *parse.SectionNode) {
 	}
 	funcs := ttemplate.FuncMap{
 		"V": func(v string) string {
-			return c.Expand(v, t.Vars, false)
+			return c.expand(v, t.Vars, false, false)
 		},
 	}
+	var parent *Template
+	var bodyNode, subjectNode, textBodyNode parse.Node = s, s, s
 	saw := make(map[string]bool)
 	for _, p := range s.Nodes.Nodes {
 		c.at(p)
@@ -608,20 +1271,19 @@ func (c *Conf) loadTemplate(s *parse.SectionNode) {
 			switch k := p.Key.Text; k {
 			case "body":
 				t.body = v
-				tmpl := c.bodies.New(name).Funcs(htemplate.FuncMap(funcs))
-				_, err := tmpl.Parse(t.body)
-				if err != nil {
-					c.error(err)
-				}
-				t.Body = tmpl
+				bodyNode = p
 			case "subject":
 				t.subject = v
-				tmpl := c.subjects.New(name).Funcs(funcs)
-				_, err := tmpl.Parse(t.subject)
-				if err != nil {
-					c.error(err)
+				subjectNode = p
+			case "textBody":
+				t.textBody = v
+				textBodyNode = p
+			case "inherit":
+				t.inherit = v
+				parent = c.Templates[v]
+				if parent == nil {
+					c.errorf("template not found: %s", v)
 				}
-				t.Subject = tmpl
 			default:
 				if !strings.HasPrefix(k, "$") {
 					c.errorf("unknown key %s", k)
@@ -633,9 +1295,70 @@ func (c *Conf) loadTemplate(s *parse.SectionNode) {
 			c.errorf("unexpected node")
 		}
 	}
+	if parent != nil {
+		for k, v := range parent.Vars {
+			if _, ok := t.Vars[k]; !ok {
+				t.Vars[k] = v
+			}
+		}
+	}
+	// A template that inherits executes its parent's body and subject. Its own
+	// body and subject are only used for the {{define}} blocks they contain,
+	// which replace the parent's blocks of the same name.
+	c.at(bodyNode)
+	if parent != nil && parent.Body != nil {
+		b, err := parent.Body.Clone()
+		if err != nil {
+			c.error(err)
+		}
+		if _, err := b.New(name).Funcs(htemplate.FuncMap(funcs)).Parse(t.body); err != nil {
+			c.error(err)
+		}
+		t.Body = b.Lookup(parent.Body.Name())
+	} else if t.body != "" {
+		tmpl := c.bodies.New(name).Funcs(htemplate.FuncMap(funcs))
+		if _, err := tmpl.Parse(t.body); err != nil {
+			c.error(err)
+		}
+		t.Body = tmpl
+	}
+	c.at(subjectNode)
+	if parent != nil && parent.Subject != nil {
+		sub, err := parent.Subject.Clone()
+		if err != nil {
+			c.error(err)
+		}
+		if _, err := sub.New(name).Funcs(funcs).Parse(t.subject); err != nil {
+			c.error(err)
+		}
+		t.Subject = sub.Lookup(parent.Subject.Name())
+	} else if t.subject != "" {
+		tmpl := c.subjects.New(name).Funcs(funcs)
+		if _, err := tmpl.Parse(t.subject); err != nil {
+			c.error(err)
+		}
+		t.Subject = tmpl
+	}
+	c.at(textBodyNode)
+	if parent != nil && parent.TextBody != nil {
+		tb, err := parent.TextBody.Clone()
+		if err != nil {
+			c.error(err)
+		}
+		if _, err := tb.New(name).Funcs(funcs).Parse(t.textBody); err != nil {
+			c.error(err)
+		}
+		t.TextBody = tb.Lookup(parent.TextBody.Name())
+	} else if t.textBody != "" {
+		tmpl := c.textBodies.New(name).Funcs(funcs)
+		if _, err := tmpl.Parse(t.textBody); err != nil {
+			c.error(err)
+		}
+		t.TextBody = tmpl
+	}
 	c.at(s)
-	if t.Body == nil && t.Subject == nil {
-		c.errorf("neither body or subject specified")
+	if t.Body == nil && t.Subject == nil && t.TextBody == nil {
+		c.errorf("neither body, subject or textBody specified")
 	}
 	c.Templates[name] = &t
 }
@@ -648,12 +1371,14 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 		c.errorf("duplicate alert name: %s", name)
 	}
 	a := Alert{
-		Def:              s.RawText,
-		Vars:             make(map[string]string),
-		Name:             name,
-		Macros:           make([]string, 0),
-		CritNotification: new(Notifications),
-		WarnNotification: new(Notifications),
+		Def:               s.RawText,
+		Vars:              make(map[string]string),
+		Name:              name,
+		Macros:            make([]string, 0),
+		CritNotification:  new(Notifications),
+		WarnNotification:  new(Notifications),
+		InfoNotification:  new(Notifications),
+		FatalNotification: new(Notifications),
 	}
 	procNotification := func(v string, ns *Notifications) {
 		if lookup := lookupNotificationRE.FindStringSubmatch(v); lookup != nil {
@@ -691,6 +1416,17 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 	for _, p := range c.getPairs(s, a.Vars, sNormal, &a.Macros) {
 		c.at(p.node)
 		v := p.val
+		if strings.HasPrefix(p.key, "label ") {
+			l := strings.TrimPrefix(p.key, "label ")
+			if !opentsdb.ValidTag(l) {
+				c.errorf("invalid label name: %s", l)
+			}
+			if a.Labels == nil {
+				a.Labels = make(map[string]string)
+			}
+			a.Labels[l] = v
+			continue
+		}
 		switch p.key {
 		case "template":
 			a.template = v
@@ -725,6 +1461,32 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 				c.errorf("warn must return a number")
 			}
 			a.Warn = warn
+		case "info":
+			a.info = v
+			info, err := expr.New(a.info)
+			if err != nil {
+				c.error(err)
+			}
+			switch info.Root.Return() {
+			case eparse.TYPE_NUMBER, eparse.TYPE_SCALAR:
+				// break
+			default:
+				c.errorf("info must return a number")
+			}
+			a.Info = info
+		case "severity":
+			a.severity = v
+			sev, err := expr.New(a.severity)
+			if err != nil {
+				c.error(err)
+			}
+			switch sev.Root.Return() {
+			case eparse.TYPE_NUMBER, eparse.TYPE_SCALAR:
+				// break
+			default:
+				c.errorf("severity must return a number")
+			}
+			a.Severity = sev
 		case "squelch":
 			a.squelch = append(a.squelch, v)
 			if err := a.Squelch.Add(v); err != nil {
@@ -734,6 +1496,10 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 			procNotification(v, a.CritNotification)
 		case "warnNotification":
 			procNotification(v, a.WarnNotification)
+		case "infoNotification":
+			procNotification(v, a.InfoNotification)
+		case "fatalNotification":
+			procNotification(v, a.FatalNotification)
 		case "unknown":
 			od, err := opentsdb.ParseDuration(v)
 			if err != nil {
@@ -748,13 +1514,42 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 			a.UnjoinedOK = true
 		case "ignoreUnknown":
 			a.IgnoreUnknown = true
+		case "maxGroups":
+			a.MaxGroups = c.parseMaxGroups(v)
+		case "depends":
+			for _, d := range strings.Split(v, ",") {
+				if d = strings.TrimSpace(d); d != "" {
+					a.Depends = append(a.Depends, d)
+				}
+			}
+		case "dedup":
+			a.Dedup = v
+		case "anchorPeriod", "anchorOffset":
+			d, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			if k := p.key; k == "anchorPeriod" {
+				if d < opentsdb.Duration(time.Second) {
+					c.errorf("anchorPeriod must be at least 1s")
+				}
+				a.AnchorPeriod = time.Duration(d)
+			} else {
+				a.AnchorOffset = time.Duration(d)
+			}
 		default:
 			c.errorf("unknown key %s", p.key)
 		}
 	}
 	c.at(s)
-	if a.Crit == nil && a.Warn == nil {
-		c.errorf("neither crit or warn specified")
+	if a.Severity != nil && (a.Crit != nil || a.Warn != nil || a.Info != nil) {
+		c.errorf("severity cannot be used with crit, warn or info")
+	}
+	if a.Crit == nil && a.Warn == nil && a.Info == nil && a.Severity == nil {
+		c.errorf("neither crit, warn or info specified")
+	}
+	if a.AnchorOffset != 0 && a.AnchorOffset >= a.AnchorPeriod {
+		c.errorf("anchorOffset must be less than anchorPeriod")
 	}
 	c.Alerts[name] = &a
 }
@@ -765,9 +1560,12 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 		c.errorf("duplicate notification name: %s", name)
 	}
 	n := Notification{
-		Def:  s.RawText,
-		Vars: make(map[string]string),
-		Name: name,
+		Def:            s.RawText,
+		Vars:           make(map[string]string),
+		Name:           name,
+		ContentType:    "application/x-www-form-urlencoded",
+		RetryDelay:     time.Second,
+		RequestTimeout: time.Second * 30,
 	}
 	funcs := ttemplate.FuncMap{
 		"V": func(v string) string {
@@ -776,7 +1574,7 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 		"json": func(v interface{}) string {
 			b, err := json.Marshal(v)
 			if err != nil {
-				log.Println(err)
+				slog.Errorln(err)
 			}
 			return string(b)
 		},
@@ -787,15 +1585,29 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 		v := p.val
 		switch k := p.key; k {
 		case "email":
-			if c.SmtpHost == "" || c.EmailFrom == "" {
-				c.errorf("email notifications require both smtpHost and emailFrom to be set")
-			}
 			n.email = v
 			email, err := mail.ParseAddressList(n.email)
 			if err != nil {
 				c.error(err)
 			}
 			n.Email = email
+		case "roster":
+			n.roster = v
+			r, ok := c.Rosters[n.roster]
+			if !ok {
+				c.errorf("unknown roster %s", n.roster)
+			}
+			n.Roster = r
+		case "emailFrom":
+			if _, err := mail.ParseAddress(v); err != nil {
+				c.error(err)
+			}
+			n.From = v
+		case "replyTo":
+			if _, err := mail.ParseAddress(v); err != nil {
+				c.error(err)
+			}
+			n.ReplyTo = v
 		case "post":
 			n.post = v
 			post, err := url.Parse(n.post)
@@ -810,6 +1622,22 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 				c.error(err)
 			}
 			n.Get = get
+		case "slack":
+			n.slack = v
+			slack, err := url.Parse(n.slack)
+			if err != nil {
+				c.error(err)
+			}
+			n.Slack = slack
+		case "slackChannel":
+			n.Channel = v
+		case "slackText":
+			n.slackText = v
+			tmpl := ttemplate.New(name).Funcs(funcs)
+			if _, err := tmpl.Parse(n.slackText); err != nil {
+				c.error(err)
+			}
+			n.SlackText = tmpl
 		case "print":
 			n.Print = true
 		case "next":
@@ -819,12 +1647,74 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 				c.errorf("unknown notification %s", n.next)
 			}
 			n.Next = next
+		case "provider":
+			n.provider = v
+			p, ok := c.Providers[n.provider]
+			if !ok {
+				c.errorf("unknown provider %s", n.provider)
+			}
+			n.Provider = p
+		case "fallback":
+			n.fallback = v
+			fallback, ok := c.Notifications[n.fallback]
+			if !ok {
+				c.errorf("unknown notification %s", n.fallback)
+			}
+			n.Fallback = fallback
+		case "maxSubject", "maxBody":
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				c.error(err)
+			}
+			if i < 20 {
+				c.errorf("%s must be at least 20", k)
+			}
+			if k == "maxSubject" {
+				n.MaxSubject = i
+			} else {
+				n.MaxBody = i
+			}
 		case "timeout":
 			d, err := opentsdb.ParseDuration(v)
 			if err != nil {
 				c.error(err)
 			}
 			n.Timeout = time.Duration(d)
+		case "contentType":
+			n.ContentType = v
+		case "retries":
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				c.error(err)
+			}
+			if i < 0 {
+				c.errorf("retries must be >= 0")
+			}
+			n.Retries = i
+		case "retryDelay", "requestTimeout":
+			d, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			if d <= 0 {
+				c.errorf("%s must be positive", k)
+			}
+			if k == "retryDelay" {
+				n.RetryDelay = time.Duration(d)
+			} else {
+				n.RequestTimeout = time.Duration(d)
+			}
+		case "timezone":
+			loc, err := time.LoadLocation(v)
+			if err != nil {
+				c.error(err)
+			}
+			n.Location = loc
+		case "locale":
+			if _, ok := dateLayouts[v]; !ok {
+				c.errorf("unknown locale %s", v)
+			}
+			n.Locale = v
 		case "body":
 			n.body = v
 			tmpl := ttemplate.New(name).Funcs(funcs)
@@ -838,14 +1728,52 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 		}
 	}
 	c.at(s)
+	if (n.Email != nil || n.Roster != nil) && (c.SmtpHost == "" || (c.EmailFrom == "" && n.From == "")) {
+		c.errorf("email notifications require both smtpHost and emailFrom to be set")
+	}
+	if (n.Channel != "" || n.SlackText != nil) && n.Slack == nil {
+		c.errorf("slackChannel and slackText require slack")
+	}
 	if n.Timeout > 0 && n.Next == nil {
 		c.errorf("timeout specified without next")
 	}
 }
 
+func (c *Conf) calendar() *expr.Calendar {
+	if c.Calendar == nil {
+		c.Calendar = &expr.Calendar{Holidays: make(map[string]bool)}
+	}
+	return c.Calendar
+}
+
+func (c *Conf) parseMaxGroups(v string) int {
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		c.error(err)
+	}
+	if i <= 0 {
+		c.errorf("maxGroups must be > 0")
+	}
+	return i
+}
+
+// GroupLimit returns the maximum number of groups a's expressions may
+// return, or 0 if unlimited.
+func (c *Conf) GroupLimit(a *Alert) int {
+	if a.MaxGroups > 0 {
+		return a.MaxGroups
+	}
+	return c.MaxGroups
+}
+
 var exRE = regexp.MustCompile(`\$(?:[\w.]+|\{[\w.]+\})`)
 
 func (c *Conf) Expand(v string, vars map[string]string, ignoreBadExpand bool) string {
+	return c.expand(v, vars, ignoreBadExpand, true)
+}
+
+// expand is Expand, but leaves encrypted values encrypted unless dec is set.
+func (c *Conf) expand(v string, vars map[string]string, ignoreBadExpand, dec bool) string {
 	ss := exRE.ReplaceAllStringFunc(v, func(s string) string {
 		var n string
 		if strings.HasPrefix(s, "${") && strings.HasSuffix(s, "}") {
@@ -866,15 +1794,18 @@ func (c *Conf) Expand(v string, vars map[string]string, ignoreBadExpand bool) st
 			}
 			c.errorf("unknown variable %s", s)
 		}
-		return c.Expand(n, vars, ignoreBadExpand)
+		return c.expand(n, vars, ignoreBadExpand, dec)
 	})
-	return ss
+	if !dec {
+		return ss
+	}
+	return c.decrypt(ss)
 }
 
 func (c *Conf) seen(v string, m map[string]bool) {
 	if m[v] {
 		switch v {
-		case "squelch", "critNotification", "warnNotification":
+		case "squelch", "critNotification", "warnNotification", "fatalNotification", "exclude", "holidays":
 			// ignore
 		default:
 			c.errorf("duplicate key: %s", v)
@@ -959,6 +1890,16 @@ func (c *Conf) AlertTemplateStrings() (*AlertTemplateStrings, error) {
 			return nil
 		}
 		parseSection = func(s *Template) error {
+			if s == nil {
+				// Blocks declared with {{define}} are not conf templates.
+				return nil
+			}
+			if p := c.Templates[s.inherit]; p != nil && !incl[p.Name] {
+				incl[p.Name] = true
+				if err := parseSection(p); err != nil {
+					return err
+				}
+			}
 			if s.Body != nil {
 				if err := parseTemplate(s.Body.Tree.Root.String()); err != nil {
 					return err
@@ -969,6 +1910,11 @@ func (c *Conf) AlertTemplateStrings() (*AlertTemplateStrings, error) {
 					return err
 				}
 			}
+			if s.TextBody != nil {
+				if err := parseTemplate(s.TextBody.Tree.Root.String()); err != nil {
+					return err
+				}
+			}
 			return nil
 		}
 		if err := parseSection(template); err != nil {
@@ -976,6 +1922,11 @@ func (c *Conf) AlertTemplateStrings() (*AlertTemplateStrings, error) {
 		}
 		delete(incl, name)
 		templates[name] = template.Def
+		// Parents must be defined before the templates that inherit them.
+		for p := c.Templates[template.inherit]; p != nil; p = c.Templates[p.inherit] {
+			delete(incl, p.Name)
+			templates[name] = p.Def + "\n\n" + templates[name]
+		}
 		for n := range incl {
 			t := c.Templates[n]
 			if t == nil {
@@ -1033,6 +1984,12 @@ func (c *Conf) AlertTemplateStrings() (*AlertTemplateStrings, error) {
 		if alert.WarnNotification != nil {
 			walkNotifications(alert.WarnNotification)
 		}
+		if alert.InfoNotification != nil {
+			walkNotifications(alert.InfoNotification)
+		}
+		if alert.FatalNotification != nil {
+			walkNotifications(alert.FatalNotification)
+		}
 		add(alert.Macros)
 		if alert.Crit != nil {
 			walk(alert.Crit.Tree.Root)
@@ -1040,6 +1997,12 @@ func (c *Conf) AlertTemplateStrings() (*AlertTemplateStrings, error) {
 		if alert.Warn != nil {
 			walk(alert.Warn.Tree.Root)
 		}
+		if alert.Info != nil {
+			walk(alert.Info.Tree.Root)
+		}
+		if alert.Severity != nil {
+			walk(alert.Severity.Tree.Root)
+		}
 		alerts[name] += alert.Def
 		if alert.Template != nil {
 			t_associations[alert.Name] = alert.Template.Name
@@ -1,13 +1,24 @@
 package conf
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/expr"
 )
 
 func TestPrint(t *testing.T) {
@@ -36,6 +47,12 @@ func TestPrint(t *testing.T) {
 		t.Errorf("bad lookup: %v", w)
 	}
 	checkMacroVarAlert(t, c.Alerts["macroVarAlert"])
+	if a := c.Alias("dc", "ny"); a != "New York" {
+		t.Errorf("bad alias: %v", a)
+	}
+	if a := c.Alias("dc", "la"); a != "la" {
+		t.Errorf("bad unaliased value: %v", a)
+	}
 }
 
 func checkMacroVarAlert(t *testing.T, a *Alert) {
@@ -61,11 +78,231 @@ func checkMacroVarAlert(t *testing.T, a *Alert) {
 	}
 }
 
+func TestTemplateInherit(t *testing.T) {
+	c, err := New("inherit", `
+		tsdbHost = localhost:4242
+		template footer {
+			body = <p>footer</p>
+		}
+		template base {
+			$team = ops
+			body = <h1>{{template "content" .}}</h1>{{template "footer" .}}{{define "content"}}base{{end}}
+			subject = [{{V "$team"}}] {{template "summary" .}}{{define "summary"}}base{{end}}
+			textBody = <{{template "text" .}}>{{define "text"}}base{{end}}
+		}
+		template child {
+			inherit = base
+			body = {{define "content"}}child{{end}}
+			subject = {{define "summary"}}child subject{{end}}
+			textBody = {{define "text"}}child text{{end}}
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := new(bytes.Buffer)
+	if err := c.Templates["child"].Body.Execute(body, nil); err != nil {
+		t.Fatal(err)
+	}
+	if b := body.String(); b != "<h1>child</h1><p>footer</p>" {
+		t.Errorf("bad child body: %s", b)
+	}
+	body.Reset()
+	if err := c.Templates["base"].Body.Execute(body, nil); err != nil {
+		t.Fatal(err)
+	}
+	if b := body.String(); b != "<h1>base</h1><p>footer</p>" {
+		t.Errorf("bad base body: %s", b)
+	}
+	subject := new(bytes.Buffer)
+	if err := c.Templates["child"].Subject.Execute(subject, nil); err != nil {
+		t.Fatal(err)
+	}
+	if s := subject.String(); s != "[ops] child subject" {
+		t.Errorf("bad child subject: %s", s)
+	}
+	text := new(bytes.Buffer)
+	if err := c.Templates["child"].TextBody.Execute(text, nil); err != nil {
+		t.Fatal(err)
+	}
+	if s := text.String(); s != "<child text>" {
+		t.Errorf("bad child text body: %s", s)
+	}
+	if c.Templates["child"].Vars["team"] != "ops" {
+		t.Errorf("vars not inherited: %v", c.Templates["child"].Vars)
+	}
+	ts, err := c.AlertTemplateStrings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New("rule", "tsdbHost = localhost:4242\n"+ts.Templates["child"]); err != nil {
+		t.Errorf("template strings for child do not parse: %v", err)
+	}
+}
+
+func TestDeprecated(t *testing.T) {
+	defer func(d []deprecation) { deprecations = d }(deprecations)
+	deprecations = []deprecation{
+		{Old: "tsdb", New: "tsdbHost"},
+		{Section: "alert", Old: "critical", New: "crit"},
+		{Old: "mean", New: "avg", Func: true},
+	}
+	c, err := New("deprecated", `tsdb = localhost:4242
+macro m {
+	critical = mean(q("avg:m", "5m", "")) > 1
+}
+alert a {
+	macro = m
+}
+alert b {
+	critical = 1
+	warn = mean(q("avg:m", "5m", "")) > mean(q("avg:m", "5m", ""))
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.TsdbHost != "localhost:4242" {
+		t.Errorf("deprecated key not applied: %q", c.TsdbHost)
+	}
+	if len(c.Warnings) != 6 {
+		t.Errorf("expected 6 warnings, got %d: %v", len(c.Warnings), c.Warnings)
+	}
+	expect := `tsdbHost = localhost:4242
+macro m {
+	crit = avg(q("avg:m", "5m", "")) > 1
+}
+alert a {
+	macro = m
+}
+alert b {
+	crit = 1
+	warn = avg(q("avg:m", "5m", "")) > avg(q("avg:m", "5m", ""))
+}
+`
+	if m := c.Migrate(); m != expect {
+		t.Errorf("bad migration:\n%s", m)
+	}
+}
+
+func TestDeprecatedSeconds(t *testing.T) {
+	c, err := New("seconds", `tsdbHost = localhost:4242
+alert a {
+	crit = since(q("avg:m", "5m", "")) > seconds("2m")
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Warnings) != 1 {
+		t.Errorf("expected 1 warning, got %v", c.Warnings)
+	}
+	if m := c.Migrate(); !strings.Contains(m, `> d("2m")`) {
+		t.Errorf("bad migration:\n%s", m)
+	}
+}
+
+func TestRelay(t *testing.T) {
+	c, err := New("relay", `
+		tsdbHost = localhost:4242
+		relay keep {
+			metric = test.keep.*
+			action = allow
+		}
+		relay test {
+			metric = test.*
+			action = drop
+		}
+		relay debug {
+			tags = debug=*
+			action = rewrite
+			strip = debug
+		}
+		relay dev {
+			tags = env=dev*
+			action = drop
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		metric string
+		tags   opentsdb.TagSet
+		keep   bool
+		rule   string
+	}{
+		{"test.keep.a", nil, true, ""},
+		{"test.a", nil, false, "test"},
+		{"os.cpu", opentsdb.TagSet{"host": "a", "debug": "1"}, true, ""},
+		{"os.cpu", opentsdb.TagSet{"env": "dev1", "debug": "1"}, false, "dev"},
+	}
+	for _, test := range tests {
+		dp := &opentsdb.DataPoint{Metric: test.metric, Tags: test.tags}
+		keep, rule := c.Relay(dp)
+		if keep != test.keep || rule != test.rule {
+			t.Errorf("%s %v: got %v %q", test.metric, test.tags, keep, rule)
+		}
+		if _, ok := dp.Tags["debug"]; ok {
+			t.Errorf("%s %v: debug tag not stripped", test.metric, test.tags)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		in     string
+		max    int
+		expect string
+	}{
+		{"short", 20, "short"},
+		{"short", 0, "short"},
+		{"summary\nhost1\nhost2\nhost3\nhost4\nhost5\n", 30, "summary\nhost1\n... and 4 more"},
+		{"a single line that is too long", 20, "a single line tha..."},
+		{"ééééééééééééé", 20, "éééééééé..."},
+	}
+	for _, test := range tests {
+		got := string(truncate([]byte(test.in), test.max))
+		if got != test.expect {
+			t.Errorf("%q %d: expected %q, got %q", test.in, test.max, test.expect, got)
+		}
+		if test.max > 0 && len(got) > test.max {
+			t.Errorf("%q %d: result too long: %d", test.in, test.max, len(got))
+		}
+	}
+}
+
+func TestTruncateHTML(t *testing.T) {
+	const note = "<p>... truncated</p>"
+	tests := []struct {
+		in     string
+		max    int
+		expect string
+	}{
+		{"<p>short</p>", 100, "<p>short</p>"},
+		{"<p>short</p>", 0, "<p>short</p>"},
+		{"<table><tr><td>a</td></tr><tr><td>b</td></tr><tr><td>c</td></tr></table>", 60, "<table><tr><td>a</td></tr></table>" + note},
+		{"<div>x<br>y<img src=a.png/></div><p>" + strings.Repeat("z", 50) + "</p>", 55, "<div>x<br>y<img src=a.png/></div>" + note},
+		{"<style>td { color: red }</style><p>one</p><p>two</p><p>three</p>", 62, "<style>td { color: red }</style><p>one</p>" + note},
+		{"<!-- <b> --><b>bold</b><i>italic</i><i>more</i>", 45, "<!-- <b> --><b>bold</b>" + note},
+	}
+	for _, test := range tests {
+		got := string(truncateHTML([]byte(test.in), test.max))
+		if got != test.expect {
+			t.Errorf("%q %d: expected %q, got %q", test.in, test.max, test.expect, got)
+		}
+		if test.max > 0 && len(got) > test.max {
+			t.Errorf("%q %d: result too long: %d", test.in, test.max, len(got))
+		}
+	}
+}
+
 func TestInvalid(t *testing.T) {
 	names := map[string]string{
 		"lookup-key-pairs":     "conf: lookup-key-pairs:3:1: at <entry a=3 { }>: lookup tags mismatch, expected {a=,b=}",
 		"number-func-args":     `conf: number-func-args:2:1: at <warn = q("", "") > 0>: expr: parse: not enough arguments for q`,
 		"lookup-key-pairs-dup": `conf: lookup-key-pairs-dup:3:1: at <entry b=2,a=1 { }>: duplicate entry`,
+		"anchor-offset":        "conf: anchor-offset:2:0: at <alert late {\\n\twarn ...>: anchorOffset must be less than anchorPeriod",
 	}
 	for fname, reason := range names {
 		path := filepath.Join("invalid", fname)
@@ -145,3 +382,663 @@ func TestSquelch(t *testing.T) {
 		}
 	}
 }
+
+func TestBreaker(t *testing.T) {
+	c := &Conf{BreakerFailures: 2, BreakerCooldown: time.Hour}
+	fail := errors.New("fail")
+	c.record("n", "email", fail)
+	if !c.allow("n", "email") {
+		t.Fatal("circuit opened after one failure")
+	}
+	c.record("n", "email", fail)
+	if c.allow("n", "email") {
+		t.Fatal("circuit closed after two failures")
+	}
+	if !c.allow("n", "post") {
+		t.Fatal("post circuit opened by email failures")
+	}
+	// Expire the cooldown: one trial is allowed, then the circuit holds.
+	c.breaker("n", "email").until = time.Now().Add(-time.Second)
+	if !c.allow("n", "email") {
+		t.Fatal("no trial after cooldown")
+	}
+	if c.allow("n", "email") {
+		t.Fatal("second send allowed during trial")
+	}
+	c.record("n", "email", nil)
+	if !c.allow("n", "email") {
+		t.Fatal("circuit open after successful trial")
+	}
+	if cs := c.Circuits(); len(cs) != 2 || cs[0].Transport != "email" || cs[0].Open {
+		t.Fatalf("unexpected circuits: %+v", cs)
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	c, err := New("test", "tsdbHost = localhost:4242\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.ArchiveDuration == 0 || c.HeartbeatTag != "host" || c.HeartbeatTimeout == 0 {
+		t.Errorf("missing defaults: archiveDuration %v, heartbeatTag %q, heartbeatTimeout %v", c.ArchiveDuration, c.HeartbeatTag, c.HeartbeatTimeout)
+	}
+}
+
+func TestEncrypted(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, 32)
+	v, err := Encrypt(key, []byte("hunter2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv(envConfKey, base64.StdEncoding.EncodeToString(key))
+	defer os.Unsetenv(envConfKey)
+	c, err := New("test", "tsdbHost = localhost:4242\n$pw = "+v+"\nsmtpHost = localhost:25\nsmtpPassword = $pw\n"+
+		"template t {\n\tsubject = s\n\tbody = {{V \"$pw\"}}\n}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.SmtpPassword != "hunter2" {
+		t.Errorf("expected decrypted password, got %q", c.SmtpPassword)
+	}
+	if c.Vars["$pw"] != v {
+		t.Errorf("expected variable to stay encrypted, got %q", c.Vars["$pw"])
+	}
+	body := new(bytes.Buffer)
+	if err := c.Templates["t"].Body.Execute(body, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(body.String(), encPrefix) {
+		t.Errorf("expected V to leave the value encrypted, got %q", body.String())
+	}
+	os.Setenv(envConfKey, base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32)))
+	if _, err := New("test", "tsdbHost = localhost:4242\nsmtpPassword = "+v+"\n"); err == nil {
+		t.Error("expected error decrypting with wrong key")
+	}
+}
+
+func TestLocale(t *testing.T) {
+	c, err := New("test", `tsdbHost = localhost:4242
+notification ny {
+	print = true
+	timezone = America/New_York
+	locale = en_US
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := time.Date(2015, 1, 2, 15, 4, 5, 0, time.UTC)
+	if s := c.Notifications["ny"].FormatTime(ts); s != "Fri Jan 2, 2015 10:04:05 AM EST" {
+		t.Errorf("bad localized time: %s", s)
+	}
+	var n *Notification
+	if s := n.FormatTime(ts); s != "2015-01-02 15:04:05 UTC" {
+		t.Errorf("bad default time: %s", s)
+	}
+	if _, err := New("test", "tsdbHost = localhost:4242\nnotification n {\n\tprint = true\n\tlocale = xx\n}\n"); err == nil {
+		t.Error("expected error for unknown locale")
+	}
+}
+
+func TestQualityAlert(t *testing.T) {
+	c, err := New("test", `tsdbHost = localhost:4242
+qualityAlert = ops
+notification ops {
+	print = true
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := c.Alerts[qualityAlertName]
+	if a == nil || a.Warn == nil || a.WarnNotification.Notifications["ops"] == nil || !a.IgnoreUnknown {
+		t.Fatalf("bad built-in alert: %+v", a)
+	}
+	if _, err := New("test", "tsdbHost = localhost:4242\nqualityAlert = missing\n"); err == nil {
+		t.Error("expected error for unknown notification")
+	}
+}
+
+type echoProvider struct{}
+
+func (echoProvider) Send(m *Message) error {
+	if m.Vars["fail"] != "" {
+		return fmt.Errorf("failed %s", m.AlertKey)
+	}
+	fmt.Fprintf(os.Stderr, "%s %s\n", m.AlertKey, m.Subject)
+	return nil
+}
+
+// TestProviderHelper is run as a plugin process by TestProvider.
+func TestProviderHelper(t *testing.T) {
+	if os.Getenv("BOSUN_TEST_PROVIDER") == "" {
+		return
+	}
+	ServeProvider(echoProvider{})
+	os.Exit(0)
+}
+
+func TestProvider(t *testing.T) {
+	os.Setenv("BOSUN_TEST_PROVIDER", "1")
+	defer os.Unsetenv("BOSUN_TEST_PROVIDER")
+	c, err := New("test", fmt.Sprintf(`tsdbHost = localhost:4242
+provider echo {
+	command = %s -test.run=TestProviderHelper
+	timeout = 10s
+}
+notification ok {
+	provider = echo
+}
+notification fail {
+	$fail = yes
+	provider = echo
+}
+`, os.Args[0]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := c.Providers["echo"]
+	if err := p.Send(&Message{AlertKey: "a{host=x}", Subject: "down"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Send(&Message{AlertKey: "a{host=y}", Vars: c.Notifications["fail"].Vars}); err == nil || !strings.Contains(err.Error(), "failed a{host=y}") {
+		t.Errorf("expected plugin error, got %v", err)
+	}
+	// The plugin keeps running after an application error.
+	if err := p.Send(&Message{AlertKey: "a{host=z}"}); err != nil {
+		t.Error(err)
+	}
+	p.Close()
+	if err := p.Send(&Message{AlertKey: "a{host=z}"}); err == nil {
+		t.Error("expected error sending to closed provider")
+	}
+	if _, err := New("test", "tsdbHost = localhost:4242\nnotification n {\n\tprovider = missing\n}\n"); err == nil {
+		t.Error("expected error for unknown provider")
+	}
+}
+
+func TestParseByteSize(t *testing.T) {
+	for s, expect := range map[string]ByteSize{
+		"1024":   1024,
+		"512MB":  512 * MB,
+		"1.5 gb": 1.5 * GB,
+		"10B":    10,
+	} {
+		b, err := ParseByteSize(s)
+		if err != nil {
+			t.Errorf("%s: %v", s, err)
+		} else if b != expect {
+			t.Errorf("%s: expected %v, got %v", s, expect, b)
+		}
+	}
+	if _, err := ParseByteSize("lots"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMailMessageID(t *testing.T) {
+	c := &Conf{MailKey: "secret"}
+	id := c.MailMessageID("a{host=x}")
+	ak, ok := c.MailAlertKey("<other@example.com> " + id)
+	if !ok || ak != "a{host=x}" {
+		t.Fatalf("expected a{host=x} from %s, got %q", id, ak)
+	}
+	if _, ok := (&Conf{MailKey: "other"}).MailAlertKey(id); ok {
+		t.Error("accepted a Message-ID signed with another key")
+	}
+	if _, err := New("test", "tsdbHost = localhost:4242\nmailListen = :2525\n"); err == nil {
+		t.Error("expected error for mailListen without mailKey")
+	}
+}
+
+func TestSearchDefs(t *testing.T) {
+	c, err := New("test", `tsdbHost = localhost:4242
+
+template t {
+	body = disk full on {{.Group.host}}
+}
+
+alert disk {
+	template = t
+	crit = max(q("sum:os.disk.fs.percent_free{host=*}", "5m", "")) < 5
+}
+
+alert cpu {
+	crit = avg(q("sum:os.cpu{host=*}", "5m", "")) > 90
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := c.SearchDefs(regexp.MustCompile(`os\.disk`))
+	if len(m) != 1 || m[0].Type != "alert" || m[0].Name != "disk" {
+		t.Fatalf("unexpected matches: %+v", m)
+	}
+	if l := m[0].Lines; len(l) != 1 || l[0].Line != 9 || !strings.Contains(l[0].Text, "os.disk") {
+		t.Errorf("unexpected lines: %+v", l)
+	}
+	if m := c.SearchDefs(regexp.MustCompile(`disk`)); len(m) != 2 {
+		t.Errorf("expected template and alert, got %+v", m)
+	}
+}
+
+func TestLogSinks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bosun-log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "bosun.log")
+	c, err := New("test", fmt.Sprintf(`tsdbHost = localhost:4242
+log file {
+	type = file
+	path = %s
+	maxSize = 100B
+	maxFiles = 2
+	format = json
+	level = warning
+}
+`, path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := c.OpenLogs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Fprintln(w, "starting up")
+	fmt.Fprintln(w, "connection failed without a level")
+	for i := 0; i < 4; i++ {
+		fmt.Fprintf(w, "error: query %d failed\n", i)
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var line struct{ Level, Msg string }
+	if err := json.Unmarshal(bytes.SplitN(b, []byte("\n"), 2)[0], &line); err != nil {
+		t.Fatal(err)
+	}
+	if line.Level != "error" || line.Msg != "query 3 failed" {
+		t.Errorf("unexpected line: %+v", line)
+	}
+	for _, name := range []string{".1", ".2"} {
+		b, err := ioutil.ReadFile(path + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Contains(b, []byte("starting up")) || bytes.Contains(b, []byte("without a level")) {
+			t.Errorf("info line written to warning sink: %s", b)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Error("expected only 2 rotated files")
+	}
+	if _, err := New("test", "tsdbHost = localhost:4242\nlog l {\n\ttype = file\n}\n"); err == nil {
+		t.Error("expected error for file log without path")
+	}
+}
+
+func TestRotateFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bosun-log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "bosun.log")
+	// A non-empty directory at path.1 can be neither removed nor replaced.
+	if err := os.MkdirAll(filepath.Join(path+".1", "x"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	f := &rotatingFile{path: path, maxSize: 10, maxFiles: 1}
+	if err := f.open(); err != nil {
+		t.Fatal(err)
+	}
+	f.Write([]byte("first line\n"))
+	if _, err := f.Write([]byte("second line\n")); err == nil {
+		t.Error("expected rotation error")
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "first line\nsecond line\n" {
+		t.Errorf("expected writes to continue to the current file, got %q", b)
+	}
+}
+
+func TestLabels(t *testing.T) {
+	c, err := New("test", `tsdbHost = localhost:4242
+macro m {
+	label tier = gold
+}
+alert a {
+	macro = m
+	label env = prod
+	crit = 1
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := c.Alerts["a"].Labels; len(l) != 2 || l["env"] != "prod" || l["tier"] != "gold" {
+		t.Errorf("unexpected labels: %v", l)
+	}
+	if _, err := New("test", "tsdbHost = localhost:4242\nalert a {\n\tlabel bad,name = x\n\tcrit = 1\n}\n"); err == nil {
+		t.Error("expected error for invalid label name")
+	}
+	if _, err := New("test", "tsdbHost = localhost:4242\nlabel env = prod\n"); err == nil {
+		t.Error("expected error for label outside an alert")
+	}
+}
+
+func TestDepends(t *testing.T) {
+	c, err := New("test", `tsdbHost = localhost:4242
+alert a {
+	depends = c, b
+	crit = 1
+}
+alert b {
+	depends = c
+	crit = 1
+}
+alert c {
+	crit = 1
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	alerts, err := c.AlertsByDependency()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, a := range alerts {
+		names = append(names, a.Name)
+	}
+	if s := strings.Join(names, ","); s != "c,b,a" {
+		t.Errorf("unexpected order: %s", s)
+	}
+	for _, text := range []string{
+		"alert a {\n\tdepends = b\n\tcrit = 1\n}\nalert b {\n\tdepends = a\n\tcrit = 1\n}\n",
+		"alert a {\n\tdepends = missing\n\tcrit = 1\n}\n",
+	} {
+		if _, err := New("test", "tsdbHost = localhost:4242\n"+text); err == nil {
+			t.Errorf("expected error for:\n%s", text)
+		}
+	}
+}
+
+func TestTSDBRoutes(t *testing.T) {
+	c, err := New("test", `tsdbHost = tsdb:4242
+tsdb ny {
+	tags = dc=ny
+	host = tsdb-ny:4242
+}
+tsdb lon {
+	tags = dc=lon
+	host = tsdb-lon:4242
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := func(tags ...opentsdb.TagSet) *opentsdb.Request {
+		r := &opentsdb.Request{}
+		for _, t := range tags {
+			r.Queries = append(r.Queries, &opentsdb.Query{Metric: "m", Aggregator: "sum", Tags: t})
+		}
+		return r
+	}
+	tests := []struct {
+		r     *opentsdb.Request
+		hosts string
+		err   bool
+	}{
+		{query(opentsdb.TagSet{"dc": "ny", "host": "*"}), "tsdb-ny:4242", false},
+		{query(opentsdb.TagSet{"dc": "lon"}, opentsdb.TagSet{"dc": "lon"}), "tsdb-lon:4242", false},
+		{query(opentsdb.TagSet{"dc": "ny"}, opentsdb.TagSet{"dc": "lon"}), "tsdb-ny:4242,tsdb-lon:4242", false},
+		{query(opentsdb.TagSet{"dc": "*"}), "tsdb-ny:4242,tsdb-lon:4242", false},
+		{query(opentsdb.TagSet{"dc": "ny|lon"}), "tsdb-ny:4242,tsdb-lon:4242", false},
+		{query(nil), "", true},
+		{query(opentsdb.TagSet{"dc": "ny"}, opentsdb.TagSet{"host": "*"}), "", true},
+	}
+	for i, test := range tests {
+		h, err := c.TSDBHosts(test.r)
+		if test.err {
+			if err == nil {
+				t.Errorf("%v: expected error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: %v", i, err)
+		}
+		if hosts := strings.Join(h, ","); hosts != test.hosts {
+			t.Errorf("%v: expected %s, got %s", i, test.hosts, hosts)
+		}
+	}
+	if _, err := New("test", "tsdbHost = tsdb:4242\ntsdb ny {\n\ttags = dc=*\n\thost = tsdb-ny:4242\n}\n"); err == nil {
+		t.Errorf("expected error for wildcard route tag")
+	}
+}
+
+func TestPostRetries(t *testing.T) {
+	var tries int
+	var got, ctype string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tries++
+		if tries < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		got, ctype = string(b), r.Header.Get("Content-Type")
+	}))
+	defer ts.Close()
+	c, err := New("test", `tsdbHost = localhost:4242
+notification hook {
+	post = `+ts.URL+`
+	contentType = application/json
+	retries = 2
+	retryDelay = 1ms
+	body = {"subject": {{json .Subject}}, "key": {{json .AlertKey}}, "incident": {{.Incident}}, "old": "{{.}}"}
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := c.Notifications["hook"]
+	if err := n.DoPost(&PostData{Subject: "down", AlertKey: "a{host=x}", Incident: 7}); err != nil {
+		t.Fatal(err)
+	}
+	if tries != 3 {
+		t.Errorf("expected 3 tries, got %d", tries)
+	}
+	if expect := `{"subject": "down", "key": "a{host=x}", "incident": 7, "old": "down"}`; got != expect {
+		t.Errorf("bad body: %s", got)
+	}
+	if ctype != "application/json" {
+		t.Errorf("bad content type: %s", ctype)
+	}
+	tries = -10
+	if err := n.DoPost(&PostData{Subject: "down"}); err == nil {
+		t.Error("expected error after retries")
+	}
+	if tries != -7 {
+		t.Errorf("expected 3 tries, got %d", tries+10)
+	}
+}
+
+func TestSlack(t *testing.T) {
+	var got slackMessage
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer ts.Close()
+	c, err := New("test", `tsdbHost = localhost:4242
+notification chat {
+	slack = `+ts.URL+`
+	slackChannel = #ops
+	slackText = [{{.Status}}] {{.Subject}}
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Notifications["chat"].DoSlack(&PostData{Subject: "disk full", AlertKey: "a{host=x}", Status: "critical", Text: "details"}); err != nil {
+		t.Fatal(err)
+	}
+	if got.Channel != "#ops" || got.Text != "[critical] disk full" || len(got.Attachments) != 1 {
+		t.Fatalf("unexpected message: %+v", got)
+	}
+	if a := got.Attachments[0]; a.Color != "danger" || a.Title != "a{host=x}" || a.Text != "details" || a.Fallback != "disk full" {
+		t.Errorf("unexpected attachment: %+v", a)
+	}
+	if _, err := New("test", "tsdbHost = localhost:4242\nnotification chat {\n\tslackChannel = #ops\n}\n"); err == nil {
+		t.Error("expected error for slackChannel without slack")
+	}
+}
+
+func TestRoutingDiff(t *testing.T) {
+	const base = `tsdbHost = localhost:4242
+notification ops {
+	print = true
+}
+notification dba {
+	print = true
+}
+template t {
+	subject = s
+}
+lookup team {
+	entry host=db* {
+		n = dba
+	}
+	entry host=* {
+		n = ops
+	}
+}
+`
+	old, err := New("old", base+`
+alert a {
+	template = t
+	crit = 1
+	critNotification = lookup("team", "n")
+}
+alert b {
+	crit = 1
+	critNotification = ops
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	new, err := New("new", base+`
+alert a {
+	template = t
+	crit = 1
+	critNotification = ops
+}
+alert c {
+	crit = 1
+	critNotification = ops
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := []expr.AlertKey{"b{host=web1}", "a{host=web1}", "a{host=db1}"}
+	var got []string
+	for _, rc := range RoutingDiff(old, new, keys) {
+		got = append(got, fmt.Sprintf("%s %v->%v", rc.AlertKey, rc.Old != nil, rc.New != nil))
+	}
+	if s := strings.Join(got, ","); s != "a{host=db1} true->true,b{host=web1} true->false" {
+		t.Errorf("unexpected changes: %s", s)
+	}
+	if r := new.Route("a{host=db1}"); r.Template != "t" || len(r.Crit) != 1 || r.Crit[0] != "ops" || r.Fatal[0] != "ops" {
+		t.Errorf("unexpected route: %+v", r)
+	}
+}
+
+func TestRoster(t *testing.T) {
+	body := `["carol@example.com"]`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if body == "" {
+			http.Error(w, "down", http.StatusServiceUnavailable)
+			return
+		}
+		io.WriteString(w, body)
+	}))
+	defer ts.Close()
+	c, err := New("test", `tsdbHost = localhost:4242
+smtpHost = localhost:25
+emailFrom = bosun@example.com
+roster ops {
+	rotation = alice@example.com, bob@example.com
+	start = 2026-01-05T09:00:00Z
+	shift = 1w
+}
+roster db {
+	url = `+ts.URL+`
+}
+notification ops {
+	email = lead@example.com
+	roster = ops
+}
+notification db {
+	roster = db
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	week := time.Hour * 24 * 7
+	for _, test := range []struct {
+		t      time.Time
+		expect string
+	}{
+		{start, "alice@example.com"},
+		{start.Add(week - time.Second), "alice@example.com"},
+		{start.Add(week), "bob@example.com"},
+		{start.Add(2 * week), "alice@example.com"},
+		{start.Add(-time.Second), "bob@example.com"},
+		{start.Add(-week - time.Second), "alice@example.com"},
+	} {
+		r := c.Notifications["ops"].Recipients(test.t)
+		if len(r) != 2 || r[0].Address != "lead@example.com" || r[1].Address != test.expect {
+			t.Errorf("%v: expected lead and %s, got %v", test.t, test.expect, r)
+		}
+	}
+	if r := c.Notifications["db"].Recipients(time.Now()); len(r) != 1 || r[0].Address != "carol@example.com" {
+		t.Errorf("unexpected recipients: %v", r)
+	}
+	body = "dave@example.com, erin@example.com"
+	if r := c.Notifications["db"].Recipients(time.Now()); len(r) != 2 || r[1].Address != "erin@example.com" {
+		t.Errorf("unexpected recipients: %v", r)
+	}
+	body = ""
+	if r, err := c.Rosters["db"].OnCall(time.Now()); err == nil || len(r) != 2 {
+		t.Errorf("expected an error and the last recipients, got %v, %v", r, err)
+	}
+	if r := c.Notifications["db"].LastRecipients(time.Now()); len(r) != 2 || r[1].Address != "erin@example.com" {
+		t.Errorf("unexpected last recipients: %v", r)
+	}
+	if r := c.Notifications["ops"].LastRecipients(start); len(r) != 2 || r[1].Address != "alice@example.com" {
+		t.Errorf("unexpected last recipients: %v", r)
+	}
+	for _, bad := range []string{
+		"roster r {\n\tshift = 1w\n}\n",
+		"roster r {\n\trotation = a@example.com\n}\n",
+		"roster r {\n\trotation = a@example.com\n\turl = http://localhost\n\tstart = 2026-01-05T09:00:00Z\n\tshift = 1d\n}\n",
+		"notification n {\n\troster = missing\n}\n",
+	} {
+		if _, err := New("test", "tsdbHost = localhost:4242\n"+bad); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
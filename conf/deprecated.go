@@ -0,0 +1,103 @@
+package conf
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/bosun-monitor/bosun/conf/parse"
+)
+
+// A deprecation marks a conf key or expression function as replaced. The old
+// name is still accepted, but parsing it records a warning on the Conf and
+// Migrate rewrites it to the new name.
+type deprecation struct {
+	// Section is the section type the key belongs to (alert, notification,
+	// ...), or empty for global keys. It is unused for functions.
+	Section string
+	Old     string
+	New     string
+	Func    bool // Old and New are expression functions, not keys
+}
+
+// deprecations lists all deprecated keys and functions. Entries should stay
+// here for at least one release after they are added.
+var deprecations = []deprecation{
+	// seconds is the same function as d, which is shorter and documented.
+	{Old: "seconds", New: "d", Func: true},
+}
+
+type edit struct {
+	pos      int
+	old, new string
+}
+
+// deprecatedKey returns the key of p, replaced by its new name if the key is
+// deprecated in section, and records a warning. Keys in macros are checked
+// against all sections.
+func (c *Conf) deprecatedKey(section string, p *parse.PairNode) string {
+	for _, d := range deprecations {
+		if d.Func || d.Old != p.Key.Text {
+			continue
+		}
+		if d.Section != section && section != "macro" {
+			continue
+		}
+		c.deprecated(p.Key, int(p.Key.Pos), d.Old, d.New)
+		return d.New
+	}
+	return p.Key.Text
+}
+
+var funcRE = make(map[string]*regexp.Regexp)
+
+// deprecatedFuncs records a warning for each deprecated function called in
+// the value of p, and returns its expanded value v with those functions
+// renamed.
+func (c *Conf) deprecatedFuncs(p *parse.PairNode, v string) string {
+	for _, d := range deprecations {
+		if !d.Func {
+			continue
+		}
+		re := funcRE[d.Old]
+		if re == nil {
+			re = regexp.MustCompile(`\b` + regexp.QuoteMeta(d.Old) + `\s*\(`)
+			funcRE[d.Old] = re
+		}
+		for _, m := range re.FindAllStringIndex(p.Val.Quoted, -1) {
+			c.deprecated(p.Val, int(p.Val.Pos)+m[0], d.Old, d.New)
+		}
+		v = re.ReplaceAllString(v, d.New+"(")
+	}
+	return v
+}
+
+func (c *Conf) deprecated(n parse.Node, pos int, old, new string) {
+	for _, e := range c.edits {
+		if e.pos == pos {
+			return
+		}
+	}
+	c.edits = append(c.edits, edit{pos, old, new})
+	location, _ := c.tree.ErrorContext(n)
+	c.Warnings = append(c.Warnings, fmt.Sprintf("conf: %s: %s is deprecated, use %s", location, old, new))
+}
+
+// Migrate returns the conf text with all deprecated keys and functions
+// replaced by their new names.
+func (c *Conf) Migrate() string {
+	edits := make([]edit, len(c.edits))
+	copy(edits, c.edits)
+	sort.Sort(sort.Reverse(editsByPos(edits)))
+	text := c.RawText
+	for _, e := range edits {
+		text = text[:e.pos] + e.new + text[e.pos+len(e.old):]
+	}
+	return text
+}
+
+type editsByPos []edit
+
+func (e editsByPos) Len() int           { return len(e) }
+func (e editsByPos) Swap(i, j int)      { e[i], e[j] = e[j], e[i] }
+func (e editsByPos) Less(i, j int) bool { return e[i].pos < e[j].pos }
@@ -0,0 +1,132 @@
+package conf
+
+import (
+	"path"
+	"strings"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/conf/parse"
+)
+
+// An InhibitRule suppresses notifications of target alert keys while a
+// matching source alert key is at or above a status. If Equal is set, the
+// source and target must also have the same values for those tag keys.
+type InhibitRule struct {
+	Def          string
+	Name         string
+	SourceAlert  string          // Glob matched against the source alert name
+	SourceTags   opentsdb.TagSet // Globs matched against source tag values
+	SourceStatus string          // warning or critical; defaults to critical
+	TargetAlert  string          // Glob matched against the target alert name
+	TargetTags   opentsdb.TagSet // Globs matched against target tag values
+	Equal        []string        // Tag keys whose values must be equal
+}
+
+func (c *Conf) loadInhibit(s *parse.SectionNode) {
+	name := s.Name.Text
+	for _, r := range c.InhibitRules {
+		if r.Name == name {
+			c.errorf("duplicate inhibit name: %s", name)
+		}
+	}
+	r := InhibitRule{
+		Def:          s.RawText,
+		Name:         name,
+		SourceAlert:  "*",
+		SourceStatus: "critical",
+		TargetAlert:  "*",
+	}
+	globs := func(v string) opentsdb.TagSet {
+		tags, err := opentsdb.ParseTags(v)
+		if tags == nil && err != nil {
+			c.error(err)
+		}
+		for _, g := range tags {
+			if _, err := path.Match(g, ""); err != nil {
+				c.error(err)
+			}
+		}
+		return tags
+	}
+	for _, p := range c.getPairs(s, nil, sNormal, nil) {
+		c.at(p.node)
+		v := p.val
+		switch k := p.key; k {
+		case "sourceAlert", "targetAlert":
+			if _, err := path.Match(v, ""); err != nil {
+				c.error(err)
+			}
+			if k == "sourceAlert" {
+				r.SourceAlert = v
+			} else {
+				r.TargetAlert = v
+			}
+		case "sourceTags":
+			r.SourceTags = globs(v)
+		case "targetTags":
+			r.TargetTags = globs(v)
+		case "sourceStatus":
+			switch v {
+			case "warning", "critical":
+				r.SourceStatus = v
+			default:
+				c.errorf("sourceStatus must be warning or critical")
+			}
+		case "equal":
+			for _, t := range strings.Split(v, ",") {
+				if t = strings.TrimSpace(t); t != "" {
+					r.Equal = append(r.Equal, t)
+				}
+			}
+		default:
+			c.errorf("unknown key %s", k)
+		}
+	}
+	c.at(s)
+	if r.SourceAlert == "*" && len(r.SourceTags) == 0 {
+		c.errorf("inhibit requires sourceAlert or sourceTags")
+	}
+	if r.TargetAlert == "*" && len(r.TargetTags) == 0 {
+		c.errorf("inhibit requires targetAlert or targetTags")
+	}
+	c.InhibitRules = append(c.InhibitRules, &r)
+}
+
+func globMatch(alertGlob string, tagGlobs opentsdb.TagSet, alert string, tags opentsdb.TagSet) bool {
+	if ok, _ := path.Match(alertGlob, alert); !ok {
+		return false
+	}
+	for k, g := range tagGlobs {
+		v, present := tags[k]
+		if !present {
+			return false
+		}
+		if ok, _ := path.Match(g, v); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSource returns true if an alert key of alert with tags matches the
+// source of the rule.
+func (r *InhibitRule) IsSource(alert string, tags opentsdb.TagSet) bool {
+	return globMatch(r.SourceAlert, r.SourceTags, alert, tags)
+}
+
+// IsTarget returns true if an alert key of alert with tags matches the
+// target of the rule.
+func (r *InhibitRule) IsTarget(alert string, tags opentsdb.TagSet) bool {
+	return globMatch(r.TargetAlert, r.TargetTags, alert, tags)
+}
+
+// EqualTags returns true if a and b have the same values for the Equal tag
+// keys of the rule.
+func (r *InhibitRule) EqualTags(a, b opentsdb.TagSet) bool {
+	for _, k := range r.Equal {
+		if a[k] != b[k] {
+			return false
+		}
+	}
+	return true
+}
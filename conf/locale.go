@@ -0,0 +1,37 @@
+package conf
+
+import "time"
+
+// dateLayouts maps the locales accepted by a notification's locale key to
+// their date layout. Layouts are numeric where month and day names would
+// otherwise need translating.
+var dateLayouts = map[string]string{
+	"":      "2006-01-02 15:04:05 MST",
+	"iso":   "2006-01-02 15:04:05 MST",
+	"en_US": "Mon Jan 2, 2006 3:04:05 PM MST",
+	"en_GB": "Mon 2 Jan 2006 15:04:05 MST",
+	"de_DE": "02.01.2006 15:04:05 MST",
+	"fr_FR": "02/01/2006 15:04:05 MST",
+	"es_ES": "02/01/2006 15:04:05 MST",
+	"nl_NL": "02-01-2006 15:04:05 MST",
+	"ja_JP": "2006/01/02 15:04:05 MST",
+	"zh_CN": "2006-01-02 15:04:05 MST",
+}
+
+// In returns t in n's timezone. A nil notification, as used when rendering
+// templates outside of a notification, means UTC.
+func (n *Notification) In(t time.Time) time.Time {
+	if n == nil || n.Location == nil {
+		return t.UTC()
+	}
+	return t.In(n.Location)
+}
+
+// FormatTime formats t in n's timezone with the layout of its locale.
+func (n *Notification) FormatTime(t time.Time) string {
+	layout := dateLayouts[""]
+	if n != nil {
+		layout = dateLayouts[n.Locale]
+	}
+	return n.In(t).Format(layout)
+}
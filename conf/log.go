@@ -0,0 +1,295 @@
+package conf
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bosun-monitor/bosun/conf/parse"
+)
+
+// A LogLevel is the severity of a log line.
+type LogLevel int
+
+const (
+	LogInfo LogLevel = iota
+	LogWarning
+	LogError
+)
+
+var logLevels = map[string]LogLevel{
+	"info":    LogInfo,
+	"warning": LogWarning,
+	"error":   LogError,
+}
+
+func (l LogLevel) String() string {
+	for k, v := range logLevels {
+		if v == l {
+			return k
+		}
+	}
+	return "unknown"
+}
+
+// logLevel returns the level of a line written with the standard log
+// package and the line without its level. The level is given explicitly by a
+// leading "error: ", "warning: " or "info: ", as written by slog; lines
+// without one are info.
+func logLevel(line string) (LogLevel, string) {
+	if strings.HasPrefix(line, "fatal: ") {
+		return LogError, line[len("fatal: "):]
+	}
+	for k, v := range logLevels {
+		if strings.HasPrefix(line, k+": ") {
+			return v, line[len(k)+2:]
+		}
+	}
+	return LogInfo, line
+}
+
+// A LogSink is a destination for the log, defined by a log section.
+type LogSink struct {
+	Def      string
+	Name     string
+	Type     string   // file, syslog, stdout or stderr
+	Path     string   // File of file sinks
+	MaxSize  ByteSize // Size at which a file is rotated; 0 never rotates
+	MaxFiles int      // Rotated files kept besides the current one
+	Tag      string   // Syslog tag
+	Format   string   // text or json
+	Level    LogLevel // Least severe level written
+}
+
+func (c *Conf) loadLog(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.LogSinks[name]; ok {
+		c.errorf("duplicate log name: %s", name)
+	}
+	l := LogSink{
+		Def:      s.RawText,
+		Name:     name,
+		MaxFiles: 5,
+		Tag:      "bosun",
+		Format:   "text",
+	}
+	for _, pair := range c.getPairs(s, nil, sNormal, nil) {
+		c.at(pair.node)
+		v := pair.val
+		switch k := pair.key; k {
+		case "type":
+			switch v {
+			case "file", "syslog", "stdout", "stderr":
+				l.Type = v
+			default:
+				c.errorf("unknown log type %s", v)
+			}
+		case "path":
+			l.Path = v
+		case "maxSize":
+			b, err := ParseByteSize(v)
+			if err != nil {
+				c.error(err)
+			}
+			l.MaxSize = b
+		case "maxFiles":
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				c.error(err)
+			}
+			if i < 1 {
+				c.errorf("maxFiles must be at least 1")
+			}
+			l.MaxFiles = i
+		case "tag":
+			l.Tag = v
+		case "format":
+			if v != "text" && v != "json" {
+				c.errorf("unknown log format %s", v)
+			}
+			l.Format = v
+		case "level":
+			lv, ok := logLevels[v]
+			if !ok {
+				c.errorf("unknown log level %s", v)
+			}
+			l.Level = lv
+		default:
+			c.errorf("unknown key %s", k)
+		}
+	}
+	c.at(s)
+	if l.Type == "" {
+		c.errorf("log requires type")
+	}
+	if l.Type == "file" && l.Path == "" {
+		c.errorf("file log requires path")
+	}
+	c.LogSinks[name] = &l
+}
+
+// OpenLogs opens the log sinks and returns a LogWriter that sends each line
+// to the sinks whose level it meets. It is meant for log.SetOutput with no
+// log flags, since it adds timestamps itself, and for slog.Set.
+func (c *Conf) OpenLogs() (*LogWriter, error) {
+	w := new(LogWriter)
+	for _, l := range c.LogSinks {
+		s := &logSinkWriter{LogSink: l}
+		switch l.Type {
+		case "file":
+			f := &rotatingFile{path: l.Path, maxSize: int64(l.MaxSize), maxFiles: l.MaxFiles}
+			if err := f.open(); err != nil {
+				return nil, err
+			}
+			s.w = f
+		case "syslog":
+			sw, err := openSyslog(l.Tag)
+			if err != nil {
+				return nil, err
+			}
+			s.syslog = sw
+		case "stdout":
+			s.w = os.Stdout
+		case "stderr":
+			s.w = os.Stderr
+		}
+		w.sinks = append(w.sinks, s)
+	}
+	return w, nil
+}
+
+// A LogWriter writes each log line to all sinks whose level it meets.
+type LogWriter struct {
+	sinks []*logSinkWriter
+}
+
+// Write writes lines from the standard log package, with levels as given
+// by logLevel.
+func (w *LogWriter) Write(b []byte) (int, error) {
+	level, line := logLevel(strings.TrimRight(string(b), "\n"))
+	w.log(level, line)
+	return len(b), nil
+}
+
+func (w *LogWriter) Error(v string)   { w.log(LogError, v) }
+func (w *LogWriter) Warning(v string) { w.log(LogWarning, v) }
+func (w *LogWriter) Info(v string)    { w.log(LogInfo, v) }
+
+// Fatal logs v as an error. The caller, such as slog.Fatal, exits.
+func (w *LogWriter) Fatal(v string) { w.log(LogError, v) }
+
+func (w *LogWriter) log(level LogLevel, line string) {
+	line = strings.TrimRight(line, "\n")
+	now := time.Now()
+	for _, s := range w.sinks {
+		if level >= s.Level {
+			s.write(now, level, line)
+		}
+	}
+}
+
+// syslogWriter is the part of *syslog.Writer used by log sinks, so that
+// syslog can be left out where it is not supported.
+type syslogWriter interface {
+	Err(m string) error
+	Warning(m string) error
+	Info(m string) error
+}
+
+type logSinkWriter struct {
+	*LogSink
+	sync.Mutex
+	w      io.Writer
+	syslog syslogWriter
+}
+
+// write writes line to the sink. Errors are dropped, since there is nowhere
+// to log them.
+func (s *logSinkWriter) write(now time.Time, level LogLevel, line string) {
+	s.Lock()
+	defer s.Unlock()
+	if s.syslog != nil {
+		switch level {
+		case LogError:
+			s.syslog.Err(line)
+		case LogWarning:
+			s.syslog.Warning(line)
+		default:
+			s.syslog.Info(line)
+		}
+		return
+	}
+	var b []byte
+	if s.Format == "json" {
+		b, _ = json.Marshal(struct {
+			Time  time.Time `json:"time"`
+			Level string    `json:"level"`
+			Msg   string    `json:"msg"`
+		}{now.UTC(), level.String(), line})
+		b = append(b, '\n')
+	} else {
+		b = []byte(now.Format("2006/01/02 15:04:05 ") + level.String() + ": " + line + "\n")
+	}
+	s.w.Write(b)
+}
+
+// rotatingFile is a file that is renamed to path.1, path.1 to path.2 and
+// so on, keeping maxFiles, once it would grow beyond maxSize. If rotation
+// fails, writes continue to the current file.
+type rotatingFile struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+	f        *os.File
+	size     int64
+}
+
+func (r *rotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	r.f, r.size = f, fi.Size()
+	return nil
+}
+
+func (r *rotatingFile) Write(b []byte) (int, error) {
+	var rerr error
+	if r.maxSize > 0 && r.size > 0 && r.size+int64(len(b)) > r.maxSize {
+		rerr = r.rotate()
+	}
+	n, err := r.f.Write(b)
+	r.size += int64(n)
+	if err == nil {
+		err = rerr
+	}
+	return n, err
+}
+
+// rotate renames the files and opens a new one at path. The current file is
+// kept open until the new one is, so that a failed rotation loses nothing.
+func (r *rotatingFile) rotate() error {
+	os.Remove(fmt.Sprintf("%s.%d", r.path, r.maxFiles))
+	for i := r.maxFiles - 1; i > 0; i-- {
+		os.Rename(fmt.Sprintf("%s.%d", r.path, i), fmt.Sprintf("%s.%d", r.path, i+1))
+	}
+	if err := os.Rename(r.path, r.path+".1"); err != nil {
+		return err
+	}
+	old := r.f
+	if err := r.open(); err != nil {
+		return err
+	}
+	old.Close()
+	return nil
+}
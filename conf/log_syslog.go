@@ -0,0 +1,15 @@
+//go:build !windows
+// +build !windows
+
+package conf
+
+import "log/syslog"
+
+// openSyslog opens the local syslog daemon with tag.
+func openSyslog(tag string) (syslogWriter, error) {
+	w, err := syslog.New(syslog.LOG_INFO|syslog.LOG_DAEMON, tag)
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
@@ -0,0 +1,8 @@
+package conf
+
+import "fmt"
+
+// openSyslog fails, since windows has no syslog.
+func openSyslog(tag string) (syslogWriter, error) {
+	return nil, fmt.Errorf("syslog log sinks are not supported on windows")
+}
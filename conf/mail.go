@@ -0,0 +1,57 @@
+package conf
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// mailIDPrefix starts the local part of the Message-IDs of notification
+// emails sent while the mail gateway is enabled.
+const mailIDPrefix = "bosun."
+
+// MailMessageID returns a Message-ID for a notification email about alert
+// key ak. It encodes ak and is signed with mailKey, so that MailAlertKey can
+// recover ak from the In-Reply-To or References header of a reply.
+func (c *Conf) MailMessageID(ak string) string {
+	a := base64.RawURLEncoding.EncodeToString([]byte(ak))
+	ts := strconv.FormatInt(time.Now().UnixNano(), 36)
+	return fmt.Sprintf("<%s%s.%s.%s@bosun>", mailIDPrefix, a, ts, c.mailSign(a+"."+ts))
+}
+
+// MailAlertKey returns the alert key of the first correctly signed
+// Message-ID from MailMessageID in ids, which is a space-separated list such
+// as a References header.
+func (c *Conf) MailAlertKey(ids string) (string, bool) {
+	if c.MailKey == "" {
+		return "", false
+	}
+	for _, id := range strings.Fields(ids) {
+		id = strings.TrimSuffix(strings.TrimPrefix(id, "<"), ">")
+		at := strings.LastIndex(id, "@")
+		if at < 0 || !strings.HasPrefix(id, mailIDPrefix) {
+			continue
+		}
+		sp := strings.Split(id[len(mailIDPrefix):at], ".")
+		if len(sp) != 3 || !hmac.Equal([]byte(sp[2]), []byte(c.mailSign(sp[0]+"."+sp[1]))) {
+			continue
+		}
+		ak, err := base64.RawURLEncoding.DecodeString(sp[0])
+		if err != nil {
+			continue
+		}
+		return string(ak), true
+	}
+	return "", false
+}
+
+func (c *Conf) mailSign(s string) string {
+	h := hmac.New(sha256.New, []byte(c.MailKey))
+	h.Write([]byte(s))
+	return hex.EncodeToString(h.Sum(nil)[:12])
+}
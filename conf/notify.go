@@ -2,66 +2,317 @@ package conf
 
 import (
 	"bytes"
-	"crypto/tls"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"net/mail"
-	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/collect"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/jordan-wright/email"
 )
 
+// Notify sends subject and body through each transport of n. Transports
+// whose circuit is open are skipped, and the message goes to n's fallback
+// notification instead.
 func (n *Notification) Notify(subject, body []byte, c *Conf, ak string, attachments ...*Attachment) {
-	if len(n.Email) > 0 {
-		go n.DoEmail(subject, body, c, ak, attachments...)
+	n.notify(subject, body, nil, c, ak, "", 0, 0, attachments...)
+}
+
+// NotifyIncident is like Notify for a message about the given incident,
+// whose ID is passed on to email headers and providers. text is the plain
+// text body for providers, or nil if the template has none. status is the
+// status of the alert key, such as "critical".
+func (n *Notification) NotifyIncident(subject, body, text []byte, c *Conf, ak, status string, incident int64, attachments ...*Attachment) {
+	n.notify(subject, body, text, c, ak, status, incident, 0, attachments...)
+}
+
+// alertLabels returns the labels of the alert of alert key ak.
+func (c *Conf) alertLabels(ak string) map[string]string {
+	if i := strings.Index(ak, "{"); i >= 0 {
+		ak = ak[:i]
+	}
+	if a := c.Alerts[ak]; a != nil {
+		return a.Labels
+	}
+	return nil
+}
+
+// IncidentHeader is the email header carrying the incident ID of a
+// notification.
+const IncidentHeader = "X-Bosun-Incident"
+
+// maxFallback limits how many fallback notifications are chained.
+const maxFallback = 3
+
+func (n *Notification) notify(origSubject, origBody, origText []byte, c *Conf, ak, status string, incident int64, depth int, attachments ...*Attachment) {
+	subject := truncate(origSubject, n.MaxSubject)
+	body := truncateHTML(origBody, n.MaxBody)
+	text := truncate(origText, n.MaxBody)
+	tripped := false
+	send := func(transport string, f func() error) {
+		if !c.allow(n.Name, transport) {
+			tripped = true
+			return
+		}
+		go func() {
+			c.record(n.Name, transport, f())
+		}()
+	}
+	if len(n.Email) > 0 || n.Roster != nil {
+		send("email", func() error { return n.DoEmail(subject, body, c, ak, incident, attachments...) })
+	}
+	d := &PostData{
+		Subject:  string(subject),
+		Text:     string(text),
+		AlertKey: ak,
+		Status:   status,
+		Incident: incident,
+		Vars:     n.Vars,
+		Labels:   c.alertLabels(ak),
 	}
 	if n.Post != nil {
-		go n.DoPost(subject)
+		send("post", func() error { return n.DoPost(d) })
+	}
+	if n.Slack != nil {
+		send("slack", func() error { return n.DoSlack(d) })
 	}
 	if n.Get != nil {
-		go n.DoGet()
+		send("get", n.DoGet)
+	}
+	if n.Provider != nil {
+		send("provider", func() error {
+			return n.Provider.Send(&Message{
+				Notification: n.Name,
+				AlertKey:     ak,
+				Subject:      string(subject),
+				Body:         string(body),
+				Text:         string(text),
+				Status:       status,
+				Vars:         n.Vars,
+				Labels:       c.alertLabels(ak),
+				Incident:     incident,
+			})
+		})
 	}
 	if n.Print {
 		go n.DoPrint(subject)
 	}
+	if tripped && n.Fallback != nil {
+		if depth >= maxFallback {
+			slog.Warningf("notification %s: fallback chain too long, dropping alert %s", n.Name, ak)
+			return
+		}
+		n.Fallback.notify(origSubject, origBody, origText, c, ak, status, incident, depth+1, attachments...)
+	}
+}
+
+// truncate shortens b to at most max bytes. Text with several lines keeps as
+// many whole lines as fit, followed by a line saying how many were dropped, so
+// a leading summary and the first groups survive. A single line is cut and
+// ends with "...". A max of zero or less means no limit.
+func truncate(b []byte, max int) []byte {
+	if max <= 0 || len(b) <= max {
+		return b
+	}
+	lines := bytes.SplitAfter(b, []byte("\n"))
+	if len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	more := func(n int) string { return fmt.Sprintf("... and %d more", n) }
+	keep, size := 0, 0
+	for k := 1; k < len(lines); k++ {
+		size += len(lines[k-1])
+		if size+len(more(len(lines)-k)) > max {
+			break
+		}
+		keep = k
+	}
+	if keep > 0 {
+		out := bytes.Join(lines[:keep], nil)
+		return append(out, more(len(lines)-keep)...)
+	}
+	const ellipsis = "..."
+	i := max - len(ellipsis)
+	for i > 0 && !utf8.RuneStart(b[i]) {
+		i--
+	}
+	return append(append([]byte{}, b[:i]...), ellipsis...)
+}
+
+// voidElements are the HTML elements without closing tags.
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true,
+	"hr": true, "img": true, "input": true, "link": true, "meta": true,
+	"param": true, "source": true, "track": true, "wbr": true,
+}
+
+// truncateHTML shortens the HTML b to at most max bytes. It cuts between
+// tags and text rather than inside a tag, closes the elements left open, and
+// ends with a paragraph saying the rest was truncated. The contents of style
+// and script elements are kept whole. A max of zero or less means no limit.
+func truncateHTML(b []byte, max int) []byte {
+	if max <= 0 || len(b) <= max {
+		return b
+	}
+	const note = "<p>... truncated</p>"
+	var open []string
+	closers := func() string {
+		var s string
+		for i := len(open) - 1; i >= 0; i-- {
+			s += "</" + open[i] + ">"
+		}
+		return s
+	}
+	cut, end := 0, ""
+	for i := 0; i < len(b); {
+		var j int
+		if b[i] != '<' {
+			j = bytes.IndexByte(b[i:], '<')
+			if j < 0 {
+				j = len(b)
+			} else {
+				j += i
+			}
+		} else if bytes.HasPrefix(b[i:], []byte("<!--")) {
+			k := bytes.Index(b[i:], []byte("-->"))
+			if k < 0 {
+				break
+			}
+			j = i + k + len("-->")
+		} else {
+			k := bytes.IndexByte(b[i:], '>')
+			if k < 0 {
+				break
+			}
+			j = i + k + 1
+			tag := b[i:j]
+			name := strings.ToLower(string(bytes.TrimLeft(tag[1:], "/")))
+			if k := strings.IndexFunc(name, func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+			}); k >= 0 {
+				name = name[:k]
+			}
+			switch {
+			case name == "":
+				// Declarations such as <!DOCTYPE html>.
+			case tag[1] == '/':
+				for k := len(open) - 1; k >= 0; k-- {
+					if open[k] == name {
+						open = open[:k]
+						break
+					}
+				}
+			case name == "style" || name == "script":
+				k := bytes.Index(bytes.ToLower(b[j:]), []byte("</"+name))
+				if k < 0 {
+					break
+				}
+				j += k
+				if k = bytes.IndexByte(b[j:], '>'); k < 0 {
+					break
+				}
+				j += k + 1
+			case !voidElements[name] && !bytes.HasSuffix(tag, []byte("/>")):
+				open = append(open, name)
+			}
+		}
+		c := closers()
+		if j+len(c)+len(note) > max {
+			break
+		}
+		cut, end = j, c
+		i = j
+	}
+	out := append([]byte{}, b[:cut]...)
+	return append(append(out, end...), note...)
 }
 
 func (n *Notification) DoPrint(subject []byte) {
 	log.Println(string(subject))
 }
 
-func (n *Notification) DoPost(subject []byte) {
+// PostData is the data of the body template of a post notification. It
+// prints as the subject, so bodies written for the subject alone keep working.
+type PostData struct {
+	Subject  string
+	Text     string // Plain text body, if the alert template has one
+	AlertKey string
+	Status   string            `json:",omitempty"` // Status of the alert key, such as "critical"
+	Incident int64             `json:",omitempty"`
+	Vars     map[string]string // Variables of the notification section
+	Labels   map[string]string // Labels of the alert
+}
+
+func (d *PostData) String() string {
+	return d.Subject
+}
+
+// DoPost posts the rendered body template of n, or else the subject, to n's
+// post URL.
+func (n *Notification) DoPost(d *PostData) error {
+	body := []byte(d.Subject)
 	if n.Body != nil {
 		buf := new(bytes.Buffer)
-		if err := n.Body.Execute(buf, string(subject)); err != nil {
-			log.Println(err)
-			return
+		if err := n.Body.Execute(buf, d); err != nil {
+			slog.Errorln(err)
+			return err
 		}
-		subject = buf.Bytes()
-	}
-	resp, err := http.Post(n.Post.String(), "application/x-www-form-urlencoded", bytes.NewBuffer(subject))
-	if resp != nil && resp.Body != nil {
-		defer resp.Body.Close()
-	}
-	if err != nil {
-		log.Println(err)
-		return
-	}
-	if resp.StatusCode >= 300 {
-		log.Println("bad response on notification post:", resp.Status)
+		body = buf.Bytes()
 	}
+	return n.do("post", func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", n.Post.String(), bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", n.ContentType)
+		return req, nil
+	})
 }
 
-func (n *Notification) DoGet() {
-	resp, err := http.Get(n.Get.String())
-	if err != nil {
-		log.Println(err)
-		return
-	}
-	if resp.StatusCode >= 300 {
-		log.Println("bad response on notification get:", resp.Status)
+func (n *Notification) DoGet() error {
+	return n.do("get", func() (*http.Request, error) {
+		return http.NewRequest("GET", n.Get.String(), nil)
+	})
+}
+
+// do sends the request made by newReq, retrying up to n.Retries times on
+// network errors and 5xx or 429 responses.
+func (n *Notification) do(method string, newReq func() (*http.Request, error)) error {
+	client := &http.Client{Timeout: n.RequestTimeout}
+	delay := n.RetryDelay
+	for try := 0; ; try++ {
+		req, err := newReq()
+		if err != nil {
+			return err
+		}
+		retry := false
+		resp, err := client.Do(req)
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode >= 300 {
+				err = fmt.Errorf("bad response on notification %s: %s", method, resp.Status)
+				retry = resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+			}
+		} else {
+			retry = true
+		}
+		if err == nil {
+			return nil
+		}
+		slog.Errorln(err)
+		if !retry || try >= n.Retries {
+			return err
+		}
+		time.Sleep(delay)
+		delay *= 2
 	}
 }
 
@@ -71,24 +322,42 @@ type Attachment struct {
 	ContentType string
 }
 
-func (n *Notification) DoEmail(subject, body []byte, c *Conf, ak string, attachments ...*Attachment) {
+// DoEmail emails subject and body to the addresses of n and those on call
+// for its roster. A non-zero incident is sent in the X-Bosun-Incident header.
+func (n *Notification) DoEmail(subject, body []byte, c *Conf, ak string, incident int64, attachments ...*Attachment) error {
 	e := email.NewEmail()
 	e.From = c.EmailFrom
-	for _, a := range n.Email {
+	if n.From != "" {
+		e.From = n.From
+	}
+	if n.ReplyTo != "" {
+		e.Headers.Set("Reply-To", n.ReplyTo)
+	}
+	for _, a := range n.Recipients(time.Now()) {
 		e.To = append(e.To, a.Address)
 	}
+	if len(e.To) == 0 {
+		return fmt.Errorf("notification %s: no recipients", n.Name)
+	}
 	e.Subject = string(subject)
 	e.HTML = body
+	if c.MailKey != "" {
+		e.Headers.Set("Message-Id", c.MailMessageID(ak))
+	}
+	if incident != 0 {
+		e.Headers.Set(IncidentHeader, strconv.FormatInt(incident, 10))
+	}
 	for _, a := range attachments {
 		e.Attach(bytes.NewBuffer(a.Data), a.Filename, a.ContentType)
 	}
-	if err := Send(e, c.SmtpHost); err != nil {
-		collect.Add("email.sent_failed", nil, 1)
-		log.Printf("failed to send alert %v to %v %v\n", ak, e.To, err)
-		return
+	if err := sendVia(e, c.smtp()); err != nil {
+		collect.Add("email.sent_failed", opentsdb.TagSet{"notification": n.Name}, 1)
+		slog.Errorf("failed to send alert %v to %v via notification %v: %v", ak, e.To, n.Name, err)
+		return err
 	}
 	collect.Add("email.sent", nil, 1)
 	log.Printf("relayed alert %v to %v sucessfully\n", ak, e.To)
+	return nil
 }
 
 // Send an email using the given host and SMTP auth (optional), returns any
@@ -96,6 +365,10 @@ func (n *Notification) DoEmail(subject, body []byte, c *Conf, ak string, attachm
 // fields and calls the smtp.SendMail function using the Email.Bytes() output as
 // the message.
 func Send(e *email.Email, addr string) error {
+	return sendVia(e, &smtpPool{addr: addr})
+}
+
+func sendVia(e *email.Email, p *smtpPool) error {
 	// Merge the To, Cc, and Bcc fields
 	to := make([]string, 0, len(e.To)+len(e.Cc)+len(e.Bcc))
 	to = append(append(append(to, e.To...), e.Cc...), e.Bcc...)
@@ -111,46 +384,12 @@ func Send(e *email.Email, addr string) error {
 	if err != nil {
 		return err
 	}
-	return SendMail(addr, from.Address, to, raw)
+	return p.send(from.Address, to, raw)
 }
 
 // SendMail connects to the server at addr, switches to TLS if
-// possible, authenticates with the optional mechanism a if possible,
-// and then sends an email from address from, to addresses to, with
+// possible, and then sends an email from address from, to addresses to, with
 // message msg.
 func SendMail(addr string, from string, to []string, msg []byte) error {
-	c, err := smtp.Dial(addr)
-	if err != nil {
-		return err
-	}
-	defer c.Close()
-	if err = c.Hello("localhost"); err != nil {
-		return err
-	}
-	if ok, _ := c.Extension("STARTTLS"); ok {
-		if err = c.StartTLS(&tls.Config{InsecureSkipVerify: true}); err != nil {
-			return err
-		}
-	}
-	if err = c.Mail(from); err != nil {
-		return err
-	}
-	for _, addr := range to {
-		if err = c.Rcpt(addr); err != nil {
-			return err
-		}
-	}
-	w, err := c.Data()
-	if err != nil {
-		return err
-	}
-	_, err = w.Write(msg)
-	if err != nil {
-		return err
-	}
-	err = w.Close()
-	if err != nil {
-		return err
-	}
-	return c.Quit()
+	return (&smtpPool{addr: addr}).send(from, to, msg)
 }
@@ -185,8 +185,11 @@ func (t *Tree) parse(root *ListNode) item {
 				t.backup2(token)
 				n = t.parsePair()
 			case itemIdentifier, itemSubsectionIdentifier:
-				t.backup2(token)
-				n = t.parseSection()
+				if root != t.Root && t.peek().typ == itemEqual {
+					n = t.parseQualifiedPair(token, token2)
+				} else {
+					n = t.parseSection(token, token2)
+				}
 			default:
 				t.unexpected(token, "input")
 			}
@@ -212,8 +215,26 @@ func (t *Tree) parsePair() *PairNode {
 	token := t.expect(itemIdentifier, context)
 	p := newPair(token.pos)
 	p.Key = newString(token.pos, token.val, token.val)
+	t.parseValue(p, context)
+	return p
+}
+
+// parseQualifiedPair parses a pair whose key is two words, such as
+// "label env = prod", within a section. The key is the words joined by a
+// space. The words have already been consumed.
+func (t *Tree) parseQualifiedPair(word, qualifier item) *PairNode {
+	const context = "qualified key=value declaration"
+	p := newPair(word.pos)
+	key := word.val + " " + qualifier.val
+	p.Key = newString(word.pos, key, key)
+	t.parseValue(p, context)
+	return p
+}
+
+// parseValue parses the "= value" part of a pair into p.
+func (t *Tree) parseValue(p *PairNode, context string) {
 	t.expect(itemEqual, context)
-	token = t.expectOneOf(itemString, itemRawString, context)
+	token := t.expectOneOf(itemString, itemRawString, context)
 	switch token.typ {
 	case itemString:
 		p.Val = newString(token.pos, token.val, token.val)
@@ -226,19 +247,18 @@ func (t *Tree) parsePair() *PairNode {
 	default:
 		t.unexpected(token, context)
 	}
-	return p
 }
 
-func (t *Tree) parseSection() *SectionNode {
+// parseSection parses a section whose type and name have already been
+// consumed.
+func (t *Tree) parseSection(typ, name item) *SectionNode {
 	const context = "section declaration"
-	token := t.expect(itemIdentifier, context)
-	s := newSection(token.pos)
-	start := token.pos
-	s.SectionType = newString(token.pos, token.val, token.val)
-	token = t.expectOneOf(itemIdentifier, itemSubsectionIdentifier, context)
-	s.Name = newString(token.pos, token.val, token.val)
+	s := newSection(typ.pos)
+	start := typ.pos
+	s.SectionType = newString(typ.pos, typ.val, typ.val)
+	s.Name = newString(name.pos, name.val, name.val)
 	t.expect(itemLeftDelim, context)
-	token = t.parse(s.Nodes)
+	token := t.parse(s.Nodes)
 	s.RawText = t.text[start : token.pos+1]
 	return s
 }
@@ -0,0 +1,208 @@
+package conf
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/conf/parse"
+)
+
+// A Provider delivers notifications through an integration that is not
+// built into bosun. Providers declared in the config file run as external
+// plugin processes; see ServeProvider.
+type Provider interface {
+	Send(m *Message) error
+}
+
+// A Message is a notification passed to a Provider.
+type Message struct {
+	Notification string
+	AlertKey     string
+	Subject      string
+	Body         string
+	Text         string            `json:",omitempty"` // Plain text body, if the template has one
+	Status       string            `json:",omitempty"` // Status of the alert key, such as "critical"
+	Vars         map[string]string // Variables of the notification section
+	Labels       map[string]string // Labels of the alert
+	Incident     int64             `json:",omitempty"` // Incident ID, if any
+}
+
+// ProviderMethod is the JSON-RPC method called for each message. Its single
+// parameter is the Message; the result is ignored.
+const ProviderMethod = "Provider.Send"
+
+// providerService adapts a Provider to net/rpc.
+type providerService struct {
+	p Provider
+}
+
+func (s *providerService) Send(m *Message, reply *bool) error {
+	*reply = true
+	return s.p.Send(m)
+}
+
+// ServeProvider serves p over JSON-RPC on stdin and stdout until stdin is
+// closed. Plugin binaries call it from main:
+//
+//	func main() {
+//		conf.ServeProvider(myProvider{})
+//	}
+//
+// Plugins in other languages read JSON-RPC 1.0 requests for ProviderMethod,
+// one per line, from stdin and write responses to stdout. Anything written to
+// stderr is logged by bosun.
+func ServeProvider(p Provider) error {
+	srv := rpc.NewServer()
+	if err := srv.RegisterName("Provider", &providerService{p}); err != nil {
+		return err
+	}
+	srv.ServeCodec(jsonrpc.NewServerCodec(stdio{os.Stdin, os.Stdout}))
+	return nil
+}
+
+type stdio struct {
+	io.Reader
+	io.WriteCloser
+}
+
+// An ExecProvider is a Provider declared in the config file, run as a long
+// lived plugin process, which is started on the first message and restarted
+// after it fails.
+type ExecProvider struct {
+	Def  string
+	Name string
+
+	command []string
+	timeout time.Duration
+
+	sync.Mutex
+	cmd    *exec.Cmd
+	client *rpc.Client
+	closed bool
+}
+
+func (p *ExecProvider) start() error {
+	cmd := exec.Command(p.command[0], p.command[1:]...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	go func() {
+		s := bufio.NewScanner(stderr)
+		for s.Scan() {
+			log.Printf("provider %s: %s", p.Name, s.Text())
+		}
+	}()
+	p.cmd = cmd
+	p.client = jsonrpc.NewClient(stdio{stdout, stdin})
+	return nil
+}
+
+// stop kills the plugin process so the next message starts a new one.
+func (p *ExecProvider) stop() {
+	p.client.Close()
+	p.cmd.Process.Kill()
+	go p.cmd.Wait()
+	p.client, p.cmd = nil, nil
+}
+
+// Close stops the plugin process. Messages sent after Close fail.
+func (p *ExecProvider) Close() {
+	p.Lock()
+	defer p.Unlock()
+	if p.client != nil {
+		p.stop()
+	}
+	p.closed = true
+}
+
+func (p *ExecProvider) Send(m *Message) error {
+	p.Lock()
+	defer p.Unlock()
+	if p.closed {
+		return fmt.Errorf("provider %s: closed", p.Name)
+	}
+	if p.client == nil {
+		if err := p.start(); err != nil {
+			return fmt.Errorf("provider %s: %v", p.Name, err)
+		}
+	}
+	var reply interface{}
+	call := p.client.Go(ProviderMethod, m, &reply, nil)
+	select {
+	case <-call.Done:
+		if _, ok := call.Error.(rpc.ServerError); ok {
+			return fmt.Errorf("provider %s: %v", p.Name, call.Error)
+		}
+		if call.Error != nil {
+			p.stop()
+			return fmt.Errorf("provider %s: %v", p.Name, call.Error)
+		}
+		return nil
+	case <-time.After(p.timeout):
+		p.stop()
+		return fmt.Errorf("provider %s: timed out after %v", p.Name, p.timeout)
+	}
+}
+
+func (c *Conf) loadProvider(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Providers[name]; ok {
+		c.errorf("duplicate provider name: %s", name)
+	}
+	p := ExecProvider{
+		Def:     s.RawText,
+		Name:    name,
+		timeout: time.Second * 30,
+	}
+	for _, pair := range c.getPairs(s, nil, sNormal, nil) {
+		c.at(pair.node)
+		v := pair.val
+		switch k := pair.key; k {
+		case "command":
+			p.command = strings.Fields(v)
+		case "timeout":
+			d, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			p.timeout = time.Duration(d)
+		default:
+			c.errorf("unknown key %s", k)
+		}
+	}
+	c.at(s)
+	if len(p.command) == 0 {
+		c.errorf("provider requires command")
+	}
+	c.Providers[name] = &p
+}
+
+// Close stops the plugin processes of c's providers. It is called on a config
+// that has been replaced.
+func (c *Conf) Close() {
+	for _, p := range c.Providers {
+		p.Close()
+	}
+}
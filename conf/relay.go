@@ -0,0 +1,142 @@
+package conf
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/conf/parse"
+)
+
+// Relay rule actions.
+const (
+	RelayAllow   = "allow"
+	RelayDrop    = "drop"
+	RelayRewrite = "rewrite"
+)
+
+// A RelayRule filters or modifies datapoints passing through the relay. Rules
+// are applied in the order they are defined. The first matching allow or drop
+// rule decides whether a datapoint is kept; matching rewrite rules modify it
+// and processing continues.
+type RelayRule struct {
+	Def    string
+	Name   string
+	Metric string          // Glob matched against the metric name
+	Tags   opentsdb.TagSet // Globs matched against tag values
+	Action string
+	Rename string   // New metric name for rewrite
+	Strip  []string // Tag keys removed by rewrite
+}
+
+func (c *Conf) loadRelay(s *parse.SectionNode) {
+	name := s.Name.Text
+	for _, r := range c.RelayRules {
+		if r.Name == name {
+			c.errorf("duplicate relay name: %s", name)
+		}
+	}
+	r := RelayRule{
+		Def:    s.RawText,
+		Name:   name,
+		Metric: "*",
+	}
+	for _, p := range c.getPairs(s, nil, sNormal, nil) {
+		c.at(p.node)
+		v := p.val
+		switch k := p.key; k {
+		case "metric":
+			if _, err := path.Match(v, ""); err != nil {
+				c.error(err)
+			}
+			r.Metric = v
+		case "tags":
+			tags, err := opentsdb.ParseTags(v)
+			if tags == nil && err != nil {
+				c.error(err)
+			}
+			for _, g := range tags {
+				if _, err := path.Match(g, ""); err != nil {
+					c.error(err)
+				}
+			}
+			r.Tags = tags
+		case "action":
+			switch v {
+			case RelayAllow, RelayDrop, RelayRewrite:
+				r.Action = v
+			default:
+				c.errorf("unknown relay action: %s", v)
+			}
+		case "rename":
+			r.Rename = v
+		case "strip":
+			for _, t := range strings.Split(v, ",") {
+				if t = strings.TrimSpace(t); t != "" {
+					r.Strip = append(r.Strip, t)
+				}
+			}
+		default:
+			c.errorf("unknown key %s", k)
+		}
+	}
+	c.at(s)
+	switch {
+	case r.Action == "":
+		c.errorf("relay action required")
+	case r.Action == RelayRewrite && r.Rename == "" && len(r.Strip) == 0:
+		c.errorf("rewrite requires rename or strip")
+	case r.Action != RelayRewrite && (r.Rename != "" || len(r.Strip) > 0):
+		c.errorf("rename and strip are only valid with rewrite")
+	}
+	c.RelayRules = append(c.RelayRules, &r)
+}
+
+// Matches returns true if the metric and tags of dp match the rule.
+func (r *RelayRule) Matches(dp *opentsdb.DataPoint) bool {
+	if ok, _ := path.Match(r.Metric, dp.Metric); !ok {
+		return false
+	}
+	for k, g := range r.Tags {
+		v, present := dp.Tags[k]
+		if !present {
+			return false
+		}
+		if ok, _ := path.Match(g, v); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Relay applies the relay rules to dp, possibly modifying it, and returns
+// false if it should be dropped. The returned string is the name of the rule
+// that dropped it.
+func (c *Conf) Relay(dp *opentsdb.DataPoint) (bool, string) {
+	for _, r := range c.RelayRules {
+		if !r.Matches(dp) {
+			continue
+		}
+		switch r.Action {
+		case RelayAllow:
+			return true, ""
+		case RelayDrop:
+			return false, r.Name
+		case RelayRewrite:
+			if r.Rename != "" {
+				dp.Metric = r.Rename
+			}
+			if len(r.Strip) > 0 {
+				tags := dp.Tags.Copy()
+				for _, k := range r.Strip {
+					delete(tags, k)
+				}
+				dp.Tags = tags
+			}
+		default:
+			panic(fmt.Errorf("unknown relay action: %s", r.Action))
+		}
+	}
+	return true, ""
+}
@@ -0,0 +1,207 @@
+package conf
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/conf/parse"
+)
+
+// A Roster resolves who is on call for a team when a notification is sent,
+// either from a rotation of addresses handed over every shift, or from an
+// external on-call service.
+type Roster struct {
+	Def      string
+	Name     string
+	Rotation []*mail.Address
+	Start    time.Time     // Start of the first shift of Rotation
+	Shift    time.Duration // Length of a shift
+	// URL is requested with a GET for the addresses on call. The response
+	// is a JSON array of addresses or a comma separated address list.
+	URL     *url.URL
+	Timeout time.Duration
+
+	sync.Mutex
+	last []*mail.Address // Last addresses returned by URL
+}
+
+// OnCall returns the addresses on call at t. If the request to URL fails,
+// the addresses of the last successful request are returned with the error.
+func (r *Roster) OnCall(t time.Time) ([]*mail.Address, error) {
+	if r.URL == nil {
+		return r.rotation(t), nil
+	}
+	addrs, err := r.fetch()
+	r.Lock()
+	defer r.Unlock()
+	if err != nil {
+		return r.last, fmt.Errorf("roster %s: %v", r.Name, err)
+	}
+	r.last = addrs
+	return addrs, nil
+}
+
+// LastOnCall is OnCall without a request to URL: the addresses of the last
+// successful request are returned instead.
+func (r *Roster) LastOnCall(t time.Time) []*mail.Address {
+	if r.URL == nil {
+		return r.rotation(t)
+	}
+	r.Lock()
+	defer r.Unlock()
+	return r.last
+}
+
+func (r *Roster) rotation(t time.Time) []*mail.Address {
+	if len(r.Rotation) == 0 {
+		return nil
+	}
+	d := t.Sub(r.Start)
+	shift := int64(d / r.Shift)
+	if d < 0 && d%r.Shift != 0 {
+		// Shifts before Start count backwards from the last address.
+		shift--
+	}
+	n := int64(len(r.Rotation))
+	i := (shift%n + n) % n
+	return r.Rotation[i : i+1]
+}
+
+func (r *Roster) fetch() ([]*mail.Address, error) {
+	client := &http.Client{Timeout: r.Timeout}
+	resp, err := client.Get(r.URL.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad response %s", resp.Status)
+	}
+	list := strings.TrimSpace(string(b))
+	if strings.HasPrefix(list, "[") {
+		var a []string
+		if err := json.Unmarshal(b, &a); err != nil {
+			return nil, err
+		}
+		list = strings.Join(a, ",")
+	}
+	if list == "" {
+		return nil, nil
+	}
+	return mail.ParseAddressList(list)
+}
+
+// Recipients returns the addresses of n and those on call for its roster at
+// t.
+func (n *Notification) Recipients(t time.Time) []*mail.Address {
+	if n.Roster == nil {
+		return n.Email
+	}
+	oncall, err := n.Roster.OnCall(t)
+	if err != nil {
+		slog.Errorln(err)
+	}
+	return append(append([]*mail.Address{}, n.Email...), oncall...)
+}
+
+// LastRecipients is Recipients using the last addresses of a roster with a
+// URL rather than requesting them.
+func (n *Notification) LastRecipients(t time.Time) []*mail.Address {
+	if n.Roster == nil {
+		return n.Email
+	}
+	return append(append([]*mail.Address{}, n.Email...), n.Roster.LastOnCall(t)...)
+}
+
+func (c *Conf) loadRoster(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Rosters[name]; ok {
+		c.errorf("duplicate roster name: %s", name)
+	}
+	r := Roster{
+		Def:     s.RawText,
+		Name:    name,
+		Timeout: time.Second * 10,
+	}
+	for _, p := range c.getPairs(s, nil, sNormal, nil) {
+		c.at(p.node)
+		v := p.val
+		switch k := p.key; k {
+		case "rotation":
+			rotation, err := mail.ParseAddressList(v)
+			if err != nil {
+				c.error(err)
+			}
+			r.Rotation = rotation
+		case "start":
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				c.error(err)
+			}
+			r.Start = t
+		case "shift":
+			d, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			if d <= 0 {
+				c.errorf("shift must be positive")
+			}
+			r.Shift = time.Duration(d)
+		case "url":
+			u, err := url.Parse(v)
+			if err != nil {
+				c.error(err)
+			}
+			if u.Scheme != "http" && u.Scheme != "https" {
+				c.errorf("url must be an http or https URL")
+			}
+			r.URL = u
+		case "timeout":
+			d, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			r.Timeout = time.Duration(d)
+		default:
+			c.errorf("unknown key %s", k)
+		}
+	}
+	c.at(s)
+	if (r.Rotation == nil) == (r.URL == nil) {
+		c.errorf("roster requires one of rotation or url")
+	}
+	if r.Rotation != nil && (r.Start.IsZero() || r.Shift == 0) {
+		c.errorf("rotation requires start and shift")
+	}
+	c.Rosters[name] = &r
+}
+
+// OnCall returns the addresses on call now for each roster.
+func (c *Conf) OnCall() map[string][]string {
+	m := make(map[string][]string)
+	for name, r := range c.Rosters {
+		addrs, err := r.OnCall(time.Now())
+		if err != nil {
+			slog.Errorln(err)
+		}
+		m[name] = []string{}
+		for _, a := range addrs {
+			m[name] = append(m[name], a.String())
+		}
+	}
+	return m
+}
@@ -0,0 +1,78 @@
+package conf
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+// A Route is where the notifications of an alert key go, by status.
+type Route struct {
+	Template string   `json:",omitempty"`
+	Info     []string `json:",omitempty"` // Notification names, sorted
+	Warn     []string `json:",omitempty"`
+	Crit     []string `json:",omitempty"`
+	Fatal    []string `json:",omitempty"`
+}
+
+// Route returns the route of ak, or nil if its alert is not defined.
+func (c *Conf) Route(ak expr.AlertKey) *Route {
+	a := c.Alerts[ak.Name()]
+	if a == nil {
+		return nil
+	}
+	tags := ak.Group()
+	names := func(ns *Notifications) []string {
+		if ns == nil {
+			return nil
+		}
+		var l []string
+		for name := range ns.Get(c, tags) {
+			l = append(l, name)
+		}
+		sort.Strings(l)
+		return l
+	}
+	r := &Route{
+		Info:  names(a.InfoNotification),
+		Warn:  names(a.WarnNotification),
+		Crit:  names(a.CritNotification),
+		Fatal: names(a.FatalNotification),
+	}
+	if r.Fatal == nil {
+		r.Fatal = r.Crit
+	}
+	if a.Template != nil {
+		r.Template = a.Template.Name
+	}
+	return r
+}
+
+// A RouteChange is an alert key whose route differs between two configs.
+// Old or New is nil if the alert is not defined in that config.
+type RouteChange struct {
+	AlertKey expr.AlertKey
+	Old, New *Route
+}
+
+// RoutingDiff returns the alert keys of keys that route to different
+// notifications or templates in new than in old, sorted by alert key.
+func RoutingDiff(old, new *Conf, keys []expr.AlertKey) []*RouteChange {
+	var l []*RouteChange
+	for _, ak := range keys {
+		o, n := old.Route(ak), new.Route(ak)
+		if reflect.DeepEqual(o, n) {
+			continue
+		}
+		l = append(l, &RouteChange{ak, o, n})
+	}
+	sort.Sort(routeChanges(l))
+	return l
+}
+
+type routeChanges []*RouteChange
+
+func (a routeChanges) Len() int           { return len(a) }
+func (a routeChanges) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a routeChanges) Less(i, j int) bool { return a[i].AlertKey < a[j].AlertKey }
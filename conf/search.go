@@ -0,0 +1,49 @@
+package conf
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/bosun-monitor/bosun/conf/parse"
+)
+
+// A DefMatch is a section of the config file with lines matching a search.
+type DefMatch struct {
+	Type  string // Section type: alert, template, notification...
+	Name  string
+	Lines []DefLine
+}
+
+// A DefLine is a line of the config file.
+type DefLine struct {
+	Line int // 1-based line number in the config file
+	Text string
+}
+
+// SearchDefs returns the sections of the config file, such as alerts and
+// templates, whose name or text matches re, with their matching lines. A
+// section whose name matches but none of whose lines do is returned with no
+// lines.
+func (c *Conf) SearchDefs(re *regexp.Regexp) []*DefMatch {
+	matches := make([]*DefMatch, 0)
+	if c.tree == nil {
+		return matches
+	}
+	for _, n := range c.tree.Root.Nodes {
+		s, ok := n.(*parse.SectionNode)
+		if !ok {
+			continue
+		}
+		m := &DefMatch{Type: s.SectionType.Text, Name: s.Name.Text}
+		first := 1 + strings.Count(c.RawText[:s.Position()], "\n")
+		for i, line := range strings.Split(s.RawText, "\n") {
+			if re.MatchString(line) {
+				m.Lines = append(m.Lines, DefLine{first + i, line})
+			}
+		}
+		if len(m.Lines) > 0 || re.MatchString(m.Name) {
+			matches = append(matches, m)
+		}
+	}
+	return matches
+}
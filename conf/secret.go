@@ -0,0 +1,108 @@
+package conf
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// Values beginning with encPrefix are encrypted with AES-256-GCM. The rest of
+// the value is the base64 of the nonce followed by the sealed plaintext.
+const encPrefix = "enc:"
+
+// The conf key is 32 base64 encoded bytes from the BOSUN_CONF_KEY environment
+// variable, or from the file named by BOSUN_CONF_KEY_FILE.
+const (
+	envConfKey     = "BOSUN_CONF_KEY"
+	envConfKeyFile = "BOSUN_CONF_KEY_FILE"
+)
+
+// ConfKey returns the key used to decrypt conf values.
+func ConfKey() ([]byte, error) {
+	s := os.Getenv(envConfKey)
+	if f := os.Getenv(envConfKeyFile); s == "" && f != "" {
+		b, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		s = string(b)
+	}
+	if s == "" {
+		return nil, fmt.Errorf("conf: encrypted value found but neither %s nor %s is set", envConfKey, envConfKeyFile)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, fmt.Errorf("conf: bad key: %v", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("conf: key must be 32 bytes, got %d", len(key))
+	}
+	return key, nil
+}
+
+// Encrypt returns plaintext encrypted with key, in a form that may be used as
+// a conf value.
+func Encrypt(key, plaintext []byte) (string, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	b := gcm.Seal(nonce, nonce, plaintext, nil)
+	return encPrefix + base64.StdEncoding.EncodeToString(b), nil
+}
+
+func decrypt(key []byte, v string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(v, encPrefix))
+	if err != nil {
+		return "", err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	if len(b) < gcm.NonceSize() {
+		return "", fmt.Errorf("encrypted value too short")
+	}
+	p, err := gcm.Open(nil, b[:gcm.NonceSize()], b[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(p), nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// decrypt returns v decrypted if it is an encrypted value, else v.
+func (c *Conf) decrypt(v string) string {
+	if !strings.HasPrefix(v, encPrefix) {
+		return v
+	}
+	if c.key == nil {
+		key, err := ConfKey()
+		if err != nil {
+			c.error(err)
+		}
+		c.key = key
+	}
+	p, err := decrypt(c.key, v)
+	if err != nil {
+		c.errorf("could not decrypt value: %v", err)
+	}
+	return p
+}
@@ -0,0 +1,69 @@
+package conf
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
+)
+
+// slackColors maps alert statuses to the colors of Slack attachments.
+var slackColors = map[string]string{
+	"normal":   "good",
+	"warning":  "warning",
+	"critical": "danger",
+}
+
+type slackMessage struct {
+	Channel     string            `json:"channel,omitempty"`
+	Text        string            `json:"text"`
+	Attachments []slackAttachment `json:"attachments,omitempty"`
+}
+
+type slackAttachment struct {
+	Fallback string `json:"fallback"`
+	Color    string `json:"color,omitempty"`
+	Title    string `json:"title"`
+	Text     string `json:"text,omitempty"`
+}
+
+// DoSlack posts d to n's Slack incoming webhook. The message text is the
+// rendered SlackText template, or else the subject, with an attachment
+// colored by the alert's status holding the plain text body.
+func (n *Notification) DoSlack(d *PostData) error {
+	text := d.Subject
+	if n.SlackText != nil {
+		buf := new(bytes.Buffer)
+		if err := n.SlackText.Execute(buf, d); err != nil {
+			slog.Errorln(err)
+			return err
+		}
+		text = buf.String()
+	}
+	color := slackColors[d.Status]
+	if color == "" && d.Status != "" {
+		color = "#808080"
+	}
+	b, err := json.Marshal(&slackMessage{
+		Channel: n.Channel,
+		Text:    text,
+		Attachments: []slackAttachment{{
+			Fallback: d.Subject,
+			Color:    color,
+			Title:    d.AlertKey,
+			Text:     d.Text,
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	return n.do("slack", func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", n.Slack.String(), bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		return req, nil
+	})
+}
@@ -0,0 +1,161 @@
+package conf
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"sync"
+)
+
+// SMTP TLS modes for the smtpTLS key. The default is to opportunistically
+// upgrade with STARTTLS when the server offers it.
+const (
+	SmtpTLSStartTLS = "starttls" // require STARTTLS
+	SmtpTLSImplicit = "tls"      // connect with TLS (usually port 465)
+	SmtpTLSNone     = "none"     // never use TLS
+)
+
+// smtpPool sends mail through a single SMTP server, keeping up to max idle
+// connections open for reuse between messages.
+type smtpPool struct {
+	sync.Mutex
+	addr     string
+	mode     string
+	username string
+	password string
+	max      int
+	idle     []*smtp.Client
+}
+
+// smtp returns the shared SMTP connection pool for this configuration.
+func (c *Conf) smtp() *smtpPool {
+	c.smtpOnce.Do(func() {
+		c.smtpPool = &smtpPool{
+			addr:     c.SmtpHost,
+			mode:     c.SmtpTLS,
+			username: c.SmtpUsername,
+			password: c.SmtpPassword,
+			max:      c.SmtpPoolSize,
+		}
+	})
+	return c.smtpPool
+}
+
+func (p *smtpPool) send(from string, to []string, msg []byte) error {
+	c, err := p.get()
+	if err != nil {
+		return err
+	}
+	if err := deliver(c, from, to, msg); err != nil {
+		c.Close()
+		return err
+	}
+	p.put(c)
+	return nil
+}
+
+func deliver(c *smtp.Client, from string, to []string, msg []byte) error {
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+	for _, addr := range to {
+		if err := c.Rcpt(addr); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// get returns an idle connection that still responds, or dials a new one.
+func (p *smtpPool) get() (*smtp.Client, error) {
+	p.Lock()
+	for len(p.idle) > 0 {
+		c := p.idle[len(p.idle)-1]
+		p.idle = p.idle[:len(p.idle)-1]
+		if c.Reset() == nil {
+			p.Unlock()
+			return c, nil
+		}
+		c.Close()
+	}
+	p.Unlock()
+	return p.dial()
+}
+
+func (p *smtpPool) put(c *smtp.Client) {
+	p.Lock()
+	if len(p.idle) < p.max {
+		p.idle = append(p.idle, c)
+		c = nil
+	}
+	p.Unlock()
+	if c != nil {
+		c.Quit()
+	}
+}
+
+func (p *smtpPool) dial() (*smtp.Client, error) {
+	host, _, err := net.SplitHostPort(p.addr)
+	if err != nil {
+		return nil, err
+	}
+	var c *smtp.Client
+	if p.mode == SmtpTLSImplicit {
+		conn, err := tls.Dial("tcp", p.addr, &tls.Config{ServerName: host})
+		if err != nil {
+			return nil, err
+		}
+		if c, err = smtp.NewClient(conn, host); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	} else if c, err = smtp.Dial(p.addr); err != nil {
+		return nil, err
+	}
+	if err := p.hello(c, host); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+func (p *smtpPool) hello(c *smtp.Client, host string) error {
+	if err := c.Hello("localhost"); err != nil {
+		return err
+	}
+	switch p.mode {
+	case SmtpTLSStartTLS:
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			return fmt.Errorf("smtp: %s does not support STARTTLS", p.addr)
+		}
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	case "":
+		// Opportunistic encryption only: the certificate is not verified,
+		// so no credentials are sent.
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(&tls.Config{InsecureSkipVerify: true}); err != nil {
+				return err
+			}
+		}
+	}
+	if p.username == "" {
+		return nil
+	}
+	if p.mode != SmtpTLSStartTLS && p.mode != SmtpTLSImplicit {
+		return fmt.Errorf("smtp: not sending credentials to %s without verified TLS", p.addr)
+	}
+	if ok, _ := c.Extension("AUTH"); !ok {
+		return fmt.Errorf("smtp: %s does not support AUTH", p.addr)
+	}
+	return c.Auth(smtp.PlainAuth("", p.username, p.password, host))
+}
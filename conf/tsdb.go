@@ -0,0 +1,121 @@
+package conf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/conf/parse"
+)
+
+// A TSDBRoute sends queries constrained to its tag values, such as dc=ny, to
+// a TSDB cluster local to that data. Queries not constrained to a single route
+// are sent to every route host and the results merged, so routes should
+// together cover all data and such queries must group by the route tags.
+type TSDBRoute struct {
+	Def  string
+	Name string
+	Tags opentsdb.TagSet // Literal tag values a query must constrain
+	Host string
+}
+
+func (c *Conf) loadTSDBRoute(s *parse.SectionNode) {
+	name := s.Name.Text
+	for _, r := range c.TSDBRoutes {
+		if r.Name == name {
+			c.errorf("duplicate tsdb name: %s", name)
+		}
+	}
+	r := TSDBRoute{
+		Def:  s.RawText,
+		Name: name,
+	}
+	for _, p := range c.getPairs(s, nil, sNormal, nil) {
+		c.at(p.node)
+		v := p.val
+		switch k := p.key; k {
+		case "tags":
+			tags, err := opentsdb.ParseTags(v)
+			if tags == nil && err != nil {
+				c.error(err)
+			}
+			for k, v := range tags {
+				if !literalTag(v) {
+					c.errorf("tsdb tag %s must be a single value: %s", k, v)
+				}
+			}
+			r.Tags = tags
+		case "host":
+			r.Host = v
+		default:
+			c.errorf("unknown key %s", k)
+		}
+	}
+	c.at(s)
+	if len(r.Tags) == 0 {
+		c.errorf("tsdb tags required")
+	}
+	if r.Host == "" {
+		c.errorf("tsdb host required")
+	}
+	c.TSDBRoutes = append(c.TSDBRoutes, &r)
+}
+
+func literalTag(v string) bool {
+	return v != "" && !strings.ContainsAny(v, "*|")
+}
+
+// Matches returns true if q constrains every tag of the route to its value.
+func (r *TSDBRoute) Matches(q *opentsdb.Query) bool {
+	for k, v := range r.Tags {
+		if q.Tags[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// TSDBHosts returns the hosts r is sent to: the host of the route matching
+// every query of r if there is one, otherwise the hosts of all routes. Without
+// routes it is TsdbHost. Results from several hosts are merged without
+// re-aggregation, so a query sent to all routes must group by every route tag
+// for its series to be distinct per host; an error is returned if not.
+func (c *Conf) TSDBHosts(r *opentsdb.Request) ([]string, error) {
+	if len(c.TSDBRoutes) == 0 {
+		return []string{c.TsdbHost}, nil
+	}
+	var host string
+	for i, q := range r.Queries {
+		h := ""
+		for _, route := range c.TSDBRoutes {
+			if route.Matches(q) {
+				h = route.Host
+				break
+			}
+		}
+		if h == "" || (i > 0 && h != host) {
+			host = ""
+			break
+		}
+		host = h
+	}
+	if host != "" {
+		return []string{host}, nil
+	}
+	var hosts []string
+	seen := make(map[string]bool)
+	for _, route := range c.TSDBRoutes {
+		for k := range route.Tags {
+			for _, q := range r.Queries {
+				if _, ok := q.Tags[k]; !ok {
+					return nil, fmt.Errorf("tsdb: query %s spans tsdb routes and must group by %s", q, k)
+				}
+			}
+		}
+		if !seen[route.Host] {
+			seen[route.Host] = true
+			hosts = append(hosts, route.Host)
+		}
+	}
+	return hosts, nil
+}
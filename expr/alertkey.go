@@ -1,14 +1,24 @@
 package expr
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
 )
 
+// AlertKeyVersion is the version of the AlertKey format. Version 1 keys are
+// the alert name followed by the group in braces, with tags sorted by key:
+// name{k1=v1,k2=v2}. The characters \ , = { and } are escaped in tag keys and
+// values with a backslash. Tags valid for OpenTSDB never need escaping.
+const AlertKeyVersion = 1
+
+// An AlertKey uniquely identifies an alert and group.
 type AlertKey string
 
+// ParseAlertKey validates a and returns it in canonical form.
 func ParseAlertKey(a string) (ak AlertKey, err error) {
 	ak = AlertKey(a)
 	defer func() {
@@ -17,14 +27,32 @@ func ParseAlertKey(a string) (ak AlertKey, err error) {
 			err = fmt.Errorf("%v", e)
 		}
 	}()
-	ak.Group()
-	return
+	return NewAlertKey(ak.Name(), ak.Group()), nil
 }
 
+// NewAlertKey returns the canonical AlertKey for name and group.
 func NewAlertKey(name string, group opentsdb.TagSet) AlertKey {
-	return AlertKey(name + group.String())
+	keys := make([]string, 0, len(group))
+	for k := range group {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	b := bytes.NewBufferString(name)
+	b.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(akEscaper.Replace(k))
+		b.WriteByte('=')
+		b.WriteString(akEscaper.Replace(group[k]))
+	}
+	b.WriteByte('}')
+	return AlertKey(b.String())
 }
 
+var akEscaper = strings.NewReplacer(`\`, `\\`, ",", `\,`, "=", `\=`, "{", `\{`, "}", `\}`)
+
 func (a AlertKey) Name() string {
 	return strings.SplitN(string(a), "{", 2)[0]
 }
@@ -33,7 +61,7 @@ func (a AlertKey) Name() string {
 // AlertKey. OpenTSDB tag validation errors are ignored.
 func (a AlertKey) Group() opentsdb.TagSet {
 	sp := strings.SplitN(string(a), "{", 2)
-	if len(sp) < 2 {
+	if len(sp) < 2 || !strings.HasSuffix(sp[1], "}") {
 		panic(fmt.Errorf("invalid alert key %s", a))
 	}
 	s := sp[1]
@@ -41,10 +69,38 @@ func (a AlertKey) Group() opentsdb.TagSet {
 	if s == "" {
 		return nil
 	}
-	g, err := opentsdb.ParseTags(s)
-	if g == nil && err != nil {
-		panic(err)
+	g := make(opentsdb.TagSet)
+	var k string
+	var sawEq bool
+	var cur bytes.Buffer
+	add := func() {
+		if !sawEq {
+			panic(fmt.Errorf("invalid alert key %s: bad tag: %s", a, cur.String()))
+		}
+		k = strings.TrimSpace(k)
+		if _, present := g[k]; present {
+			panic(fmt.Errorf("invalid alert key %s: duplicated tag: %s", a, k))
+		}
+		g[k] = strings.TrimSpace(cur.String())
+		cur.Reset()
+		sawEq = false
+	}
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s):
+			i++
+			cur.WriteByte(s[i])
+		case c == '=' && !sawEq:
+			k = cur.String()
+			cur.Reset()
+			sawEq = true
+		case c == ',':
+			add()
+		default:
+			cur.WriteByte(c)
+		}
 	}
+	add()
 	return g
 }
 
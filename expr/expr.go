@@ -6,11 +6,14 @@ import (
 	"math"
 	"reflect"
 	"runtime"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/bosun-monitor/bosun/_third_party/github.com/MiniProfiler/go/miniprofiler"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
 	"github.com/bosun-monitor/bosun/expr/parse"
+	"github.com/bosun-monitor/bosun/graphite"
 	"github.com/bosun-monitor/bosun/search"
 )
 
@@ -20,10 +23,244 @@ type state struct {
 	lookups    map[string]*Lookup
 	now        time.Time
 	autods     int
-	context    opentsdb.Context
+	tsdbs      TSDBProvider
 	queries    []opentsdb.Request
 	unjoinedOk bool
 	squelched  func(tags opentsdb.TagSet) bool
+	history    AlertStatusProvider
+	exclusions []TimeRange
+	calendar   *Calendar
+	match      search.MatchFlags
+	maxGroups  int
+	start      time.Time
+}
+
+// A TimeRange is a closed interval of time.
+type TimeRange struct {
+	Start, End time.Time
+}
+
+// excluded returns true if the unix timestamp ts falls in an excluded range.
+func (e *state) excluded(ts string) bool {
+	if len(e.exclusions) == 0 {
+		return false
+	}
+	i, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return false
+	}
+	t := time.Unix(i, 0)
+	for _, r := range e.exclusions {
+		if !t.Before(r.Start) && !t.After(r.End) {
+			return true
+		}
+	}
+	return false
+}
+
+// TSDBProvider gives expressions access to the time series backends read by
+// query functions. A method returns nil if its backend is not configured,
+// and functions reading from it fail.
+type TSDBProvider interface {
+	OpenTSDB() opentsdb.Context
+	Graphite() graphite.Context
+}
+
+// Backends is a TSDBProvider of fixed contexts.
+type Backends struct {
+	OpenTSDBContext opentsdb.Context
+	GraphiteContext graphite.Context
+}
+
+func (b Backends) OpenTSDB() opentsdb.Context { return b.OpenTSDBContext }
+func (b Backends) Graphite() graphite.Context { return b.GraphiteContext }
+
+// tsdb returns the OpenTSDB context of the evaluation.
+func (e *state) tsdb() (opentsdb.Context, error) {
+	if e.tsdbs != nil {
+		if c := e.tsdbs.OpenTSDB(); c != nil {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("opentsdb: tsdbHost not configured")
+}
+
+// graphite returns the Graphite context of the evaluation.
+func (e *state) graphite() (graphite.Context, error) {
+	if e.tsdbs != nil {
+		if c := e.tsdbs.Graphite(); c != nil {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("graphite: graphiteHost not configured")
+}
+
+// SourceProvider is implemented by an AlertStatusProvider that also tracks
+// when collectors were last seen.
+type SourceProvider interface {
+	SourcesLastSeen() map[string]time.Time
+}
+
+// StateProvider is implemented by an AlertStatusProvider that also knows
+// when alert keys entered their current status.
+type StateProvider interface {
+	StateSince(alert string) map[AlertKey]time.Time
+}
+
+// CritHistoryProvider is implemented by an AlertStatusProvider that also
+// records the crit values of alerts.
+type CritHistoryProvider interface {
+	// CritHistory returns the crit values of each key of alert recorded
+	// since the given time.
+	CritHistory(alert string, since time.Time) map[AlertKey][]float64
+}
+
+// QualityProvider is implemented by an AlertStatusProvider that also tracks
+// data quality problems in relayed data points.
+type QualityProvider interface {
+	// DataQuality returns the number of data points with each problem and
+	// metric since the given time. Recently seen pairs with no problems in
+	// that time have a zero count.
+	DataQuality(since time.Time) []QualityCount
+}
+
+// A QualityCount is the number of data points of a metric with a problem.
+type QualityCount struct {
+	Metric  string
+	Problem string
+	Count   int64
+}
+
+// A Calendar defines the business days counted by "bd" durations. Saturday,
+// Sunday and holidays are not business days.
+type Calendar struct {
+	Location *time.Location  // Time zone of day boundaries; nil is UTC
+	Holidays map[string]bool // Dates as 2006-01-02
+}
+
+// BusinessDay returns true if t is on a business day.
+func (c *Calendar) BusinessDay(t time.Time) bool {
+	if wd := t.Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+	return c == nil || !c.Holidays[t.Format("2006-01-02")]
+}
+
+// maxBusinessDays bounds how far back a "bd" duration may reach.
+const maxBusinessDays = 3660
+
+// duration parses s as a duration. In addition to the opentsdb units, an
+// integer followed by "bd" is a number of business days: the wall-clock
+// time back from now that includes that many days of business day time,
+// skipping weekends and holidays.
+func (e *state) duration(s string) (opentsdb.Duration, error) {
+	if !strings.HasSuffix(s, "bd") {
+		return opentsdb.ParseDuration(s)
+	}
+	n, err := strconv.Atoi(strings.TrimSuffix(s, "bd"))
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("expr: invalid business day duration %s", s)
+	}
+	if n > maxBusinessDays {
+		return 0, fmt.Errorf("expr: business day duration %s too long", s)
+	}
+	loc := e.location()
+	now := e.now.In(loc)
+	t := now
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	remaining := time.Duration(n) * time.Hour * 24
+	for i := 0; remaining > 0; i++ {
+		if i > maxBusinessDays*7 {
+			return 0, fmt.Errorf("expr: no business days in %s", s)
+		}
+		if e.calendar.BusinessDay(day) {
+			avail := t.Sub(day)
+			if avail >= remaining {
+				t = t.Add(-remaining)
+				break
+			}
+			remaining -= avail
+		}
+		t = day
+		day = time.Date(day.Year(), day.Month(), day.Day()-1, 0, 0, 0, 0, loc)
+	}
+	return opentsdb.Duration(now.Sub(t)), nil
+}
+
+// expand expands the wildcard tag values of q and fails if it would return
+// more than maxGroups groups: the product of the number of values of each
+// tag, with "*" counting the values seen by search.
+func (e *state) expand(q *opentsdb.Query) error {
+	if err := e.search.ExpandFlag(q, e.match); err != nil {
+		return err
+	}
+	if e.maxGroups <= 0 {
+		return nil
+	}
+	n := 1
+	for k, v := range q.Tags {
+		if v == "*" {
+			n *= len(e.search.TagValuesByMetricTagKey(q.Metric, k))
+		} else {
+			n *= len(strings.Split(v, "|"))
+		}
+		if n > e.maxGroups {
+			return fmt.Errorf("expr: %s expands to more than maxGroups %d groups", q, e.maxGroups)
+		}
+	}
+	return nil
+}
+
+// checkGroups fails if a query returned more than maxGroups groups, which
+// expand cannot foresee for tags search has not seen.
+func (e *state) checkGroups(n int) error {
+	if e.maxGroups > 0 && n > e.maxGroups {
+		return fmt.Errorf("expr: query returned %d groups, more than maxGroups %d", n, e.maxGroups)
+	}
+	return nil
+}
+
+// location returns the time zone of the calendar's day boundaries.
+func (e *state) location() *time.Location {
+	if e.calendar != nil && e.calendar.Location != nil {
+		return e.calendar.Location
+	}
+	return time.UTC
+}
+
+// businessPoints returns the points of s that fall on business days if
+// either window bound is a "bd" duration, so that a business day window does
+// not include the weekends and holidays it spans. Otherwise s is returned.
+func (e *state) businessPoints(s Series, sduration, eduration string) Series {
+	if !strings.HasSuffix(sduration, "bd") && !strings.HasSuffix(eduration, "bd") {
+		return s
+	}
+	loc := e.location()
+	b := make(Series)
+	for k, v := range s {
+		ts, err := strconv.ParseInt(k, 10, 64)
+		if err != nil || e.calendar.BusinessDay(time.Unix(ts, 0).In(loc)) {
+			b[k] = v
+		}
+	}
+	return b
+}
+
+// window returns sduration, or the duration from the Start option to now if
+// it is set and the window ends at now.
+func (e *state) window(sduration, eduration string) string {
+	if e.start.IsZero() || eduration != "" {
+		return sduration
+	}
+	return fmt.Sprintf("%ds", int64(e.now.Sub(e.start).Seconds()))
+}
+
+// AlertStatusProvider gives expressions access to alert state maintained by
+// the scheduler.
+type AlertStatusProvider interface {
+	// AbnormalSince returns all known keys of alert, each with the time it
+	// became non-normal, or the zero time if it is currently normal.
+	AbnormalSince(alert string) map[AlertKey]time.Time
 }
 
 func (e *state) addRequest(r opentsdb.Request) {
@@ -45,16 +282,45 @@ func New(expr string) (*Expr, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := checkDurations(t.Root); err != nil {
+		return nil, err
+	}
 	e := &Expr{
 		Tree: t,
 	}
 	return e, nil
 }
 
+// Options are the settings of an evaluation that come from the config and
+// the alert being checked rather than from the expression. The zero value
+// evaluates without lookups, alert state, exclusions or a calendar.
+type Options struct {
+	Autods     int  // Downsample queries to this many points; 0 disables
+	UnjoinedOK bool // Drop rather than fail on unjoined groups
+	Lookups    map[string]*Lookup
+	// Squelched reports whether results for tags are dropped; nil drops
+	// none.
+	Squelched func(tags opentsdb.TagSet) bool
+	// History gives access to alert state. If nil, functions that use
+	// alert state return an error.
+	History    AlertStatusProvider
+	Exclusions []TimeRange // Data ignored by baseline functions like band
+	Calendar   *Calendar   // Business days of "bd" durations
+	// Match modifies how wildcard tag values in queries are expanded.
+	Match search.MatchFlags
+	// MaxGroups, if positive, fails queries that expand to or return more
+	// groups.
+	MaxGroups int
+	// Start, if not zero, replaces the start of the q and graphite calls
+	// whose window ends at now, as when graphing a chosen time range.
+	Start time.Time
+}
+
 // Execute applies a parse expression to the specified OpenTSDB context, and
 // returns one result per group. T may be nil to ignore timings.
-func (e *Expr) Execute(c opentsdb.Context, T miniprofiler.Timer, now time.Time, autods int, unjoinedOk bool, search *search.Search, lookups map[string]*Lookup, squelched func(tags opentsdb.TagSet) bool) (r *Results, queries []opentsdb.Request, err error) {
+func (e *Expr) Execute(tsdbs TSDBProvider, T miniprofiler.Timer, now time.Time, search *search.Search, o Options) (r *Results, queries []opentsdb.Request, err error) {
 	defer errRecover(&err)
+	squelched := o.Squelched
 	if squelched == nil {
 		squelched = func(tags opentsdb.TagSet) bool {
 			return false
@@ -62,13 +328,19 @@ func (e *Expr) Execute(c opentsdb.Context, T miniprofiler.Timer, now time.Time,
 	}
 	s := &state{
 		Expr:       e,
-		context:    c,
+		tsdbs:      tsdbs,
 		now:        now,
-		autods:     autods,
-		unjoinedOk: unjoinedOk,
+		autods:     o.Autods,
+		unjoinedOk: o.UnjoinedOK,
 		search:     search,
-		lookups:    lookups,
+		lookups:    o.Lookups,
 		squelched:  squelched,
+		history:    o.History,
+		exclusions: o.Exclusions,
+		calendar:   o.Calendar,
+		match:      o.Match,
+		maxGroups:  o.MaxGroups,
+		start:      o.Start,
 	}
 	if T == nil {
 		T = new(miniprofiler.Profile)
@@ -133,6 +405,9 @@ type Result struct {
 	Computations
 	Value
 	Group opentsdb.TagSet
+	// Bundle holds related series of the group by name, added by bundle()
+	// and carried along so templates can graph them without querying.
+	Bundle map[string]Series `json:",omitempty"`
 }
 
 type Results struct {
@@ -165,8 +440,9 @@ func (r *Result) AddComputation(text string, value interface{}) {
 
 type Union struct {
 	Computations
-	A, B  Value
-	Group opentsdb.TagSet
+	A, B   Value
+	Group  opentsdb.TagSet
+	Bundle map[string]Series
 }
 
 // wrap creates a new Result with a nil group and given value.
@@ -183,6 +459,22 @@ func wrap(v float64) *Results {
 
 func (u *Union) ExtendComputations(o *Result) {
 	u.Computations = append(u.Computations, o.Computations...)
+	u.Bundle = extendBundle(u.Bundle, o.Bundle)
+}
+
+// extendBundle adds the series of b to a that a does not already have.
+func extendBundle(a, b map[string]Series) map[string]Series {
+	if len(b) == 0 {
+		return a
+	}
+	m := make(map[string]Series, len(a)+len(b))
+	for k, v := range b {
+		m[k] = v
+	}
+	for k, v := range a {
+		m[k] = v
+	}
+	return m
 }
 
 // union returns the combination of a and b where one is a subset of the other.
@@ -283,6 +575,7 @@ func (e *state) walkBinary(node *parse.BinaryNode, T miniprofiler.Timer) *Result
 		r := Result{
 			Group:        v.Group,
 			Computations: v.Computations,
+			Bundle:       v.Bundle,
 		}
 		an, aok := v.A.(Scalar)
 		bn, bok := v.B.(Scalar)
@@ -337,6 +630,20 @@ func (e *state) walkBinary(node *parse.BinaryNode, T miniprofiler.Timer) *Result
 						s[k] = opentsdb.Point(operate(node.OpStr, float64(v), bv))
 					}
 					value = s
+				case Series:
+					// Only timestamps present in both series are kept. Series
+					// from differently downsampled queries should be passed
+					// through align() first.
+					s := make(Series)
+					for k, v := range at {
+						if w, ok := bt[k]; ok {
+							s[k] = opentsdb.Point(operate(node.OpStr, float64(v), float64(w)))
+						}
+					}
+					if len(s) == 0 && len(at) > 0 && len(bt) > 0 {
+						panic(fmt.Errorf("expr: %s: series for %s share no timestamps; use align()", node, v.Group))
+					}
+					value = s
 				default:
 					panic(ErrUnknownOp)
 				}
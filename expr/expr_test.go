@@ -1,10 +1,16 @@
 package expr
 
 import (
+	"math"
+	"reflect"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/expr/parse"
+	"github.com/bosun-monitor/bosun/graphite"
+	"github.com/bosun-monitor/bosun/search"
 )
 
 func TestExprSimple(t *testing.T) {
@@ -35,6 +41,10 @@ func TestExprSimple(t *testing.T) {
 		{"1>=2", 0},
 		{"-1 > 0", 0},
 		{"-1 < 0", 1},
+		{`d("1h30m")`, 5400},
+		{`d("1d") / d("1h")`, 24},
+		{`d("0bd")`, 0},
+		{`seconds("2m")`, 120},
 	}
 
 	for _, et := range exprTests {
@@ -43,7 +53,7 @@ func TestExprSimple(t *testing.T) {
 			t.Error(err)
 			break
 		}
-		r, _, err := e.Execute(opentsdb.Host(""), nil, time.Now(), 0, false, nil, nil, nil)
+		r, _, err := e.Execute(nil, nil, time.Now(), nil, Options{})
 		if err != nil {
 			t.Error(err)
 			break
@@ -59,12 +69,267 @@ func TestExprSimple(t *testing.T) {
 	}
 }
 
+type testHistory map[AlertKey]time.Time
+
+func (h testHistory) AbnormalSince(alert string) map[AlertKey]time.Time {
+	m := make(map[AlertKey]time.Time)
+	for ak, t := range h {
+		if ak.Name() == alert {
+			m[ak] = t
+		}
+	}
+	return m
+}
+
+func TestAbnormalFor(t *testing.T) {
+	now := time.Now()
+	h := testHistory{
+		"a{host=x}": now.Add(-time.Hour),
+		"a{host=y}": time.Time{},
+		"b{host=x}": now.Add(-time.Minute),
+	}
+	e, err := New(`abnormalFor("a") > 1800`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, _, err := e.Execute(nil, nil, now, nil, Options{History: h})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(r.Results))
+	}
+	for _, res := range r.Results {
+		expect := Number(0)
+		if res.Group["host"] == "x" {
+			expect = 1
+		}
+		if res.Value != expect {
+			t.Errorf("%v: expected %v, got %v", res.Group, expect, res.Value)
+		}
+	}
+}
+
+// testStates uses the times of a testHistory as state change times.
+type testStates struct{ testHistory }
+
+func (h testStates) StateSince(alert string) map[AlertKey]time.Time {
+	return h.AbnormalSince(alert)
+}
+
+func TestStateFor(t *testing.T) {
+	now := time.Now()
+	h := testStates{testHistory{
+		"a{host=x}": now.Add(-time.Hour),
+		"a{host=y}": now.Add(-time.Minute),
+	}}
+	e, err := New(`stateFor("a") > 1800`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, _, err := e.Execute(nil, nil, now, nil, Options{History: h})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(r.Results))
+	}
+	for _, res := range r.Results {
+		expect := Number(0)
+		if res.Group["host"] == "x" {
+			expect = 1
+		}
+		if res.Value != expect {
+			t.Errorf("%v: expected %v, got %v", res.Group, expect, res.Value)
+		}
+	}
+	if _, _, err := e.Execute(nil, nil, now, nil, Options{History: h.testHistory}); err == nil {
+		t.Error("expected error without a StateProvider")
+	}
+}
+
+type testCrits struct {
+	testHistory
+	values map[AlertKey][]float64
+}
+
+func (h testCrits) CritHistory(alert string, since time.Time) map[AlertKey][]float64 {
+	return h.values
+}
+
+func TestCritQuantile(t *testing.T) {
+	h := testCrits{values: map[AlertKey][]float64{
+		"a{host=x}": {5, 1, 4, 2, 3},
+		"a{host=y}": {10},
+	}}
+	e, err := New(`critQuantile("a", .5, "1w")`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, _, err := e.Execute(nil, nil, time.Now(), nil, Options{History: h})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(r.Results))
+	}
+	for _, res := range r.Results {
+		expect := Number(3)
+		if res.Group["host"] == "y" {
+			expect = 10
+		}
+		if res.Value != expect {
+			t.Errorf("%v: expected %v, got %v", res.Group, expect, res.Value)
+		}
+	}
+	if _, _, err := e.Execute(nil, nil, time.Now(), nil, Options{History: h.testHistory}); err == nil {
+		t.Error("expected error without a CritHistoryProvider")
+	}
+}
+
+func TestExcluded(t *testing.T) {
+	e := &state{
+		exclusions: []TimeRange{
+			{time.Unix(100, 0), time.Unix(200, 0)},
+		},
+	}
+	for ts, expect := range map[string]bool{
+		"99":  false,
+		"100": true,
+		"150": true,
+		"200": true,
+		"201": false,
+	} {
+		if got := e.excluded(ts); got != expect {
+			t.Errorf("%s: expected %v, got %v", ts, expect, got)
+		}
+	}
+}
+
+func TestBusinessDuration(t *testing.T) {
+	// Monday 10:00.
+	now := time.Date(2015, 3, 9, 10, 0, 0, 0, time.UTC)
+	holiday := &Calendar{Holidays: map[string]bool{"2015-03-06": true}}
+	tests := []struct {
+		d        string
+		calendar *Calendar
+		expect   time.Duration
+	}{
+		{"1h", nil, time.Hour},
+		{"0bd", nil, 0},
+		{"1bd", nil, time.Hour * 72},
+		{"2bd", nil, time.Hour * 96},
+		{"1bd", holiday, time.Hour * 96},
+	}
+	for _, test := range tests {
+		e := &state{now: now, calendar: test.calendar}
+		d, err := e.duration(test.d)
+		if err != nil {
+			t.Errorf("%s: %v", test.d, err)
+		} else if time.Duration(d) != test.expect {
+			t.Errorf("%s: expected %v, got %v", test.d, test.expect, time.Duration(d))
+		}
+	}
+	if _, err := (&state{now: now}).duration("xbd"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+// pointsContext answers every query with a series of its points for host a.
+type pointsContext map[string]opentsdb.Point
+
+func (c pointsContext) Query(r *opentsdb.Request) (opentsdb.ResponseSet, error) {
+	return opentsdb.ResponseSet{{
+		Metric: r.Queries[0].Metric,
+		Tags:   opentsdb.TagSet{"host": "a"},
+		DPS:    c,
+	}}, nil
+}
+
+func TestBusinessQuery(t *testing.T) {
+	// Monday 10:00.
+	now := time.Date(2015, 3, 9, 10, 0, 0, 0, time.UTC)
+	at := func(day, hour int) string {
+		return strconv.FormatInt(time.Date(2015, 3, day, hour, 0, 0, 0, time.UTC).Unix(), 10)
+	}
+	for _, test := range []struct {
+		expr   string
+		expect []string
+	}{
+		{`q("avg:cpu{host=*}", "2bd", "")`, []string{at(6, 12), at(9, 8)}},
+		{`q("avg:cpu{host=*}", "4d", "")`, []string{at(6, 12), at(7, 12), at(8, 12), at(9, 8)}},
+	} {
+		// Friday, Saturday, Sunday, and Monday morning.
+		c := pointsContext{at(6, 12): 1, at(7, 12): 2, at(8, 12): 3, at(9, 8): 4}
+		e, err := New(test.expr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, _, err := e.Execute(Backends{OpenTSDBContext: c}, nil, now, search.NewSearch(), Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r.Results) != 1 {
+			t.Fatalf("%s: unexpected results: %+v", test.expr, r.Results)
+		}
+		s := r.Results[0].Value.(Series)
+		if len(s) != len(test.expect) {
+			t.Errorf("%s: expected %v, got %v", test.expr, test.expect, s)
+		}
+		for _, k := range test.expect {
+			if _, ok := s[k]; !ok {
+				t.Errorf("%s: missing point %s in %v", test.expr, k, s)
+			}
+		}
+	}
+}
+
+func TestAlertKey(t *testing.T) {
+	tests := []struct {
+		input     string
+		canonical AlertKey
+	}{
+		{"a{}", "a{}"},
+		{"a{host=x}", "a{host=x}"},
+		{"a{ host = x , dc = ny }", "a{dc=ny,host=x}"},
+		{`a{k=v\,w\=x\}}`, `a{k=v\,w\=x\}}`},
+	}
+	for _, test := range tests {
+		ak, err := ParseAlertKey(test.input)
+		if err != nil {
+			t.Errorf("%s: %v", test.input, err)
+			continue
+		}
+		if ak != test.canonical {
+			t.Errorf("%s: expected %s, got %s", test.input, test.canonical, ak)
+		}
+		if rt := NewAlertKey(ak.Name(), ak.Group()); rt != ak {
+			t.Errorf("%s: round trip gave %s", test.input, rt)
+		}
+	}
+	if g := AlertKey(`a{k=v\,w\=x\}}`).Group(); g["k"] != "v,w=x}" {
+		t.Errorf("bad unescape: %v", g)
+	}
+	for _, bad := range []string{"a", "a{k}", "a{k=1,k=2}", "a{k=1"} {
+		if _, err := ParseAlertKey(bad); err == nil {
+			t.Errorf("%s: expected error", bad)
+		}
+	}
+}
+
 func TestExprParse(t *testing.T) {
 	var exprTests = []struct {
 		input string
 		valid bool
 	}{
 		{`avg(q("test", "1m", 1))`, false},
+		{`d("1h30m") > 60`, true},
+		{`d("90 minutes")`, false},
+		{`d("x5bd")`, false},
+		{`d("5bd")`, true},
+		{`seconds("x5bd")`, false},
+		{`seconds("5bd")`, true},
+		{`seconds("90 minutes")`, false},
 	}
 
 	for _, et := range exprTests {
@@ -77,6 +342,482 @@ func TestExprParse(t *testing.T) {
 	}
 }
 
+func TestSeverity(t *testing.T) {
+	tests := []struct {
+		v          float64
+		thresholds []float64
+		level      float64
+	}{
+		{50, []float64{80, 90, 95}, 0},
+		{80, []float64{80, 90, 95}, 1},
+		{92, []float64{80, 90, 95}, 2},
+		{99, []float64{80, 90, 95}, 3},
+		{25, []float64{20, 10, 5}, 0},
+		{10, []float64{20, 10, 5}, 2},
+		{1, []float64{20, 10, 5}, 3},
+		{3, []float64{1}, 1},
+	}
+	for _, test := range tests {
+		if l := severity(test.v, test.thresholds); l != test.level {
+			t.Errorf("severity(%v, %v): expected %v, got %v", test.v, test.thresholds, test.level, l)
+		}
+	}
+}
+
+func TestNth(t *testing.T) {
+	s := Series{"30": 3, "10": 1, "20": 2}
+	tests := []struct {
+		n, v float64
+	}{
+		{0, 1},
+		{1, 2},
+		{2, 3},
+		{-1, 3},
+		{-3, 1},
+	}
+	for _, test := range tests {
+		if v := nth(s, test.n); v != test.v {
+			t.Errorf("nth(%v): expected %v, got %v", test.n, test.v, v)
+		}
+	}
+	if v := nth(s, 3); !math.IsNaN(v) {
+		t.Errorf("nth out of range: expected NaN, got %v", v)
+	}
+	if first(s) != 1 || last(s) != 3 {
+		t.Errorf("bad first/last: %v, %v", first(s), last(s))
+	}
+}
+
+func TestOutliers(t *testing.T) {
+	vals := []float64{10, 11, 9, 10, 12, 50, 10}
+	expect := []float64{0, 0, 0, 0, 0, 1, 0}
+	got := outliers(vals, 3)
+	for i := range expect {
+		if got[i] != expect[i] {
+			t.Errorf("outliers(%v): expected %v, got %v", vals, expect, got)
+			break
+		}
+	}
+	if vals[5] != 50 {
+		t.Errorf("outliers modified its input: %v", vals)
+	}
+}
+
+func TestCounterRate(t *testing.T) {
+	s := Series{
+		"0":  100,
+		"10": 200,
+		"20": 50,                  // reset
+		"30": math.MaxUint32 - 99, // near 32-bit max
+		"40": 100,                 // 32-bit wrap
+	}
+	near := float64(math.MaxUint32 - 99)
+	expect := Series{
+		"10": 10,
+		"20": 5,
+		"30": opentsdb.Point((near - 50) / 10),
+		"40": 20,
+	}
+	r := counterRate(s)
+	if len(r) != len(expect) {
+		t.Fatalf("expected %v, got %v", expect, r)
+	}
+	for k, v := range expect {
+		if r[k] != v {
+			t.Errorf("%s: expected %v, got %v", k, v, r[k])
+		}
+	}
+}
+
+func TestBurnRate(t *testing.T) {
+	s := Series{
+		"0":  1,
+		"10": 1,
+		"20": .99,
+		"30": .98,
+	}
+	// long: 1 - .9925 = .0075 of a .01 budget; short: 1 - .985 = .015.
+	if v := burnrate(s, 20, .99); math.Abs(v-.75) > 1e-9 {
+		t.Errorf("expected .75, got %v", v)
+	}
+	if v := burnrate(s, 40, .99); !math.IsNaN(v) {
+		t.Errorf("expected NaN for empty short window, got %v", v)
+	}
+}
+
+func TestDownsample(t *testing.T) {
+	s := make(Series)
+	for i := 0; i < 100; i++ {
+		s[strconv.Itoa(i)] = opentsdb.Point(i)
+	}
+	sum := func(a, b float64) float64 { return a + b }
+	r := downsample(s, 10, sum, true)
+	if len(r) != 10 {
+		t.Fatalf("expected 10 points, got %d", len(r))
+	}
+	if r["0"] != 4.5 || r["90"] != 94.5 {
+		t.Errorf("bad averages: %v", r)
+	}
+	r = downsample(s, 3, math.Max, false)
+	if len(r) != 3 || r["0"] != 33 || r["67"] != 99 {
+		t.Errorf("bad max: %v", r)
+	}
+}
+
+func TestAlign(t *testing.T) {
+	s := Series{"59": 1, "61": 2, "65": 4, "200": 8}
+	r := align(s, 60, "avg", "none")
+	if len(r) != 3 || r["0"] != 1 || r["60"] != 3 || r["180"] != 8 {
+		t.Errorf("bad avg: %v", r)
+	}
+	r = align(s, 60, "max", "prev")
+	if len(r) != 4 || r["60"] != 4 || r["120"] != 4 {
+		t.Errorf("bad prev fill: %v", r)
+	}
+	r = align(s, 60, "last", "zero")
+	if r["60"] != 4 || r["120"] != 0 {
+		t.Errorf("bad zero fill: %v", r)
+	}
+}
+
+func TestDecompose(t *testing.T) {
+	s := make(Series)
+	pattern := []float64{0, 5, 10, 5, 0, -5}
+	for i := 0; i < 60; i++ {
+		v := float64(i) + pattern[i%len(pattern)]
+		if i == 30 {
+			v += 50
+		}
+		s[strconv.Itoa(i*10)] = opentsdb.Point(v)
+	}
+	r, err := decompose(s, 60, "residual")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := r["300"]; v < 30 {
+		t.Errorf("expected spike in residual, got %v", v)
+	}
+	for _, k := range []string{"100", "150", "450", "500"} {
+		if v := r[k]; math.Abs(float64(v)) > 10 {
+			t.Errorf("%s: expected small residual, got %v", k, v)
+		}
+	}
+	if _, err := decompose(s, 600, "residual"); err == nil {
+		t.Error("expected error for short series")
+	}
+}
+
+func TestIntegral(t *testing.T) {
+	s := Series{"0": 1, "10": 3, "30": 3}
+	if v := integral(s); v != 80 {
+		t.Errorf("expected 80, got %v", v)
+	}
+	if v := integral(Series{"5": 7}); v != 0 {
+		t.Errorf("expected 0 for one point, got %v", v)
+	}
+}
+
+func TestTrueFor(t *testing.T) {
+	tests := []struct {
+		s      Series
+		expect float64
+	}{
+		{Series{"0": 1, "60": 0, "120": 1, "180": 1, "240": 1}, 120},
+		{Series{"0": 1, "60": 1, "120": 0}, 0},
+		{Series{"0": 1, "60": opentsdb.Point(math.NaN()), "120": 1}, 0},
+		{Series{"0": 1, "600": 1}, 600},
+	}
+	for i, test := range tests {
+		if v := trueFor(test.s); v != test.expect {
+			t.Errorf("%d: expected %v, got %v", i, test.expect, v)
+		}
+	}
+}
+
+func TestRatio(t *testing.T) {
+	hosts := new(Results)
+	for _, h := range []string{"a", "b"} {
+		hosts.Results = append(hosts.Results, &Result{Group: opentsdb.TagSet{"host": h, "dc": "ny"}, Value: Number(10)})
+	}
+	hosts.Results = append(hosts.Results, &Result{Group: opentsdb.TagSet{"host": "c", "dc": "la"}, Value: Number(10)})
+	dcs := &Results{Results: []*Result{
+		{Group: opentsdb.TagSet{"dc": "ny"}, Value: Number(40)},
+		{Group: opentsdb.TagSet{"dc": "la"}, Value: Number(0)},
+	}}
+	ratios := func(r *Results) map[string]Number {
+		m := make(map[string]Number)
+		for _, res := range r.Results {
+			m[res.Group.String()] = res.Value.(Number)
+		}
+		return m
+	}
+	// Each host joins its dc; a zero denominator gives def.
+	r, err := Ratio(nil, nil, hosts, dcs, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := map[string]Number{"{dc=ny,host=a}": 0.25, "{dc=ny,host=b}": 0.25, "{dc=la,host=c}": -1}
+	if got := ratios(r); !reflect.DeepEqual(got, expect) {
+		t.Errorf("hosts over dcs: expected %v, got %v", expect, got)
+	}
+	// Each dc joins all of its hosts, with the hosts' groups.
+	r, err = Ratio(nil, nil, dcs, hosts, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect = map[string]Number{"{dc=ny,host=a}": 4, "{dc=ny,host=b}": 4, "{dc=la,host=c}": 0}
+	if got := ratios(r); !reflect.DeepEqual(got, expect) {
+		t.Errorf("dcs over hosts: expected %v, got %v", expect, got)
+	}
+	// Groups without a join are def.
+	r, err = Ratio(nil, nil, dcs, &Results{}, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect = map[string]Number{"{dc=ny}": -1, "{dc=la}": -1}
+	if got := ratios(r); !reflect.DeepEqual(got, expect) {
+		t.Errorf("unjoined: expected %v, got %v", expect, got)
+	}
+}
+
+func TestWAvg(t *testing.T) {
+	values, weights := new(Results), new(Results)
+	for i, v := range []struct{ latency, requests float64 }{{100, 90}, {500, 10}, {1000, 0}} {
+		g := opentsdb.TagSet{"host": strconv.Itoa(i)}
+		values.Results = append(values.Results, &Result{Group: g, Value: Number(v.latency)})
+		weights.Results = append(weights.Results, &Result{Group: g, Value: Number(v.requests)})
+	}
+	values.Results = append(values.Results, &Result{Group: opentsdb.TagSet{"host": "unweighted"}, Value: Number(5000)})
+	r, err := WAvg(nil, nil, values, weights)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Results) != 1 || r.Results[0].Value != Number(140) {
+		t.Errorf("expected 140, got %+v", r.Results[0])
+	}
+	// Latency per dc, weighted by requests to each host of the dc.
+	dcs := &Results{Results: []*Result{
+		{Group: opentsdb.TagSet{"dc": "ny"}, Value: Number(100)},
+		{Group: opentsdb.TagSet{"dc": "la"}, Value: Number(400)},
+	}}
+	hosts := &Results{Results: []*Result{
+		{Group: opentsdb.TagSet{"dc": "ny", "host": "a"}, Value: Number(30)},
+		{Group: opentsdb.TagSet{"dc": "ny", "host": "b"}, Value: Number(60)},
+		{Group: opentsdb.TagSet{"dc": "la", "host": "c"}, Value: Number(10)},
+	}}
+	r, err = WAvg(nil, nil, dcs, hosts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Results) != 1 || r.Results[0].Value != Number(130) {
+		t.Errorf("expected 130, got %+v", r.Results[0])
+	}
+}
+
+func TestPick(t *testing.T) {
+	d := &Results{}
+	for _, host := range []string{"web1", "web2"} {
+		d.Results = append(d.Results, &Result{
+			Group: opentsdb.TagSet{"host": host, "dc": "ny"},
+			Value: Number(len(d.Results)),
+		})
+	}
+	r, err := Pick(nil, nil, d, "host=web2,dc=ny")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Results) != 1 || r.Results[0].Value != Number(1) {
+		t.Errorf("bad pick: %+v", r.Results)
+	}
+	if _, err := Pick(nil, nil, d, "dc=ny"); err == nil {
+		t.Error("expected error for ambiguous tags")
+	}
+	if _, err := Pick(nil, nil, d, "host=web3"); err == nil {
+		t.Error("expected error for missing group")
+	}
+}
+
+// shiftContext answers queries ending now with cur, and earlier queries with
+// prev.
+type shiftContext struct {
+	now       time.Time
+	cur, prev float64
+}
+
+func (c shiftContext) Query(r *opentsdb.Request) (opentsdb.ResponseSet, error) {
+	end, err := opentsdb.ParseTime(r.End)
+	if err != nil {
+		return nil, err
+	}
+	v := c.cur
+	if c.now.Sub(end) > time.Hour {
+		v = c.prev
+	}
+	return opentsdb.ResponseSet{{
+		Tags: opentsdb.TagSet{"host": "a"},
+		DPS:  map[string]opentsdb.Point{strconv.FormatInt(end.Unix(), 10): opentsdb.Point(v)},
+	}}, nil
+}
+
+func TestOverPeriod(t *testing.T) {
+	now := time.Now()
+	for _, f := range []string{"dayOverDay", "weekOverWeek"} {
+		e, err := New(f + `("avg:m{host=*}", "1h")`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, _, err := e.Execute(Backends{OpenTSDBContext: shiftContext{now, 300, 200}}, nil, now, search.NewSearch(), Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r.Results) != 1 || r.Results[0].Value != Number(1.5) {
+			t.Errorf("%s: expected 1.5, got %+v", f, r.Results)
+		}
+	}
+}
+
+func TestLag(t *testing.T) {
+	up, down := make(Series), make(Series)
+	for i := int64(0); i < 60; i++ {
+		v := opentsdb.Point(math.Sin(float64(i) / 5))
+		up[strconv.FormatInt(i*60, 10)] = v
+		down[strconv.FormatInt(i*60+180, 10)] = v * 10
+	}
+	a := &Results{Results: []*Result{{Value: up, Group: opentsdb.TagSet{"host": "a"}}}}
+	b := &Results{Results: []*Result{{Value: down, Group: opentsdb.TagSet{}}}}
+	r, err := Lag(new(state), nil, a, b, "10m", "1m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := r.Results[0].Value.(Number); v != 180 {
+		t.Errorf("expected lag 180, got %v", v)
+	}
+	r, err = Lag(new(state), nil, b, a, "10m", "1m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := r.Results[0].Value.(Number); !math.IsNaN(float64(v)) {
+		t.Errorf("expected NaN for unmatched group, got %v", v)
+	}
+}
+
+type graphiteContext graphite.Response
+
+func (g graphiteContext) Query(r *graphite.Request) (graphite.Response, error) {
+	return graphite.Response(g), nil
+}
+
+func TestGraphite(t *testing.T) {
+	v := 2.0
+	g := graphiteContext{
+		{Target: "web01.cpu.0", Datapoints: []graphite.DataPoint{{&v, 100}, {nil, 160}}},
+		{Target: "web02.cpu.0", Datapoints: []graphite.DataPoint{{&v, 100}}},
+	}
+	e, err := New(`sum(graphite("*.cpu.0", "5m", "", "host.."))`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, _, err := e.Execute(Backends{GraphiteContext: g}, nil, time.Now(), nil, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Results) != 2 {
+		t.Fatalf("expected 2 results, got %v", r.Results)
+	}
+	for _, res := range r.Results {
+		if res.Value != Number(2) || len(res.Group) != 1 || res.Group["host"] == "" {
+			t.Errorf("unexpected result %v %v", res.Group, res.Value)
+		}
+	}
+	for _, q := range []string{
+		`sum(graphite("*.cpu.0", "5m", "", ""))`,
+		`sum(graphite("*.cpu.0", "5m", "", "host"))`,
+	} {
+		e, err := New(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := e.Execute(Backends{GraphiteContext: g}, nil, time.Now(), nil, Options{}); err == nil {
+			t.Errorf("%s: expected error", q)
+		}
+	}
+}
+
+func TestPairDiff(t *testing.T) {
+	d := &Results{Results: []*Result{
+		{Value: Number(10), Group: opentsdb.TagSet{"host": "a", "role": "primary"}},
+		{Value: Number(7), Group: opentsdb.TagSet{"host": "a", "role": "replica"}},
+		{Value: Number(5), Group: opentsdb.TagSet{"host": "b", "role": "primary"}},
+		{Value: Number(1), Group: opentsdb.TagSet{"host": "c", "role": "replica"}},
+	}}
+	r, err := PairDiff(nil, nil, d, "role", "primary", "replica")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Results) != 1 {
+		t.Fatalf("expected 1 pair, got %v", r.Results)
+	}
+	if res := r.Results[0]; res.Value != Number(3) || !res.Group.Equal(opentsdb.TagSet{"host": "a"}) {
+		t.Errorf("expected host=a 3, got %v %v", res.Group, res.Value)
+	}
+}
+
+func TestGPercentile(t *testing.T) {
+	d := &Results{}
+	for i, dc := range []string{"ny", "ny", "ny", "la", "la"} {
+		d.Results = append(d.Results, &Result{
+			Group: opentsdb.TagSet{"dc": dc, "host": strconv.Itoa(i)},
+			Value: Number(i),
+		})
+	}
+	r, err := GPercentile(nil, nil, d, 1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Results) != 1 || r.Results[0].Value != Number(4) || len(r.Results[0].Group) != 0 {
+		t.Errorf("bad fleet max: %+v", r.Results[0])
+	}
+	r, _ = GPercentile(nil, nil, d, 0, "dc")
+	for _, res := range r.Results {
+		expect := map[string]Number{"ny": 0, "la": 3}[res.Group["dc"]]
+		if res.Value != expect {
+			t.Errorf("%s: expected %v, got %v", res.Group, expect, res.Value)
+		}
+	}
+	for _, p := range []float64{-0.1, 1.5} {
+		if _, err := GPercentile(nil, nil, d, p, ""); err == nil {
+			t.Errorf("%v: expected error", p)
+		}
+	}
+}
+
+func TestDES(t *testing.T) {
+	s := Series{"0": 10, "1": 12, "2": 11, "3": 15}
+	// alpha = beta = .5: the level starts at 10 and the trend at 12 - 10.
+	//   1: level .5*12 + .5*(10+2)   = 12,    trend .5*2    + .5*2   = 2
+	//   2: level .5*11 + .5*(12+2)   = 12.5,  trend .5*.5   + .5*2   = 1.25
+	//   3: level .5*15 + .5*(12.5+1.25) = 14.375
+	expect := Series{"0": 10, "1": 12, "2": 12.5, "3": 14.375}
+	r := des(s, .5, .5)
+	if len(r) != len(expect) {
+		t.Fatalf("expected %v, got %v", expect, r)
+	}
+	for k, v := range expect {
+		if math.Abs(float64(r[k]-v)) > 1e-9 {
+			t.Errorf("%s: expected %v, got %v", k, v, r[k])
+		}
+	}
+	// With alpha = 1 the level follows the data exactly.
+	for k, v := range s {
+		if r := des(s, 1, .3); r[k] != v {
+			t.Errorf("alpha 1: %s: expected %v, got %v", k, v, r[k])
+		}
+	}
+	if _, err := DES(nil, nil, &Results{}, 0, .5); err == nil {
+		t.Error("expected error for alpha 0")
+	}
+}
+
 /*
 const TSDBHost = "ny-devtsdb04:4242"
 
@@ -91,3 +832,279 @@ func TestExprQuery(t *testing.T) {
 	}
 }
 */
+
+// metricContext answers queries for each metric with a series of its value
+// for host a.
+type metricContext map[string]float64
+
+func (c metricContext) Query(r *opentsdb.Request) (opentsdb.ResponseSet, error) {
+	m := r.Queries[0].Metric
+	return opentsdb.ResponseSet{{
+		Metric: m,
+		Tags:   opentsdb.TagSet{"host": "a"},
+		DPS:    map[string]opentsdb.Point{"0": opentsdb.Point(c[m])},
+	}}, nil
+}
+
+func TestBundle(t *testing.T) {
+	e, err := New(`avg(bundle(bundle(q("avg:cpu{host=*}", "1h", ""), "mem", q("avg:mem{host=*}", "1h", "")), "disk", q("avg:disk{host=*}", "1h", ""))) > 50`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := metricContext{"cpu": 90, "mem": 2, "disk": 3}
+	r, _, err := e.Execute(Backends{OpenTSDBContext: c}, nil, time.Now(), search.NewSearch(), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Results) != 1 || r.Results[0].Value != Number(1) {
+		t.Fatalf("unexpected results: %+v", r.Results)
+	}
+	b := r.Results[0].Bundle
+	if len(b) != 2 || b["mem"]["0"] != 2 || b["disk"]["0"] != 3 {
+		t.Errorf("unexpected bundle: %v", b)
+	}
+}
+
+// requestContext records the requests it is sent.
+type requestContext []*opentsdb.Request
+
+func (c *requestContext) Query(r *opentsdb.Request) (opentsdb.ResponseSet, error) {
+	*c = append(*c, r)
+	return opentsdb.ResponseSet{{
+		Metric: r.Queries[0].Metric,
+		Tags:   opentsdb.TagSet{"host": "a"},
+		DPS:    map[string]opentsdb.Point{"1370000000": 1},
+	}}, nil
+}
+
+func TestMaxGroups(t *testing.T) {
+	sr := search.NewSearch()
+	for _, h := range []string{"a", "b", "c"} {
+		sr.Index(opentsdb.MultiDataPoint{{Metric: "cpu", Timestamp: 10, Value: 1, Tags: opentsdb.TagSet{"host": h}}})
+	}
+	sr.Lock()
+	sr.Copy()
+	sr.Unlock()
+	for _, test := range []struct {
+		query string
+		fail  bool
+	}{
+		{`q("avg:cpu{host=*}", "1h", "")`, true},
+		{`q("avg:cpu{host=a|b|c}", "1h", "")`, true},
+		{`q("avg:cpu{host=a|b}", "1h", "")`, false},
+		{`q("avg:cpu", "1h", "")`, false},
+	} {
+		var c requestContext
+		e, err := New(test.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _, err = e.Execute(Backends{OpenTSDBContext: &c}, nil, time.Now(), sr, Options{MaxGroups: 2})
+		if test.fail {
+			if err == nil {
+				t.Errorf("%s: expected error", test.query)
+			}
+			if len(c) != 0 {
+				t.Errorf("%s: expected no queries, got %d", test.query, len(c))
+			}
+		} else if err != nil {
+			t.Errorf("%s: %v", test.query, err)
+		}
+	}
+}
+
+func TestQueryStart(t *testing.T) {
+	now := time.Unix(1370000000, 0).UTC()
+	for _, test := range []struct {
+		query string
+		start string
+	}{
+		{`q("avg:cpu", "1h", "")`, "2013/05/31-09:33:20"},
+		{`q("avg:cpu", "1h", "30m")`, "2013/05/31-10:33:20"},
+		{`change("avg:cpu", "1h", "")`, "2013/05/31-10:33:20"},
+	} {
+		var c requestContext
+		e, err := New(test.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := e.Execute(Backends{OpenTSDBContext: &c}, nil, now, search.NewSearch(), Options{Start: now.Add(-2 * time.Hour)}); err != nil {
+			t.Fatal(err)
+		}
+		if len(c) != 1 || c[0].Start != test.start {
+			t.Errorf("%s: expected start %s, got %+v", test.query, test.start, c)
+		}
+	}
+}
+
+func TestQueryBetween(t *testing.T) {
+	var c requestContext
+	e, err := New(`avg(qbetween("avg:cpu{host=*}", "2013-06-01T00:00:00Z", "2013-06-01T01:00:00Z"))`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, _, err := e.Execute(Backends{OpenTSDBContext: &c}, nil, time.Now(), search.NewSearch(), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Results) != 1 || r.Results[0].Value != Number(1) {
+		t.Errorf("unexpected results: %+v", r.Results)
+	}
+	if len(c) != 1 || c[0].Start != int64(1370044800) || c[0].End != int64(1370048400) {
+		t.Errorf("unexpected requests: %+v", c)
+	}
+	for _, q := range []string{
+		`qbetween("avg:cpu", "yesterday", "2013-06-01T01:00:00Z")`,
+		`qbetween("avg:cpu", "2013-06-01T01:00:00Z", "2013-06-01T00:00:00Z")`,
+	} {
+		e, err := New(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := e.Execute(Backends{OpenTSDBContext: &c}, nil, time.Now(), search.NewSearch(), Options{}); err == nil {
+			t.Errorf("%s: expected error", q)
+		}
+	}
+}
+
+func TestBand(t *testing.T) {
+	var c requestContext
+	now := time.Unix(1370044800, 0)
+	e, err := New(`len(band("avg:cpu{host=*}", "1h", "1w", 4))`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, _, err := e.Execute(Backends{OpenTSDBContext: &c}, nil, now, search.NewSearch(), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Results) != 1 || r.Results[0].Value != Number(1) {
+		t.Errorf("unexpected results: %+v", r.Results)
+	}
+	if len(c) != 4 {
+		t.Fatalf("expected 4 requests, got %d", len(c))
+	}
+	week := int64(7 * 24 * 3600)
+	for i, req := range c {
+		end := now.Unix() - int64(i+1)*week
+		if req.End != end || req.Start != end-3600 {
+			t.Errorf("period %d: expected %d to %d, got %v to %v", i, end-3600, end, req.Start, req.End)
+		}
+	}
+	for _, q := range []string{
+		`band("avg:cpu", "1h", "1w", 0)`,
+		`band("avg:cpu", "1h", "1w", 101)`,
+	} {
+		e, err := New(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := e.Execute(Backends{OpenTSDBContext: &c}, nil, now, search.NewSearch(), Options{}); err == nil {
+			t.Errorf("%s: expected error", q)
+		}
+	}
+}
+
+func TestIfElse(t *testing.T) {
+	host := func(h string, v Value) *Result {
+		return &Result{Group: opentsdb.TagSet{"host": h}, Value: v}
+	}
+	a := &Results{Results: []*Result{host("a", Series{"1": 1}), host("b", Series{"1": 1})}}
+	b := &Results{Results: []*Result{host("a", Series{"1": 2}), host("b", Series{"1": 2}), host("c", Series{"1": 2})}}
+	cond := &Results{Results: []*Result{host("a", Number(1)), host("b", Number(0)), host("c", Number(math.NaN()))}}
+	r, err := IfElse(nil, nil, cond, a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := map[string]Value{"a": Series{"1": 1}, "b": Series{"1": 2}, "c": Series{"1": 2}}
+	if len(r.Results) != len(expect) {
+		t.Fatalf("unexpected results: %v", r.Results)
+	}
+	for _, res := range r.Results {
+		if !reflect.DeepEqual(res.Value, expect[res.Group["host"]]) {
+			t.Errorf("host %s: expected %v, got %v", res.Group["host"], expect[res.Group["host"]], res.Value)
+		}
+	}
+	r, _ = IfElse(nil, nil, &Results{Results: []*Result{{Value: Number(1)}}}, a, b)
+	if len(r.Results) != 2 {
+		t.Errorf("expected ungrouped condition to select a, got %v", r.Results)
+	}
+	var c requestContext
+	e, err := New(`ifelseNumber(avg(q("avg:cpu{host=*}", "1h", "")) > 0, avg(q("avg:cpu{host=*}", "1h", "")) * 2, avg(q("avg:cpu{host=*}", "1h", "")) * 3)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, _, err := e.Execute(Backends{OpenTSDBContext: &c}, nil, time.Now(), search.NewSearch(), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Results) != 1 || res.Results[0].Value != Number(2) {
+		t.Errorf("unexpected results: %+v", res.Results)
+	}
+	if _, err := New(`ifelse(1, q("avg:cpu", "1h", ""), q("avg:cpu", "1h", ""))`); err == nil {
+		t.Error("expected error for a scalar condition")
+	}
+}
+
+func TestReductions(t *testing.T) {
+	odd := Series{"1": 4, "2": 1, "3": 9, "4": 3, "5": 3}
+	even := Series{"1": 4, "2": 1, "3": 10, "4": 3}
+	tests := []struct {
+		f    func(Series, ...float64) float64
+		args []float64
+		odd  float64
+		even float64
+		name string
+	}{
+		{avg, nil, 4, 4.5, "avg"},
+		{sum, nil, 20, 18, "sum"},
+		{dev, nil, math.Sqrt(9), math.Sqrt(15), "dev"},
+		{seriesMedian, nil, 3, 3.5, "median"},
+		{percentile, []float64{0}, 1, 1, "min"},
+		{percentile, []float64{1}, 9, 10, "max"},
+		{percentile, []float64{.25}, 3, 3, "p25"},
+		{percentile, []float64{.99}, 9, 10, "p99"},
+	}
+	for _, test := range tests {
+		if v := test.f(odd, test.args...); v != test.odd {
+			t.Errorf("%s of %v: expected %v, got %v", test.name, odd, test.odd, v)
+		}
+		if v := test.f(even, test.args...); v != test.even {
+			t.Errorf("%s of %v: expected %v, got %v", test.name, even, test.even, v)
+		}
+	}
+	if v := dev(Series{"1": 5}); v != 0 {
+		t.Errorf("expected 0 deviation of one value, got %v", v)
+	}
+	// Empty series are dropped rather than reduced.
+	r, err := reduce(nil, nil, &Results{Results: []*Result{{Value: Series{}}, {Value: odd}}}, seriesMedian)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Results) != 1 || r.Results[0].Value != Number(3) {
+		t.Errorf("unexpected results: %v", r.Results)
+	}
+	var c requestContext
+	for _, q := range []string{"avg", "dev", "max", "median", "min", "sum"} {
+		e, err := New(q + `(q("avg:cpu{host=*}", "1h", ""))`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e.Tree.Root.Return() != parse.TYPE_NUMBER {
+			t.Errorf("%s: expected a number", q)
+		}
+		if _, _, err := e.Execute(Backends{OpenTSDBContext: &c}, nil, time.Now(), search.NewSearch(), Options{}); err != nil {
+			t.Errorf("%s: %v", q, err)
+		}
+	}
+	for _, q := range []string{
+		`percentile(q("avg:cpu", "1h", ""))`,
+		`percentile(q("avg:cpu", "1h", ""), "0.99")`,
+		`median(avg(q("avg:cpu", "1h", "")))`,
+		`median(1)`,
+	} {
+		if _, err := New(q); err == nil {
+			t.Errorf("%s: expected a type error", q)
+		}
+	}
+}
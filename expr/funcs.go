@@ -13,6 +13,8 @@ import (
 	"github.com/bosun-monitor/bosun/_third_party/github.com/MiniProfiler/go/miniprofiler"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
 	"github.com/bosun-monitor/bosun/expr/parse"
+	"github.com/bosun-monitor/bosun/graphite"
+	"github.com/bosun-monitor/bosun/search"
 )
 
 var builtins = map[string]parse.Func{
@@ -23,6 +25,11 @@ var builtins = map[string]parse.Func{
 		parse.TYPE_SERIES,
 		Band,
 	},
+	"burnrate": {
+		[]parse.FuncType{parse.TYPE_STRING, parse.TYPE_STRING, parse.TYPE_STRING, parse.TYPE_SCALAR},
+		parse.TYPE_NUMBER,
+		BurnRate,
+	},
 	"change": {
 		[]parse.FuncType{parse.TYPE_STRING, parse.TYPE_STRING, parse.TYPE_STRING},
 		parse.TYPE_NUMBER,
@@ -33,15 +40,35 @@ var builtins = map[string]parse.Func{
 		parse.TYPE_SCALAR,
 		Count,
 	},
+	"dayOverDay": {
+		[]parse.FuncType{parse.TYPE_STRING, parse.TYPE_STRING},
+		parse.TYPE_NUMBER,
+		DayOverDay,
+	},
 	"diff": {
 		[]parse.FuncType{parse.TYPE_STRING, parse.TYPE_STRING, parse.TYPE_STRING},
 		parse.TYPE_NUMBER,
 		Diff,
 	},
+	"graphite": {
+		[]parse.FuncType{parse.TYPE_STRING, parse.TYPE_STRING, parse.TYPE_STRING, parse.TYPE_STRING},
+		parse.TYPE_SERIES,
+		graphiteWindow,
+	},
 	"q": {
 		[]parse.FuncType{parse.TYPE_STRING, parse.TYPE_STRING, parse.TYPE_STRING},
 		parse.TYPE_SERIES,
-		Query,
+		queryWindow,
+	},
+	"qbetween": {
+		[]parse.FuncType{parse.TYPE_STRING, parse.TYPE_STRING, parse.TYPE_STRING},
+		parse.TYPE_SERIES,
+		QueryBetween,
+	},
+	"weekOverWeek": {
+		[]parse.FuncType{parse.TYPE_STRING, parse.TYPE_STRING},
+		parse.TYPE_NUMBER,
+		WeekOverWeek,
 	},
 
 	// Reduction functions
@@ -66,6 +93,16 @@ var builtins = map[string]parse.Func{
 		parse.TYPE_NUMBER,
 		Forecast_lr,
 	},
+	"integral": {
+		[]parse.FuncType{parse.TYPE_SERIES},
+		parse.TYPE_NUMBER,
+		Integral,
+	},
+	"lag": {
+		[]parse.FuncType{parse.TYPE_SERIES, parse.TYPE_SERIES, parse.TYPE_STRING, parse.TYPE_STRING},
+		parse.TYPE_NUMBER,
+		Lag,
+	},
 	"last": {
 		[]parse.FuncType{parse.TYPE_SERIES},
 		parse.TYPE_NUMBER,
@@ -91,6 +128,11 @@ var builtins = map[string]parse.Func{
 		parse.TYPE_NUMBER,
 		Min,
 	},
+	"nth": {
+		[]parse.FuncType{parse.TYPE_SERIES, parse.TYPE_SCALAR},
+		parse.TYPE_NUMBER,
+		Nth,
+	},
 	"percentile": {
 		[]parse.FuncType{parse.TYPE_SERIES, parse.TYPE_SCALAR},
 		parse.TYPE_NUMBER,
@@ -106,9 +148,34 @@ var builtins = map[string]parse.Func{
 		parse.TYPE_NUMBER,
 		Sum,
 	},
+	"trueFor": {
+		[]parse.FuncType{parse.TYPE_SERIES},
+		parse.TYPE_NUMBER,
+		TrueFor,
+	},
 
 	// Group functions
 
+	"gpercentile": {
+		[]parse.FuncType{parse.TYPE_NUMBER, parse.TYPE_SCALAR, parse.TYPE_STRING},
+		parse.TYPE_NUMBER,
+		GPercentile,
+	},
+	"outlier": {
+		[]parse.FuncType{parse.TYPE_NUMBER, parse.TYPE_SCALAR},
+		parse.TYPE_NUMBER,
+		Outlier,
+	},
+	"pairDiff": {
+		[]parse.FuncType{parse.TYPE_NUMBER, parse.TYPE_STRING, parse.TYPE_STRING, parse.TYPE_STRING},
+		parse.TYPE_NUMBER,
+		PairDiff,
+	},
+	"pick": {
+		[]parse.FuncType{parse.TYPE_NUMBER, parse.TYPE_STRING},
+		parse.TYPE_NUMBER,
+		Pick,
+	},
 	"t": {
 		[]parse.FuncType{parse.TYPE_NUMBER, parse.TYPE_STRING},
 		parse.TYPE_SERIES,
@@ -119,19 +186,84 @@ var builtins = map[string]parse.Func{
 		parse.TYPE_SCALAR,
 		Ungroup,
 	},
+	"wavg": {
+		[]parse.FuncType{parse.TYPE_NUMBER, parse.TYPE_NUMBER},
+		parse.TYPE_NUMBER,
+		WAvg,
+	},
 
 	// Other functions
 
+	"abnormalFor": {
+		[]parse.FuncType{parse.TYPE_STRING},
+		parse.TYPE_NUMBER,
+		AbnormalFor,
+	},
 	"abs": {
 		[]parse.FuncType{parse.TYPE_NUMBER},
 		parse.TYPE_NUMBER,
 		Abs,
 	},
+	"align": {
+		[]parse.FuncType{parse.TYPE_SERIES, parse.TYPE_STRING, parse.TYPE_STRING, parse.TYPE_STRING},
+		parse.TYPE_SERIES,
+		Align,
+	},
+	"bundle": {
+		[]parse.FuncType{parse.TYPE_SERIES, parse.TYPE_STRING, parse.TYPE_SERIES},
+		parse.TYPE_SERIES,
+		Bundle,
+	},
+	"critQuantile": {
+		[]parse.FuncType{parse.TYPE_STRING, parse.TYPE_SCALAR, parse.TYPE_STRING},
+		parse.TYPE_NUMBER,
+		CritQuantile,
+	},
+	"crate": {
+		[]parse.FuncType{parse.TYPE_SERIES},
+		parse.TYPE_SERIES,
+		CounterRate,
+	},
+	"des": {
+		[]parse.FuncType{parse.TYPE_SERIES, parse.TYPE_SCALAR, parse.TYPE_SCALAR},
+		parse.TYPE_SERIES,
+		DES,
+	},
+	"d": {
+		[]parse.FuncType{parse.TYPE_STRING},
+		parse.TYPE_SCALAR,
+		Duration,
+	},
+	"decompose": {
+		[]parse.FuncType{parse.TYPE_SERIES, parse.TYPE_STRING, parse.TYPE_STRING},
+		parse.TYPE_SERIES,
+		Decompose,
+	},
+	"downsample": {
+		[]parse.FuncType{parse.TYPE_SERIES, parse.TYPE_SCALAR, parse.TYPE_STRING},
+		parse.TYPE_SERIES,
+		Downsample,
+	},
 	"dropna": {
 		[]parse.FuncType{parse.TYPE_SERIES},
 		parse.TYPE_SERIES,
 		DropNA,
 	},
+	"heartbeat": {
+		nil,
+		parse.TYPE_NUMBER,
+		Heartbeat,
+	},
+	"ifelse": {
+		[]parse.FuncType{parse.TYPE_NUMBER, parse.TYPE_SERIES, parse.TYPE_SERIES},
+		parse.TYPE_SERIES,
+		IfElse,
+	},
+	"ifelseNumber": {
+		[]parse.FuncType{parse.TYPE_NUMBER, parse.TYPE_NUMBER, parse.TYPE_NUMBER},
+		parse.TYPE_NUMBER,
+		IfElse,
+	},
 	"lookup": {
 		[]parse.FuncType{parse.TYPE_STRING, parse.TYPE_STRING},
 		parse.TYPE_NUMBER,
@@ -142,6 +274,31 @@ var builtins = map[string]parse.Func{
 		parse.TYPE_NUMBER,
 		NV,
 	},
+	"quality": {
+		[]parse.FuncType{parse.TYPE_STRING},
+		parse.TYPE_NUMBER,
+		Quality,
+	},
+	"ratio": {
+		[]parse.FuncType{parse.TYPE_NUMBER, parse.TYPE_NUMBER, parse.TYPE_SCALAR},
+		parse.TYPE_NUMBER,
+		Ratio,
+	},
+	"seconds": {
+		[]parse.FuncType{parse.TYPE_STRING},
+		parse.TYPE_SCALAR,
+		Duration,
+	},
+	"severity": {
+		[]parse.FuncType{parse.TYPE_NUMBER, parse.TYPE_STRING},
+		parse.TYPE_NUMBER,
+		Severity,
+	},
+	"stateFor": {
+		[]parse.FuncType{parse.TYPE_STRING},
+		parse.TYPE_NUMBER,
+		StateFor,
+	},
 }
 
 func NV(e *state, T miniprofiler.Timer, series *Results, v float64) (results *Results, err error) {
@@ -149,6 +306,409 @@ func NV(e *state, T miniprofiler.Timer, series *Results, v float64) (results *Re
 	return series, nil
 }
 
+// Ratio divides a by b per joined group. A group of a joins the first group
+// of b that it includes, or else every group of b that includes it, and the
+// result has the more specific group of the two. Groups of a missing from b,
+// or whose denominator is zero or NaN, are given the value def. Groups of b
+// that join no group of a are dropped.
+func Ratio(e *state, T miniprofiler.Timer, a, b *Results, def float64) (*Results, error) {
+	res := new(Results)
+	for _, ra := range a.Results {
+		rbs := joined(ra.Group, b.Results)
+		if len(rbs) == 0 {
+			r := &Result{
+				Value: Number(def),
+				Group: ra.Group,
+			}
+			r.Computations = append(r.Computations, ra.Computations...)
+			res.Results = append(res.Results, r)
+			continue
+		}
+		for _, rb := range rbs {
+			v := def
+			d := float64(rb.Value.Value().(Number))
+			if d != 0 && !math.IsNaN(d) {
+				v = float64(ra.Value.Value().(Number)) / d
+			}
+			g := ra.Group
+			if len(rb.Group) > len(g) {
+				g = rb.Group
+			}
+			r := &Result{
+				Value: Number(v),
+				Group: g,
+			}
+			r.Computations = append(r.Computations, ra.Computations...)
+			r.Computations = append(r.Computations, rb.Computations...)
+			res.Results = append(res.Results, r)
+		}
+	}
+	return res, nil
+}
+
+// joined returns the results of rs that join group g: the first whose group
+// g includes, or else all whose groups include g.
+func joined(g opentsdb.TagSet, rs []*Result) []*Result {
+	var more []*Result
+	for _, r := range rs {
+		if g.Subset(r.Group) {
+			return []*Result{r}
+		}
+		if r.Group.Subset(g) {
+			more = append(more, r)
+		}
+	}
+	return more
+}
+
+// Severity returns, per group, the number of thresholds in the comma-separated
+// list that the value has reached. An ascending list ("80,90,95") counts values
+// >= each threshold; a descending list ("20,10,5") counts values <= each
+// threshold. A value of 0 means no threshold was reached.
+func Severity(e *state, T miniprofiler.Timer, series *Results, thresholds string) (*Results, error) {
+	var ts []float64
+	for _, s := range strings.Split(thresholds, ",") {
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return nil, fmt.Errorf("severity: bad threshold %q", s)
+		}
+		ts = append(ts, f)
+	}
+	for i := 1; i < len(ts); i++ {
+		if ts[i] == ts[i-1] || (ts[i] > ts[i-1]) != (ts[1] > ts[0]) {
+			return nil, fmt.Errorf("severity: thresholds must be strictly ascending or descending")
+		}
+	}
+	for _, r := range series.Results {
+		r.Value = Number(severity(float64(r.Value.Value().(Number)), ts))
+	}
+	return series, nil
+}
+
+func severity(v float64, thresholds []float64) (level float64) {
+	if math.IsNaN(v) {
+		return math.NaN()
+	}
+	descending := len(thresholds) > 1 && thresholds[1] < thresholds[0]
+	for _, t := range thresholds {
+		if (!descending && v >= t) || (descending && v <= t) {
+			level++
+		}
+	}
+	return
+}
+
+// CounterRate converts a counter series to a per-second rate. Wraps and
+// resets are handled as by search.CounterDelta. The first point has no rate
+// and is dropped.
+func CounterRate(e *state, T miniprofiler.Timer, series *Results) (*Results, error) {
+	for _, res := range series.Results {
+		res.Value = counterRate(res.Value.Value().(Series))
+	}
+	return series, nil
+}
+
+func counterRate(dps Series) Series {
+	r := make(Series)
+	keys := sortedTimes(dps)
+	for i := 1; i < len(keys); i++ {
+		prev := float64(dps[strconv.FormatInt(keys[i-1], 10)])
+		cur := float64(dps[strconv.FormatInt(keys[i], 10)])
+		delta := search.CounterDelta(prev, cur)
+		r[strconv.FormatInt(keys[i], 10)] = opentsdb.Point(delta / float64(keys[i]-keys[i-1]))
+	}
+	return r
+}
+
+// DES smooths a series with double exponential (Holt) smoothing. alpha is the
+// level smoothing factor and beta the trend smoothing factor, both in (0, 1].
+func DES(e *state, T miniprofiler.Timer, series *Results, alpha, beta float64) (*Results, error) {
+	if alpha <= 0 || alpha > 1 || beta <= 0 || beta > 1 {
+		return nil, fmt.Errorf("des: alpha and beta must be in (0, 1]")
+	}
+	for _, res := range series.Results {
+		res.Value = des(res.Value.Value().(Series), alpha, beta)
+	}
+	return series, nil
+}
+
+func des(dps Series, alpha, beta float64) Series {
+	r := make(Series)
+	keys := sortedTimes(dps)
+	var level, trend float64
+	for i, t := range keys {
+		k := strconv.FormatInt(t, 10)
+		v := float64(dps[k])
+		switch i {
+		case 0:
+			level = v
+		case 1:
+			trend = v - level
+			fallthrough
+		default:
+			last := level
+			level = alpha*v + (1-alpha)*(level+trend)
+			trend = beta*(level-last) + (1-beta)*trend
+		}
+		r[k] = opentsdb.Point(level)
+	}
+	return r
+}
+
+// Decompose splits each series into trend, seasonal and residual components
+// with period as the length of one season, and returns the one named by
+// component. The trend is a centered moving average one period wide, the
+// seasonal component is the mean detrended value at each phase of the period,
+// and the residual is what remains. Each series must span at least two
+// periods.
+func Decompose(e *state, T miniprofiler.Timer, series *Results, period, component string) (*Results, error) {
+	d, err := e.duration(period)
+	if err != nil {
+		return nil, err
+	}
+	sec := int64(time.Duration(d).Seconds())
+	if sec < 1 {
+		return nil, fmt.Errorf("decompose: period must be at least 1s")
+	}
+	switch component {
+	case "trend", "seasonal", "residual":
+	default:
+		return nil, fmt.Errorf("decompose: unknown component %s", component)
+	}
+	for _, res := range series.Results {
+		s, err := decompose(res.Value.Value().(Series), sec, component)
+		if err != nil {
+			return nil, fmt.Errorf("decompose: %s: %v", res.Group, err)
+		}
+		res.Value = s
+	}
+	return series, nil
+}
+
+func decompose(dps Series, period int64, component string) (Series, error) {
+	keys := sortedTimes(dps)
+	if len(keys) < 2 || keys[len(keys)-1]-keys[0] < 2*period {
+		return nil, fmt.Errorf("need at least two periods of data")
+	}
+	vals := make([]float64, len(keys))
+	for i, t := range keys {
+		vals[i] = float64(dps[strconv.FormatInt(t, 10)])
+	}
+	// Centered moving average over a window one period wide.
+	trend := make([]float64, len(keys))
+	var sum float64
+	lo, hi := 0, 0
+	for i, t := range keys {
+		for hi < len(keys) && keys[hi] <= t+period/2 {
+			sum += vals[hi]
+			hi++
+		}
+		for keys[lo] < t-period/2 {
+			sum -= vals[lo]
+			lo++
+		}
+		trend[i] = sum / float64(hi-lo)
+	}
+	// Phase bins are sized by the median sampling interval so that each
+	// holds roughly one point per period.
+	steps := make([]int64, len(keys)-1)
+	for i := range steps {
+		steps[i] = keys[i+1] - keys[i]
+	}
+	sort.Sort(int64Slice(steps))
+	bins := int64(1)
+	if step := steps[len(steps)/2]; step > 0 && step < period {
+		bins = period / step
+	}
+	phase := func(t int64) int {
+		p := t % period
+		if p < 0 {
+			p += period
+		}
+		return int(p * bins / period)
+	}
+	sums := make([]float64, bins)
+	counts := make([]int, bins)
+	for i, t := range keys {
+		b := phase(t)
+		sums[b] += vals[i] - trend[i]
+		counts[b]++
+	}
+	// Center the seasonal component so that it sums to zero over a period.
+	var mean float64
+	var n int
+	for b := range sums {
+		if counts[b] > 0 {
+			sums[b] /= float64(counts[b])
+			mean += sums[b]
+			n++
+		}
+	}
+	mean /= float64(n)
+	r := make(Series)
+	for i, t := range keys {
+		seasonal := sums[phase(t)] - mean
+		var v float64
+		switch component {
+		case "trend":
+			v = trend[i]
+		case "seasonal":
+			v = seasonal
+		default:
+			v = vals[i] - trend[i] - seasonal
+		}
+		r[strconv.FormatInt(t, 10)] = opentsdb.Point(v)
+	}
+	return r, nil
+}
+
+// Downsample reduces each series to at most n points by splitting its time
+// range into n equal buckets and aggregating each with agg, one of avg, min,
+// max or sum. Each point is placed at the time of the first point in its
+// bucket; empty buckets are omitted.
+func Downsample(e *state, T miniprofiler.Timer, series *Results, n float64, agg string) (*Results, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("downsample: n must be at least 1")
+	}
+	var f func(a, b float64) float64
+	switch agg {
+	case "avg", "sum":
+		f = func(a, b float64) float64 { return a + b }
+	case "min":
+		f = math.Min
+	case "max":
+		f = math.Max
+	default:
+		return nil, fmt.Errorf("downsample: unknown aggregator %s", agg)
+	}
+	for _, res := range series.Results {
+		res.Value = downsample(res.Value.Value().(Series), int(n), f, agg == "avg")
+	}
+	return series, nil
+}
+
+func downsample(dps Series, n int, f func(a, b float64) float64, avg bool) Series {
+	if len(dps) <= n {
+		return dps
+	}
+	keys := sortedTimes(dps)
+	first, last := keys[0], keys[len(keys)-1]
+	width := float64(last-first+1) / float64(n)
+	r := make(Series)
+	var bucketTime int64
+	var v float64
+	count, bucket := 0, -1
+	flush := func() {
+		if count == 0 {
+			return
+		}
+		if avg {
+			v /= float64(count)
+		}
+		r[strconv.FormatInt(bucketTime, 10)] = opentsdb.Point(v)
+	}
+	for _, t := range keys {
+		p := float64(dps[strconv.FormatInt(t, 10)])
+		if b := int(float64(t-first) / width); b != bucket {
+			flush()
+			bucket, bucketTime, v, count = b, t, p, 1
+			continue
+		}
+		v = f(v, p)
+		count++
+	}
+	flush()
+	return r
+}
+
+// Align buckets each series into intervals of step aligned to the unix epoch,
+// so series from different queries share timestamps and can be combined with
+// binary operators. Each bucket is reduced with agg (avg, min, max, sum or
+// last) and stamped with its start time. fill controls empty buckets between
+// the first and last: none leaves them out, zero sets them to 0, and prev
+// repeats the preceding bucket.
+func Align(e *state, T miniprofiler.Timer, series *Results, step, agg, fill string) (*Results, error) {
+	d, err := e.duration(step)
+	if err != nil {
+		return nil, err
+	}
+	sec := int64(time.Duration(d).Seconds())
+	if sec < 1 {
+		return nil, fmt.Errorf("align: step must be at least 1s")
+	}
+	switch agg {
+	case "avg", "min", "max", "sum", "last":
+	default:
+		return nil, fmt.Errorf("align: unknown aggregator %s", agg)
+	}
+	switch fill {
+	case "none", "zero", "prev":
+	default:
+		return nil, fmt.Errorf("align: unknown fill %s", fill)
+	}
+	for _, res := range series.Results {
+		res.Value = align(res.Value.Value().(Series), sec, agg, fill)
+	}
+	return series, nil
+}
+
+func align(dps Series, step int64, agg, fill string) Series {
+	r := make(Series)
+	if len(dps) == 0 {
+		return r
+	}
+	floor := func(t int64) int64 {
+		b := t - t%step
+		if t < 0 && t%step != 0 {
+			b -= step
+		}
+		return b
+	}
+	keys := sortedTimes(dps)
+	counts := make(map[int64]int)
+	for _, t := range keys {
+		b := floor(t)
+		k := strconv.FormatInt(b, 10)
+		p := dps[strconv.FormatInt(t, 10)]
+		v, ok := r[k]
+		switch {
+		case !ok, agg == "last":
+			v = p
+		case agg == "min":
+			v = opentsdb.Point(math.Min(float64(v), float64(p)))
+		case agg == "max":
+			v = opentsdb.Point(math.Max(float64(v), float64(p)))
+		default:
+			v += p
+		}
+		r[k] = v
+		counts[b]++
+	}
+	if agg == "avg" {
+		for b, n := range counts {
+			k := strconv.FormatInt(b, 10)
+			r[k] /= opentsdb.Point(n)
+		}
+	}
+	if fill == "none" {
+		return r
+	}
+	var prev opentsdb.Point
+	for b := floor(keys[0]); b <= floor(keys[len(keys)-1]); b += step {
+		k := strconv.FormatInt(b, 10)
+		if v, ok := r[k]; ok {
+			prev = v
+			continue
+		}
+		if fill == "zero" {
+			r[k] = 0
+		} else {
+			r[k] = prev
+		}
+	}
+	return r
+}
+
 func DropNA(e *state, T miniprofiler.Timer, series *Results) (*Results, error) {
 	for _, res := range series.Results {
 		nv := make(Series)
@@ -165,6 +725,165 @@ func DropNA(e *state, T miniprofiler.Timer, series *Results) (*Results, error) {
 	return series, nil
 }
 
+// AbnormalFor returns, for each group of alert, the number of seconds it has
+// been non-normal, or 0 if it is normal.
+func AbnormalFor(e *state, T miniprofiler.Timer, alert string) (*Results, error) {
+	if e.history == nil {
+		return nil, fmt.Errorf("abnormalFor: alert state not available")
+	}
+	results := new(Results)
+	results.IgnoreUnjoined = true
+	for ak, since := range e.history.AbnormalSince(alert) {
+		var d float64
+		if !since.IsZero() {
+			d = e.now.Sub(since).Seconds()
+		}
+		results.Results = append(results.Results, &Result{
+			Value: Number(d),
+			Group: ak.Group(),
+		})
+	}
+	return results, nil
+}
+
+// StateFor returns, for each group of alert, the number of seconds since it
+// entered its current status, so that thresholds can change the longer an
+// incident runs.
+func StateFor(e *state, T miniprofiler.Timer, alert string) (*Results, error) {
+	sp, ok := e.history.(StateProvider)
+	if !ok {
+		return nil, fmt.Errorf("stateFor: alert state not available")
+	}
+	results := new(Results)
+	results.IgnoreUnjoined = true
+	for ak, since := range sp.StateSince(alert) {
+		results.Results = append(results.Results, &Result{
+			Value: Number(e.now.Sub(since).Seconds()),
+			Group: ak.Group(),
+		})
+	}
+	return results, nil
+}
+
+// Heartbeat returns, grouped by source, the number of seconds since each
+// collector sending through the relay was last seen.
+func Heartbeat(e *state, T miniprofiler.Timer) (*Results, error) {
+	sp, ok := e.history.(SourceProvider)
+	if !ok {
+		return nil, fmt.Errorf("heartbeat: source registry not available")
+	}
+	results := new(Results)
+	results.IgnoreUnjoined = true
+	for name, seen := range sp.SourcesLastSeen() {
+		results.Results = append(results.Results, &Result{
+			Value: Number(e.now.Sub(seen).Seconds()),
+			Group: opentsdb.TagSet{"source": name},
+		})
+	}
+	return results, nil
+}
+
+// IfElse selects, per group, the result of a where cond is true (non-zero
+// and not NaN) and the result of b where it is false. The condition of a
+// group is the value of the first result of cond whose group is a subset of
+// it, so an ungrouped cond selects for all groups. Groups without a
+// condition are dropped. It is used by ifelse for series and ifelseNumber
+// for numbers.
+func IfElse(e *state, T miniprofiler.Timer, cond, a, b *Results) (*Results, error) {
+	res := new(Results)
+	pick := func(from *Results, want bool) {
+		for _, r := range from.Results {
+			for _, c := range cond.Results {
+				if !r.Group.Subset(c.Group) {
+					continue
+				}
+				v := float64(c.Value.Value().(Number))
+				if (v != 0 && !math.IsNaN(v)) == want {
+					nr := *r
+					nr.Computations = append(append(Computations{}, r.Computations...), c.Computations...)
+					res.Results = append(res.Results, &nr)
+				}
+				break
+			}
+		}
+	}
+	pick(a, true)
+	pick(b, false)
+	return res, nil
+}
+
+// Quality returns the number of relayed data points with each data quality
+// problem in the last window, grouped by metric and problem.
+func Quality(e *state, T miniprofiler.Timer, window string) (*Results, error) {
+	qp, ok := e.history.(QualityProvider)
+	if !ok {
+		return nil, fmt.Errorf("quality: data quality tracking not available")
+	}
+	d, err := e.duration(window)
+	if err != nil {
+		return nil, err
+	}
+	results := new(Results)
+	results.IgnoreUnjoined = true
+	for _, c := range qp.DataQuality(e.now.Add(-time.Duration(d))) {
+		results.Results = append(results.Results, &Result{
+			Value: Number(c.Count),
+			Group: opentsdb.TagSet{"metric": c.Metric, "problem": c.Problem},
+		})
+	}
+	return results, nil
+}
+
+// CritQuantile returns, for each group of alert, the p quantile of the crit
+// values the scheduler recorded for it in the last window, so that an alert
+// can fire when above its own usual range.
+func CritQuantile(e *state, T miniprofiler.Timer, alert string, p float64, window string) (*Results, error) {
+	cp, ok := e.history.(CritHistoryProvider)
+	if !ok {
+		return nil, fmt.Errorf("critQuantile: crit history not available")
+	}
+	if p < 0 || p > 1 {
+		return nil, fmt.Errorf("critQuantile: p must be between 0 and 1")
+	}
+	d, err := e.duration(window)
+	if err != nil {
+		return nil, err
+	}
+	results := new(Results)
+	results.IgnoreUnjoined = true
+	for ak, values := range cp.CritHistory(alert, e.now.Add(-time.Duration(d))) {
+		if len(values) == 0 {
+			continue
+		}
+		results.Results = append(results.Results, &Result{
+			Value: Number(quantile(values, p)),
+			Group: ak.Group(),
+		})
+	}
+	return results, nil
+}
+
+// Bundle returns series with the series of related in the same group added to
+// its bundle under name. The bundled series are not evaluated, but templates
+// of the alert can graph them by name without querying again.
+func Bundle(e *state, T miniprofiler.Timer, series *Results, name string, related *Results) (*Results, error) {
+	for _, r := range series.Results {
+		for _, rr := range related.Results {
+			if !r.Group.Subset(rr.Group) {
+				continue
+			}
+			b := make(map[string]Series, len(r.Bundle)+1)
+			for k, v := range r.Bundle {
+				b[k] = v
+			}
+			b[name] = rr.Value.(Series)
+			r.Bundle = b
+			break
+		}
+	}
+	return series, nil
+}
+
 func lookup(e *state, T miniprofiler.Timer, lookup, key string) (results *Results, err error) {
 	results = new(Results)
 	results.IgnoreUnjoined = true
@@ -212,22 +931,24 @@ func Band(e *state, T miniprofiler.Timer, query, duration, period string, num fl
 	r.IgnoreUnjoined = true
 	T.Step("band", func(T miniprofiler.Timer) {
 		var d, p opentsdb.Duration
-		d, err = opentsdb.ParseDuration(duration)
+		d, err = e.duration(duration)
 		if err != nil {
 			return
 		}
-		p, err = opentsdb.ParseDuration(period)
+		p, err = e.duration(period)
 		if err != nil {
 			return
 		}
 		if num < 1 || num > 100 {
 			err = fmt.Errorf("expr: Band: num out of bounds")
+			return
 		}
-		q, err := opentsdb.ParseQuery(query)
+		var q *opentsdb.Query
+		q, err = opentsdb.ParseQuery(query)
 		if q == nil && err != nil {
 			return
 		}
-		if err = e.search.Expand(q); err != nil {
+		if err = e.expand(q); err != nil {
 			return
 		}
 		req := opentsdb.Request{
@@ -241,10 +962,13 @@ func Band(e *state, T miniprofiler.Timer, query, duration, period string, num fl
 		}
 		for i := 0; i < int(num); i++ {
 			now = now.Add(time.Duration(-p))
-			req.End = now.Unix()
-			req.Start = now.Add(time.Duration(-d)).Unix()
+			// Each period is its own request so that they are cached and
+			// traced separately.
+			preq := req
+			preq.End = now.Unix()
+			preq.Start = now.Add(time.Duration(-d)).Unix()
 			var s opentsdb.ResponseSet
-			s, err = timeRequest(e, T, &req)
+			s, err = timeRequest(e, T, &preq)
 			if err != nil {
 				return
 			}
@@ -260,14 +984,18 @@ func Band(e *state, T miniprofiler.Timer, query, duration, period string, num fl
 					newarr = false
 					values := a.Value.(Series)
 					for k, v := range res.DPS {
-						values[k] = v
+						if !e.excluded(k) {
+							values[k] = v
+						}
 					}
 				}
 				if newarr {
 					values := make(Series)
 					a := &Result{Group: res.Tags}
 					for k, v := range res.DPS {
-						values[k] = v
+						if !e.excluded(k) {
+							values[k] = v
+						}
 					}
 					a.Value = values
 					r.Results = append(r.Results, a)
@@ -284,10 +1012,10 @@ func Query(e *state, T miniprofiler.Timer, query, sduration, eduration string) (
 	if q == nil && err != nil {
 		return
 	}
-	if err = e.search.Expand(q); err != nil {
+	if err = e.expand(q); err != nil {
 		return
 	}
-	sd, err := opentsdb.ParseDuration(sduration)
+	sd, err := e.duration(sduration)
 	if err != nil {
 		return
 	}
@@ -297,7 +1025,7 @@ func Query(e *state, T miniprofiler.Timer, query, sduration, eduration string) (
 	}
 	if eduration != "" {
 		var ed opentsdb.Duration
-		ed, err = opentsdb.ParseDuration(eduration)
+		ed, err = e.duration(eduration)
 		if err != nil {
 			return
 		}
@@ -311,6 +1039,56 @@ func Query(e *state, T miniprofiler.Timer, query, sduration, eduration string) (
 	if err != nil {
 		return
 	}
+	for _, res := range s {
+		if e.squelched(res.Tags) {
+			continue
+		}
+		r.Results = append(r.Results, &Result{
+			Value: e.businessPoints(Series(res.DPS), sduration, eduration),
+			Group: res.Tags,
+		})
+	}
+	return
+}
+
+// queryWindow is the q function: Query with its window moved to the Start
+// option. Functions that query internally keep their own windows.
+func queryWindow(e *state, T miniprofiler.Timer, query, sduration, eduration string) (*Results, error) {
+	return Query(e, T, query, e.window(sduration, eduration), eduration)
+}
+
+// QueryBetween is like Query for the absolute window from start to end, given
+// as RFC3339 times, instead of durations before now. It pins exact windows for
+// backtesting and incident review.
+func QueryBetween(e *state, T miniprofiler.Timer, query, start, end string) (r *Results, err error) {
+	r = new(Results)
+	q, err := opentsdb.ParseQuery(query)
+	if q == nil && err != nil {
+		return
+	}
+	if err = e.expand(q); err != nil {
+		return
+	}
+	st, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return nil, fmt.Errorf("qbetween: bad start time: %v", err)
+	}
+	et, err := time.Parse(time.RFC3339, end)
+	if err != nil {
+		return nil, fmt.Errorf("qbetween: bad end time: %v", err)
+	}
+	if !st.Before(et) {
+		return nil, fmt.Errorf("qbetween: start %s is not before end %s", start, end)
+	}
+	req := opentsdb.Request{
+		Queries: []*opentsdb.Query{q},
+		Start:   st.Unix(),
+		End:     et.Unix(),
+	}
+	s, err := timeRequest(e, T, &req)
+	if err != nil {
+		return
+	}
 	for _, res := range s {
 		if e.squelched(res.Tags) {
 			continue
@@ -323,6 +1101,95 @@ func Query(e *state, T miniprofiler.Timer, query, sduration, eduration string) (
 	return
 }
 
+// graphiteWindow is the graphite function, with its window moved to the Start
+// option as by queryWindow.
+func graphiteWindow(e *state, T miniprofiler.Timer, query, sduration, eduration, format string) (*Results, error) {
+	return Graphite(e, T, query, e.window(sduration, eduration), eduration, format)
+}
+
+// Graphite queries the Graphite render API for query from sduration ago to
+// eduration ago, or now if eduration is empty. format names the tags of each
+// returned series by the dot-separated nodes of its target: "host..core"
+// groups web01.cpu.3 by host=web01,core=3. Nodes with an empty name are
+// ignored. With an empty format only a single series may be returned. Null
+// values are left out.
+func Graphite(e *state, T miniprofiler.Timer, query, sduration, eduration, format string) (r *Results, err error) {
+	g, err := e.graphite()
+	if err != nil {
+		return
+	}
+	sd, err := e.duration(sduration)
+	if err != nil {
+		return
+	}
+	var ed opentsdb.Duration
+	if eduration != "" {
+		if ed, err = e.duration(eduration); err != nil {
+			return
+		}
+	}
+	start := e.now.Add(-time.Duration(sd))
+	end := e.now.Add(-time.Duration(ed))
+	req := &graphite.Request{
+		Targets: []string{query},
+		Start:   &start,
+		End:     &end,
+	}
+	var resp graphite.Response
+	T.StepCustomTiming("graphite", "query", req.URL(""), func() {
+		resp, err = g.Query(req)
+	})
+	if err != nil {
+		return
+	}
+	if err = e.checkGroups(len(resp)); err != nil {
+		return
+	}
+	if r, err = graphiteResults(e, resp, format); err != nil {
+		return
+	}
+	for _, res := range r.Results {
+		res.Value = e.businessPoints(res.Value.(Series), sduration, eduration)
+	}
+	return
+}
+
+func graphiteResults(e *state, resp graphite.Response, format string) (*Results, error) {
+	r := new(Results)
+	names := strings.Split(format, ".")
+	if format == "" && len(resp) > 1 {
+		return nil, fmt.Errorf("graphite: format required for %d series", len(resp))
+	}
+	for _, s := range resp {
+		tags := make(opentsdb.TagSet)
+		if format != "" {
+			nodes := strings.Split(s.Target, ".")
+			if len(nodes) != len(names) {
+				return nil, fmt.Errorf("graphite: target %s does not match format %s", s.Target, format)
+			}
+			for i, name := range names {
+				if name != "" {
+					tags[name] = nodes[i]
+				}
+			}
+		}
+		if e.squelched(tags) {
+			continue
+		}
+		dps := make(Series)
+		for _, dp := range s.Datapoints {
+			if dp.Value != nil {
+				dps[strconv.FormatInt(dp.Time, 10)] = opentsdb.Point(*dp.Value)
+			}
+		}
+		r.Results = append(r.Results, &Result{
+			Value: dps,
+			Group: tags,
+		})
+	}
+	return r, nil
+}
+
 func timeRequest(e *state, T miniprofiler.Timer, req *opentsdb.Request) (s opentsdb.ResponseSet, err error) {
 	r := *req
 	if e.autods > 0 {
@@ -330,23 +1197,66 @@ func timeRequest(e *state, T miniprofiler.Timer, req *opentsdb.Request) (s opent
 			return nil, err
 		}
 	}
+	c, err := e.tsdb()
+	if err != nil {
+		return nil, err
+	}
 	e.addRequest(r)
 	b, _ := json.MarshalIndent(&r, "", "  ")
 	T.StepCustomTiming("tsdb", "query", string(b), func() {
-		s, err = e.context.Query(&r)
+		s, err = c.Query(&r)
 	})
+	if err == nil {
+		err = e.checkGroups(len(s))
+	}
 	return
 }
 
+// DayOverDay returns the average of query over the last duration divided by
+// its average over the same window one day earlier. Groups without data a day
+// earlier, or whose average then is zero, are NaN.
+func DayOverDay(e *state, T miniprofiler.Timer, query, duration string) (*Results, error) {
+	return overPeriod(e, T, query, duration, time.Hour*24)
+}
+
+// WeekOverWeek is like DayOverDay, comparing with one week earlier.
+func WeekOverWeek(e *state, T miniprofiler.Timer, query, duration string) (*Results, error) {
+	return overPeriod(e, T, query, duration, time.Hour*24*7)
+}
+
+func overPeriod(e *state, T miniprofiler.Timer, query, duration string, shift time.Duration) (*Results, error) {
+	d, err := e.duration(duration)
+	if err != nil {
+		return nil, err
+	}
+	cur, err := Query(e, T, query, duration, "")
+	if err != nil {
+		return nil, err
+	}
+	if cur, err = reduce(e, T, cur, avg); err != nil {
+		return nil, err
+	}
+	start := fmt.Sprintf("%ds", int64((time.Duration(d) + shift).Seconds()))
+	end := fmt.Sprintf("%ds", int64(shift.Seconds()))
+	prev, err := Query(e, T, query, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if prev, err = reduce(e, T, prev, avg); err != nil {
+		return nil, err
+	}
+	return Ratio(e, T, cur, prev, math.NaN())
+}
+
 func Change(e *state, T miniprofiler.Timer, query, sduration, eduration string) (r *Results, err error) {
 	r = new(Results)
-	sd, err := opentsdb.ParseDuration(sduration)
+	sd, err := e.duration(sduration)
 	if err != nil {
 		return
 	}
 	var ed opentsdb.Duration
 	if eduration != "" {
-		ed, err = opentsdb.ParseDuration(eduration)
+		ed, err = e.duration(eduration)
 		if err != nil {
 			return
 		}
@@ -363,6 +1273,58 @@ func change(dps Series, args ...float64) float64 {
 	return avg(dps) * args[0]
 }
 
+// BurnRate returns the rate at which the error budget of an SLO is being
+// consumed. query must return the ratio of successful events (0 to 1), and
+// objective is the target ratio (like 0.999). The burn rate is computed over
+// both the short and long windows, ending now, and the smaller is returned,
+// so a threshold on the result fires only when both windows are burning.
+func BurnRate(e *state, T miniprofiler.Timer, query, short, long string, objective float64) (r *Results, err error) {
+	if objective <= 0 || objective >= 1 {
+		return nil, fmt.Errorf("expr: burnrate: objective must be between 0 and 1")
+	}
+	sd, err := e.duration(short)
+	if err != nil {
+		return
+	}
+	ld, err := e.duration(long)
+	if err != nil {
+		return
+	}
+	if sd > ld {
+		return nil, fmt.Errorf("expr: burnrate: short window longer than long window")
+	}
+	r, err = Query(e, T, query, long, "")
+	if err != nil {
+		return
+	}
+	cutoff := e.now.Add(-time.Duration(sd)).Unix()
+	r, err = reduce(e, T, r, burnrate, float64(cutoff), objective)
+	return
+}
+
+func burnrate(dps Series, args ...float64) float64 {
+	cutoff, budget := args[0], 1-args[1]
+	var sum, shortSum float64
+	var shortN int
+	for k, v := range dps {
+		t, err := strconv.ParseFloat(k, 64)
+		if err != nil {
+			panic(err)
+		}
+		sum += float64(v)
+		if t >= cutoff {
+			shortSum += float64(v)
+			shortN++
+		}
+	}
+	if shortN == 0 {
+		return math.NaN()
+	}
+	long := (1 - sum/float64(len(dps))) / budget
+	short := (1 - shortSum/float64(shortN)) / budget
+	return math.Min(short, long)
+}
+
 func Diff(e *state, T miniprofiler.Timer, query, sduration, eduration string) (r *Results, err error) {
 	r, err = Query(e, T, query, sduration, eduration)
 	if err != nil {
@@ -394,6 +1356,47 @@ func reduce(e *state, T miniprofiler.Timer, series *Results, F func(Series, ...f
 	return &res, nil
 }
 
+// Duration, the d function and its deprecated alias seconds, returns the number of seconds in the
+// duration s, like "1h30m", or a business day duration like "5bd", which is
+// counted back from now with the calendar.
+func Duration(e *state, T miniprofiler.Timer, s string) (*Results, error) {
+	d, err := e.duration(s)
+	if err != nil {
+		return nil, err
+	}
+	return &Results{
+		Results: []*Result{
+			{Value: Scalar(d.Seconds())},
+		},
+	}, nil
+}
+
+// checkDurations reports an error for a duration argument of d or seconds in
+// the tree of n that does not parse.
+func checkDurations(n parse.Node) (err error) {
+	parse.Walk(n, func(n parse.Node) {
+		f, ok := n.(*parse.FuncNode)
+		if !ok || err != nil || (f.Name != "d" && f.Name != "seconds") || len(f.Args) != 1 {
+			return
+		}
+		a, ok := f.Args[0].(*parse.StringNode)
+		if !ok {
+			return
+		}
+		v := a.Text
+		if strings.HasSuffix(v, "bd") {
+			if i, perr := strconv.Atoi(strings.TrimSuffix(v, "bd")); perr != nil || i < 0 || i > maxBusinessDays {
+				err = fmt.Errorf("expr: %s: invalid business day duration %s", f.Name, v)
+			}
+			return
+		}
+		if _, perr := opentsdb.ParseDuration(v); perr != nil {
+			err = fmt.Errorf("expr: %s: %v", f.Name, perr)
+		}
+	})
+	return
+}
+
 func Abs(e *state, T miniprofiler.Timer, series *Results) *Results {
 	for _, s := range series.Results {
 		s.Value = Number(math.Abs(float64(s.Value.Value().(Number))))
@@ -462,6 +1465,100 @@ func length(dps Series, args ...float64) (a float64) {
 	return float64(len(dps))
 }
 
+// Integral returns the area under each series in value-seconds, using the
+// trapezoidal rule between consecutive points. The integral of a per-second
+// rate is the total count over the series.
+func Integral(e *state, T miniprofiler.Timer, series *Results) (*Results, error) {
+	return reduce(e, T, series, integral)
+}
+
+func integral(dps Series, args ...float64) (a float64) {
+	keys := sortedTimes(dps)
+	for i := 1; i < len(keys); i++ {
+		prev := float64(dps[strconv.FormatInt(keys[i-1], 10)])
+		cur := float64(dps[strconv.FormatInt(keys[i], 10)])
+		a += (prev + cur) / 2 * float64(keys[i]-keys[i-1])
+	}
+	return
+}
+
+// Lag cross-correlates each series of a with the series of b whose group
+// is a subset of its own, and returns the lag in seconds, within ±maxLag, at
+// which b best follows a. Both series are first aligned to step, filling
+// gaps with the previous value. A positive lag means b follows a; groups
+// without a match in b, or with fewer than three overlapping points at every
+// lag, are NaN.
+func Lag(e *state, T miniprofiler.Timer, a, b *Results, maxLag, step string) (*Results, error) {
+	ml, err := e.duration(maxLag)
+	if err != nil {
+		return nil, err
+	}
+	st, err := e.duration(step)
+	if err != nil {
+		return nil, err
+	}
+	sec := int64(time.Duration(st).Seconds())
+	if sec < 1 {
+		return nil, fmt.Errorf("lag: step must be at least 1s")
+	}
+	steps := int64(time.Duration(ml).Seconds()) / sec
+	res := new(Results)
+	for _, ra := range a.Results {
+		var rb *Result
+		for _, r := range b.Results {
+			if ra.Group.Subset(r.Group) {
+				rb = r
+				break
+			}
+		}
+		r := &Result{Group: ra.Group, Value: Number(math.NaN())}
+		if rb != nil {
+			l, c := lag(align(ra.Value.Value().(Series), sec, "avg", "prev"), align(rb.Value.Value().(Series), sec, "avg", "prev"), sec, steps)
+			r.Value = Number(l)
+			r.AddComputation("correlation", Number(c))
+		}
+		res.Results = append(res.Results, r)
+	}
+	return res, nil
+}
+
+// lag returns the lag, in seconds and a multiple of step, at which the Pearson
+// correlation of a(t) and b(t+lag) is highest, and that correlation.
+func lag(a, b Series, step, steps int64) (best, corr float64) {
+	best, corr = math.NaN(), math.Inf(-1)
+	times := sortedTimes(a)
+	for i := -steps; i <= steps; i++ {
+		var n, sa, sb, saa, sbb, sab float64
+		for _, t := range times {
+			vb, ok := b[strconv.FormatInt(t+i*step, 10)]
+			if !ok {
+				continue
+			}
+			x, y := float64(a[strconv.FormatInt(t, 10)]), float64(vb)
+			n++
+			sa += x
+			sb += y
+			saa += x * x
+			sbb += y * y
+			sab += x * y
+		}
+		if n < 3 {
+			continue
+		}
+		d := math.Sqrt(n*saa-sa*sa) * math.Sqrt(n*sbb-sb*sb)
+		if d == 0 || math.IsNaN(d) {
+			continue
+		}
+		if c := (n*sab - sa*sb) / d; c > corr {
+			best, corr = float64(i*step), c
+		}
+	}
+	if math.IsNaN(best) {
+		corr = math.NaN()
+	}
+	return
+}
+
 func Last(e *state, T miniprofiler.Timer, series *Results) (*Results, error) {
 	return reduce(e, T, series, last)
 }
@@ -500,6 +1597,69 @@ func first(dps Series, args ...float64) (a float64) {
 	return
 }
 
+func Nth(e *state, T miniprofiler.Timer, series *Results, n float64) (*Results, error) {
+	return reduce(e, T, series, nth, n)
+}
+
+// nth returns the value of the nth point in time order, starting at 0. A
+// negative n counts back from the last point, so -1 is the last point. NaN is
+// returned if the series has no such point.
+func nth(dps Series, args ...float64) float64 {
+	keys := sortedTimes(dps)
+	i := int(args[0])
+	if i < 0 {
+		i += len(keys)
+	}
+	if i < 0 || i >= len(keys) {
+		return math.NaN()
+	}
+	return float64(dps[strconv.FormatInt(keys[i], 10)])
+}
+
+// sortedTimes returns the timestamps of dps in ascending order.
+func sortedTimes(dps Series) []int64 {
+	keys := make([]int64, 0, len(dps))
+	for k := range dps {
+		d, err := strconv.ParseInt(k, 10, 64)
+		if err != nil {
+			panic(err)
+		}
+		keys = append(keys, d)
+	}
+	sort.Sort(int64Slice(keys))
+	return keys
+}
+
+type int64Slice []int64
+
+func (p int64Slice) Len() int           { return len(p) }
+func (p int64Slice) Less(i, j int) bool { return p[i] < p[j] }
+func (p int64Slice) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+
+// TrueFor returns the number of seconds each series has been continuously
+// true (non-zero and not NaN), from the first point of the trailing run of
+// true values to the last point. It is 0 if the last point is false.
+func TrueFor(e *state, T miniprofiler.Timer, series *Results) (*Results, error) {
+	return reduce(e, T, series, trueFor)
+}
+
+func trueFor(dps Series, args ...float64) float64 {
+	keys := sortedTimes(dps)
+	start := -1
+	for i, t := range keys {
+		v := float64(dps[strconv.FormatInt(t, 10)])
+		if v == 0 || math.IsNaN(v) {
+			start = -1
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start < 0 {
+		return 0
+	}
+	return float64(keys[len(keys)-1] - keys[start])
+}
+
 func Since(e *state, T miniprofiler.Timer, series *Results) (*Results, error) {
 	return reduce(e, T, series, since)
 }
@@ -570,7 +1730,17 @@ func Min(e *state, T miniprofiler.Timer, series *Results) (r *Results, err error
 }
 
 func Median(e *state, T miniprofiler.Timer, series *Results) (r *Results, err error) {
-	return reduce(e, T, series, percentile, .5)
+	return reduce(e, T, series, seriesMedian)
+}
+
+// seriesMedian returns the median of the values of dps, the mean of the two
+// middle values if there is an even number.
+func seriesMedian(dps Series, args ...float64) float64 {
+	x := make([]float64, 0, len(dps))
+	for _, v := range dps {
+		x = append(x, float64(v))
+	}
+	return median(x)
 }
 
 func Max(e *state, T miniprofiler.Timer, series *Results) (r *Results, err error) {
@@ -580,11 +1750,16 @@ func Max(e *state, T miniprofiler.Timer, series *Results) (r *Results, err error
 // percentile returns the value at the corresponding percentile between 0 and 1.
 // Min and Max can be simulated using p <= 0 and p >= 1, respectively.
 func percentile(dps Series, args ...float64) (a float64) {
-	p := args[0]
-	var x []float64
+	x := make([]float64, 0, len(dps))
 	for _, v := range dps {
 		x = append(x, float64(v))
 	}
+	return quantile(x, args[0])
+}
+
+// quantile returns the value of x at p, as percentile does. x is sorted in
+// place and must not be empty.
+func quantile(x []float64, p float64) float64 {
 	sort.Float64s(x)
 	if p <= 0 {
 		return x[0]
@@ -597,6 +1772,160 @@ func percentile(dps Series, args ...float64) (a float64) {
 	return x[int(i)]
 }
 
+// Outlier sets each group's value to 1 if it deviates from the median of all
+// groups by more than k median absolute deviations, else 0. If the MAD is 0,
+// any value that differs from the median is an outlier.
+func Outlier(e *state, T miniprofiler.Timer, series *Results, k float64) (*Results, error) {
+	var vals []float64
+	for _, r := range series.Results {
+		vals = append(vals, float64(r.Value.Value().(Number)))
+	}
+	flags := outliers(vals, k)
+	for i, r := range series.Results {
+		r.Value = Number(flags[i])
+	}
+	return series, nil
+}
+
+// outliers returns 1 for each value further than k MADs from the median.
+func outliers(vals []float64, k float64) []float64 {
+	flags := make([]float64, len(vals))
+	if len(vals) == 0 {
+		return flags
+	}
+	m := median(vals)
+	devs := make([]float64, len(vals))
+	for i, v := range vals {
+		devs[i] = math.Abs(v - m)
+	}
+	mad := median(devs)
+	for i, d := range devs {
+		if d > k*mad {
+			flags[i] = 1
+		}
+	}
+	return flags
+}
+
+// median returns the median of x without modifying it.
+func median(x []float64) float64 {
+	s := make([]float64, len(x))
+	copy(s, x)
+	sort.Float64s(s)
+	if l := len(s); l%2 == 0 {
+		return (s[l/2-1] + s[l/2]) / 2
+	}
+	return s[len(s)/2]
+}
+
+// GPercentile computes the pth percentile (0 to 1) of the values of all
+// groups, such as the 95th percentile CPU of all hosts. Groups are combined
+// when they share the values of the comma-separated tag keys in gp; an empty
+// gp combines all groups into one result. NaN values are ignored.
+func GPercentile(e *state, T miniprofiler.Timer, d *Results, p float64, gp string) (*Results, error) {
+	if p < 0 || p > 1 {
+		return nil, fmt.Errorf("gpercentile: p must be between 0 and 1")
+	}
+	var keys []string
+	for _, k := range strings.Split(gp, ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			keys = append(keys, k)
+		}
+	}
+	type bucket struct {
+		group opentsdb.TagSet
+		vals  []float64
+	}
+	var order []string
+	m := make(map[string]*bucket)
+	for _, r := range d.Results {
+		ts := make(opentsdb.TagSet)
+		for _, k := range keys {
+			if v, ok := r.Group[k]; ok {
+				ts[k] = v
+			}
+		}
+		id := ts.String()
+		b := m[id]
+		if b == nil {
+			b = &bucket{group: ts}
+			m[id] = b
+			order = append(order, id)
+		}
+		v := float64(r.Value.Value().(Number))
+		if !math.IsNaN(v) {
+			b.vals = append(b.vals, v)
+		}
+	}
+	var r Results
+	for _, id := range order {
+		b := m[id]
+		v := math.NaN()
+		if len(b.vals) > 0 {
+			v = quantile(b.vals, p)
+		}
+		res := &Result{Group: b.group, Value: Number(v)}
+		res.AddComputation(fmt.Sprintf("gpercentile of %d groups", len(b.vals)), Number(v))
+		r.Results = append(r.Results, res)
+	}
+	return &r, nil
+}
+
+// WAvg returns the average of values across all groups, weighted by the
+// values of the joined groups in weights, joined as by Ratio: a value whose
+// group includes a group of weights has that weight, and a value whose group
+// is included in several groups of weights counts once with each. Groups
+// missing from weights or with NaN values or weights are skipped. The result
+// has an empty group, and is NaN if the weights sum to zero.
+func WAvg(e *state, T miniprofiler.Timer, values, weights *Results) (*Results, error) {
+	var sum, wsum float64
+	n := 0
+	for _, rv := range values.Results {
+		v := float64(rv.Value.Value().(Number))
+		for _, rw := range joined(rv.Group, weights.Results) {
+			w := float64(rw.Value.Value().(Number))
+			if math.IsNaN(v) || math.IsNaN(w) {
+				continue
+			}
+			sum += v * w
+			wsum += w
+			n++
+		}
+	}
+	v := math.NaN()
+	if wsum != 0 {
+		v = sum / wsum
+	}
+	res := &Result{Group: make(opentsdb.TagSet), Value: Number(v)}
+	res.AddComputation(fmt.Sprintf("wavg of %d groups", n), Number(v))
+	return &Results{Results: []*Result{res}}, nil
+}
+
+// Pick returns the one group of d whose tags include all of tags, such as
+// "host=web1,dc=ny". It is an error if no group or more than one matches.
+func Pick(e *state, T miniprofiler.Timer, d *Results, tags string) (*Results, error) {
+	ts, err := opentsdb.ParseTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	var picked *Result
+	for _, r := range d.Results {
+		if !r.Group.Subset(ts) {
+			continue
+		}
+		if picked != nil {
+			return nil, fmt.Errorf("pick: %s matches more than one group", ts)
+		}
+		picked = r
+	}
+	if picked == nil {
+		return nil, fmt.Errorf("pick: no group matches %s", ts)
+	}
+	res := *d
+	res.Results = []*Result{picked}
+	return &res, nil
+}
+
 func Ungroup(e *state, T miniprofiler.Timer, d *Results) (*Results, error) {
 	if len(d.Results) != 1 {
 		return nil, fmt.Errorf("ungroup: requires exactly one group")
@@ -605,6 +1934,42 @@ func Ungroup(e *state, T miniprofiler.Timer, d *Results) (*Results, error) {
 	return d, nil
 }
 
+// PairDiff pairs each group of d whose tag tagk is a with the group whose
+// tags are the same except that tagk is b, and returns the value of the
+// first minus the value of the second, grouped by the other tags. For
+// example, pairDiff(x, "role", "primary", "replica") compares primaries and
+// replicas of each host. Groups without a pair are left out.
+func PairDiff(e *state, T miniprofiler.Timer, d *Results, tagk, a, b string) (*Results, error) {
+	others := make(map[string]*Result)
+	for _, r := range d.Results {
+		if r.Group[tagk] != b {
+			continue
+		}
+		g := r.Group.Copy()
+		delete(g, tagk)
+		others[g.String()] = r
+	}
+	res := new(Results)
+	for _, ra := range d.Results {
+		if ra.Group[tagk] != a {
+			continue
+		}
+		g := ra.Group.Copy()
+		delete(g, tagk)
+		rb := others[g.String()]
+		if rb == nil {
+			continue
+		}
+		va := float64(ra.Value.Value().(Number))
+		vb := float64(rb.Value.Value().(Number))
+		r := &Result{Group: g, Value: Number(va - vb)}
+		r.AddComputation(fmt.Sprintf("%s=%s", tagk, a), Number(va))
+		r.AddComputation(fmt.Sprintf("%s=%s", tagk, b), Number(vb))
+		res.Results = append(res.Results, r)
+	}
+	return res, nil
+}
+
 func Transpose(e *state, T miniprofiler.Timer, d *Results, gp string) (*Results, error) {
 	gps := strings.Split(gp, ",")
 	m := make(map[string]*Result)
@@ -229,14 +229,11 @@ func (b *BinaryNode) StringAST() string {
 func (b *BinaryNode) Check() error {
 	t1 := b.Args[0].Return()
 	t2 := b.Args[1].Return()
-	if t1 == TYPE_SERIES && t2 == TYPE_SERIES {
-		return fmt.Errorf("parse: type error in %s: at least one side must be a number", b)
-	}
 	check := t1
 	if t1 == TYPE_SERIES {
 		check = t2
 	}
-	if check != TYPE_NUMBER && check != TYPE_SCALAR {
+	if check != TYPE_NUMBER && check != TYPE_SCALAR && (t1 != TYPE_SERIES || t2 != TYPE_SERIES) {
 		return fmt.Errorf("parse: type error in %s: expected a number", b)
 	}
 	if err := b.Args[0].Check(); err != nil {
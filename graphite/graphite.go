@@ -0,0 +1,94 @@
+// Package graphite queries the render API of Graphite.
+package graphite
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Request is a query for one or more targets over a time range.
+type Request struct {
+	Targets []string
+	Start   *time.Time
+	End     *time.Time // Now if nil
+}
+
+// Response is the series returned for a Request.
+type Response []Series
+
+// Series is one series of a response. Target is the name of the series, such
+// as web01.cpu.idle.
+type Series struct {
+	Target     string
+	Datapoints []DataPoint
+}
+
+// DataPoint is a value and a unix timestamp. Graphite returns null for
+// missing values, which unmarshals to a nil Value.
+type DataPoint struct {
+	Value *float64
+	Time  int64
+}
+
+func (d *DataPoint) UnmarshalJSON(b []byte) error {
+	var v [2]*float64
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	if v[1] == nil {
+		return fmt.Errorf("graphite: data point without timestamp: %s", b)
+	}
+	d.Value, d.Time = v[0], int64(*v[1])
+	return nil
+}
+
+// URL returns the render API URL of r on host.
+func (r *Request) URL(host string) string {
+	v := url.Values{"format": {"json"}, "target": r.Targets}
+	if r.Start != nil {
+		v.Set("from", strconv.FormatInt(r.Start.Unix(), 10))
+	}
+	if r.End != nil {
+		v.Set("until", strconv.FormatInt(r.End.Unix(), 10))
+	}
+	u := url.URL{
+		Scheme:   "http",
+		Host:     host,
+		Path:     "/render",
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+// Context is the interface for querying Graphite.
+type Context interface {
+	Query(*Request) (Response, error)
+}
+
+// Host is a Context that queries the Graphite server at a host:port.
+type Host string
+
+// DefaultClient is the client used by Host.
+var DefaultClient = &http.Client{Timeout: time.Minute}
+
+func (h Host) Query(r *Request) (Response, error) {
+	resp, err := DefaultClient.Get(r.URL(string(h)))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("graphite: %s: %s", resp.Status, b)
+	}
+	var res Response
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,36 @@
+package graphite
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestQuery(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/render" || r.FormValue("target") != "web*.cpu" || r.FormValue("from") != "100" {
+			http.Error(w, "bad request "+r.URL.String(), 400)
+			return
+		}
+		w.Write([]byte(`[{"target": "web01.cpu", "datapoints": [[1.5, 100], [null, 160]]}]`))
+	}))
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+	start := time.Unix(100, 0)
+	res, err := Host(u.Host).Query(&Request{Targets: []string{"web*.cpu"}, Start: &start})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || res[0].Target != "web01.cpu" || len(res[0].Datapoints) != 2 {
+		t.Fatalf("unexpected response: %+v", res)
+	}
+	dps := res[0].Datapoints
+	if dps[0].Value == nil || *dps[0].Value != 1.5 || dps[0].Time != 100 || dps[1].Value != nil {
+		t.Errorf("unexpected data points: %+v", dps)
+	}
+	if _, err := Host(u.Host).Query(&Request{Targets: []string{"other"}}); err == nil {
+		t.Error("expected error for bad status")
+	}
+}
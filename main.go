@@ -1,9 +1,11 @@
 package main
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"net/http/httptest"
@@ -18,6 +20,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/collect"
 	"github.com/bosun-monitor/bosun/_third_party/gopkg.in/fsnotify.v1"
 	"github.com/bosun-monitor/bosun/conf"
@@ -41,6 +44,11 @@ var (
 	flagQuiet    = flag.Bool("q", false, "quiet-mode: don't send any notifications except from the rule test page")
 	flagDev      = flag.Bool("dev", false, "enable dev mode: use local resources")
 	flagVersion  = flag.Bool("version", false, "Prints the version and exits.")
+	flagMigrate  = flag.Bool("migrate", false, "rewrite deprecated constructs in the config file in place and exit")
+	flagEncrypt  = flag.Bool("encrypt", false, "read a value from stdin, print it encrypted for use in the config file with the key from BOSUN_CONF_KEY, and exit")
+	flagDryRun   = flag.Bool("dryrun", false, "evaluate all alerts once against the saved state, print the notifications that would be sent, and exit")
+	flagRoutes   = flag.String("routes", "", "print the alert keys of the saved state that the config routes to different notifications or templates than the given old config file, and exit")
+	flagMode     = flag.String("mode", "", "run mode: evaluator evaluates alerts and serves only the API; web serves the UI and API from the evaluator's state file and forwards changes to evaluatorURL; empty does both")
 )
 
 func main() {
@@ -49,14 +57,81 @@ func main() {
 		fmt.Printf("bosun version %v (%v)\n", VersionDate, VersionID)
 		os.Exit(0)
 	}
+	if *flagEncrypt {
+		key, err := conf.ConfKey()
+		if err != nil {
+			log.Fatal(err)
+		}
+		b, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			log.Fatal(err)
+		}
+		v, err := conf.Encrypt(key, bytes.TrimRight(b, "\r\n"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(v)
+		os.Exit(0)
+	}
+	var mode web.Mode
+	switch *flagMode {
+	case "":
+		mode = web.ModeAll
+	case "evaluator":
+		mode = web.ModeEvaluator
+	case "web":
+		mode = web.ModeWeb
+	default:
+		log.Fatalf("unknown mode %s", *flagMode)
+	}
 	runtime.GOMAXPROCS(runtime.NumCPU())
 	c, err := conf.ParseFile(*flagConf)
 	if err != nil {
 		log.Fatal(err)
 	}
+	for _, w := range c.Warnings {
+		log.Println(w)
+	}
+	if *flagMigrate {
+		if len(c.Warnings) == 0 {
+			os.Exit(0)
+		}
+		fi, err := os.Stat(*flagConf)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := ioutil.WriteFile(*flagConf, []byte(c.Migrate()), fi.Mode()); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("migrated %d deprecated constructs in %s", len(c.Warnings), *flagConf)
+		os.Exit(0)
+	}
 	if *flagTest {
 		os.Exit(0)
 	}
+	if flag.NArg() > 0 {
+		if !runCommand(c, flag.Args()) {
+			log.Fatalf("unknown command %s", flag.Arg(0))
+		}
+		os.Exit(0)
+	}
+	if *flagDryRun {
+		dryRun(c)
+		os.Exit(0)
+	}
+	if *flagRoutes != "" {
+		routes(c, *flagRoutes)
+		os.Exit(0)
+	}
+	if len(c.LogSinks) > 0 {
+		w, err := c.OpenLogs()
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.SetFlags(0)
+		log.SetOutput(w)
+		slog.Set(w)
+	}
 	httpListen := &url.URL{
 		Scheme: "http",
 		Host:   c.HttpListen,
@@ -99,8 +174,12 @@ func main() {
 	if *flagQuiet {
 		c.Quiet = true
 	}
-	go func() { log.Fatal(web.Listen(c.HttpListen, *flagDev, tsdbHost)) }()
-	go func() { log.Fatal(sched.Run()) }()
+	go func() { log.Fatal(web.Listen(c.HttpListen, *flagDev, tsdbHost, mode)) }()
+	if mode == web.ModeWeb {
+		go sched.Follow()
+	} else {
+		go func() { log.Fatal(sched.Run()) }()
+	}
 	if *flagWatch {
 		watch(".", "*.go", quit)
 		watch(filepath.Join("web", "static", "templates"), "*.html", quit)
@@ -118,6 +197,46 @@ func main() {
 	select {}
 }
 
+// dryRun evaluates all alerts in c and prints the notifications that would be
+// sent.
+func dryRun(c *conf.Conf) {
+	s := sched.DefaultSched
+	s.Init(c)
+	s.RestoreState()
+	ps := s.DryRun(time.Now())
+	silenced := 0
+	for _, p := range ps {
+		note := ""
+		if p.Silenced {
+			note = " (silenced)"
+			silenced++
+		}
+		fmt.Printf("%s: %v -> %v: notify %s%s\n", p.AlertKey, p.From, p.To, p.Notification, note)
+	}
+	fmt.Printf("%d notifications would be sent, %d silenced\n", len(ps)-silenced, silenced)
+}
+
+func routes(c *conf.Conf, old string) {
+	oc, err := conf.ParseFile(old)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s := sched.DefaultSched
+	s.Init(oc)
+	s.RestoreState()
+	changes := s.RoutingDiff(c)
+	route := func(r *conf.Route) string {
+		if r == nil {
+			return "(no alert)"
+		}
+		return fmt.Sprintf("template %s, warn %v, crit %v, fatal %v", r.Template, r.Warn, r.Crit, r.Fatal)
+	}
+	for _, rc := range changes {
+		fmt.Printf("%s:\n\t- %s\n\t+ %s\n", rc.AlertKey, route(rc.Old), route(rc.New))
+	}
+	fmt.Printf("%d alert keys would route differently\n", len(changes))
+}
+
 func quit() {
 	os.Exit(0)
 }
@@ -140,7 +259,7 @@ func run(name string, arg ...string) func() {
 		go func() { io.Copy(os.Stdout, stdout) }()
 		go func() { io.Copy(os.Stderr, stderr) }()
 		if err := c.Wait(); err != nil {
-			log.Printf("run error: %v: %v", name, err)
+			slog.Errorf("run error: %v: %v", name, err)
 		}
 		log.Println("run complete:", name)
 	}
@@ -177,7 +296,7 @@ func watch(root, pattern string, f func()) {
 					wait = time.Now().Add(time.Second * 2)
 				}
 			case err := <-watcher.Errors:
-				log.Println("error:", err)
+				slog.Errorln(err)
 			}
 		}
 	}()
@@ -0,0 +1,124 @@
+package sched
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+// ArchivedState is the state of an alert key whose alert was removed from
+// the configuration.
+type ArchivedState struct {
+	*State
+	Archived time.Time
+}
+
+// archiveState moves st out of the active states. It is called while
+// restoring state or reloading the config for alert keys whose alert no
+// longer exists.
+func (s *Schedule) archiveState(ak expr.AlertKey, st *State) {
+	if s.archive == nil {
+		s.archive = make(map[expr.AlertKey]*ArchivedState)
+	}
+	if prev := s.archive[ak]; prev != nil && prev.Touched.After(st.Touched) {
+		return
+	}
+	s.archive[ak] = &ArchivedState{State: st, Archived: time.Now().UTC()}
+}
+
+// archiveRemoved archives the states of alert keys whose alert is not in the
+// config, and restores the archived states of alerts that are in it again.
+// s must be locked.
+func (s *Schedule) archiveRemoved() {
+	for ak, st := range s.status {
+		if _, present := s.Conf.Alerts[ak.Name()]; !present {
+			log.Println("sched: alert no longer present, archiving:", ak)
+			s.archiveState(ak, st)
+			delete(s.status, ak)
+			delete(s.Notifications, ak)
+		}
+	}
+	for ak, a := range s.archive {
+		if _, present := s.Conf.Alerts[ak.Name()]; present && s.status[ak] == nil {
+			log.Println("sched: alert present again, restoring archived state:", ak)
+			s.status[ak] = a.State
+			delete(s.archive, ak)
+		}
+	}
+}
+
+// gcArchive purges archived states older than the configured archive
+// duration. It runs on each save and restore. s must be locked.
+func (s *Schedule) gcArchive() {
+	cutoff := time.Now().UTC().Add(-s.Conf.ArchiveDuration)
+	for ak, a := range s.archive {
+		if a.Archived.Before(cutoff) {
+			log.Println("sched: purging archived alert key:", ak)
+			delete(s.archive, ak)
+		}
+	}
+}
+
+// ArchivedStates returns the archived states, sorted by alert key.
+func (s *Schedule) ArchivedStates() []ArchivedState {
+	s.Lock()
+	defer s.Unlock()
+	archived := make([]ArchivedState, 0, len(s.archive))
+	for _, a := range s.archive {
+		st := *a.State
+		archived = append(archived, ArchivedState{State: &st, Archived: a.Archived})
+	}
+	sort.Sort(archivedByKey(archived))
+	return archived
+}
+
+type archivedByKey []ArchivedState
+
+func (a archivedByKey) Len() int           { return len(a) }
+func (a archivedByKey) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a archivedByKey) Less(i, j int) bool { return a[i].AlertKey() < a[j].AlertKey() }
+
+// RestoreArchived moves the archived states of aks back into the active
+// states. Each alert must again be present in the configuration. Either all
+// keys are restored or none are.
+func (s *Schedule) RestoreArchived(aks []expr.AlertKey) error {
+	s.Lock()
+	defer s.Unlock()
+	for _, ak := range aks {
+		if s.archive[ak] == nil {
+			return fmt.Errorf("no archived state for %s", ak)
+		}
+		if _, present := s.Conf.Alerts[ak.Name()]; !present {
+			return fmt.Errorf("alert %s is not in the configuration", ak.Name())
+		}
+		if s.status[ak] != nil {
+			return fmt.Errorf("%s already has state", ak)
+		}
+	}
+	for _, ak := range aks {
+		s.status[ak] = s.archive[ak].State
+		delete(s.archive, ak)
+		log.Println("sched: restored archived alert key:", ak)
+	}
+	s.Save()
+	return nil
+}
+
+// PurgeArchived deletes the archived states of aks.
+func (s *Schedule) PurgeArchived(aks []expr.AlertKey) error {
+	s.Lock()
+	defer s.Unlock()
+	for _, ak := range aks {
+		if s.archive[ak] == nil {
+			return fmt.Errorf("no archived state for %s", ak)
+		}
+	}
+	for _, ak := range aks {
+		delete(s.archive, ak)
+	}
+	s.Save()
+	return nil
+}
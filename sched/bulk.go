@@ -0,0 +1,104 @@
+package sched
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+// MatchKeys returns the sorted alert keys whose alert name equals alert (if
+// not empty) and whose tags match the glob patterns in tagList, as for
+// silences.
+func (s *Schedule) MatchKeys(alert, tagList string) ([]expr.AlertKey, error) {
+	if alert == "" && tagList == "" {
+		return nil, fmt.Errorf("must specify either alert or tags")
+	}
+	f := &Silence{Alert: alert}
+	if tagList != "" {
+		tags, err := opentsdb.ParseTags(tagList)
+		if err != nil && tags == nil {
+			return nil, err
+		}
+		f.Tags = tags
+	}
+	var aks expr.AlertKeys
+	s.Lock()
+	for ak := range s.status {
+		if f.Matches(ak.Name(), ak.Group()) {
+			aks = append(aks, ak)
+		}
+	}
+	s.Unlock()
+	sort.Sort(aks)
+	return aks, nil
+}
+
+// BulkAction applies action t to all of aks at once. Every key is checked
+// first; the result maps each key to the reason it cannot be actioned, or to
+// the empty string if it can. If any key fails nothing is changed. If preview
+// is true the keys are only checked.
+func (s *Schedule) BulkAction(user, message string, t ActionType, aks []expr.AlertKey, preview bool) (map[expr.AlertKey]string, error) {
+	if len(aks) == 0 {
+		return nil, fmt.Errorf("no alert keys specified")
+	}
+	s.Lock()
+	defer s.Unlock()
+	res := make(map[expr.AlertKey]string)
+	failed := 0
+	for _, ak := range aks {
+		res[ak] = ""
+		if err := s.checkAction(t, ak); err != nil {
+			res[ak] = err.Error()
+			failed++
+		}
+	}
+	if failed > 0 {
+		return res, fmt.Errorf("%d of %d alert keys cannot be actioned", failed, len(res))
+	}
+	if preview {
+		return res, nil
+	}
+	for ak := range res {
+		s.action(user, message, t, ak)
+	}
+	s.Save()
+	return res, nil
+}
+
+// BulkSilence adds one silence per alert key, each matching exactly that
+// key's alert and group, from start to end. The result lists the silence ID
+// for each key. If preview is true no silences are added.
+func (s *Schedule) BulkSilence(start, end time.Time, aks []expr.AlertKey, user, message string, preview bool) (map[expr.AlertKey]string, error) {
+	if len(aks) == 0 {
+		return nil, fmt.Errorf("no alert keys specified")
+	}
+	sis := make(map[expr.AlertKey]*Silence)
+	for _, ak := range aks {
+		si, err := newSilence(start, end, ak.Name(), "", "", 0, user, message)
+		if err != nil {
+			return nil, err
+		}
+		si.Tags = ak.Group()
+		sis[ak] = si
+	}
+	s.Lock()
+	defer s.Unlock()
+	res := make(map[expr.AlertKey]string)
+	for ak, si := range sis {
+		if s.status[ak] == nil {
+			return nil, fmt.Errorf("no such alert key: %v", ak)
+		}
+		res[ak] = si.ID()
+	}
+	if preview {
+		return res, nil
+	}
+	for _, si := range sis {
+		s.Silence[si.ID()] = si
+	}
+	s.Save()
+	return res, nil
+}
@@ -0,0 +1,175 @@
+package sched
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/bosun-monitor/bosun/conf"
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+// A Canary is the outcome of evaluating a new config once against the
+// current alert states, without notifying or recording anything.
+type Canary struct {
+	Conf          string // Name of the evaluated config
+	Time          time.Time
+	Changes       []*StatusChange // Alert keys whose status would change
+	Notifications []*Projection   // Notifications the new config would send
+}
+
+// A StatusChange is an alert key whose status under the new config differs
+// from its status under the running config. Keys of alerts that the new
+// config removes change to none.
+type StatusChange struct {
+	AlertKey        expr.AlertKey
+	Running, Canary Status
+}
+
+// Paging returns the number of notifications of the canary that are not
+// silenced.
+func (ca *Canary) Paging() int {
+	n := 0
+	for _, p := range ca.Notifications {
+		if !p.Silenced {
+			n++
+		}
+	}
+	return n
+}
+
+// Canary evaluates every alert of c once at now against copies of the
+// current alert states and silences, and returns the differences from the
+// running config.
+func (s *Schedule) Canary(c *conf.Conf, now time.Time) *Canary {
+	cs := new(Schedule)
+	cs.Init(c)
+	cs.canary = true
+	cs.readOnly = true
+	cs.Search = s.Search
+	s.Lock()
+	for ak, st := range s.status {
+		cp := *st
+		cs.status[ak] = &cp
+	}
+	for id, si := range s.Silence {
+		cs.Silence[id] = si
+	}
+	s.Unlock()
+	events, ps := cs.project(now)
+	ca := &Canary{
+		Conf:          c.Name,
+		Time:          now.UTC(),
+		Changes:       make([]*StatusChange, 0),
+		Notifications: ps,
+	}
+	if ca.Notifications == nil {
+		ca.Notifications = make([]*Projection, 0)
+	}
+	s.Lock()
+	defer s.Unlock()
+	for ak, st := range s.status {
+		running, to := st.Last().Status, StNone
+		if ev := events[ak]; ev != nil {
+			to = ev.Status
+		} else if c.Alerts[ak.Name()] != nil {
+			// Not evaluated this cycle, such as an anchored alert.
+			continue
+		}
+		if to != running {
+			ca.Changes = append(ca.Changes, &StatusChange{ak, running, to})
+		}
+	}
+	for ak, ev := range events {
+		if s.status[ak] == nil && ev.Status > StNormal {
+			ca.Changes = append(ca.Changes, &StatusChange{ak, StNone, ev.Status})
+		}
+	}
+	sort.Sort(statusChanges(ca.Changes))
+	return ca
+}
+
+type statusChanges []*StatusChange
+
+func (c statusChanges) Len() int           { return len(c) }
+func (c statusChanges) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+func (c statusChanges) Less(i, j int) bool { return c[i].AlertKey < c[j].AlertKey }
+
+// A pendingReload is a config awaiting confirmation by ConfirmReload.
+type pendingReload struct {
+	conf   *conf.Conf
+	canary *Canary
+}
+
+// Reload evaluates c with Canary. If the canary would send no more than
+// reloadThreshold of the running config unsilenced notifications, c
+// replaces the running config. Otherwise c replaces any earlier pending
+// config and waits for ConfirmReload.
+func (s *Schedule) Reload(c *conf.Conf) (ca *Canary, applied bool) {
+	ca = s.Canary(c, time.Now())
+	if ca.Paging() <= s.Conf.ReloadThreshold {
+		s.swapConf(c)
+		return ca, true
+	}
+	s.Lock()
+	s.pending = &pendingReload{c, ca}
+	s.Unlock()
+	log.Printf("sched: reload of %s would send %d notifications; awaiting confirmation", c.Name, ca.Paging())
+	return ca, false
+}
+
+// PendingReload returns the canary of the config awaiting confirmation, or
+// nil if there is none.
+func (s *Schedule) PendingReload() *Canary {
+	s.Lock()
+	defer s.Unlock()
+	if s.pending == nil {
+		return nil
+	}
+	return s.pending.canary
+}
+
+// ConfirmReload replaces the running config with the pending one.
+func (s *Schedule) ConfirmReload() (*Canary, error) {
+	s.Lock()
+	p := s.pending
+	s.Unlock()
+	if p == nil {
+		return nil, fmt.Errorf("no pending reload")
+	}
+	s.swapConf(p.conf)
+	return p.canary, nil
+}
+
+// swapConf replaces the running config with c between checks.
+func (s *Schedule) swapConf(c *conf.Conf) {
+	s.checkRunning <- true
+	s.Lock()
+	old := s.Conf
+	s.Conf = c
+	s.Lookups = c.GetLookups()
+	s.pending = nil
+	s.archiveRemoved()
+	s.Unlock()
+	<-s.checkRunning
+	if old != nil && old != c {
+		old.Close()
+	}
+	s.internals.load(time.Now())
+	log.Printf("sched: reloaded %s", c.Name)
+	s.Hook(HookLoad, "", fmt.Sprintf("reloaded %s with %d alerts", c.Name, len(c.Alerts)), 0)
+}
+
+// RoutingDiff returns the known alert keys that c routes to different
+// notifications or templates than the running config.
+func (s *Schedule) RoutingDiff(c *conf.Conf) []*conf.RouteChange {
+	s.Lock()
+	old := s.Conf
+	keys := make([]expr.AlertKey, 0, len(s.status))
+	for ak := range s.status {
+		keys = append(keys, ak)
+	}
+	s.Unlock()
+	return conf.RoutingDiff(old, c, keys)
+}
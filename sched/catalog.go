@@ -0,0 +1,78 @@
+package sched
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// CatalogMetric describes a metric known to the search index or the metadata
+// store.
+type CatalogMetric struct {
+	Metric      string
+	Description []*MetadataDescription `json:",omitempty"`
+	Unit        string                 `json:",omitempty"`
+	Rate        string                 `json:",omitempty"`
+	TagKeys     []string
+	Tagsets     int // Distinct tag sets seen
+	Active      int // Tag sets with data within the active window
+	LastSeen    int64
+}
+
+// A Catalog is one page of metrics.
+type Catalog struct {
+	Total   int // Number of metrics matching the query, across all pages
+	Metrics []*CatalogMetric
+}
+
+// Catalog returns the metrics whose name contains query, sorted by name,
+// skipping the first offset and returning at most limit. Tag sets with a data
+// point in the last active are counted as active.
+func (s *Schedule) Catalog(query string, offset, limit int, active time.Duration) *Catalog {
+	stats := s.Search.Stats(time.Now().Add(-active).Unix())
+	meta := s.MetadataMetrics()
+	names := make(map[string]bool)
+	for m := range stats {
+		names[m] = true
+	}
+	for m := range meta {
+		names[m] = true
+	}
+	var sorted []string
+	for m := range names {
+		if strings.Contains(m, query) {
+			sorted = append(sorted, m)
+		}
+	}
+	sort.Strings(sorted)
+	c := &Catalog{
+		Total:   len(sorted),
+		Metrics: make([]*CatalogMetric, 0),
+	}
+	if offset > len(sorted) {
+		offset = len(sorted)
+	}
+	sorted = sorted[offset:]
+	if limit < len(sorted) {
+		sorted = sorted[:limit]
+	}
+	for _, m := range sorted {
+		cm := &CatalogMetric{
+			Metric:  m,
+			TagKeys: make([]string, 0),
+		}
+		if st := stats[m]; st != nil {
+			cm.TagKeys = st.TagKeys
+			cm.Tagsets = st.Tagsets
+			cm.Active = st.Active
+			cm.LastSeen = st.LastSeen
+		}
+		if md := meta[m]; md != nil {
+			cm.Description = md.Description
+			cm.Unit = md.Unit
+			cm.Rate = md.Type
+		}
+		c.Metrics = append(c.Metrics, cm)
+	}
+	return c
+}
@@ -0,0 +1,132 @@
+package sched
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/collect"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+)
+
+// A ChangeRecord is the response of the change-management API for an alert's
+// tags. Active is true when an approved change is in progress.
+type ChangeRecord struct {
+	Active  bool
+	ID      string `json:",omitempty"`
+	Summary string `json:",omitempty"`
+}
+
+type changeEntry struct {
+	record  *ChangeRecord
+	fetched time.Time
+}
+
+// changeCache holds change records by request URL.
+type changeCache struct {
+	sync.Mutex
+	entries map[string]changeEntry
+}
+
+var changeClient = &http.Client{Timeout: time.Second * 10}
+
+func fetchChange(u string) (*ChangeRecord, error) {
+	resp, err := changeClient.Get(u)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return &ChangeRecord{}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("change lookup: %s: %s", u, resp.Status)
+	}
+	var r ChangeRecord
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return nil, fmt.Errorf("change lookup: %s: %v", u, err)
+	}
+	return &r, nil
+}
+
+// ActiveChange returns the active change record for tags, or nil if there is
+// none or changeURL is not configured. Lookups are cached for changeCache.
+// Failed lookups are logged and treated as no change, so that alerts are not
+// lost when the change-management system is down.
+func (s *Schedule) ActiveChange(tags opentsdb.TagSet) *ChangeRecord {
+	return s.changeRecord(tags, true)
+}
+
+// changeRecord is ActiveChange. If fetch is false it only reads the cache,
+// however old, and returns nil for tags not looked up before.
+func (s *Schedule) changeRecord(tags opentsdb.TagSet, fetch bool) *ChangeRecord {
+	u, err := s.Conf.ChangeRequest(tags)
+	if err != nil {
+		slog.Errorln("change lookup:", err)
+		return nil
+	}
+	if u == "" {
+		return nil
+	}
+	c := &s.changes
+	c.Lock()
+	e, ok := c.entries[u]
+	c.Unlock()
+	if !ok && !fetch {
+		return nil
+	}
+	if fetch && (!ok || time.Since(e.fetched) > s.Conf.ChangeCache) {
+		r, err := fetchChange(u)
+		if err != nil {
+			slog.Errorln(err)
+			collect.Add("change.errors", nil, 1)
+			return nil
+		}
+		e = changeEntry{r, time.Now()}
+		c.Lock()
+		if c.entries == nil {
+			c.entries = make(map[string]changeEntry)
+		}
+		c.entries[u] = e
+		c.Unlock()
+	}
+	if !e.record.Active {
+		return nil
+	}
+	return e.record
+}
+
+// prefetchChanges looks up the change records of groups, so that underChange
+// and notification templates can read them from the cache while s is locked.
+// s must not be locked.
+func (s *Schedule) prefetchChanges(groups []opentsdb.TagSet) {
+	if s.Conf.ChangeURL == "" {
+		return
+	}
+	seen := make(map[string]bool)
+	for _, g := range groups {
+		if k := g.String(); !seen[k] {
+			seen[k] = true
+			s.ActiveChange(g)
+		}
+	}
+}
+
+// underChange reports whether notifications for st are suppressed by an
+// active change. It reads only the records cached by prefetchChanges, since
+// s is locked.
+func (s *Schedule) underChange(st *State) bool {
+	if s.Conf.ChangeAnnotate {
+		return false
+	}
+	r := s.changeRecord(st.Group, false)
+	if r == nil {
+		return false
+	}
+	log.Printf("suppressing %s during change %s", st.AlertKey(), r.ID)
+	return true
+}
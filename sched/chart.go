@@ -43,50 +43,88 @@ func Autostyle(i int) chart.Style {
 
 var white = color.RGBA{0xff, 0xff, 0xff, 0xff}
 
+// GraphOptions controls how expression results are rendered.
+type GraphOptions struct {
+	Legend     string    // Key position, such as "itl" or "obc"; "none" hides the key
+	Start, End time.Time // X axis range; zero values autoscale
+}
+
 func (s *Schedule) ExprSVG(t miniprofiler.Timer, w io.Writer, width, height int, res []*expr.Result, q string, now time.Time) error {
-	ch, err := s.ExprGraph(t, res, q, now)
-	if err != nil {
-		return err
-	}
-	g := svg.New(w)
-	g.StartviewUnit(100, 100, "%", 0, 0, width, height)
-	g.Rect(0, 0, width, height, "fill: #ffffff")
-	sgr := svgg.AddTo(g, 0, 0, width, height, "", 12, white)
-	ch.Plot(sgr)
-	g.End()
-	return nil
+	return s.ExprRender(t, w, "svg", width, height, res, q, now, GraphOptions{})
 }
 
 func (s *Schedule) ExprPNG(t miniprofiler.Timer, w io.Writer, width, height int, res []*expr.Result, q string, now time.Time) error {
-	ch, err := s.ExprGraph(t, res, q, now)
+	return s.ExprRender(t, w, "png", width, height, res, q, now, GraphOptions{})
+}
+
+// ExprRender renders res as a chart in format svg or png to w.
+func (s *Schedule) ExprRender(t miniprofiler.Timer, w io.Writer, format string, width, height int, res []*expr.Result, q string, now time.Time, o GraphOptions) error {
+	ch, err := s.ExprGraph(t, res, q, now, o)
 	if err != nil {
 		return err
 	}
-	g := image.NewRGBA(image.Rectangle{Min: image.ZP, Max: image.Pt(width, height)})
-	sgr := imgg.AddTo(g, 0, 0, width, height, white, nil, nil)
-	ch.Plot(sgr)
-	return png.Encode(w, g)
+	switch format {
+	case "svg":
+		g := svg.New(w)
+		g.StartviewUnit(100, 100, "%", 0, 0, width, height)
+		g.Rect(0, 0, width, height, "fill: #ffffff")
+		sgr := svgg.AddTo(g, 0, 0, width, height, "", 12, white)
+		ch.Plot(sgr)
+		g.End()
+		return nil
+	case "png":
+		g := image.NewRGBA(image.Rectangle{Min: image.ZP, Max: image.Pt(width, height)})
+		sgr := imgg.AddTo(g, 0, 0, width, height, white, nil, nil)
+		ch.Plot(sgr)
+		return png.Encode(w, g)
+	}
+	return fmt.Errorf("unknown graph format: %s", format)
+}
+
+// keyPositions are the key positions of vdobler/chart: inside (i) the plot
+// at its top, center or bottom (t, c, b) and left, center or right (l, c, r),
+// or outside (o) on one of its sides.
+var keyPositions = map[string]bool{
+	"itl": true, "itc": true, "itr": true,
+	"icl": true, "icc": true, "icr": true,
+	"ibl": true, "ibc": true, "ibr": true,
+	"otl": true, "otc": true, "otr": true,
+	"obl": true, "obc": true, "obr": true,
+	"olt": true, "olc": true, "olb": true,
+	"ort": true, "orc": true, "orb": true,
 }
 
-func (s *Schedule) ExprGraph(t miniprofiler.Timer, res []*expr.Result, q string, now time.Time) (chart.Chart, error) {
+func (s *Schedule) ExprGraph(t miniprofiler.Timer, res []*expr.Result, q string, now time.Time, o GraphOptions) (chart.Chart, error) {
 	c := chart.ScatterChart{
 		Title: fmt.Sprintf("%s - %s", q, now.Format(time.RFC1123)),
 		Key:   chart.Key{Pos: "itl"},
 	}
+	switch o.Legend {
+	case "":
+	case "none":
+		c.Key.Hide = true
+	default:
+		if !keyPositions[o.Legend] {
+			return nil, fmt.Errorf("unknown legend position: %s", o.Legend)
+		}
+		c.Key.Pos = o.Legend
+	}
 	c.XRange.Time = true
+	if !o.Start.IsZero() && !o.End.IsZero() {
+		c.XRange.TFixed(o.Start, o.End, nil)
+	}
 	for ri, r := range res {
 		rv := r.Value.(expr.Series)
-		pts := make([]chart.EPoint, len(rv))
-		idx := 0
+		pts := make([]chart.EPoint, 0, len(rv))
 		for k, v := range rv {
 			i, err := strconv.ParseInt(k, 10, 64)
 			if err != nil {
 				return nil, err
 			}
-			//names[idx] = time.Unix(i, 0).Format("02 Jan 15:04")
-			pts[idx].X = float64(i)
-			pts[idx].Y = float64(v)
-			idx++
+			if (!o.Start.IsZero() && i < o.Start.Unix()) || (!o.End.IsZero() && i > o.End.Unix()) {
+				continue
+			}
+			pts = append(pts, chart.EPoint{X: float64(i), Y: float64(v)})
 		}
 		slice.Sort(pts, func(i, j int) bool {
 			return pts[i].X < pts[j].X
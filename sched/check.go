@@ -8,10 +8,12 @@ import (
 	"time"
 
 	"github.com/bosun-monitor/bosun/_third_party/github.com/MiniProfiler/go/miniprofiler"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/collect"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
 	"github.com/bosun-monitor/bosun/conf"
 	"github.com/bosun-monitor/bosun/expr"
+	"github.com/bosun-monitor/bosun/search"
 )
 
 func (s *Schedule) Status(ak expr.AlertKey) *State {
@@ -30,23 +32,69 @@ func (s *Schedule) Status(ak expr.AlertKey) *State {
 	return state
 }
 
+// RunHistory holds the state of one check cycle. All expressions evaluated in
+// the cycle share its start time, search snapshot and alert states, so they
+// see the same view of time, of the search index and of prior cycles.
 type RunHistory struct {
-	Start   time.Time
-	Context opentsdb.Context
-	Events  map[expr.AlertKey]*Event
+	Start  time.Time
+	TSDB   expr.TSDBProvider
+	Events map[expr.AlertKey]*Event
+	Search *search.Search
+	Trace  string // Trace ID of the cycle
+
+	trace string // Trace ID of the alert being evaluated
+
+	abnormal map[string]map[expr.AlertKey]time.Time
+	states   map[string]map[expr.AlertKey]time.Time
+	sources  map[string]time.Time
+	quality  *qualityRegistry
+	crit     *critHistory
+
+	critRetention map[string]time.Duration // Alert -> crit values to keep
 }
 
 func (s *Schedule) NewRunHistory(start time.Time) *RunHistory {
+	s.Lock()
+	abnormal := s.abnormalSince()
+	states := s.stateSince()
+	s.Unlock()
+	trace := NewTraceID()
 	return &RunHistory{
-		Start:   start,
-		Context: opentsdb.NewCache(s.Conf.TsdbHost, s.Conf.ResponseLimit),
-		Events:  make(map[expr.AlertKey]*Event),
+		Start:    start,
+		Trace:    trace,
+		trace:    trace,
+		TSDB:     s.TSDB(trace),
+		Events:   make(map[expr.AlertKey]*Event),
+		Search:   s.Search.Snapshot(),
+		abnormal: abnormal,
+		states:   states,
+		sources:  s.SourcesLastSeen(),
+		quality:  &s.quality,
+		crit:     &s.crit,
+
+		critRetention: critRetention(s.Conf),
 	}
 }
 
+// AbnormalSince implements expr.AlertStatusProvider using the alert states as
+// of the start of the cycle.
+func (r *RunHistory) AbnormalSince(alert string) map[expr.AlertKey]time.Time {
+	return r.abnormal[alert]
+}
+
+// StateSince implements expr.StateProvider using the alert states as of the
+// start of the cycle.
+func (r *RunHistory) StateSince(alert string) map[expr.AlertKey]time.Time {
+	return r.states[alert]
+}
+
 // Check evaluates all critical and warning alert rules. An error is returned if
 // the check could not be performed.
 func (s *Schedule) Check(T miniprofiler.Timer, now time.Time) (time.Duration, error) {
+	alerts, err := s.Conf.AlertsByDependency()
+	if err != nil {
+		return 0, err
+	}
 	select {
 	case s.checkRunning <- true:
 		// Good, we've got the lock.
@@ -55,40 +103,97 @@ func (s *Schedule) Check(T miniprofiler.Timer, now time.Time) (time.Duration, er
 	}
 	r := s.NewRunHistory(now)
 	start := time.Now()
-	for _, a := range s.Conf.Alerts {
+	for _, a := range alerts {
+		if !s.OwnsAlert(a.Name) || !s.anchorDue(a, now) {
+			continue
+		}
 		s.CheckAlert(T, r, a)
 	}
 	d := time.Since(start)
+	s.internals.cycle(start, d)
 	s.RunHistory(r)
+	s.Lock()
+	s.cycles++
+	s.Unlock()
 	<-s.checkRunning
 	return d, nil
 }
 
+// anchorDue reports whether a should be evaluated at now: always for alerts
+// without an anchor, otherwise only once per anchor time. It must be called
+// with checkRunning held.
+func (s *Schedule) anchorDue(a *conf.Alert, now time.Time) bool {
+	if a.AnchorPeriod <= 0 {
+		return true
+	}
+	anchor := a.LastAnchor(now)
+	if !s.anchors[a.Name].Before(anchor) {
+		return false
+	}
+	s.anchors[a.Name] = anchor
+	return true
+}
+
 // RunHistory processes an event history and trisggers notifications if needed.
 func (s *Schedule) RunHistory(r *RunHistory) {
 	checkNotify := false
 	silenced := s.Silenced()
+	forget := s.forgetSilenced()
+	var groups []opentsdb.TagSet
+	for ak, event := range r.Events {
+		if event.Status > StNormal {
+			groups = append(groups, ak.Group())
+		}
+	}
+	s.prefetchChanges(groups)
 	s.Lock()
 	defer s.Unlock()
+	// During the first startupSuppress check cycles state changes are
+	// recorded but not notified, so a restart does not page again for known
+	// conditions. Evaluation errors are still sent to the event hook.
+	suppress := s.cycles < s.Conf.StartupSuppress
+	withheld := 0
 	for ak, event := range r.Events {
 		state := s.status[ak]
+		// Alert keys suppressed by a firing parent keep their status.
+		state.DependsOn = event.DependsOn
+		if event.DependsOn != "" {
+			continue
+		}
+		lastFatal := state.Last().Fatal
+		n := len(state.History)
 		last := state.Append(event)
+		if i := s.activeIncident(state); i != nil && len(state.History) > n {
+			i.Events = append(i.Events, state.Last())
+		}
 		a := s.Conf.Alerts[ak.Name()]
 		if event.Status > StNormal {
+			if !state.Open || s.activeIncident(state) == nil {
+				s.openIncident(ak, state)
+			}
 			var subject = new(bytes.Buffer)
 			if event.Status != StUnknown {
-				if err := s.ExecuteSubject(subject, r, a, state); err != nil {
-					log.Println(err)
+				if err := s.ExecuteSubject(subject, r, a, state, nil); err != nil {
+					slog.Errorln(err)
+					subject.Reset()
+					subject.WriteString(fallbackSubject(state))
 				}
 			}
 			state.Subject = subject.String()
 			state.Open = true
 		}
+		if event.Status != last && !(last == StNone && event.Status == StNormal) {
+			s.addTransition(ak, last, event.Status, state.Subject)
+		}
 		// On state increase, clear old notifications and notify current.
 		// On state decrease, and if the old alert was already acknowledged, notify current.
 		// If the old alert was not acknowledged, do nothing.
 		// Do nothing if state did not change.
 		notify := func(ns *conf.Notifications) {
+			if suppress {
+				withheld++
+				return
+			}
 			nots := ns.Get(s.Conf, state.Group)
 			for _, n := range nots {
 				s.Notify(state, n)
@@ -97,21 +202,29 @@ func (s *Schedule) RunHistory(r *RunHistory) {
 		}
 		notifyCurrent := func() {
 			state.NeedAck = true
-			switch event.Status {
-			case StCritical, StUnknown:
-				notify(a.CritNotification)
-			case StWarning:
-				notify(a.WarnNotification)
+			if ns := notificationsFor(a, event); ns != nil {
+				notify(ns)
 			}
 		}
 		clearOld := func() {
 			state.NeedAck = false
 			delete(s.Notifications, ak)
 		}
-		if event.Status > last {
+		escalated := event.Status.Severity() > last.Severity() || event.Status == last && event.Fatal && !lastFatal
+		deescalated := event.Status.Severity() < last.Severity() || event.Status == last && !event.Fatal && lastFatal
+		if event.Status == StUnknown && forget[ak] {
+			go func(ak expr.AlertKey) {
+				log.Printf("auto forget %s because was silenced", ak)
+				err := s.Action("bosun", "Auto forget because was silenced.", ActionForget, ak)
+				if err != nil {
+					slog.Errorln(err)
+				}
+			}(ak)
+		}
+		if escalated {
 			clearOld()
 			notifyCurrent()
-		} else if event.Status < last {
+		} else if deescalated {
 			if _, hasOld := s.Notifications[ak]; hasOld {
 				notifyCurrent()
 			}
@@ -121,18 +234,58 @@ func (s *Schedule) RunHistory(r *RunHistory) {
 					log.Printf("auto close %s because was silenced", ak)
 					err := s.Action("bosun", "Auto close because was silenced.", ActionClose, ak)
 					if err != nil {
-						log.Println(err)
+						slog.Errorln(err)
 					}
 				}(ak)
 			}
 		}
 	}
+	if withheld > 0 {
+		log.Printf("startup suppression withheld notifications for %d alert keys (cycle %d of %d)", withheld, s.cycles+1, s.Conf.StartupSuppress)
+	}
 	if checkNotify && s.nc != nil {
 		s.nc <- true
 	}
+	// Drop the crit values of alert keys that were purged or archived.
+	s.crit.prune(func(ak expr.AlertKey) bool {
+		_, ok := s.status[ak]
+		return ok
+	})
 	s.Save()
 }
 
+// notificationsFor returns the notifications of a for the status of event, or
+// nil if there are none.
+func notificationsFor(a *conf.Alert, event *Event) *conf.Notifications {
+	switch event.Status {
+	case StCritical, StUnknown:
+		if fn := a.FatalNotification; event.Fatal && (len(fn.Notifications) > 0 || len(fn.Lookups) > 0) {
+			return fn
+		}
+		return a.CritNotification
+	case StWarning:
+		return a.WarnNotification
+	case StInfo:
+		return a.InfoNotification
+	}
+	return nil
+}
+
+// severityStatus converts the value of a severity expression to a status.
+func severityStatus(n float64) (status Status, fatal bool) {
+	switch n {
+	case 0:
+		return StNormal, false
+	case 1:
+		return StWarning, false
+	case 2:
+		return StCritical, false
+	case 3:
+		return StCritical, true
+	}
+	return StError, false
+}
+
 // CheckUnknown checks for unknown alerts.
 func (s *Schedule) CheckUnknown() {
 	for _ = range time.Tick(s.Conf.CheckFrequency / 4) {
@@ -144,12 +297,12 @@ func (s *Schedule) CheckUnknown() {
 				continue
 			}
 			a := s.Conf.Alerts[ak.Name()]
-			if a.IgnoreUnknown {
+			if a == nil || a.IgnoreUnknown || !s.OwnsAlert(a.Name) {
 				continue
 			}
 			t := a.Unknown
 			if t == 0 {
-				t = s.Conf.CheckFrequency * 2
+				t = s.Conf.CheckFrequency*2 + a.AnchorPeriod
 			}
 			if t == 0 {
 				continue
@@ -157,23 +310,78 @@ func (s *Schedule) CheckUnknown() {
 			if time.Since(st.Touched) < t {
 				continue
 			}
-			r.Events[ak] = &Event{Status: StUnknown}
+			r.Events[ak] = &Event{Status: StUnknown, Trace: r.Trace}
 		}
 		s.Unlock()
 		s.RunHistory(r)
 	}
 }
 
+// setTrace sets the trace ID of subsequent evaluations and TSDB queries.
+func (r *RunHistory) setTrace(trace string) {
+	r.trace = trace
+	if c, ok := r.TSDB.OpenTSDB().(*TracedCache); ok {
+		c.Trace = trace
+	}
+}
+
 func (s *Schedule) CheckAlert(T miniprofiler.Timer, r *RunHistory, a *conf.Alert) {
-	log.Printf("checking alert %v", a.Name)
+	r.setTrace(r.Trace + "-" + NewTraceID())
+	defer r.setTrace(r.Trace)
+	log.Printf("checking alert %v (trace %s)", a.Name, r.trace)
 	start := time.Now()
 	var warns expr.AlertKeys
 	crits, err := s.CheckExpr(T, r, a, a.Crit, StCritical, nil)
 	if err == nil {
+		s.recordCrit(T, r, a)
 		warns, _ = s.CheckExpr(T, r, a, a.Warn, StWarning, crits)
+		s.CheckExpr(T, r, a, a.Info, StInfo, append(crits, warns...))
+	}
+	if a.Severity != nil {
+		crits, _ = s.CheckExpr(T, r, a, a.Severity, StNone, nil)
+	}
+	d := time.Since(start)
+	s.internals.alert(a.Name, start, d)
+	collect.Put("check.duration", opentsdb.TagSet{"name": a.Name}, d.Seconds())
+	if s.Conf.AlertMetrics && !s.canary {
+		s.putAlertMetrics(r, a)
+	}
+	log.Printf("done checking alert %v (%s, trace %s): %v crits, %v warns", a.Name, d, r.trace, len(crits), len(warns))
+}
+
+// putAlertMetrics records the number of groups of alert a in each status for
+// this run.
+func (s *Schedule) putAlertMetrics(r *RunHistory, a *conf.Alert) {
+	counts := map[Status]int{
+		StNormal:   0,
+		StInfo:     0,
+		StWarning:  0,
+		StCritical: 0,
+		StError:    0,
+	}
+	for ak, ev := range r.Events {
+		if ak.Name() != a.Name {
+			continue
+		}
+		counts[ev.Status]++
+	}
+	for st, n := range counts {
+		collect.Put("alert.groups", opentsdb.TagSet{"name": a.Name, "status": st.String()}, n)
+	}
+}
+
+// alertOptions returns the options of evaluating the expressions of alert a
+// in the run rh.
+func (s *Schedule) alertOptions(rh *RunHistory, a *conf.Alert) expr.Options {
+	return expr.Options{
+		UnjoinedOK: a.UnjoinedOK,
+		Lookups:    s.Conf.GetLookups(),
+		Squelched:  s.Conf.AlertSquelched(a),
+		History:    rh,
+		Exclusions: s.Conf.Exclusions,
+		Calendar:   s.Conf.Calendar,
+		MaxGroups:  s.Conf.GroupLimit(a),
 	}
-	collect.Put("check.duration", opentsdb.TagSet{"name": a.Name}, time.Since(start).Seconds())
-	log.Printf("done checking alert %v (%s): %v crits, %v warns", a.Name, time.Since(start), len(crits), len(warns))
 }
 
 func (s *Schedule) CheckExpr(T miniprofiler.Timer, rh *RunHistory, a *conf.Alert, e *expr.Expr, checkStatus Status, ignore expr.AlertKeys) (alerts expr.AlertKeys, err error) {
@@ -185,9 +393,14 @@ func (s *Schedule) CheckExpr(T miniprofiler.Timer, rh *RunHistory, a *conf.Alert
 			return
 		}
 		collect.Add("check.errs", opentsdb.TagSet{"metric": a.Name}, 1)
-		log.Println(err)
+		slog.Errorf("%v (trace %s)", err, rh.trace)
+		s.Hook(HookError, a.Name, err.Error(), 0)
 	}()
-	results, _, err := e.Execute(rh.Context, T, rh.Start, 0, a.UnjoinedOK, s.Search, s.Conf.GetLookups(), s.Conf.AlertSquelched(a))
+	results, _, err := e.Execute(rh.TSDB, T, rh.Start, rh.Search, s.alertOptions(rh, a))
+	if max := s.Conf.GroupLimit(a); err == nil && max > 0 && len(results.Results) > max {
+		collect.Add("check.group_limit", opentsdb.TagSet{"metric": a.Name}, 1)
+		err = fmt.Errorf("%s: expression returned %d groups, more than maxGroups %d", a.Name, len(results.Results), max)
+	}
 	if err != nil {
 		ak := expr.NewAlertKey(a.Name, nil)
 		state := s.Status(ak)
@@ -204,9 +417,11 @@ func (s *Schedule) CheckExpr(T miniprofiler.Timer, rh *RunHistory, a *conf.Alert
 		}
 		rh.Events[ak] = &Event{
 			Status: StError,
+			Trace:  rh.trace,
 		}
 		return
 	}
+	parents := s.firingParents(rh, a)
 Loop:
 	for _, r := range results.Results {
 		if s.Conf.Squelched(a, r.Group) {
@@ -233,7 +448,7 @@ Loop:
 		}
 		event := rh.Events[ak]
 		if event == nil {
-			event = new(Event)
+			event = &Event{Trace: rh.trace}
 			rh.Events[ak] = event
 		}
 		result := Result{
@@ -241,21 +456,31 @@ Loop:
 			Expr:   e.String(),
 		}
 		switch checkStatus {
+		case StInfo:
+			event.Info = &result
 		case StWarning:
 			event.Warn = &result
-		case StCritical:
+		case StCritical, StNone:
 			event.Crit = &result
 		}
+		if p := dependsOn(parents, r.Group); p != "" {
+			event.DependsOn = p
+			continue
+		}
+		fatal := false
 		if math.IsNaN(n) {
 			status = StError
+		} else if checkStatus == StNone {
+			status, fatal = severityStatus(n)
 		} else if n == 0 {
 			status = StNormal
 		}
 		if status != StNormal {
 			alerts = append(alerts, ak)
 		}
-		if status > rh.Events[ak].Status {
+		if status.Severity() > rh.Events[ak].Status.Severity() {
 			event.Status = status
+			event.Fatal = fatal
 			state.Result = &result
 		}
 	}
@@ -0,0 +1,168 @@
+package sched
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/MiniProfiler/go/miniprofiler"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/conf"
+	"github.com/bosun-monitor/bosun/expr"
+	eparse "github.com/bosun-monitor/bosun/expr/parse"
+)
+
+// A CritValue is the crit value of an alert key at one check.
+type CritValue struct {
+	Time  int64 // Unix seconds
+	Value float64
+}
+
+// critHistory records the crit values of the alerts read by critQuantile.
+type critHistory struct {
+	sync.Mutex
+	values map[expr.AlertKey][]CritValue
+}
+
+func (h *critHistory) add(ak expr.AlertKey, now time.Time, v float64, retention time.Duration) {
+	h.Lock()
+	defer h.Unlock()
+	if h.values == nil {
+		h.values = make(map[expr.AlertKey][]CritValue)
+	}
+	vs := h.values[ak]
+	expire := now.Add(-retention).Unix()
+	i := 0
+	for i < len(vs) && vs[i].Time < expire {
+		i++
+	}
+	h.values[ak] = append(vs[i:], CritValue{now.Unix(), v})
+}
+
+// prune removes the values of the alert keys for which keep returns false.
+func (h *critHistory) prune(keep func(expr.AlertKey) bool) {
+	h.Lock()
+	defer h.Unlock()
+	for ak := range h.values {
+		if !keep(ak) {
+			delete(h.values, ak)
+		}
+	}
+}
+
+// since returns the values of each key of alert recorded since the given
+// time.
+func (h *critHistory) since(alert string, since time.Time) map[expr.AlertKey][]float64 {
+	h.Lock()
+	defer h.Unlock()
+	from := since.Unix()
+	m := make(map[expr.AlertKey][]float64)
+	for ak, vs := range h.values {
+		if ak.Name() != alert {
+			continue
+		}
+		// since copies the values so that CritQuantile may sort them.
+		for _, v := range vs {
+			if v.Time >= from {
+				m[ak] = append(m[ak], v.Value)
+			}
+		}
+	}
+	return m
+}
+
+// critRetention returns how long to keep the crit values of each alert: the
+// longest window of the critQuantile calls reading it in c. Alerts that are
+// not read are not recorded.
+func critRetention(c *conf.Conf) map[string]time.Duration {
+	r := make(map[string]time.Duration)
+	for _, a := range c.Alerts {
+		for _, e := range []*expr.Expr{a.Crit, a.Warn, a.Info, a.Severity} {
+			if e == nil {
+				continue
+			}
+			eparse.Walk(e.Tree.Root, func(n eparse.Node) {
+				f, ok := n.(*eparse.FuncNode)
+				if !ok || f.Name != "critQuantile" || len(f.Args) != 3 {
+					return
+				}
+				alert, ok := f.Args[0].(*eparse.StringNode)
+				window, wok := f.Args[2].(*eparse.StringNode)
+				if !ok || !wok {
+					return
+				}
+				if d := windowDuration(window.Text); d > r[alert.Text] {
+					r[alert.Text] = d
+				}
+			})
+		}
+	}
+	return r
+}
+
+// windowDuration parses an expression window. Business days, which skip
+// weekends and holidays, are counted as two days each to be safe.
+func windowDuration(s string) time.Duration {
+	if n, err := strconv.Atoi(strings.TrimSuffix(s, "bd")); err == nil && strings.HasSuffix(s, "bd") {
+		return time.Duration(n) * 48 * time.Hour
+	}
+	d, err := opentsdb.ParseDuration(s)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(d)
+}
+
+// critOperand returns the expression whose value is recorded as the crit
+// value of e: the left side if e is a comparison, such as x in x > 10,
+// otherwise e itself.
+func critOperand(e *expr.Expr) *expr.Expr {
+	b, ok := e.Tree.Root.(*eparse.BinaryNode)
+	if !ok {
+		return e
+	}
+	switch b.OpStr {
+	case ">", ">=", "<", "<=", "==", "!=":
+	default:
+		return e
+	}
+	o, err := expr.New(b.Args[0].String())
+	if err != nil || o.Tree.Root.Return() != eparse.TYPE_NUMBER {
+		return e
+	}
+	return o
+}
+
+// recordCrit records the crit values of a if critQuantile reads them.
+func (s *Schedule) recordCrit(T miniprofiler.Timer, rh *RunHistory, a *conf.Alert) {
+	retention := rh.critRetention[a.Name]
+	if retention <= 0 || a.Crit == nil {
+		return
+	}
+	results, _, err := critOperand(a.Crit).Execute(rh.TSDB, T, rh.Start, rh.Search, s.alertOptions(rh, a))
+	if err != nil {
+		return
+	}
+	for _, r := range results.Results {
+		var v float64
+		switch n := r.Value.(type) {
+		case expr.Number:
+			v = float64(n)
+		case expr.Scalar:
+			v = float64(n)
+		default:
+			continue
+		}
+		if math.IsNaN(v) {
+			continue
+		}
+		s.crit.add(expr.NewAlertKey(a.Name, r.Group), rh.Start, v, retention)
+	}
+}
+
+// CritHistory implements expr.CritHistoryProvider.
+func (r *RunHistory) CritHistory(alert string, since time.Time) map[expr.AlertKey][]float64 {
+	return r.crit.since(alert, since)
+}
@@ -0,0 +1,113 @@
+package sched
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"log"
+	"regexp"
+	"sort"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
+	"github.com/bosun-monitor/bosun/conf"
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+var dedupRE = regexp.MustCompile(`{[^}]+}`)
+
+// dedupKey returns the dedup key of st, or "" if its alert has none.
+func (s *Schedule) dedupKey(st *State) string {
+	a := s.Conf.Alerts[st.Alert]
+	if a == nil || a.Dedup == "" {
+		return ""
+	}
+	return dedupRE.ReplaceAllStringFunc(a.Dedup, func(m string) string {
+		return st.Group[m[1:len(m)-1]]
+	})
+}
+
+// A dedupNote identifies the alert key notified for a dedup key.
+type dedupNote struct {
+	notification string
+	key          string
+}
+
+// dedup collapses the states about to be sent through n that share a dedup
+// key. It returns the states to notify with the states collapsed into each.
+// States whose key was already notified for another alert key with an active
+// incident are collapsed into that notification and not sent. s must be
+// locked.
+func (s *Schedule) dedup(n *conf.Notification, states []*State) map[expr.AlertKey][]*State {
+	send := make(map[expr.AlertKey][]*State)
+	byKey := make(map[string][]*State)
+	for _, st := range states {
+		k := s.dedupKey(st)
+		if k == "" {
+			send[st.AlertKey()] = nil
+			continue
+		}
+		byKey[k] = append(byKey[k], st)
+	}
+	if s.dedupSent == nil {
+		s.dedupSent = make(map[dedupNote]expr.AlertKey)
+	}
+	for k, l := range byKey {
+		sort.Sort(dedupOrder(l))
+		note := dedupNote{n.Name, k}
+		if ak, ok := s.dedupSent[note]; ok {
+			if st := s.status[ak]; st != nil && s.activeIncident(st) != nil {
+				for _, st := range l {
+					if st.AlertKey() == ak {
+						send[ak] = nil
+						continue
+					}
+					log.Printf("deduplicating %s into %s via %s (key %s)", st.AlertKey(), ak, n.Name, k)
+				}
+				continue
+			}
+		}
+		primary := l[0]
+		send[primary.AlertKey()] = l[1:]
+		s.dedupSent[note] = primary.AlertKey()
+		for _, st := range l[1:] {
+			log.Printf("deduplicating %s into %s via %s (key %s)", st.AlertKey(), primary.AlertKey(), n.Name, k)
+		}
+	}
+	return send
+}
+
+// dedupOrder sorts the most severe states first, then by alert key.
+type dedupOrder []*State
+
+func (a dedupOrder) Len() int      { return len(a) }
+func (a dedupOrder) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a dedupOrder) Less(i, j int) bool {
+	si, sj := a[i].Last().Status.Severity(), a[j].Last().Status.Severity()
+	if si != sj {
+		return si > sj
+	}
+	return a[i].AlertKey() < a[j].AlertKey()
+}
+
+var relatedBody = template.Must(template.New("related").Parse(`
+<p>Related alerts:</p>
+<ul>{{range .}}<li>{{.Last.Status}}: {{.AlertKey}}{{with .Subject}} ({{.}}){{end}}</li>{{end}}</ul>
+`))
+
+// addRelated appends the states collapsed into a notification to its
+// subject, body and text body.
+func addRelated(subject, body *bytes.Buffer, text *[]byte, related []*State) {
+	fmt.Fprintf(subject, " (+%d related)", len(related))
+	if err := relatedBody.Execute(body, related); err != nil {
+		slog.Errorln(err)
+	}
+	if *text == nil {
+		return
+	}
+	t := append([]byte(nil), *text...)
+	t = append(t, "\n\nRelated alerts:\n"...)
+	for _, st := range related {
+		t = append(t, fmt.Sprintf("%s: %s\n", st.Last().Status, st.AlertKey())...)
+	}
+	*text = t
+}
@@ -0,0 +1,54 @@
+package sched
+
+import (
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/conf"
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+// firing reports whether status suppresses dependent alerts.
+func firing(status Status) bool {
+	return status == StWarning || status == StCritical
+}
+
+// firingParents returns the alert keys of the alerts a depends on that are
+// firing: as evaluated earlier in rh, since alerts are checked in dependency
+// order, or else as last recorded.
+func (s *Schedule) firingParents(rh *RunHistory, a *conf.Alert) []expr.AlertKey {
+	if len(a.Depends) == 0 {
+		return nil
+	}
+	var parents []expr.AlertKey
+	evaluated := make(map[string]bool)
+	for ak, event := range rh.Events {
+		for _, d := range a.Depends {
+			if ak.Name() == d {
+				evaluated[d] = true
+				if firing(event.Status) {
+					parents = append(parents, ak)
+				}
+			}
+		}
+	}
+	s.Lock()
+	defer s.Unlock()
+	for ak, st := range s.status {
+		for _, d := range a.Depends {
+			if ak.Name() == d && !evaluated[d] && firing(st.Status()) {
+				parents = append(parents, ak)
+			}
+		}
+	}
+	return parents
+}
+
+// dependsOn returns the first of parents whose tags are a subset of group,
+// or "" if there is none.
+func dependsOn(parents []expr.AlertKey, group opentsdb.TagSet) expr.AlertKey {
+	for _, p := range parents {
+		if group.Subset(p.Group()) {
+			return p
+		}
+	}
+	return ""
+}
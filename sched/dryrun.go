@@ -0,0 +1,78 @@
+package sched
+
+import (
+	"sort"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/MiniProfiler/go/miniprofiler"
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+// A Projection is a notification that a check would send.
+type Projection struct {
+	AlertKey     expr.AlertKey
+	From, To     Status
+	Notification string
+	Silenced     bool
+}
+
+// DryRun evaluates every alert once at now and returns the notifications that
+// the resulting state changes would send, without sending them or recording
+// the new states. Silenced alert keys are included with Silenced set. s is
+// left read only and sends no event hooks, so it should not be run after.
+func (s *Schedule) DryRun(now time.Time) []*Projection {
+	s.canary = true
+	s.readOnly = true
+	_, ps := s.project(now)
+	return ps
+}
+
+// project evaluates every alert once at now and returns the resulting events
+// and the notifications they would send.
+func (s *Schedule) project(now time.Time) (map[expr.AlertKey]*Event, []*Projection) {
+	rh := s.NewRunHistory(now)
+	T := new(miniprofiler.Profile)
+	alerts, _ := s.Conf.AlertsByDependency()
+	for _, a := range alerts {
+		s.CheckAlert(T, rh, a)
+	}
+	silenced := s.Silenced()
+	var ps []*Projection
+	s.Lock()
+	defer s.Unlock()
+	for ak, event := range rh.Events {
+		prev := s.status[ak].Last()
+		last := prev.Status
+		escalated := event.Status.Severity() > last.Severity() || event.Status == last && event.Fatal && !prev.Fatal
+		if !escalated || event.Status <= StNormal || event.DependsOn != "" {
+			continue
+		}
+		ns := notificationsFor(s.Conf.Alerts[ak.Name()], event)
+		if ns == nil {
+			continue
+		}
+		_, isSilenced := silenced[ak]
+		for _, n := range ns.Get(s.Conf, ak.Group()) {
+			ps = append(ps, &Projection{
+				AlertKey:     ak,
+				From:         last,
+				To:           event.Status,
+				Notification: n.Name,
+				Silenced:     isSilenced,
+			})
+		}
+	}
+	sort.Sort(projections(ps))
+	return rh.Events, ps
+}
+
+type projections []*Projection
+
+func (p projections) Len() int      { return len(p) }
+func (p projections) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+func (p projections) Less(i, j int) bool {
+	if p[i].AlertKey != p[j].AlertKey {
+		return p[i].AlertKey < p[j].AlertKey
+	}
+	return p[i].Notification < p[j].Notification
+}
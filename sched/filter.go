@@ -57,6 +57,14 @@ func makeFilter(filter string) (func(*conf.Conf, *conf.Alert, *State) bool, erro
 			add(func(c *conf.Conf, a *conf.Alert, s *State) bool {
 				return s.NeedAck != v
 			})
+		case "label":
+			// label:env=prod matches alerts with that label value;
+			// label:env matches alerts with any env label.
+			lv := strings.SplitN(value, "=", 2)
+			add(func(c *conf.Conf, a *conf.Alert, s *State) bool {
+				v, ok := a.Labels[lv[0]]
+				return ok && (len(lv) == 1 || v == lv[1])
+			})
 		case "notify":
 			add(func(c *conf.Conf, a *conf.Alert, s *State) bool {
 				r := false
@@ -70,6 +78,8 @@ func makeFilter(filter string) (func(*conf.Conf, *conf.Alert, *State) bool, erro
 				}
 				f(a.CritNotification)
 				f(a.WarnNotification)
+				f(a.InfoNotification)
+				f(a.FatalNotification)
 				return r
 			})
 		case "status":
@@ -77,6 +87,8 @@ func makeFilter(filter string) (func(*conf.Conf, *conf.Alert, *State) bool, erro
 			switch value {
 			case "normal":
 				v = StNormal
+			case "info":
+				v = StInfo
 			case "warning":
 				v = StWarning
 			case "critical":
@@ -0,0 +1,74 @@
+package sched
+
+import (
+	"log"
+	"os"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
+)
+
+const followFreq = time.Second * 10
+
+// Follow makes the default schedule a read-only replica of the state file
+// written by another process. See (*Schedule).Follow.
+func Follow() {
+	DefaultSched.Follow()
+}
+
+// Follow makes s a read-only replica: s never writes the state file, and
+// reloads it whenever it changes, or every followFreq from Redis. It does not return. Follow is used instead
+// of Run by web-only instances, which serve the UI and API from the state of
+// a separate evaluator.
+func (s *Schedule) Follow() {
+	s.Lock()
+	s.readOnly = true
+	s.Unlock()
+	var mtime time.Time
+	if fi, err := os.Stat(s.Conf.StateFile); err == nil {
+		mtime = fi.ModTime()
+	}
+	for _ = range time.Tick(followFreq) {
+		if s.Conf.RedisHost != "" {
+			s.reload()
+			continue
+		}
+		fi, err := os.Stat(s.Conf.StateFile)
+		if err != nil {
+			slog.Errorln("sched: follow:", err)
+			continue
+		}
+		if !fi.ModTime().After(mtime) {
+			continue
+		}
+		mtime = fi.ModTime()
+		s.reload()
+	}
+}
+
+// reload replaces the state of s with that of the state file.
+func (s *Schedule) reload() {
+	n := new(Schedule)
+	n.Init(s.Conf)
+	n.RestoreState()
+	s.Lock()
+	s.status = n.status
+	s.Silence = n.Silence
+	s.SilenceLog = n.SilenceLog
+	s.Notifications = n.Notifications
+	s.archive = n.archive
+	s.incidents = n.incidents
+	s.maxIncident = n.maxIncident
+	s.Unlock()
+	s.metalock.Lock()
+	s.Metadata = n.Metadata
+	s.metalock.Unlock()
+	s.Search.Lock()
+	s.Search.Metric = n.Search.Metric
+	s.Search.Tagk = n.Search.Tagk
+	s.Search.Tagv = n.Search.Tagv
+	s.Search.MetricTags = n.Search.MetricTags
+	s.Search.Copy()
+	s.Search.Unlock()
+	log.Println("sched: reloaded state from", s.Conf.StateFile)
+}
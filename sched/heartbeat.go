@@ -0,0 +1,105 @@
+package sched
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+)
+
+// A Source is a collector sending data through the relay.
+type Source struct {
+	Name       string
+	Remote     string // Address of the most recent sender
+	LastSeen   time.Time
+	Datapoints int64
+	Stale      bool // Not seen within heartbeatTimeout
+}
+
+type sourceRegistry struct {
+	sync.Mutex
+	sources map[string]*Source
+}
+
+// Heartbeat records the datapoints of one relayed put from remote. If
+// heartbeatMetric is set, each of its datapoints marks the source named by
+// the datapoint's heartbeatTag as seen. Otherwise the sender address is the
+// source.
+func (s *Schedule) Heartbeat(remote string, mdp opentsdb.MultiDataPoint) {
+	now := time.Now().UTC()
+	s.sources.Lock()
+	defer s.sources.Unlock()
+	if s.sources.sources == nil {
+		s.sources.sources = make(map[string]*Source)
+	}
+	seen := func(name string, n int64) {
+		src := s.sources.sources[name]
+		if src == nil {
+			src = &Source{Name: name}
+			s.sources.sources[name] = src
+		}
+		src.Remote = remote
+		src.LastSeen = now
+		src.Datapoints += n
+	}
+	if s.Conf.HeartbeatMetric == "" {
+		seen(remote, int64(len(mdp)))
+		return
+	}
+	for _, dp := range mdp {
+		if dp.Metric != s.Conf.HeartbeatMetric {
+			continue
+		}
+		if name := dp.Tags[s.Conf.HeartbeatTag]; name != "" {
+			seen(name, 1)
+		}
+	}
+}
+
+// Sources returns all sources seen since startup, sorted by name.
+func (s *Schedule) Sources() []Source {
+	s.sources.Lock()
+	defer s.sources.Unlock()
+	cutoff := time.Now().UTC().Add(-s.Conf.HeartbeatTimeout)
+	sources := make([]Source, 0, len(s.sources.sources))
+	for _, src := range s.sources.sources {
+		c := *src
+		c.Stale = c.LastSeen.Before(cutoff)
+		sources = append(sources, c)
+	}
+	sort.Sort(sourcesByName(sources))
+	return sources
+}
+
+// ForgetSource removes a source, such as a decommissioned host, from the
+// registry.
+func (s *Schedule) ForgetSource(name string) {
+	s.sources.Lock()
+	delete(s.sources.sources, name)
+	s.sources.Unlock()
+}
+
+// SourcesLastSeen returns the last seen time of each source. It implements
+// expr.SourceProvider.
+func (s *Schedule) SourcesLastSeen() map[string]time.Time {
+	s.sources.Lock()
+	defer s.sources.Unlock()
+	m := make(map[string]time.Time, len(s.sources.sources))
+	for name, src := range s.sources.sources {
+		m[name] = src.LastSeen
+	}
+	return m
+}
+
+// SourcesLastSeen implements expr.SourceProvider using the registry as of
+// the start of the cycle.
+func (r *RunHistory) SourcesLastSeen() map[string]time.Time {
+	return r.sources
+}
+
+type sourcesByName []Source
+
+func (s sourcesByName) Len() int           { return len(s) }
+func (s sourcesByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s sourcesByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
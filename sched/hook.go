@@ -0,0 +1,68 @@
+package sched
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
+)
+
+// HookType identifies a bosun lifecycle event sent to the eventHook URL.
+type HookType string
+
+const (
+	HookLoad      HookType = "load"
+	HookCheck     HookType = "check"
+	HookError     HookType = "error"
+	HookSaveError HookType = "save_error"
+	HookMemory    HookType = "memory"
+	HookFailover  HookType = "failover"
+	HookShard     HookType = "shard"
+)
+
+// HookEvent is the JSON body posted to the eventHook URL.
+type HookEvent struct {
+	Type     HookType
+	Time     time.Time
+	Alert    string  `json:",omitempty"`
+	Message  string  `json:",omitempty"`
+	Duration float64 `json:",omitempty"` // seconds
+}
+
+// hookClient posts events to the eventHook URL, so that an unresponsive
+// endpoint does not pile up requests.
+var hookClient = &http.Client{Timeout: 10 * time.Second}
+
+// Hook posts a lifecycle event to the configured eventHook URL, if any. The
+// request is made asynchronously; failures are only logged.
+func (s *Schedule) Hook(t HookType, alert, message string, d time.Duration) {
+	if s.Conf == nil || s.Conf.EventHook == nil || s.canary {
+		return
+	}
+	ev := HookEvent{
+		Type:     t,
+		Time:     time.Now().UTC(),
+		Alert:    alert,
+		Message:  message,
+		Duration: d.Seconds(),
+	}
+	u := s.Conf.EventHook.String()
+	go func() {
+		b, err := json.Marshal(&ev)
+		if err != nil {
+			slog.Errorln(err)
+			return
+		}
+		resp, err := hookClient.Post(u, "application/json", bytes.NewReader(b))
+		if err != nil {
+			slog.Errorln("event hook:", err)
+			return
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			slog.Errorln("bad response on event hook:", resp.Status)
+		}
+	}()
+}
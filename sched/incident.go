@@ -0,0 +1,133 @@
+package sched
+
+import (
+	"sort"
+	"time"
+
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+// maxIncidents is the number of ended incidents kept.
+const maxIncidents = 10000
+
+// An Incident groups the firings of an alert key from when it opens until it
+// is closed or forgotten. Incidents are numbered in the order they open.
+type Incident struct {
+	Id       int64
+	AlertKey expr.AlertKey
+	Start    time.Time
+	End      *time.Time `json:",omitempty"`
+	Events   []Event
+	Actions  []Action
+}
+
+// Active returns true if the incident has not ended.
+func (i *Incident) Active() bool {
+	return i.End == nil
+}
+
+// copy returns a copy of i that is safe to use after s is unlocked.
+func (i *Incident) copy() *Incident {
+	c := *i
+	c.Events = append([]Event(nil), i.Events...)
+	c.Actions = append([]Action(nil), i.Actions...)
+	return &c
+}
+
+// openIncident starts a new incident for st with its last event. s must be
+// locked.
+func (s *Schedule) openIncident(ak expr.AlertKey, st *State) *Incident {
+	if s.incidents == nil {
+		s.incidents = make(map[int64]*Incident)
+	}
+	s.maxIncident++
+	i := &Incident{
+		Id:       s.maxIncident,
+		AlertKey: ak,
+		Start:    time.Now().UTC(),
+		Events:   []Event{st.Last()},
+	}
+	s.incidents[i.Id] = i
+	st.Incident = i.Id
+	s.pruneIncidents()
+	return i
+}
+
+// activeIncident returns the incident of st if it has not ended. s must be
+// locked.
+func (s *Schedule) activeIncident(st *State) *Incident {
+	if i := s.incidents[st.Incident]; i != nil && i.Active() {
+		return i
+	}
+	return nil
+}
+
+// pruneIncidents removes the oldest ended incidents beyond maxIncidents. s
+// must be locked.
+func (s *Schedule) pruneIncidents() {
+	var ended []int64
+	for id, i := range s.incidents {
+		if !i.Active() {
+			ended = append(ended, id)
+		}
+	}
+	if len(ended) <= maxIncidents {
+		return
+	}
+	sort.Sort(int64s(ended))
+	for _, id := range ended[:len(ended)-maxIncidents] {
+		delete(s.incidents, id)
+	}
+}
+
+// restoreIncidents sets the last incident ID after the incidents are
+// restored. s must be locked.
+func (s *Schedule) restoreIncidents() {
+	for id := range s.incidents {
+		if id > s.maxIncident {
+			s.maxIncident = id
+		}
+	}
+}
+
+type int64s []int64
+
+func (a int64s) Len() int           { return len(a) }
+func (a int64s) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a int64s) Less(i, j int) bool { return a[i] < a[j] }
+
+// Incident returns the incident with the given ID, or nil.
+func (s *Schedule) Incident(id int64) *Incident {
+	s.Lock()
+	defer s.Unlock()
+	if i := s.incidents[id]; i != nil {
+		return i.copy()
+	}
+	return nil
+}
+
+// Incidents returns the incidents that were active at some time after since,
+// most recent first. If alert is not empty, only incidents of that alert are
+// returned.
+func (s *Schedule) Incidents(alert string, since time.Time) []*Incident {
+	s.Lock()
+	defer s.Unlock()
+	var l []*Incident
+	for _, i := range s.incidents {
+		if alert != "" && i.AlertKey.Name() != alert {
+			continue
+		}
+		if i.End != nil && i.End.Before(since) {
+			continue
+		}
+		l = append(l, i.copy())
+	}
+	sort.Sort(incidentsByID(l))
+	return l
+}
+
+type incidentsByID []*Incident
+
+func (a incidentsByID) Len() int           { return len(a) }
+func (a incidentsByID) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a incidentsByID) Less(i, j int) bool { return a[i].Id > a[j].Id }
@@ -0,0 +1,45 @@
+package sched
+
+import (
+	"log"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/collect"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+// inhibitedBy returns the name of the first inhibit rule that suppresses
+// notifications for st, and the alert key inhibiting it, or "" if none does.
+// s must be locked.
+func (s *Schedule) inhibitedBy(st *State) (string, expr.AlertKey) {
+	for _, r := range s.Conf.InhibitRules {
+		if !r.IsTarget(st.Alert, st.Group) {
+			continue
+		}
+		min := StCritical
+		if r.SourceStatus == "warning" {
+			min = StWarning
+		}
+		for ak, src := range s.status {
+			if sev := src.Status().Severity(); src == st || sev < min.Severity() || sev > StCritical.Severity() {
+				continue
+			}
+			if r.IsSource(src.Alert, src.Group) && r.EqualTags(src.Group, st.Group) {
+				return r.Name, ak
+			}
+		}
+	}
+	return "", ""
+}
+
+// inhibited reports whether notifications for st are inhibited, logging and
+// counting it if so. s must be locked.
+func (s *Schedule) inhibited(st *State) bool {
+	rule, by := s.inhibitedBy(st)
+	if rule == "" {
+		return false
+	}
+	collect.Add("alerts.inhibited", opentsdb.TagSet{"rule": rule}, 1)
+	log.Printf("inhibiting %s: rule %s, source %s", st.AlertKey(), rule, by)
+	return true
+}
@@ -0,0 +1,152 @@
+package sched
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bosun-monitor/bosun/search"
+)
+
+// internalsHistory is the number of check cycles and state saves whose
+// timings are kept.
+const internalsHistory = 20
+
+// A Timing records when an operation started and how long it took.
+type Timing struct {
+	Start    time.Time
+	Duration time.Duration
+	Err      string `json:",omitempty"`
+}
+
+// An AlertTiming is the duration of the last evaluation of an alert.
+type AlertTiming struct {
+	Alert string
+	Timing
+}
+
+// Internals describes the workings of the schedule, to diagnose a slow
+// bosun.
+type Internals struct {
+	Cycles       []Timing // Most recent first
+	SlowAlerts   []AlertTiming
+	Saves        []Timing // Most recent first
+	CheckRunning bool
+	Pending      map[string]int // Queue name -> depth
+	Search       search.IndexSize
+	Config       string
+	Loaded       time.Time
+	Cycle        int // Check cycles completed since start
+}
+
+// internalStats records timings of the schedule for Internals.
+type internalStats struct {
+	sync.Mutex
+	cycles []Timing
+	saves  []Timing
+	alerts map[string]Timing
+	loaded time.Time
+}
+
+func pushTiming(l []Timing, t Timing) []Timing {
+	l = append(l, t)
+	if len(l) > internalsHistory {
+		l = l[len(l)-internalsHistory:]
+	}
+	return l
+}
+
+func (i *internalStats) cycle(start time.Time, d time.Duration) {
+	i.Lock()
+	i.cycles = pushTiming(i.cycles, Timing{Start: start, Duration: d})
+	i.Unlock()
+}
+
+func (i *internalStats) alert(name string, start time.Time, d time.Duration) {
+	i.Lock()
+	if i.alerts == nil {
+		i.alerts = make(map[string]Timing)
+	}
+	i.alerts[name] = Timing{Start: start, Duration: d}
+	i.Unlock()
+}
+
+func (i *internalStats) save(start time.Time, d time.Duration, err error) {
+	t := Timing{Start: start, Duration: d}
+	if err != nil {
+		t.Err = err.Error()
+	}
+	i.Lock()
+	i.saves = pushTiming(i.saves, t)
+	i.Unlock()
+}
+
+func (i *internalStats) load(t time.Time) {
+	i.Lock()
+	i.loaded = t
+	i.Unlock()
+}
+
+func reversed(l []Timing) []Timing {
+	r := make([]Timing, len(l))
+	for i, t := range l {
+		r[len(l)-1-i] = t
+	}
+	return r
+}
+
+type alertTimings []AlertTiming
+
+func (a alertTimings) Len() int      { return len(a) }
+func (a alertTimings) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a alertTimings) Less(i, j int) bool {
+	if a[i].Duration != a[j].Duration {
+		return a[i].Duration > a[j].Duration
+	}
+	return a[i].Alert < a[j].Alert
+}
+
+// Internals returns recent check cycle and state save timings, the n slowest
+// alerts of their last evaluation, queue depths and search index sizes.
+func (s *Schedule) Internals(n int) *Internals {
+	s.Lock()
+	c := s.Conf
+	in := &Internals{
+		CheckRunning: len(s.checkRunning) > 0,
+		Config:       c.Name,
+		Cycle:        s.cycles,
+		Pending: map[string]int{
+			"notifications": 0,
+			"reload":        0,
+			"save":          0,
+		},
+	}
+	for _, states := range s.notifications {
+		in.Pending["notifications"] += len(states)
+	}
+	if s.pending != nil {
+		in.Pending["reload"] = 1
+	}
+	if savePending {
+		in.Pending["save"] = 1
+	}
+	in.Pending["stream"] = len(s.transitions)
+	s.Unlock()
+	in.Search = s.Search.Size()
+	i := &s.internals
+	i.Lock()
+	defer i.Unlock()
+	in.Cycles = reversed(i.cycles)
+	in.Saves = reversed(i.saves)
+	in.Loaded = i.loaded
+	for name, t := range i.alerts {
+		if _, ok := c.Alerts[name]; ok {
+			in.SlowAlerts = append(in.SlowAlerts, AlertTiming{name, t})
+		}
+	}
+	sort.Sort(alertTimings(in.SlowAlerts))
+	if n >= 0 && len(in.SlowAlerts) > n {
+		in.SlowAlerts = in.SlowAlerts[:n]
+	}
+	return in
+}
@@ -0,0 +1,227 @@
+package sched
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/collect"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/conf"
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+// mailMaxSize limits the size of an inbound message.
+const mailMaxSize = 1 << 20
+
+// ListenMail accepts replies to notification emails over SMTP on mailListen
+// and applies the command on the first line of each reply with HandleMail.
+func (s *Schedule) ListenMail() error {
+	l, err := net.Listen("tcp", s.Conf.MailListen)
+	if err != nil {
+		return err
+	}
+	log.Println("sched: listening for mail on", s.Conf.MailListen)
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.serveMail(conn)
+	}
+}
+
+// serveMail runs a minimal SMTP session on conn. Messages are handled
+// before DATA is acknowledged, so that the sender gets a bounce explaining
+// why a command failed.
+func (s *Schedule) serveMail(conn net.Conn) {
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(time.Minute * 5))
+	tp := textproto.NewConn(conn)
+	reply := func(code int, msg string) { tp.PrintfLine("%d %s", code, msg) }
+	reply(220, "bosun ESMTP")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch verb {
+		case "HELO", "EHLO", "MAIL", "RCPT", "RSET", "NOOP":
+			reply(250, "OK")
+		case "DATA":
+			reply(354, "end data with <CR><LF>.<CR><LF>")
+			b, err := ioutil.ReadAll(io.LimitReader(tp.DotReader(), mailMaxSize))
+			if err != nil {
+				return
+			}
+			if err := s.HandleMail(b); err != nil {
+				slog.Errorln("sched: mail:", err)
+				collect.Add("mail.errors", nil, 1)
+				reply(554, err.Error())
+				continue
+			}
+			collect.Add("mail.commands", nil, 1)
+			reply(250, "OK")
+		case "QUIT":
+			reply(221, "bye")
+			return
+		default:
+			reply(502, "command not implemented")
+		}
+	}
+}
+
+// HandleMail applies the command in a reply to a notification email. The
+// reply must reference the Message-ID of the notification, and its sender
+// must be an email recipient of one of the notifications of the alert key.
+// The first line of the body that is not quoted is the command:
+//
+//	ack [message]
+//	close [message]
+//	silence <duration> [message]
+func (s *Schedule) HandleMail(raw []byte) error {
+	m, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	from, err := mail.ParseAddress(m.Header.Get("From"))
+	if err != nil {
+		return err
+	}
+	key, ok := s.Conf.MailAlertKey(m.Header.Get("In-Reply-To") + " " + m.Header.Get("References"))
+	if !ok {
+		return fmt.Errorf("not a reply to a notification")
+	}
+	ak, err := expr.ParseAlertKey(key)
+	if err != nil {
+		return err
+	}
+	if !s.mailRecipient(ak, from.Address) {
+		return fmt.Errorf("%s is not a recipient of %s", from.Address, ak)
+	}
+	text, err := mailText(m)
+	if err != nil {
+		return err
+	}
+	fields := mailCommand(text)
+	if len(fields) == 0 {
+		return fmt.Errorf("no command found")
+	}
+	user := from.Address
+	message := func(from int) string {
+		if from >= len(fields) {
+			return "via email"
+		}
+		return strings.Join(fields[from:], " ")
+	}
+	switch strings.ToLower(fields[0]) {
+	case "ack":
+		return s.Action(user, message(1), ActionAcknowledge, ak)
+	case "close":
+		return s.Action(user, message(1), ActionClose, ak)
+	case "silence":
+		if len(fields) < 2 {
+			return fmt.Errorf("silence requires a duration")
+		}
+		d, err := opentsdb.ParseDuration(fields[1])
+		if err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		_, err = s.AddSilence(now, now.Add(time.Duration(d)), ak.Name(), ak.Group().Tags(), "", 0, false, 0, user, message(2), true, "")
+		if err == nil {
+			log.Printf("sched: %s silenced %s for %s: %s", user, ak, fields[1], message(2))
+		}
+		return err
+	}
+	return fmt.Errorf("unknown command %q", fields[0])
+}
+
+// mailRecipient returns whether address is an email recipient of a
+// notification of ak. Rosters are not requested for each mail: their last
+// addresses are used.
+func (s *Schedule) mailRecipient(ak expr.AlertKey, address string) bool {
+	a := s.Conf.Alerts[ak.Name()]
+	if a == nil {
+		return false
+	}
+	for _, ns := range []*conf.Notifications{a.CritNotification, a.WarnNotification, a.InfoNotification, a.FatalNotification} {
+		if ns == nil {
+			continue
+		}
+		for _, n := range ns.Get(s.Conf, ak.Group()) {
+			for ; n != nil; n = n.Next {
+				for _, e := range n.LastRecipients(time.Now()) {
+					if strings.EqualFold(e.Address, address) {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// mailText returns the first text/plain part of m, decoded.
+func mailText(m *mail.Message) (string, error) {
+	return partText(m.Header.Get("Content-Type"), m.Header.Get("Content-Transfer-Encoding"), m.Body)
+}
+
+func partText(contentType, encoding string, body io.Reader) (string, error) {
+	mt, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mt = "text/plain"
+	}
+	if strings.EqualFold(encoding, "quoted-printable") {
+		body = quotedprintable.NewReader(body)
+	}
+	if strings.HasPrefix(mt, "multipart/") {
+		r := multipart.NewReader(body, params["boundary"])
+		for {
+			p, err := r.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return "", err
+			}
+			t, err := partText(p.Header.Get("Content-Type"), p.Header.Get("Content-Transfer-Encoding"), p)
+			if err == nil && t != "" {
+				return t, nil
+			}
+		}
+		return "", fmt.Errorf("no text/plain part")
+	}
+	if mt != "text/plain" {
+		return "", nil
+	}
+	b, err := ioutil.ReadAll(body)
+	return string(b), err
+}
+
+// mailCommand returns the fields of the first line of text that is neither
+// blank nor quoted.
+func mailCommand(text string) []string {
+	sc := bufio.NewScanner(strings.NewReader(text))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, ">") {
+			continue
+		}
+		return strings.Fields(line)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package sched
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"runtime/pprof"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/collect"
+	"github.com/bosun-monitor/bosun/conf"
+)
+
+const (
+	// memoryFreq is how often the watchdog samples memory use.
+	memoryFreq = time.Second * 10
+	// memoryProfiles is the number of heap profiles kept in
+	// memoryProfileDir; older ones are deleted.
+	memoryProfiles = 5
+	memoryPrefix   = "bosun-heap-"
+	memorySuffix   = ".pprof"
+)
+
+// memoryUsage returns the resident set size of the process, or the memory
+// obtained from the OS by the runtime where that is unavailable, and the
+// bytes of allocated heap objects.
+func memoryUsage() (rss, heap uint64) {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	rss = ms.Sys
+	if b, err := ioutil.ReadFile("/proc/self/statm"); err == nil {
+		if f := strings.Fields(string(b)); len(f) > 1 {
+			if pages, err := strconv.ParseUint(f[1], 10, 64); err == nil {
+				rss = pages * uint64(os.Getpagesize())
+			}
+		}
+	}
+	return rss, ms.HeapAlloc
+}
+
+// MemoryWatchdog samples memory use until the program exits, and acts when
+// it exceeds memoryLimit.
+func (s *Schedule) MemoryWatchdog() {
+	tripped := false
+	for _ = range time.Tick(memoryFreq) {
+		rss, heap := memoryUsage()
+		collect.Put("memory.rss", nil, rss)
+		collect.Put("memory.heap", nil, heap)
+		tripped = s.checkMemory(rss, heap, tripped)
+	}
+}
+
+// checkMemory handles one sample and returns whether the watchdog is
+// tripped. It trips once when rss exceeds memoryLimit and rearms when rss
+// falls below 90% of it, so a process hovering at the limit does not write a
+// profile every sample.
+func (s *Schedule) checkMemory(rss, heap uint64, tripped bool) bool {
+	limit := uint64(s.Conf.MemoryLimit)
+	if rss < limit/10*9 {
+		return false
+	}
+	if tripped || rss <= limit {
+		return tripped
+	}
+	s.memoryExceeded(rss, heap)
+	return true
+}
+
+// memoryExceeded writes a heap profile, optionally drops caches, and sends
+// the memory event hook and notification.
+func (s *Schedule) memoryExceeded(rss, heap uint64) {
+	msg := fmt.Sprintf("memory use %v exceeds memoryLimit %v (heap %v)", conf.ByteSize(rss), s.Conf.MemoryLimit, conf.ByteSize(heap))
+	log.Println("sched:", msg)
+	collect.Add("memory.exceeded", nil, 1)
+	if name, err := s.writeHeapProfile(); err != nil {
+		slog.Errorln("sched: heap profile:", err)
+	} else {
+		msg += "; heap profile written to " + name
+	}
+	if s.Conf.MemoryDropCaches {
+		s.Search.DropReplica()
+		debug.FreeOSMemory()
+		msg += "; search read replica dropped"
+	}
+	s.Hook(HookMemory, "", msg, 0)
+	if n := s.Conf.Notifications[s.Conf.MemoryAlert]; n != nil {
+		n.Notify([]byte("bosun: "+msg), []byte(msg), s.Conf, "bosun.memory")
+	}
+}
+
+// writeHeapProfile writes a heap profile to memoryProfileDir, or the
+// temporary directory, and deletes all but the newest memoryProfiles.
+func (s *Schedule) writeHeapProfile() (string, error) {
+	dir := s.Conf.MemoryProfileDir
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	// Zero padded so that lexical order is time order.
+	name := filepath.Join(dir, fmt.Sprintf("%s%020d%s", memoryPrefix, time.Now().UnixNano(), memorySuffix))
+	f, err := os.Create(name)
+	if err != nil {
+		return "", err
+	}
+	err = pprof.WriteHeapProfile(f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(name)
+		return "", err
+	}
+	old, err := filepath.Glob(filepath.Join(dir, memoryPrefix+"*"+memorySuffix))
+	if err != nil {
+		return name, err
+	}
+	sort.Strings(old)
+	for len(old) > memoryProfiles {
+		if err := os.Remove(old[0]); err != nil {
+			slog.Errorln("sched:", err)
+		}
+		old = old[1:]
+	}
+	return name, nil
+}
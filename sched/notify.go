@@ -2,9 +2,12 @@ package sched
 
 import (
 	"bytes"
+	"fmt"
 	"log"
 	"time"
 
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
 	"github.com/bosun-monitor/bosun/conf"
 	"github.com/bosun-monitor/bosun/expr"
 )
@@ -35,10 +38,18 @@ func (s *Schedule) Notify(st *State, n *conf.Notification) {
 func (s *Schedule) CheckNotifications(rh *RunHistory) time.Duration {
 	silenced := s.Silenced()
 	s.Lock()
+	groups := s.notifyGroups()
+	s.Unlock()
+	s.prefetchChanges(groups)
+	s.Lock()
 	defer s.Unlock()
 	notifications := s.Notifications
 	s.Notifications = nil
 	for ak, ns := range notifications {
+		if !s.OwnsAlert(ak.Name()) {
+			// The alert moved to another shard member.
+			continue
+		}
 		if _, present := silenced[ak]; present {
 			log.Println("silencing", ak)
 			continue
@@ -64,6 +75,10 @@ func (s *Schedule) CheckNotifications(rh *RunHistory) time.Duration {
 	s.notifications = nil
 	timeout := time.Hour
 	now := time.Now()
+	s.sendSuppressed(now)
+	if t := s.throttle.pending(); !t.IsZero() && t.Sub(now) < timeout {
+		timeout = t.Sub(now)
+	}
 	for _, ns := range s.Notifications {
 		for name, t := range ns {
 			n, present := s.Conf.Notifications[name]
@@ -79,48 +94,105 @@ func (s *Schedule) CheckNotifications(rh *RunHistory) time.Duration {
 	return timeout
 }
 
+// notifyGroups returns the groups of the alert keys with pending or
+// escalating notifications. s must be locked.
+func (s *Schedule) notifyGroups() []opentsdb.TagSet {
+	var groups []opentsdb.TagSet
+	for ak := range s.Notifications {
+		groups = append(groups, ak.Group())
+	}
+	for _, states := range s.notifications {
+		for _, st := range states {
+			groups = append(groups, st.Group)
+		}
+	}
+	return groups
+}
+
 func (s *Schedule) sendNotifications(rh *RunHistory, silenced map[expr.AlertKey]time.Time) {
-	if s.Conf.Quiet {
+	if s.Quiet() {
 		log.Println("quiet mode prevented", len(s.notifications), "notifications")
 		return
 	}
 	for n, states := range s.notifications {
 		ustates := make(States)
+		var send []*State
 		for _, st := range states {
 			ak := st.AlertKey()
-			if st.Last().Status == StUnknown {
+			switch {
+			case st.DependsOn != "", s.inhibited(st), s.underChange(st), s.provisioning(st), s.snoozed(st):
+				// Keep escalating so the alert is sent once the inhibition,
+				// change, provisioning grace or snooze ends.
+			case st.Last().Status == StUnknown:
 				if _, ok := silenced[ak]; ok {
 					log.Println("silencing unknown", ak)
 					continue
 				}
 				ustates[ak] = st
-			} else {
-				s.notify(rh, st, n)
+			default:
+				send = append(send, st)
 			}
 			if n.Next != nil {
 				s.AddNotification(ak, n, time.Now().UTC())
 			}
 		}
+		related := s.dedup(n, send)
+		for _, st := range send {
+			if rel, ok := related[st.AlertKey()]; ok {
+				s.notify(rh, st, n, rel)
+			}
+		}
 		for name, group := range ustates.GroupSets() {
 			s.unotify(name, group, n)
 		}
 	}
 }
 
-func (s *Schedule) notify(rh *RunHistory, st *State, n *conf.Notification) {
+// notify sends the notification n for st. related are the states collapsed
+// into it by their dedup key.
+func (s *Schedule) notify(rh *RunHistory, st *State, n *conf.Notification, related []*State) {
 	a := s.Conf.Alerts[st.Alert]
 	subject := new(bytes.Buffer)
-	if err := s.ExecuteSubject(subject, rh, a, st); err != nil {
-		log.Println(err)
-		subject = bytes.NewBufferString(err.Error())
+	if err := s.ExecuteSubject(subject, rh, a, st, n); err != nil {
+		slog.Errorf("%s: subject template: %v", st.AlertKey(), err)
+		subject = bytes.NewBufferString(fallbackSubject(st))
 	}
 	body := new(bytes.Buffer)
-	attachments, err := s.ExecuteBody(body, rh, a, st, true)
-	if err != nil {
-		log.Println(err)
-		body = bytes.NewBufferString(err.Error())
+	var attachments []*conf.Attachment
+	var err error
+	if a.Template != nil && a.Template.Body != nil {
+		attachments, err = s.ExecuteBody(body, rh, a, st, n, true)
+		if err != nil {
+			slog.Errorf("%s: body template: %v", st.AlertKey(), err)
+		}
+	}
+	if a.Template == nil || a.Template.Body == nil || err != nil {
+		body.Reset()
+		var ferr error
+		attachments, ferr = s.executeFallbackBody(body, rh, a, st, n, true, err)
+		if ferr != nil {
+			slog.Errorln(ferr)
+			body = bytes.NewBufferString(fallbackSubject(st))
+			attachments = nil
+		}
+	}
+	var text []byte
+	buf := new(bytes.Buffer)
+	if err := s.ExecuteTextBody(buf, rh, a, st, n); err != nil {
+		slog.Errorf("%s: textBody template: %v", st.AlertKey(), err)
+		text = []byte(fmt.Sprintf("%s\n\nThe template of this alert failed to render: %v", fallbackSubject(st), err))
+	} else if buf.Len() > 0 {
+		text = buf.Bytes()
 	}
-	n.Notify(subject.Bytes(), body.Bytes(), s.Conf, string(st.AlertKey()), attachments...)
+	if len(related) > 0 {
+		addRelated(subject, body, &text, related)
+	}
+	if s.throttled(n, string(st.AlertKey())) {
+		return
+	}
+	log.Printf("notifying %s via %s (trace %s)", st.AlertKey(), n.Name, st.Last().Trace)
+	st.LastNotified = time.Now().UTC()
+	n.NotifyIncident(subject.Bytes(), body.Bytes(), text, s.Conf, string(st.AlertKey()), st.Last().Status.String(), st.Incident, attachments...)
 }
 
 func (s *Schedule) unotify(name string, group expr.AlertKeys, n *conf.Notification) {
@@ -130,17 +202,21 @@ func (s *Schedule) unotify(name string, group expr.AlertKeys, n *conf.Notificati
 	s.Group[now] = group
 	if t := s.Conf.UnknownTemplate; t != nil {
 		data := s.unknownData(now, name, group)
+		data.notification = n
 		if t.Body != nil {
 			if err := t.Body.Execute(body, &data); err != nil {
-				log.Println("unknown template error:", err)
+				slog.Errorln("unknown template error:", err)
 			}
 		}
 		if t.Subject != nil {
 			if err := t.Subject.Execute(subject, &data); err != nil {
-				log.Println("unknown template error:", err)
+				slog.Errorln("unknown template error:", err)
 			}
 		}
 	}
+	if s.throttled(n, name) {
+		return
+	}
 	n.Notify(subject.Bytes(), body.Bytes(), s.Conf, name)
 }
 
@@ -0,0 +1,25 @@
+package sched
+
+import (
+	"log"
+	"time"
+)
+
+// provisioning reports whether notifications for st are withheld because the
+// ProvisionTag value of its group was first seen less than ProvisionGrace
+// ago, so hosts still being set up do not page.
+func (s *Schedule) provisioning(st *State) bool {
+	if s.Conf.ProvisionGrace <= 0 {
+		return false
+	}
+	v, ok := st.Group[s.Conf.ProvisionTag]
+	if !ok {
+		return false
+	}
+	first := s.Search.FirstSeen(s.Conf.ProvisionTag, v)
+	if first.IsZero() || time.Since(first) >= s.Conf.ProvisionGrace {
+		return false
+	}
+	log.Printf("suppressing %s while %s=%s is provisioned (first seen %s)", st.AlertKey(), s.Conf.ProvisionTag, v, first)
+	return true
+}
@@ -0,0 +1,139 @@
+package sched
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/collect"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+// Data quality problems detected in relayed data points.
+const (
+	QualityDuplicate  = "duplicate"    // Same timestamp as the newest point of its series
+	QualityOutOfOrder = "out_of_order" // Older than the newest point of its series
+	QualityFuture     = "future"       // Further ahead of now than qualityFuture
+	QualityPast       = "past"         // Further behind now than qualityPast
+)
+
+// qualityRetention is how long problem counts are kept.
+const qualityRetention = time.Hour * 24
+
+type qualityKey struct {
+	metric, problem string
+}
+
+// qualityRegistry counts problems per metric in one minute buckets.
+type qualityRegistry struct {
+	sync.Mutex
+	buckets map[qualityKey]map[int64]int64 // Unix minute -> count
+}
+
+func (q *qualityRegistry) add(metric, problem string, now time.Time) {
+	q.Lock()
+	defer q.Unlock()
+	if q.buckets == nil {
+		q.buckets = make(map[qualityKey]map[int64]int64)
+	}
+	k := qualityKey{metric, problem}
+	if q.buckets[k] == nil {
+		q.buckets[k] = make(map[int64]int64)
+	}
+	q.buckets[k][now.Unix()/60]++
+}
+
+// counts returns the problems since the given time, and drops those older
+// than qualityRetention.
+func (q *qualityRegistry) counts(since time.Time) []expr.QualityCount {
+	q.Lock()
+	defer q.Unlock()
+	from := since.Unix() / 60
+	expire := time.Now().Add(-qualityRetention).Unix() / 60
+	counts := make([]expr.QualityCount, 0)
+	for k, b := range q.buckets {
+		c := expr.QualityCount{Metric: k.metric, Problem: k.problem}
+		for m, n := range b {
+			if m < expire {
+				delete(b, m)
+				continue
+			}
+			if m >= from {
+				c.Count += n
+			}
+		}
+		if len(b) == 0 {
+			delete(q.buckets, k)
+			continue
+		}
+		counts = append(counts, c)
+	}
+	sort.Sort(qualityCounts(counts))
+	return counts
+}
+
+type qualityCounts []expr.QualityCount
+
+func (q qualityCounts) Len() int      { return len(q) }
+func (q qualityCounts) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q qualityCounts) Less(i, j int) bool {
+	if q[i].Metric != q[j].Metric {
+		return q[i].Metric < q[j].Metric
+	}
+	return q[i].Problem < q[j].Problem
+}
+
+// seconds converts an OpenTSDB timestamp, in seconds or milliseconds, to
+// seconds.
+func seconds(ts int64) int64 {
+	if ts > 1e12 {
+		return ts / 1000
+	}
+	return ts
+}
+
+// CheckQuality records data quality problems in mdp. It must be called before
+// mdp is indexed, since duplicates and out of order points are found by
+// comparing with the newest indexed point of each series.
+func (s *Schedule) CheckQuality(mdp opentsdb.MultiDataPoint) {
+	now := time.Now()
+	batch := make(map[string]int64)
+	for _, dp := range mdp {
+		ts := seconds(dp.Timestamp)
+		key := dp.Metric + dp.Tags.String()
+		last, ok := batch[key]
+		if !ok {
+			last = seconds(s.Search.LastTimestamp(dp.Metric, dp.Tags))
+		}
+		if ts > last {
+			batch[key] = ts
+		}
+		var problem string
+		switch {
+		case last > 0 && ts == last:
+			problem = QualityDuplicate
+		case ts < last:
+			problem = QualityOutOfOrder
+		case s.Conf.QualityFuture > 0 && time.Unix(ts, 0).Sub(now) > s.Conf.QualityFuture:
+			problem = QualityFuture
+		case s.Conf.QualityPast > 0 && now.Sub(time.Unix(ts, 0)) > s.Conf.QualityPast:
+			problem = QualityPast
+		default:
+			continue
+		}
+		s.quality.add(dp.Metric, problem, now)
+		collect.Add("relay.quality", opentsdb.TagSet{"problem": problem}, 1)
+	}
+}
+
+// DataQuality returns the problems found in relayed data points since the
+// given time.
+func (s *Schedule) DataQuality(since time.Time) []expr.QualityCount {
+	return s.quality.counts(since)
+}
+
+// DataQuality implements expr.QualityProvider.
+func (r *RunHistory) DataQuality(since time.Time) []expr.QualityCount {
+	return r.quality.counts(since)
+}
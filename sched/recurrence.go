@@ -0,0 +1,123 @@
+package sched
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Recurrence is a parsed cron-like schedule of five fields: minute, hour,
+// day of month, month and day of week, matched in UTC. Each field is *, a
+// number, a range a-b, any of those with a step /n, or a comma separated
+// list of them. Sunday is day 0 or 7. As in cron, if both day fields are
+// restricted a time matches when either does.
+type Recurrence struct {
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool
+}
+
+var recurrenceFields = []struct {
+	name     string
+	min, max int
+}{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+// ParseRecurrence parses a cron-like spec such as "0 2 * * 0", every Sunday
+// at 02:00.
+func ParseRecurrence(spec string) (*Recurrence, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != len(recurrenceFields) {
+		return nil, fmt.Errorf("recurrence: expected %d fields, got %d", len(recurrenceFields), len(fields))
+	}
+	var bits [5]uint64
+	for i, f := range fields {
+		b, err := parseRecurrenceField(f, recurrenceFields[i].min, recurrenceFields[i].max)
+		if err != nil {
+			return nil, fmt.Errorf("recurrence: %s: %v", recurrenceFields[i].name, err)
+		}
+		bits[i] = b
+	}
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+	return &Recurrence{
+		minute: bits[0],
+		hour:   bits[1],
+		dom:    bits[2],
+		month:  bits[3],
+		dow:    bits[4],
+		domAny: fields[2] == "*",
+		dowAny: fields[4] == "*",
+	}, nil
+}
+
+func parseRecurrenceField(f string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(f, ",") {
+		step := 1
+		i := strings.Index(part, "/")
+		if i >= 0 {
+			s, err := strconv.Atoi(part[i+1:])
+			if err != nil || s < 1 {
+				return 0, fmt.Errorf("bad step in %q", part)
+			}
+			step = s
+			part = part[:i]
+		}
+		lo, hi := min, max
+		if part != "*" {
+			r := strings.SplitN(part, "-", 2)
+			var err error
+			if lo, err = strconv.Atoi(r[0]); err != nil {
+				return 0, fmt.Errorf("bad value %q", part)
+			}
+			hi = lo
+			if len(r) == 2 {
+				if hi, err = strconv.Atoi(r[1]); err != nil {
+					return 0, fmt.Errorf("bad value %q", part)
+				}
+			} else if i >= 0 {
+				// n/step runs from n to the end of the range.
+				hi = max
+			}
+			if lo < min || hi > max || lo > hi {
+				return 0, fmt.Errorf("%q out of range %d-%d", part, min, max)
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// Match returns whether the minute of t is in r.
+func (r *Recurrence) Match(t time.Time) bool {
+	t = t.UTC()
+	has := func(bits uint64, v int) bool { return bits&(1<<uint(v)) != 0 }
+	if !has(r.minute, t.Minute()) || !has(r.hour, t.Hour()) || !has(r.month, int(t.Month())) {
+		return false
+	}
+	dom, dow := has(r.dom, t.Day()), has(r.dow, int(t.Weekday()))
+	if r.domAny || r.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// Last returns the latest minute of r at or before t and after t-within.
+func (r *Recurrence) Last(t time.Time, within time.Duration) (time.Time, bool) {
+	from := t.Add(-within)
+	for m := t.Truncate(time.Minute); m.After(from); m = m.Add(-time.Minute) {
+		if r.Match(m) {
+			return m, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,368 @@
+package sched
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
+)
+
+// With a redisHost, the state is kept in Redis rather than the state file,
+// with a hash per bucket of the state key-value store. Instances sharing the
+// Redis form an active/standby pair: the instance holding the active lease
+// checks alerts, sends notifications and writes the state; the others reload
+// the state and take the lease when it expires. Instances with a shardName
+// instead share the alert checks; see shard.go.
+
+const (
+	redisPrefix = "bosun:"
+	redisActive = redisPrefix + "active"
+	haFreq      = time.Second * 5
+	haLease     = time.Second * 15
+)
+
+const (
+	renewScript   = `if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("pexpire", KEYS[1], ARGV[2]) else return 0 end`
+	releaseScript = `if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("del", KEYS[1]) else return 0 end`
+)
+
+type redisError string
+
+func (e redisError) Error() string { return "redis: " + string(e) }
+
+// redisConn is a connection to a Redis server, redialed after an error.
+type redisConn struct {
+	addr     string
+	password string
+
+	sync.Mutex
+	c net.Conn
+	r *bufio.Reader
+}
+
+func newRedis(addr, password string) *redisConn {
+	return &redisConn{addr: addr, password: password}
+}
+
+// Do sends a command and returns its reply.
+func (rc *redisConn) Do(args ...string) (interface{}, error) {
+	replies, err := rc.Pipeline([][]string{args})
+	if err != nil {
+		return nil, err
+	}
+	if e, ok := replies[0].(redisError); ok {
+		return nil, e
+	}
+	return replies[0], nil
+}
+
+// Pipeline sends cmds and returns their replies, which are strings, int64s,
+// []byte bulk strings, nil, []interface{} arrays or redisErrors.
+func (rc *redisConn) Pipeline(cmds [][]string) ([]interface{}, error) {
+	rc.Lock()
+	defer rc.Unlock()
+	if rc.c == nil {
+		if err := rc.dial(); err != nil {
+			return nil, err
+		}
+	}
+	replies, err := rc.pipeline(cmds)
+	if err != nil {
+		rc.c.Close()
+		rc.c = nil
+	}
+	return replies, err
+}
+
+func (rc *redisConn) dial() error {
+	c, err := net.DialTimeout("tcp", rc.addr, time.Second*10)
+	if err != nil {
+		return err
+	}
+	rc.c = c
+	rc.r = bufio.NewReader(c)
+	if rc.password == "" {
+		return nil
+	}
+	replies, err := rc.pipeline([][]string{{"AUTH", rc.password}})
+	if err == nil {
+		if e, ok := replies[0].(redisError); ok {
+			err = e
+		}
+	}
+	if err != nil {
+		c.Close()
+		rc.c = nil
+	}
+	return err
+}
+
+func (rc *redisConn) pipeline(cmds [][]string) ([]interface{}, error) {
+	rc.c.SetDeadline(time.Now().Add(time.Minute))
+	var buf bytes.Buffer
+	for _, args := range cmds {
+		fmt.Fprintf(&buf, "*%d\r\n", len(args))
+		for _, a := range args {
+			fmt.Fprintf(&buf, "$%d\r\n%s\r\n", len(a), a)
+		}
+	}
+	if _, err := buf.WriteTo(rc.c); err != nil {
+		return nil, err
+	}
+	replies := make([]interface{}, len(cmds))
+	for i := range cmds {
+		r, err := readReply(rc.r)
+		if err != nil {
+			return nil, err
+		}
+		replies[i] = r
+	}
+	return replies, nil
+}
+
+func readReply(r *bufio.Reader) (interface{}, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("redis: malformed reply %q", line)
+	}
+	line = line[:len(line)-2]
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return redisError(line[1:]), nil
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		b := make([]byte, n+2)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	case '*':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		a := make([]interface{}, n)
+		for i := range a {
+			if a[i], err = readReply(r); err != nil {
+				return nil, err
+			}
+		}
+		return a, nil
+	}
+	return nil, fmt.Errorf("redis: malformed reply %q", line)
+}
+
+// readRedisState loads the state key-value store kept under the key prefix
+// from Redis. Commits of the returned stateDB write to Redis.
+func readRedisState(rc *redisConn, prefix string) (*stateDB, error) {
+	db := &stateDB{
+		path:    "redis://" + rc.addr + "/" + prefix,
+		buckets: make(map[string]map[string][]byte),
+		sums:    make(map[string]uint64),
+		lens:    make(map[string]int64),
+		redis:   rc,
+		prefix:  prefix,
+	}
+	r, err := rc.Do("SMEMBERS", prefix+"buckets")
+	if err != nil {
+		return nil, err
+	}
+	buckets, _ := r.([]interface{})
+	for _, b := range buckets {
+		bucket := string(b.([]byte))
+		r, err := rc.Do("HGETALL", prefix+"state:"+bucket)
+		if err != nil {
+			return nil, err
+		}
+		kvs, _ := r.([]interface{})
+		for i := 0; i+1 < len(kvs); i += 2 {
+			db.apply(opPut, bucket, string(kvs[i].([]byte)), kvs[i+1].([]byte), 0)
+		}
+	}
+	return db, nil
+}
+
+// commitRedis sends the pending records of db to Redis.
+func (db *stateDB) commitRedis() (int, error) {
+	n := db.pending.Len()
+	if n == 0 {
+		return 0, nil
+	}
+	var cmds [][]string
+	buckets := make(map[string]bool)
+	r := bytes.NewReader(db.pending.Bytes())
+	for {
+		op, bucket, key, value, _, err := readRecord(r)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return 0, err
+		}
+		switch op {
+		case opPut:
+			cmds = append(cmds, []string{"HSET", db.prefix + "state:" + bucket, key, string(value)})
+		case opDelete:
+			cmds = append(cmds, []string{"HDEL", db.prefix + "state:" + bucket, key})
+		}
+		if !buckets[bucket] {
+			buckets[bucket] = true
+			cmds = append(cmds, []string{"SADD", db.prefix + "buckets", bucket})
+		}
+	}
+	replies, err := db.redis.Pipeline(cmds)
+	if err != nil {
+		return 0, err
+	}
+	for _, r := range replies {
+		if e, ok := r.(redisError); ok {
+			return 0, e
+		}
+	}
+	db.pending.Reset()
+	return n, nil
+}
+
+// Quiet reports whether s sends no notifications, either because of the
+// quiet config or flag, because it was made quiet with SetQuiet, or because
+// it is the standby of an active/standby pair.
+func (s *Schedule) Quiet() bool {
+	s.haLock.Lock()
+	defer s.haLock.Unlock()
+	return s.Conf.Quiet || s.quiet || s.standby
+}
+
+// SetQuiet makes s quiet or not. A quiet instance of an active/standby pair,
+// whether by SetQuiet or the quiet config, gives up or never takes the active
+// lease, so that the standby takes over.
+func (s *Schedule) SetQuiet(quiet bool) {
+	s.haLock.Lock()
+	s.quiet = quiet
+	s.haLock.Unlock()
+	log.Println("sched: quiet set to", quiet)
+	if s.Conf.RedisHost != "" && s.Conf.ShardName == "" {
+		s.lease()
+	}
+}
+
+// Standby reports whether s is the standby of an active/standby pair.
+func (s *Schedule) Standby() bool {
+	s.haLock.Lock()
+	defer s.haLock.Unlock()
+	return s.standby
+}
+
+// HA renews or takes the active lease every haFreq, and reloads the state
+// from Redis while s is the standby. It does not return.
+func (s *Schedule) HA() {
+	for {
+		s.lease()
+		if s.Standby() {
+			s.reload()
+		}
+		time.Sleep(haFreq)
+	}
+}
+
+// lease takes or renews the active lease, or releases it if s is quiet, and
+// sets s.standby accordingly. A standby that takes the lease reloads the
+// state first.
+func (s *Schedule) lease() {
+	s.haLock.Lock()
+	if s.haID == "" {
+		host, _ := os.Hostname()
+		s.haID = fmt.Sprintf("%s:%d:%d", host, os.Getpid(), time.Now().UnixNano())
+	}
+	id, quiet, standby, renewed := s.haID, s.quiet || s.Conf.Quiet, s.standby, s.haRenewed
+	s.haLock.Unlock()
+	// Before its first lease s is neither active nor standby.
+	wasActive := !standby && !renewed.IsZero()
+	rc := s.redis()
+	ms := strconv.FormatInt(int64(haLease/time.Millisecond), 10)
+	// The lease expires haLease after the request that renewed it was sent.
+	now := time.Now()
+	active := false
+	if quiet {
+		if _, err := rc.Do("EVAL", releaseScript, "1", redisActive, id); err != nil {
+			slog.Errorln("sched: releasing active lease:", err)
+		}
+	} else if r, err := rc.Do("EVAL", renewScript, "1", redisActive, id, ms); err != nil {
+		// Without Redis, stay active until the lease would have expired,
+		// after which another instance may have taken it.
+		slog.Errorln("sched: renewing active lease:", err)
+		if standby || time.Since(renewed) < haLease {
+			return
+		}
+		log.Println("sched: active lease expired, standby")
+	} else if r == int64(1) {
+		active = true
+	} else if r, err := rc.Do("SET", redisActive, id, "NX", "PX", ms); err != nil {
+		// The lease is not held by s, so it cannot stay active.
+		slog.Errorln("sched: taking active lease:", err)
+	} else {
+		active = r == "OK"
+	}
+	if active && standby {
+		log.Println("sched: took active lease, reloading state")
+		s.Hook(HookFailover, "", "took active lease", 0)
+		s.reload()
+		// The records last written by s may since have been overwritten.
+		s.Lock()
+		s.db = nil
+		s.Unlock()
+	} else if !active && !standby {
+		log.Println("sched: standby")
+		if wasActive && !quiet {
+			s.Hook(HookFailover, "", "lost active lease", 0)
+		}
+	}
+	s.haLock.Lock()
+	s.standby = !active
+	if active {
+		s.haRenewed = now
+	}
+	s.haLock.Unlock()
+}
+
+var redisConns = struct {
+	sync.Mutex
+	m map[string]*redisConn
+}{m: make(map[string]*redisConn)}
+
+// redisState returns the prefix of the Redis keys of the state of s.
+func (s *Schedule) redisState() string {
+	if s.Conf.ShardName != "" {
+		return shardPrefix(s.Conf.ShardName)
+	}
+	return redisPrefix
+}
+
+// redis returns the connection to the Redis of the config, shared by all
+// schedules.
+func (s *Schedule) redis() *redisConn {
+	redisConns.Lock()
+	defer redisConns.Unlock()
+	rc := redisConns.m[s.Conf.RedisHost]
+	if rc == nil || rc.password != s.Conf.RedisPassword {
+		rc = newRedis(s.Conf.RedisHost, s.Conf.RedisPassword)
+		redisConns.m[s.Conf.RedisHost] = rc
+	}
+	return rc
+}
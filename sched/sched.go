@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/collect"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/metadata"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
@@ -33,18 +34,53 @@ type Schedule struct {
 
 	Conf          *conf.Conf
 	status        States
+	archive       map[expr.AlertKey]*ArchivedState
 	Notifications map[expr.AlertKey]map[string]time.Time
 	Silence       map[string]*Silence
+	SilenceLog    []*ClearedSilence
 	Group         map[time.Time]expr.AlertKeys
 	Metadata      map[metadata.Metakey]Metavalues
 	Search        *search.Search
 	Lookups       map[string]*expr.Lookup
+	// NewTSDB, if set, replaces the backends of Conf, such as to mock them
+	// in tests.
+	NewTSDB func(trace string) expr.TSDBProvider
 
 	LastCheck     time.Time
 	nc            chan interface{}
 	notifications map[*conf.Notification][]*State
 	metalock      sync.Mutex
 	checkRunning  chan bool
+	anchors       map[string]time.Time // Alert name -> last anchor evaluated
+	readOnly      bool                 // Set by Follow, Canary and DryRun; state is never saved
+	db            *stateDB             // State file, opened by the first save
+	cycles        int                  // Check cycles completed since start
+	canary        bool                 // Set by Canary and DryRun; no hooks are sent
+	pending       *pendingReload       // Config awaiting ConfirmReload
+	shardStart    time.Time            // First check as a shard member
+	shardsDown    map[string]bool      // Shard members found to have stopped checking
+	silenceSync   map[string]bool      // Silences in Redis as of the last syncSilences
+
+	haLock    sync.Mutex
+	haID      string    // Value of the active lease when held by s
+	quiet     bool      // Set by SetQuiet
+	standby   bool      // Another instance holds the active lease
+	shardLive []string  // Shard members with a live heartbeat in Redis, sorted
+	haRenewed time.Time // When the active lease was last taken or renewed
+
+	sources      sourceRegistry
+	changes      changeCache
+	quality      qualityRegistry
+	crit         critHistory
+	throttle     notifyThrottle
+	internals    internalStats
+	incidents    map[int64]*Incident
+	dedupSent    map[dedupNote]expr.AlertKey
+	maxIncident  int64 // ID of the last incident opened
+	transitions  []*Transition
+	streamStart  int64
+	streamCursor int64
+	streamWake   chan struct{}
 }
 
 type Metavalues []Metavalue
@@ -261,12 +297,15 @@ func (states States) GroupSets() map[string]expr.AlertKeys {
 type StateGroup struct {
 	Active   bool `json:",omitempty"`
 	Status   Status
-	Subject  string        `json:",omitempty"`
-	Len      int           `json:",omitempty"`
-	Alert    string        `json:",omitempty"`
-	AlertKey expr.AlertKey `json:",omitempty"`
-	Ago      string        `json:",omitempty"`
-	Children []*StateGroup `json:",omitempty"`
+	Subject  string            `json:",omitempty"`
+	Len      int               `json:",omitempty"`
+	Alert    string            `json:",omitempty"`
+	AlertKey expr.AlertKey     `json:",omitempty"`
+	Ago      string            `json:",omitempty"`
+	Labels   map[string]string `json:",omitempty"`
+	Children []*StateGroup     `json:",omitempty"`
+	// DependsOn is the firing parent alert key suppressing this one.
+	DependsOn expr.AlertKey `json:",omitempty"`
 }
 
 type StateGroups struct {
@@ -276,15 +315,27 @@ type StateGroups struct {
 	}
 	TimeAndDate []int
 	Silenced    map[expr.AlertKey]time.Time
+	Aliases     map[string]map[string]string `json:",omitempty"`
 }
 
-func (s *Schedule) MarshalGroups(filter string) (*StateGroups, error) {
-	t := StateGroups{
-		TimeAndDate: s.Conf.TimeAndDate,
-		Silenced:    s.Silenced(),
-	}
+// FilterStates returns copies of the open states matching filter, which uses
+// the same syntax as the dashboard filter.
+func (s *Schedule) FilterStates(filter string) ([]State, error) {
 	s.Lock()
 	defer s.Unlock()
+	status, err := s.filterStates(filter)
+	if err != nil {
+		return nil, err
+	}
+	states := make([]State, 0, len(status))
+	for _, st := range status {
+		states = append(states, *st)
+	}
+	return states, nil
+}
+
+// filterStates returns the open states matching filter. s must be locked.
+func (s *Schedule) filterStates(filter string) (States, error) {
 	status := make(States)
 	matches, err := makeFilter(filter)
 	if err != nil {
@@ -302,10 +353,25 @@ func (s *Schedule) MarshalGroups(filter string) (*StateGroups, error) {
 			status[k] = v
 		}
 	}
+	return status, nil
+}
+
+func (s *Schedule) MarshalGroups(filter string) (*StateGroups, error) {
+	t := StateGroups{
+		TimeAndDate: s.Conf.TimeAndDate,
+		Silenced:    s.Silenced(),
+		Aliases:     s.Conf.AliasValues(),
+	}
+	s.Lock()
+	defer s.Unlock()
+	status, err := s.filterStates(filter)
+	if err != nil {
+		return nil, err
+	}
 	for tuple, states := range status.GroupStates() {
 		var grouped []*StateGroup
 		switch tuple.Status {
-		case StWarning, StCritical, StUnknown, StError:
+		case StInfo, StWarning, StCritical, StUnknown, StError:
 			for name, group := range states.GroupSets() {
 				g := StateGroup{
 					Active:  tuple.Active,
@@ -315,13 +381,19 @@ func (s *Schedule) MarshalGroups(filter string) (*StateGroups, error) {
 				}
 				for _, ak := range group {
 					st := s.status[ak]
+					var labels map[string]string
+					if a := s.Conf.Alerts[ak.Name()]; a != nil {
+						labels = a.Labels
+					}
 					g.Children = append(g.Children, &StateGroup{
-						Active:   tuple.Active,
-						Status:   tuple.Status,
-						AlertKey: ak,
-						Alert:    ak.Name(),
-						Subject:  st.Subject,
-						Ago:      marshalTime(st.Last().Time),
+						Active:    tuple.Active,
+						Status:    tuple.Status,
+						AlertKey:  ak,
+						Alert:     ak.Name(),
+						Subject:   st.Subject,
+						Ago:       marshalTime(st.Last().Time),
+						Labels:    labels,
+						DependsOn: st.DependsOn,
 					})
 				}
 				grouped = append(grouped, &g)
@@ -345,7 +417,7 @@ func (s *Schedule) MarshalGroups(filter string) (*StateGroups, error) {
 				return false
 			}
 			if a.Status != b.Status {
-				return a.Status > b.Status
+				return a.Status.Severity() > b.Status.Severity()
 			}
 			if a.AlertKey != b.AlertKey {
 				return a.AlertKey < b.AlertKey
@@ -366,6 +438,33 @@ func marshalTime(t time.Time) string {
 	return string(b)
 }
 
+// TSDB returns the time series backends for evaluations with the given
+// trace ID: those of NewTSDB if set, otherwise those configured by Conf.
+func (s *Schedule) TSDB(trace string) expr.TSDBProvider {
+	if s.NewTSDB != nil {
+		return s.NewTSDB(trace)
+	}
+	c := NewTracedCache(s.Conf.TsdbHost, s.Conf.ResponseLimit, trace)
+	if len(s.Conf.TSDBRoutes) > 0 {
+		c.Route = s.Conf.TSDBHosts
+	}
+	return expr.Backends{
+		OpenTSDBContext: c,
+		GraphiteContext: s.Conf.GraphiteContext(),
+	}
+}
+
+// ExprOptions returns the options of evaluating expressions outside of an
+// alert check, such as from the web UI, with the alert state of s as history.
+func (s *Schedule) ExprOptions() expr.Options {
+	return expr.Options{
+		Lookups:    s.Lookups,
+		History:    s,
+		Exclusions: s.Conf.Exclusions,
+		Calendar:   s.Conf.Calendar,
+	}
+}
+
 var DefaultSched = &Schedule{}
 
 // Loads a configuration into the default schedule
@@ -385,13 +484,25 @@ func (s *Schedule) Init(c *conf.Conf) {
 	s.Metadata = make(map[metadata.Metakey]Metavalues)
 	s.Lookups = c.GetLookups()
 	s.status = make(States)
+	s.archive = make(map[expr.AlertKey]*ArchivedState)
 	s.Search = search.NewSearch()
 	s.checkRunning = make(chan bool, 1)
+	s.anchors = make(map[string]time.Time)
+	s.streamStart = streamNow()
+	s.streamCursor = s.streamStart
+	s.transitions = nil
 }
 
 func (s *Schedule) Load(c *conf.Conf) {
 	s.Init(c)
+	if c.SnapshotURL != "" && c.StateFile != "" {
+		if err := s.restoreSnapshot(); err != nil {
+			slog.Errorln("sched: snapshot restore failed:", err)
+		}
+	}
 	s.RestoreState()
+	s.internals.load(time.Now())
+	s.Hook(HookLoad, "", fmt.Sprintf("loaded %s with %d alerts", c.Name, len(c.Alerts)), 0)
 }
 
 // Restores notification and alert state from the file on disk.
@@ -401,46 +512,74 @@ func (s *Schedule) RestoreState() {
 	s.Search.Lock()
 	defer s.Search.Unlock()
 	s.Notifications = nil
-	f, err := os.Open(s.Conf.StateFile)
-	if err != nil {
-		log.Println(err)
-		return
+	var dec stateDecoder
+	var db *stateDB
+	var err error
+	if s.Conf.RedisHost != "" {
+		db, err = readRedisState(s.redis(), s.redisState())
+	} else {
+		db, err = readStateDB(s.Conf.StateFile, false)
 	}
-	var r io.Reader = f
-	gr, err := gzip.NewReader(f)
-	if err != nil {
-		f.Seek(0, 0)
+	if err == errLegacyState {
+		f, err := os.Open(s.Conf.StateFile)
+		if err != nil {
+			slog.Errorln(err)
+			return
+		}
+		defer f.Close()
+		var r io.Reader = f
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			f.Seek(0, 0)
+		} else {
+			defer gr.Close()
+			r = gr
+		}
+		dec = gobStateDecoder{gob.NewDecoder(r)}
+	} else if err != nil {
+		slog.Errorln(err)
+		return
 	} else {
-		defer gr.Close()
-		r = gr
+		dec = db
 	}
-	dec := gob.NewDecoder(r)
-	if err := dec.Decode(&s.Search.Metric); err != nil {
-		log.Println(err)
+	if err := dec.Decode("search.metric", &s.Search.Metric); err != nil {
+		slog.Errorln(err)
 	}
-	if err := dec.Decode(&s.Search.Tagk); err != nil {
-		log.Println(err)
+	if err := dec.Decode("search.tagk", &s.Search.Tagk); err != nil {
+		slog.Errorln(err)
 	}
-	if err := dec.Decode(&s.Search.Tagv); err != nil {
-		log.Println(err)
+	if err := dec.Decode("search.tagv", &s.Search.Tagv); err != nil {
+		slog.Errorln(err)
 	}
-	if err := dec.Decode(&s.Search.MetricTags); err != nil {
-		log.Println(err)
+	if err := dec.Decode("search.metrictags", &s.Search.MetricTags); err != nil {
+		slog.Errorln(err)
 	}
 	notifications := make(map[expr.AlertKey]map[string]time.Time)
-	if err := dec.Decode(&notifications); err != nil {
-		log.Println(err)
+	if err := dec.Decode("notifications", &notifications); err != nil {
+		slog.Errorln(err)
 	}
-	if err := dec.Decode(&s.Silence); err != nil {
-		log.Println(err)
+	if err := dec.Decode("silence", &s.Silence); err != nil {
+		slog.Errorln(err)
 	}
 	status := make(States)
-	if err := dec.Decode(&status); err != nil {
-		log.Println(err)
+	if err := dec.Decode("status", &status); err != nil {
+		slog.Errorln(err)
 	}
-	for ak, st := range status {
+	for oak, st := range status {
+		ak, err := expr.ParseAlertKey(string(oak))
+		if err != nil {
+			slog.Errorln("sched: invalid alert key, ignoring:", oak, err)
+			continue
+		}
+		if ak != oak {
+			log.Printf("sched: migrated alert key %s to %s", oak, ak)
+		}
+		if prev := s.status[ak]; prev != nil && prev.Touched.After(st.Touched) {
+			continue
+		}
 		if a, present := s.Conf.Alerts[ak.Name()]; !present {
-			log.Println("sched: alert no longer present, ignoring:", ak)
+			log.Println("sched: alert no longer present, archiving:", ak)
+			s.archiveState(ak, st)
 			continue
 		} else if s.Conf.Squelched(a, st.Group) {
 			log.Println("sched: alert now squelched:", ak)
@@ -458,7 +597,7 @@ func (s *Schedule) RestoreState() {
 			}
 		}
 		s.status[ak] = st
-		for name, t := range notifications[ak] {
+		for name, t := range notifications[oak] {
 			n, present := s.Conf.Notifications[name]
 			if !present {
 				log.Println("sched: notification not present during restore:", name)
@@ -467,9 +606,40 @@ func (s *Schedule) RestoreState() {
 			s.AddNotification(ak, n, t)
 		}
 	}
-	if err := dec.Decode(&s.Metadata); err != nil {
-		log.Println(err)
+	if err := dec.Decode("metadata", &s.Metadata); err != nil {
+		slog.Errorln(err)
+	}
+	// State files written before alert key versioning end here.
+	var version int
+	if err := dec.Decode("version", &version); err == nil && version > expr.AlertKeyVersion {
+		slog.Warningf("sched: state file alert key version %d is newer than %d", version, expr.AlertKeyVersion)
+	}
+	archive := make(map[expr.AlertKey]*ArchivedState)
+	if err := dec.Decode("archive", &archive); err == nil {
+		for ak, a := range archive {
+			if _, present := s.Conf.Alerts[ak.Name()]; present && s.status[ak] == nil {
+				log.Println("sched: alert present again, restoring archived state:", ak)
+				s.status[ak] = a.State
+				continue
+			}
+			if prev := s.archive[ak]; prev == nil || prev.Touched.Before(a.Touched) {
+				s.archive[ak] = a
+			}
+		}
+	}
+	s.gcArchive()
+	if err := dec.Decode("silencelog", &s.SilenceLog); err != nil && err != io.EOF {
+		slog.Errorln(err)
+	}
+	s.crit.Lock()
+	if err := dec.Decode("crit", &s.crit.values); err != nil && err != io.EOF {
+		slog.Errorln(err)
 	}
+	s.crit.Unlock()
+	if err := dec.Decode("incidents", &s.incidents); err != nil && err != io.EOF {
+		slog.Errorln(err)
+	}
+	s.restoreIncidents()
 	s.Search.Copy()
 }
 
@@ -504,81 +674,173 @@ func (s *Schedule) save() {
 	defer s.Search.Unlock()
 	defer s.Unlock()
 	savePending = false
-	if s.Conf.StateFile == "" {
+	s.gcArchive()
+	if (s.Conf.StateFile == "" && s.Conf.RedisHost == "") || s.readOnly || s.Standby() {
 		return
 	}
-	tmp := s.Conf.StateFile + ".tmp"
-	f, err := os.Create(tmp)
-	defer f.Close()
+	start := time.Now()
+	err := s.writeState()
+	s.internals.save(start, time.Since(start), err)
 	if err != nil {
-		log.Println(err)
+		slog.Errorln(err)
+		s.Hook(HookSaveError, "", err.Error(), 0)
 		return
 	}
-	gz := gzip.NewWriter(f)
-	defer gz.Close()
-	cw := &counterWriter{w: gz}
-	enc := gob.NewEncoder(cw)
-	if err := enc.Encode(s.Search.Metric); err != nil {
-		log.Println(err)
-		return
-	}
-	log.Println("search.metric wrote", conf.ByteSize(cw.written))
-	cw.written = 0
-	if err := enc.Encode(s.Search.Tagk); err != nil {
-		log.Println(err)
-		return
-	}
-	log.Println("search.tagk wrote", conf.ByteSize(cw.written))
-	cw.written = 0
-	if err := enc.Encode(s.Search.Tagv); err != nil {
-		log.Println(err)
-		return
-	}
-	log.Println("search.tagv wrote", conf.ByteSize(cw.written))
-	cw.written = 0
-	if err := enc.Encode(s.Search.MetricTags); err != nil {
-		log.Println(err)
-		return
+	log.Println("sched: wrote state to", s.db.path)
+}
+
+// writeState writes the changes to the schedule state since the last save
+// to the state file, or Redis if configured. A state file in the gob format
+// of earlier versions is kept as StateFile.gob and replaced. s and s.Search
+// must be locked.
+func (s *Schedule) writeState() error {
+	if s.db != nil && s.db.path != s.Conf.StateFile && s.db.redis == nil {
+		s.db.Close()
+		s.db = nil
 	}
-	log.Println("search.metrictags wrote", conf.ByteSize(cw.written))
-	cw.written = 0
-	if err := enc.Encode(s.Notifications); err != nil {
-		log.Println(err)
-		return
+	if s.db == nil && s.Conf.RedisHost != "" {
+		db, err := readRedisState(s.redis(), s.redisState())
+		if err != nil {
+			return err
+		}
+		s.db = db
+	} else if s.db == nil {
+		db, err := readStateDB(s.Conf.StateFile, true)
+		if err == errLegacyState {
+			backup := s.Conf.StateFile + ".gob"
+			if err := os.Rename(s.Conf.StateFile, backup); err != nil {
+				return err
+			}
+			log.Println("sched: moved gob state file to", backup)
+			db, err = readStateDB(s.Conf.StateFile, true)
+		}
+		if err != nil {
+			return err
+		}
+		s.db = db
 	}
-	log.Println("notifications wrote", conf.ByteSize(cw.written))
-	cw.written = 0
-	if err := enc.Encode(s.Silence); err != nil {
-		log.Println(err)
-		return
+	s.crit.Lock()
+	err := s.putState(s.db)
+	s.crit.Unlock()
+	if err != nil {
+		return err
 	}
-	log.Println("silence wrote", conf.ByteSize(cw.written))
-	cw.written = 0
-	if err := enc.Encode(s.status); err != nil {
-		log.Println(err)
-		return
+	n, err := s.db.Commit()
+	if err != nil {
+		return err
 	}
-	log.Println("status wrote", conf.ByteSize(cw.written))
-	cw.written = 0
-	if err := enc.Encode(s.Metadata); err != nil {
-		log.Println(err)
-		return
+	log.Println("state wrote", conf.ByteSize(n))
+	return nil
+}
+
+// stateValue is a named part of the schedule state.
+type stateValue struct {
+	name  string
+	value interface{}
+}
+
+// keyedState are the parts of the state that are maps stored with a record
+// per key, so that a change to one alert key rewrites only its record.
+var keyedState = map[string]bool{
+	"notifications": true,
+	"status":        true,
+	"archive":       true,
+}
+
+// stateValues returns the parts of the schedule state in the order of the
+// gob encoding. s, s.Search and s.crit must be locked.
+func (s *Schedule) stateValues() []stateValue {
+	return []stateValue{
+		{"search.metric", s.Search.Metric},
+		{"search.tagk", s.Search.Tagk},
+		{"search.tagv", s.Search.Tagv},
+		{"search.metrictags", s.Search.MetricTags},
+		{"notifications", s.Notifications},
+		{"silence", s.Silence},
+		{"status", s.status},
+		{"metadata", s.Metadata},
+		{"version", expr.AlertKeyVersion},
+		{"archive", s.archive},
+		{"silencelog", s.SilenceLog},
+		{"crit", s.crit.values},
+		{"incidents", s.incidents},
 	}
-	log.Println("metadata wrote", conf.ByteSize(cw.written))
-	cw.written = 0
-	if err := gz.Close(); err != nil {
-		log.Println(err)
-		return
+}
+
+// putState puts the schedule state into db. s, s.Search and s.crit must be
+// locked.
+func (s *Schedule) putState(db *stateDB) error {
+	for _, sv := range s.stateValues() {
+		if !keyedState[sv.name] {
+			if err := db.Put("state", sv.name, sv.value); err != nil {
+				return err
+			}
+			continue
+		}
+		m := reflect.ValueOf(sv.value)
+		keys := make(map[string]bool)
+		for _, k := range m.MapKeys() {
+			if !s.writesState(sv.name, k.String()) {
+				continue
+			}
+			keys[k.String()] = true
+			if err := db.Put(sv.name, k.String(), m.MapIndex(k).Interface()); err != nil {
+				return err
+			}
+		}
+		for _, k := range db.Keys(sv.name) {
+			if !keys[k] {
+				db.Delete(sv.name, k)
+			}
+		}
 	}
-	if err := f.Close(); err != nil {
-		log.Println(err)
-		return
+	return nil
+}
+
+// writesState reports whether s writes key of the named keyed part of the
+// state. Members of a shard cluster in Redis write only the states and
+// notifications of the alerts they own.
+func (s *Schedule) writesState(name, key string) bool {
+	if s.Conf.RedisHost == "" || s.Conf.ShardName == "" || (name != "status" && name != "notifications") {
+		return true
 	}
-	if err := os.Rename(tmp, s.Conf.StateFile); err != nil {
-		log.Println(err)
-		return
+	return s.OwnsAlert(expr.AlertKey(key).Name())
+}
+
+// stateDecoder decodes the named parts of the schedule state in the order
+// of stateValues.
+type stateDecoder interface {
+	Decode(name string, v interface{}) error
+}
+
+// gobStateDecoder decodes a gob encoded state, ignoring the names.
+type gobStateDecoder struct {
+	*gob.Decoder
+}
+
+func (d gobStateDecoder) Decode(name string, v interface{}) error {
+	return d.Decoder.Decode(v)
+}
+
+// encodeState writes the gzipped gob encoding of the schedule state to w,
+// as uploaded to the snapshot store. s and s.Search must be locked.
+func (s *Schedule) encodeState(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	defer gz.Close()
+	cw := &counterWriter{w: gz}
+	enc := gob.NewEncoder(cw)
+	s.crit.Lock()
+	defer s.crit.Unlock()
+	for _, sv := range s.stateValues() {
+		if err := enc.Encode(sv.value); err != nil {
+			return err
+		}
+		if sv.name != "version" {
+			log.Println(sv.name, "wrote", conf.ByteSize(cw.written))
+		}
+		cw.written = 0
 	}
-	log.Println("sched: wrote state to", s.Conf.StateFile)
+	return gz.Close()
 }
 
 func (s *Schedule) Run() error {
@@ -588,6 +850,22 @@ func (s *Schedule) Run() error {
 	}
 	go s.Poll()
 	go s.CheckUnknown()
+	if s.Conf.SnapshotURL != "" {
+		go s.Snapshots()
+	}
+	if s.Conf.MemoryLimit > 0 {
+		go s.MemoryWatchdog()
+	}
+	if s.Conf.MailListen != "" {
+		go func() { log.Fatal(s.ListenMail()) }()
+	}
+	if s.Conf.RedisHost != "" && s.Conf.ShardName != "" {
+		s.heartbeat()
+		go s.Shards()
+	} else if s.Conf.RedisHost != "" {
+		s.lease()
+		go s.HA()
+	}
 	for {
 		wait := time.After(s.Conf.CheckFrequency)
 		if s.Conf.CheckFrequency < time.Second {
@@ -596,14 +874,22 @@ func (s *Schedule) Run() error {
 		if s.Conf == nil {
 			return fmt.Errorf("sched: nil configuration")
 		}
+		if s.Standby() {
+			<-wait
+			continue
+		}
 		log.Println("starting check")
 		now := time.Now()
 		dur, err := s.Check(nil, now)
 		if err != nil {
-			log.Println(err)
+			slog.Errorln(err)
 		}
 		log.Printf("check took %v\n", dur)
+		s.Hook(HookCheck, "", "", dur)
 		s.LastCheck = now
+		if len(s.Conf.ShardMembers) > 0 {
+			s.checkShards(now)
+		}
 		<-wait
 	}
 }
@@ -639,7 +925,7 @@ func pingHost(host string) {
 		timeout = 0
 	}
 	if err := p.Run(); err != nil {
-		log.Print(err)
+		slog.Error(err)
 	}
 	collect.Put("ping.timeout", tags, timeout)
 }
@@ -658,6 +944,16 @@ type State struct {
 	NeedAck   bool
 	Open      bool
 	Forgotten bool
+	// LastNotified is when a notification was last sent for this alert key.
+	LastNotified time.Time
+	// DependsOn is the firing parent alert key while it suppresses this
+	// one, which is then unevaluated.
+	DependsOn expr.AlertKey `json:",omitempty"`
+	// Incident is the ID of the current or last incident of this alert key.
+	Incident int64 `json:",omitempty"`
+	// SnoozedUntil is when a snooze of the notifications of this alert key
+	// ends.
+	SnoozedUntil time.Time
 }
 
 func (s *State) AlertKey() expr.AlertKey {
@@ -689,15 +985,34 @@ func (s *Schedule) Action(user, message string, t ActionType, ak expr.AlertKey)
 		s.Unlock()
 		s.Save()
 	}()
+	if err := s.checkAction(t, ak); err != nil {
+		return err
+	}
+	s.action(user, message, t, ak)
+	return nil
+}
+
+// ActionHistory returns the actions taken on ak, most recent last.
+func (s *Schedule) ActionHistory(ak expr.AlertKey) ([]Action, error) {
+	s.Lock()
+	defer s.Unlock()
+	st := s.status[ak]
+	if st == nil {
+		return nil, fmt.Errorf("no such alert key: %v", ak)
+	}
+	return append([]Action(nil), st.Actions...), nil
+}
+
+// checkAction returns an error if action t cannot be applied to ak. s must be
+// locked.
+func (s *Schedule) checkAction(t ActionType, ak expr.AlertKey) error {
 	st := s.status[ak]
 	if st == nil {
 		return fmt.Errorf("no such alert key: %v", ak)
 	}
-	ack := func() {
-		delete(s.Notifications, ak)
-		st.NeedAck = false
+	if !s.OwnsAlert(ak.Name()) {
+		return fmt.Errorf("%v is checked by shard member %s: take actions there", ak, s.alertOwner(ak.Name()))
 	}
-	isUnknown := st.Last().Status == StUnknown
 	switch t {
 	case ActionAcknowledge:
 		if !st.NeedAck {
@@ -706,40 +1021,108 @@ func (s *Schedule) Action(user, message string, t ActionType, ak expr.AlertKey)
 		if !st.Open {
 			return fmt.Errorf("cannot acknowledge closed alert")
 		}
-		ack()
 	case ActionClose:
-		if st.NeedAck {
-			ack()
-		}
 		if st.IsActive() {
 			return fmt.Errorf("cannot close active alert")
 		}
-		st.Open = false
 	case ActionForget:
-		if !isUnknown {
+		if st.Last().Status != StUnknown {
 			return fmt.Errorf("can only forget unknowns")
 		}
-		if st.NeedAck {
-			ack()
-		}
+	default:
+		return fmt.Errorf("unknown action type: %v", t)
+	}
+	return nil
+}
+
+// action applies t to ak, which must have passed checkAction. s must be
+// locked.
+func (s *Schedule) action(user, message string, t ActionType, ak expr.AlertKey) {
+	st := s.status[ak]
+	if st.NeedAck {
+		delete(s.Notifications, ak)
+		st.NeedAck = false
+	}
+	switch t {
+	case ActionClose:
+		st.Open = false
+	case ActionForget:
 		st.Open = false
 		st.Forgotten = true
 		delete(s.status, ak)
-	default:
-		return fmt.Errorf("unknown action type: %v", t)
 	}
-	st.Actions = append(st.Actions, Action{
+	s.logAction(user, message, t, st)
+}
+
+// logAction records action t on st. s must be locked.
+func (s *Schedule) logAction(user, message string, t ActionType, st *State) {
+	a := Action{
 		User:    user,
 		Message: message,
 		Type:    t,
 		Time:    time.Now().UTC(),
-	})
+	}
+	st.Actions = append(st.Actions, a)
+	if i := s.activeIncident(st); i != nil {
+		i.Actions = append(i.Actions, a)
+		if !st.Open {
+			i.End = &a.Time
+		}
+	}
 	// Would like to also track the alert group, but I believe this is impossible because any character
 	// that could be used as a delimiter could also be a valid tag key or tag value character
-	if err := collect.Add("actions", opentsdb.TagSet{"user": user, "alert": ak.Name(), "type": t.String()}, 1); err != nil {
-		log.Println(err)
+	if err := collect.Add("actions", opentsdb.TagSet{"user": user, "alert": st.Alert, "type": t.String()}, 1); err != nil {
+		slog.Errorln(err)
 	}
-	return nil
+}
+
+// AbnormalSince returns all keys of alert with the start time of their current
+// non-normal run, or the zero time if they are normal. It implements
+// expr.AlertStatusProvider.
+func (s *Schedule) AbnormalSince(alert string) map[expr.AlertKey]time.Time {
+	s.Lock()
+	defer s.Unlock()
+	return s.abnormalSince()[alert]
+}
+
+// abnormalSince returns AbnormalSince for all alerts. s must be locked.
+func (s *Schedule) abnormalSince() map[string]map[expr.AlertKey]time.Time {
+	m := make(map[string]map[expr.AlertKey]time.Time)
+	for ak, st := range s.status {
+		var since time.Time
+		for i := len(st.History) - 1; i >= 0 && st.History[i].Status > StNormal; i-- {
+			since = st.History[i].Time
+		}
+		if m[ak.Name()] == nil {
+			m[ak.Name()] = make(map[expr.AlertKey]time.Time)
+		}
+		m[ak.Name()][ak] = since
+	}
+	return m
+}
+
+// StateSince returns all keys of alert with the time they entered their
+// current status. It implements expr.StateProvider.
+func (s *Schedule) StateSince(alert string) map[expr.AlertKey]time.Time {
+	s.Lock()
+	defer s.Unlock()
+	return s.stateSince()[alert]
+}
+
+// stateSince returns, for all alerts, the time each key entered its current
+// status. s must be locked.
+func (s *Schedule) stateSince() map[string]map[expr.AlertKey]time.Time {
+	m := make(map[string]map[expr.AlertKey]time.Time)
+	for ak, st := range s.status {
+		if len(st.History) == 0 {
+			continue
+		}
+		if m[ak.Name()] == nil {
+			m[ak.Name()] = make(map[expr.AlertKey]time.Time)
+		}
+		m[ak.Name()][ak] = st.Last().Time
+	}
+	return m
 }
 
 func (s *State) Touch() {
@@ -751,7 +1134,7 @@ func (s *State) Touch() {
 // status. Returns the previous status.
 func (s *State) Append(event *Event) Status {
 	last := s.Last()
-	if len(s.History) == 0 || s.Last().Status != event.Status {
+	if len(s.History) == 0 || s.Last().Status != event.Status || s.Last().Fatal != event.Fatal {
 		event.Time = time.Now().UTC()
 		s.History = append(s.History, *event)
 	}
@@ -765,10 +1148,57 @@ func (s *State) Last() Event {
 	return s.History[len(s.History)-1]
 }
 
+// Previous returns the event before the most recent one, which differs from
+// it in status or fatality.
+func (s *State) Previous() Event {
+	if len(s.History) < 2 {
+		return Event{}
+	}
+	return s.History[len(s.History)-2]
+}
+
+// PreviousStatus returns the status before the current one, or StNone if
+// there was none.
+func (s *State) PreviousStatus() Status {
+	return s.Previous().Status
+}
+
+// PreviousDuration returns how long the previous status lasted before
+// changing to the current one, or 0 if there was no previous status.
+func (s *State) PreviousDuration() time.Duration {
+	if len(s.History) < 2 {
+		return 0
+	}
+	return s.Last().Time.Sub(s.Previous().Time)
+}
+
+// SinceChange returns how long the current status has lasted.
+func (s *State) SinceChange() time.Duration {
+	if len(s.History) == 0 {
+		return 0
+	}
+	return time.Since(s.Last().Time)
+}
+
+// SinceNotified returns the time since the last notification for the alert
+// key, or 0 if none has been sent.
+func (s *State) SinceNotified() time.Duration {
+	if s.LastNotified.IsZero() {
+		return 0
+	}
+	return time.Since(s.LastNotified)
+}
+
 type Event struct {
 	Warn, Crit, Error *Result
+	Info              *Result `json:",omitempty"`
 	Status            Status
+	Fatal             bool   `json:",omitempty"` // critical with fatal severity
+	Trace             string `json:",omitempty"` // evaluation that caused the event
 	Time              time.Time
+	// DependsOn is the firing parent alert key that kept this evaluation
+	// from changing the status.
+	DependsOn expr.AlertKey `json:",omitempty"`
 }
 
 type Result struct {
@@ -785,12 +1215,26 @@ const (
 	StCritical
 	StUnknown
 	StError
+	// StInfo is less severe than StWarning. It comes last so the statuses
+	// of saved states keep their values.
+	StInfo
 )
 
+// Severity orders statuses from least to most severe: StInfo is between
+// StNormal and StWarning, the others keep their order.
+func (s Status) Severity() float64 {
+	if s == StInfo {
+		return float64(StNormal) + 0.5
+	}
+	return float64(s)
+}
+
 func (s Status) String() string {
 	switch s {
 	case StNormal:
 		return "normal"
+	case StInfo:
+		return "info"
 	case StWarning:
 		return "warning"
 	case StCritical:
@@ -809,6 +1253,7 @@ func (s Status) MarshalJSON() ([]byte, error) {
 }
 
 func (s Status) IsNormal() bool   { return s == StNormal }
+func (s Status) IsInfo() bool     { return s == StInfo }
 func (s Status) IsWarning() bool  { return s == StWarning }
 func (s Status) IsCritical() bool { return s == StCritical }
 func (s Status) IsUnknown() bool  { return s == StUnknown }
@@ -828,6 +1273,7 @@ const (
 	ActionAcknowledge
 	ActionClose
 	ActionForget
+	ActionSnooze
 )
 
 func (a ActionType) String() string {
@@ -838,6 +1284,8 @@ func (a ActionType) String() string {
 		return "Closed"
 	case ActionForget:
 		return "Forgotten"
+	case ActionSnooze:
+		return "Snoozed"
 	default:
 		return "none"
 	}
@@ -868,14 +1316,14 @@ func (s *Schedule) Host(filter string) map[string]*HostData {
 				Interfaces: make(map[string]*HostInterface),
 			}
 			e.CPU.Processors = make(map[string]string)
-			if v, err := s.Search.GetLast("os.cpu", host, true); err != nil {
+			if v, err := s.Search.GetLast("os.cpu", host, true); err == nil {
 				e.CPU.Used = v
 			}
 			e.Memory.Modules = make(map[string]string)
-			if v, err := s.Search.GetLast("os.mem.total", host, false); err != nil {
+			if v, err := s.Search.GetLast("os.mem.total", host, false); err == nil {
 				e.Memory.Total = int64(v)
 			}
-			if v, err := s.Search.GetLast("os.mem.used", host, false); err != nil {
+			if v, err := s.Search.GetLast("os.mem.used", host, false); err == nil {
 				e.Memory.Used = int64(v)
 			}
 			res[tags["host"]] = e
@@ -889,11 +1337,11 @@ func (s *Schedule) Host(filter string) map[string]*HostData {
 					"iface": name,
 				}
 				intag := opentsdb.TagSet{"direction": "in"}.Merge(itag).String()
-				if v, err := s.Search.GetLast("os.net.bytes", intag, true); err != nil {
+				if v, err := s.Search.GetLast("os.net.bytes", intag, true); err == nil {
 					h.Inbps = int64(v) * 8
 				}
 				outtag := opentsdb.TagSet{"direction": "out"}.Merge(itag).String()
-				if v, err := s.Search.GetLast("os.net.bytes", outtag, true); err != nil {
+				if v, err := s.Search.GetLast("os.net.bytes", outtag, true); err == nil {
 					h.Outbps = int64(v) * 8
 				}
 				e.Interfaces[name] = h
@@ -988,3 +1436,16 @@ type HostData struct {
 	}
 	SerialNumber string `json:",omitempty"`
 }
+
+// StatesByStatus sorts states by descending status, then by alert key.
+type StatesByStatus []State
+
+func (s StatesByStatus) Len() int      { return len(s) }
+func (s StatesByStatus) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s StatesByStatus) Less(i, j int) bool {
+	a, b := s[i].Last().Status, s[j].Last().Status
+	if a != b {
+		return a > b
+	}
+	return s[i].AlertKey() < s[j].AlertKey()
+}
@@ -1,18 +1,32 @@
 package sched
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/bosun-monitor/bosun/_third_party/github.com/MiniProfiler/go/miniprofiler"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/metadata"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
 	"github.com/bosun-monitor/bosun/conf"
+	"github.com/bosun-monitor/bosun/expr"
+	"github.com/bosun-monitor/bosun/search"
 )
 
 func init() {
@@ -38,7 +52,14 @@ func testSched(t *testing.T, st *schedTest) {
 		}
 		var resp opentsdb.ResponseSet
 		for _, rq := range req.Queries {
-			qs := fmt.Sprintf(`q("%s", "%v", "%v")`, rq, req.Start, req.End)
+			// Query.String ranges over the tag map, so build the key from
+			// the sorted tags instead.
+			untagged := *rq
+			untagged.Tags = nil
+			qs := fmt.Sprintf(`q("%s", "%v", "%v")`, untagged, req.Start, req.End)
+			if len(rq.Tags) > 0 {
+				qs = fmt.Sprintf(`q("%s%s", "%v", "%v")`, untagged, rq.Tags, req.Start, req.End)
+			}
 			q := st.queries[qs]
 			if q == nil {
 				t.Errorf("unknown query: %s", qs)
@@ -171,3 +192,2011 @@ func TestCount(t *testing.T) {
 		},
 	})
 }
+
+func TestShardOwner(t *testing.T) {
+	members := []string{"a", "b", "c"}
+	owned := make(map[string]int)
+	for i := 0; i < 100; i++ {
+		name := fmt.Sprintf("alert%d", i)
+		o := shardOwner(name, members)
+		if o != shardOwner(name, members) {
+			t.Fatalf("unstable owner for %s", name)
+		}
+		owned[o]++
+		// Removing a member must only move the alerts it owned.
+		if o != "c" && shardOwner(name, members[:2]) != o {
+			t.Errorf("%s moved from %s after removing c", name, o)
+		}
+	}
+	for _, m := range members {
+		if owned[m] == 0 {
+			t.Errorf("member %s owns no alerts", m)
+		}
+	}
+}
+
+func TestCheckShards(t *testing.T) {
+	c, err := conf.New("", "tsdbHost = localhost:4242\nshardMembers = a,b,c\nshardName = b\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	live := fixedTSDB{{
+		Metric: "bosun.shard.checked",
+		Tags:   opentsdb.TagSet{"member": "a"},
+		DPS:    map[string]opentsdb.Point{"0": 1},
+	}}
+	s.NewTSDB = func(trace string) expr.TSDBProvider {
+		return expr.Backends{OpenTSDBContext: live}
+	}
+	now := time.Now()
+	s.checkShards(now)
+	if len(s.shardsDown) != 0 {
+		t.Fatalf("members reported down before a full window: %v", s.shardsDown)
+	}
+	now = now.Add(c.CheckFrequency * shardMissed)
+	s.checkShards(now)
+	if len(s.shardsDown) != 1 || !s.shardsDown["c"] {
+		t.Fatalf("expected c down, got %v", s.shardsDown)
+	}
+	live = append(live, &opentsdb.Response{
+		Metric: "bosun.shard.checked",
+		Tags:   opentsdb.TagSet{"member": "c"},
+		DPS:    map[string]opentsdb.Point{"0": 1},
+	})
+	s.checkShards(now.Add(c.CheckFrequency))
+	if len(s.shardsDown) != 0 {
+		t.Fatalf("expected c back, got %v", s.shardsDown)
+	}
+}
+
+func TestSilenceContains(t *testing.T) {
+	now := time.Now()
+	wide := &Silence{
+		Start: now,
+		End:   now.Add(time.Hour * 2),
+		Tags:  opentsdb.TagSet{"host": "ny-*"},
+	}
+	narrow := &Silence{
+		Start: now.Add(time.Minute),
+		End:   now.Add(time.Hour),
+		Alert: "a",
+		Tags:  opentsdb.TagSet{"host": "ny-*", "iface": "eth0"},
+	}
+	later := &Silence{
+		Start: now.Add(time.Hour * 3),
+		End:   now.Add(time.Hour * 4),
+		Tags:  opentsdb.TagSet{"host": "ny-*"},
+	}
+	if !wide.Contains(narrow) || narrow.Contains(wide) {
+		t.Error("bad contains")
+	}
+	if !wide.Overlaps(narrow) || wide.Overlaps(later) || wide.Contains(later) {
+		t.Error("bad overlaps")
+	}
+	s := new(Schedule)
+	s.Init(new(conf.Conf))
+	s.Silence[wide.ID()] = wide
+	s.Silence[narrow.ID()] = narrow
+	s.Silence[later.ID()] = later
+	r := s.RedundantSilences()
+	if len(r) != 1 || r[narrow.ID()] != wide.ID() {
+		t.Errorf("bad redundant silences: %v", r)
+	}
+}
+
+func TestStream(t *testing.T) {
+	s := new(Schedule)
+	s.Init(new(conf.Conf))
+	p := s.Stream(0, 0)
+	if len(p.Transitions) != 0 || p.Missed {
+		t.Fatalf("unexpected initial page: %+v", p)
+	}
+	go func() {
+		time.Sleep(time.Millisecond * 50)
+		s.Lock()
+		s.addTransition("a{host=x}", StNormal, StCritical, "down")
+		s.Unlock()
+	}()
+	next := s.Stream(p.Cursor, time.Second)
+	if len(next.Transitions) != 1 || next.Transitions[0].To != StCritical {
+		t.Fatalf("expected one critical transition, got %+v", next)
+	}
+	if next.Cursor <= p.Cursor {
+		t.Errorf("cursor did not advance")
+	}
+	if p := s.Stream(next.Cursor, 0); len(p.Transitions) != 0 {
+		t.Errorf("expected no transitions after cursor, got %d", len(p.Transitions))
+	}
+	if p := s.Stream(1, 0); !p.Missed || len(p.Transitions) != 1 {
+		t.Errorf("expected missed with one transition, got %+v", p)
+	}
+}
+
+func TestSeverityStatus(t *testing.T) {
+	tests := []struct {
+		n      float64
+		status Status
+		fatal  bool
+	}{
+		{0, StNormal, false},
+		{1, StWarning, false},
+		{2, StCritical, false},
+		{3, StCritical, true},
+		{4, StError, false},
+		{1.5, StError, false},
+	}
+	for _, test := range tests {
+		status, fatal := severityStatus(test.n)
+		if status != test.status || fatal != test.fatal {
+			t.Errorf("%v: expected %v %v, got %v %v", test.n, test.status, test.fatal, status, fatal)
+		}
+	}
+}
+
+func TestArchive(t *testing.T) {
+	c, err := conf.New("", `tsdbHost = localhost:4242
+alert a {
+	crit = 1
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	s.archiveState("a{host=x}", &State{Alert: "a", Group: opentsdb.TagSet{"host": "x"}})
+	s.archiveState("b{host=x}", &State{Alert: "b", Group: opentsdb.TagSet{"host": "x"}})
+	if a := s.ArchivedStates(); len(a) != 2 || a[0].AlertKey() != "a{host=x}" {
+		t.Fatalf("unexpected archive: %v", a)
+	}
+	if err := s.RestoreArchived([]expr.AlertKey{"a{host=x}", "b{host=x}"}); err == nil {
+		t.Fatal("expected error restoring removed alert")
+	}
+	if len(s.status) != 0 {
+		t.Fatal("partial restore")
+	}
+	if err := s.RestoreArchived([]expr.AlertKey{"a{host=x}"}); err != nil {
+		t.Fatal(err)
+	}
+	if s.status["a{host=x}"] == nil {
+		t.Fatal("state not restored")
+	}
+	if err := s.PurgeArchived([]expr.AlertKey{"b{host=x}"}); err != nil {
+		t.Fatal(err)
+	}
+	if a := s.ArchivedStates(); len(a) != 0 {
+		t.Fatalf("archive not empty: %v", a)
+	}
+	s.archiveState("b{host=y}", &State{Alert: "b", Group: opentsdb.TagSet{"host": "y"}})
+	s.archive["b{host=y}"].Archived = time.Now().Add(-c.ArchiveDuration - time.Hour)
+	s.save()
+	if a := s.ArchivedStates(); len(a) != 0 {
+		t.Fatalf("expected save to purge expired archive, got %v", a)
+	}
+}
+
+func TestHeartbeat(t *testing.T) {
+	s := new(Schedule)
+	s.Init(&conf.Conf{HeartbeatMetric: "scollector.alive", HeartbeatTag: "host", HeartbeatTimeout: time.Minute})
+	s.Heartbeat("10.0.0.1", opentsdb.MultiDataPoint{
+		{Metric: "os.cpu", Tags: opentsdb.TagSet{"host": "a"}},
+		{Metric: "scollector.alive", Tags: opentsdb.TagSet{"host": "b"}},
+	})
+	src := s.Sources()
+	if len(src) != 1 || src[0].Name != "b" || src[0].Stale || src[0].Remote != "10.0.0.1" {
+		t.Fatalf("unexpected sources: %+v", src)
+	}
+	s.sources.sources["b"].LastSeen = time.Now().Add(-time.Hour)
+	if src := s.Sources(); !src[0].Stale {
+		t.Errorf("expected stale source")
+	}
+	s.ForgetSource("b")
+	if src := s.Sources(); len(src) != 0 {
+		t.Errorf("expected no sources, got %+v", src)
+	}
+}
+
+func TestInhibit(t *testing.T) {
+	c, err := conf.New("", `tsdbHost = localhost:4242
+alert dc.down {
+	crit = 1
+}
+alert host.down {
+	crit = 1
+}
+inhibit dc {
+	sourceAlert = dc.down
+	targetAlert = host.*
+	equal = dc
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := new(Schedule)
+	s.Init(c)
+	src := &State{Alert: "dc.down", Group: opentsdb.TagSet{"dc": "ny"}}
+	src.Append(&Event{Status: StCritical})
+	s.status[src.AlertKey()] = src
+	for _, test := range []struct {
+		dc     string
+		expect bool
+	}{
+		{"ny", true},
+		{"la", false},
+	} {
+		st := &State{Alert: "host.down", Group: opentsdb.TagSet{"dc": test.dc, "host": "a"}}
+		if rule, _ := s.inhibitedBy(st); (rule != "") != test.expect {
+			t.Errorf("dc %s: expected inhibited %v", test.dc, test.expect)
+		}
+	}
+	src.Append(&Event{Status: StNormal})
+	st := &State{Alert: "host.down", Group: opentsdb.TagSet{"dc": "ny", "host": "a"}}
+	if rule, _ := s.inhibitedBy(st); rule != "" {
+		t.Errorf("inhibited by normal source")
+	}
+}
+
+func TestTracedCache(t *testing.T) {
+	var got []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get(TraceHeader))
+		fmt.Fprint(w, "[]")
+	}))
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+	c := NewTracedCache(u.Host, 1<<20, "abc")
+	req := &opentsdb.Request{Start: "1h-ago", Queries: []*opentsdb.Query{{Metric: "m", Aggregator: "sum"}}}
+	if _, err := c.Query(req); err != nil {
+		t.Fatal(err)
+	}
+	c.Query(req)
+	if len(got) != 1 || got[0] != "abc" {
+		t.Errorf("expected one query traced abc, got %v", got)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	objects := make(map[string][]byte)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=ak/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch {
+		case r.Method == "PUT":
+			objects[r.URL.Path], _ = ioutil.ReadAll(r.Body)
+		case r.Method == "DELETE":
+			delete(objects, r.URL.Path)
+		case r.URL.Query().Get("list-type") == "2":
+			fmt.Fprint(w, "<ListBucketResult>")
+			for k := range objects {
+				fmt.Fprintf(w, "<Contents><Key>%s</Key></Contents>", strings.TrimPrefix(k, "/b/"))
+			}
+			fmt.Fprint(w, "</ListBucketResult>")
+		default:
+			b, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+			}
+			w.Write(b)
+		}
+	}))
+	defer ts.Close()
+	dir, err := ioutil.TempDir("", "bosun")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c := &conf.Conf{
+		StateFile:         filepath.Join(dir, "state"),
+		SnapshotURL:       ts.URL + "/b/snap",
+		SnapshotAccessKey: "ak",
+		SnapshotRetain:    1,
+	}
+	s := new(Schedule)
+	s.Init(c)
+	old := "/b/snap/bosun-00000000000000000001.state.gz"
+	objects[old] = nil
+	if err := s.Snapshot(); err != nil {
+		t.Fatal(err)
+	}
+	if _, present := objects[old]; present || len(objects) != 1 {
+		t.Fatalf("expected only the new snapshot retained, got %d", len(objects))
+	}
+	if err := s.restoreSnapshot(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(c.StateFile); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCatalog(t *testing.T) {
+	s := new(Schedule)
+	s.Init(&conf.Conf{})
+	now := time.Now().Unix()
+	s.Search.Index(opentsdb.MultiDataPoint{
+		{Metric: "os.cpu", Timestamp: now, Value: 1, Tags: opentsdb.TagSet{"host": "a"}},
+		{Metric: "os.cpu", Timestamp: now - 7200, Value: 1, Tags: opentsdb.TagSet{"host": "b"}},
+		{Metric: "os.mem", Timestamp: now, Value: 1, Tags: opentsdb.TagSet{"host": "a"}},
+	})
+	s.PutMetadata(metadata.Metakey{Metric: "os.cpu", Name: "unit"}, "percent")
+	s.PutMetadata(metadata.Metakey{Metric: "os.disk", Name: "desc"}, "Disk usage.")
+	c := s.Catalog("os.", 0, 2, time.Hour)
+	if c.Total != 3 || len(c.Metrics) != 2 {
+		t.Fatalf("unexpected page: %+v", c)
+	}
+	cpu := c.Metrics[0]
+	if cpu.Metric != "os.cpu" || cpu.Unit != "percent" || cpu.Tagsets != 2 || cpu.Active != 1 || cpu.LastSeen != now {
+		t.Errorf("bad os.cpu entry: %+v", cpu)
+	}
+	if c.Metrics[1].Metric != "os.disk" || len(c.Metrics[1].Description) != 1 {
+		t.Errorf("bad os.disk entry: %+v", c.Metrics[1])
+	}
+	if c := s.Catalog("", 3, 10, time.Hour); c.Total != 3 || len(c.Metrics) != 0 {
+		t.Errorf("expected empty last page, got %+v", c)
+	}
+}
+
+func TestAnchorDue(t *testing.T) {
+	s := new(Schedule)
+	s.Init(&conf.Conf{})
+	a := &conf.Alert{Name: "a", AnchorPeriod: time.Hour, AnchorOffset: 5 * time.Minute}
+	at := func(h, m int) time.Time { return time.Date(2015, 1, 1, h, m, 0, 0, time.UTC) }
+	for i, c := range []struct {
+		now time.Time
+		due bool
+	}{
+		{at(10, 0), true}, // First check after start
+		{at(10, 4), false},
+		{at(10, 6), true},
+		{at(10, 30), false},
+		{at(11, 5), true},
+		{at(11, 10), false},
+	} {
+		if due := s.anchorDue(a, c.now); due != c.due {
+			t.Errorf("%d: %v: expected due %v", i, c.now, c.due)
+		}
+	}
+	if !s.anchorDue(&conf.Alert{Name: "b"}, at(10, 0)) {
+		t.Error("unanchored alerts are always due")
+	}
+}
+
+func TestActiveChange(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("host") != "a" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"active": true, "id": "CHG1"}`)
+	}))
+	defer ts.Close()
+	c, err := conf.New("test", "tsdbHost = localhost:4242\nchangeURL = "+ts.URL+"/?host={{.host}}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := new(Schedule)
+	s.Init(c)
+	if r := s.ActiveChange(opentsdb.TagSet{"host": "a"}); r == nil || r.ID != "CHG1" {
+		t.Fatalf("expected change CHG1, got %+v", r)
+	}
+	if r := s.ActiveChange(opentsdb.TagSet{"host": "a"}); r == nil || requests != 1 {
+		t.Errorf("expected cached change, got %+v after %d requests", r, requests)
+	}
+	if r := s.ActiveChange(opentsdb.TagSet{"host": "b"}); r != nil {
+		t.Errorf("expected no change, got %+v", r)
+	}
+	st := &State{Group: opentsdb.TagSet{"host": "a"}, Alert: "x"}
+	if !s.underChange(st) {
+		t.Error("expected suppression")
+	}
+	// underChange runs with s locked and must not query the API.
+	n := requests
+	if s.underChange(&State{Group: opentsdb.TagSet{"host": "c"}, Alert: "x"}) || requests != n {
+		t.Errorf("expected a cache-only lookup, got %d requests", requests-n)
+	}
+	s.prefetchChanges([]opentsdb.TagSet{{"host": "c"}, {"host": "c"}})
+	if requests != n+1 {
+		t.Errorf("expected 1 prefetch request, got %d", requests-n)
+	}
+	c.ChangeAnnotate = true
+	if s.underChange(st) {
+		t.Error("annotate mode should not suppress")
+	}
+}
+
+func TestReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bosun")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c, err := conf.New("", `tsdbHost = localhost:4242
+alert a {
+	crit = 1
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = filepath.Join(dir, "bosun.state")
+	evaluator := new(Schedule)
+	evaluator.Init(c)
+	evaluator.status["a{host=x}"] = &State{Alert: "a", Group: opentsdb.TagSet{"host": "x"}, Touched: time.Now()}
+	if err := evaluator.writeState(); err != nil {
+		t.Fatal(err)
+	}
+	follower := new(Schedule)
+	follower.Init(c)
+	follower.readOnly = true
+	follower.reload()
+	if follower.status["a{host=x}"] == nil {
+		t.Fatal("state not reloaded")
+	}
+	delete(follower.status, "a{host=x}")
+	follower.save()
+	follower.reload()
+	if follower.status["a{host=x}"] == nil {
+		t.Fatal("read-only schedule overwrote the state file")
+	}
+}
+
+func TestPreviousState(t *testing.T) {
+	st := new(State)
+	if st.PreviousStatus() != StNone || st.PreviousDuration() != 0 || st.SinceNotified() != 0 {
+		t.Fatal("expected zero values for a new state")
+	}
+	start := time.Now().Add(-time.Hour)
+	st.History = []Event{
+		{Status: StWarning, Time: start},
+		{Status: StCritical, Time: start.Add(42 * time.Minute)},
+	}
+	if st.PreviousStatus() != StWarning {
+		t.Errorf("expected previous status warning, got %v", st.PreviousStatus())
+	}
+	if d := st.PreviousDuration(); d != 42*time.Minute {
+		t.Errorf("expected 42m in previous status, got %v", d)
+	}
+	if d := st.SinceChange(); d < 18*time.Minute || d > 19*time.Minute {
+		t.Errorf("expected about 18m since change, got %v", d)
+	}
+}
+
+func TestQuality(t *testing.T) {
+	s := new(Schedule)
+	s.Init(&conf.Conf{QualityFuture: time.Minute * 10})
+	now := time.Now().Unix()
+	tags := opentsdb.TagSet{"host": "a"}
+	s.Search.Index(opentsdb.MultiDataPoint{{Metric: "m", Timestamp: now - 60, Value: 1, Tags: tags}})
+	s.CheckQuality(opentsdb.MultiDataPoint{
+		{Metric: "m", Timestamp: now - 60, Value: 1, Tags: tags},
+		{Metric: "m", Timestamp: now - 120, Value: 1, Tags: tags},
+		{Metric: "m", Timestamp: now, Value: 1, Tags: tags},
+		{Metric: "m", Timestamp: now, Value: 1, Tags: tags},
+		{Metric: "m", Timestamp: (now + 3600) * 1000, Value: 1, Tags: tags},
+		{Metric: "n", Timestamp: now - 86400*365, Value: 1, Tags: tags},
+	})
+	counts := s.DataQuality(time.Now().Add(-time.Minute))
+	expect := []expr.QualityCount{
+		{Metric: "m", Problem: QualityDuplicate, Count: 2},
+		{Metric: "m", Problem: QualityFuture, Count: 1},
+		{Metric: "m", Problem: QualityOutOfOrder, Count: 1},
+	}
+	if len(counts) != len(expect) {
+		t.Fatalf("expected %v, got %v", expect, counts)
+	}
+	for i := range expect {
+		if counts[i] != expect[i] {
+			t.Errorf("expected %v, got %v", expect[i], counts[i])
+		}
+	}
+}
+
+func TestMemoryWatchdog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bosun")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	s := new(Schedule)
+	s.Init(&conf.Conf{MemoryLimit: 1000, MemoryProfileDir: dir, MemoryDropCaches: true})
+	profiles := func() int {
+		m, _ := filepath.Glob(filepath.Join(dir, memoryPrefix+"*"))
+		return len(m)
+	}
+	tripped := false
+	for i, c := range []struct {
+		rss      uint64
+		tripped  bool
+		profiles int
+	}{
+		{500, false, 0},
+		{1001, true, 1},
+		{2000, true, 1},
+		{950, true, 1},
+		{800, false, 1},
+		{1200, true, 2},
+	} {
+		tripped = s.checkMemory(c.rss, 0, tripped)
+		if tripped != c.tripped {
+			t.Errorf("%v: expected tripped %v", i, c.tripped)
+		}
+		if n := profiles(); n != c.profiles {
+			t.Errorf("%v: expected %v profiles, got %v", i, c.profiles, n)
+		}
+	}
+	for i := 0; i < memoryProfiles+2; i++ {
+		if _, err := s.writeHeapProfile(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := profiles(); n != memoryProfiles {
+		t.Errorf("expected %v profiles kept, got %v", memoryProfiles, n)
+	}
+}
+
+func TestHandleMail(t *testing.T) {
+	c, err := conf.New("", `tsdbHost = localhost:4242
+smtpHost = localhost:25
+emailFrom = bosun@example.com
+mailKey = secret
+notification ops {
+	email = ops@example.com
+}
+alert a {
+	crit = 1
+	critNotification = ops
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := new(Schedule)
+	s.Init(c)
+	ak := expr.AlertKey("a{host=x}")
+	s.status[ak] = &State{Alert: "a", Group: opentsdb.TagSet{"host": "x"}, NeedAck: true, Open: true}
+	id := c.MailMessageID(string(ak))
+	reply := func(from, body string) error {
+		return s.HandleMail([]byte("From: " + from + "\r\nIn-Reply-To: " + id +
+			"\r\nContent-Type: text/plain\r\n\r\n" + body))
+	}
+	if err := reply("mallory@example.com", "ack"); err == nil {
+		t.Error("expected error for unknown sender")
+	}
+	if err := reply("Ops <OPS@example.com>", "\r\nack on it\r\n\r\n> quoted\r\n"); err != nil {
+		t.Fatal(err)
+	}
+	st := s.status[ak]
+	if st.NeedAck || len(st.Actions) != 1 || st.Actions[0].Message != "on it" || st.Actions[0].User != "OPS@example.com" {
+		t.Errorf("bad state after ack: %+v", st)
+	}
+	if err := reply("ops@example.com", "silence 2h"); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Silenced()) != 1 {
+		t.Error("expected alert key to be silenced")
+	}
+	if err := reply("ops@example.com", "reboot"); err == nil {
+		t.Error("expected error for unknown command")
+	}
+}
+
+func TestStartupSuppress(t *testing.T) {
+	c, err := conf.New("", `tsdbHost = localhost:4242
+startupSuppress = 1
+notification ops {
+	print = true
+}
+alert a {
+	crit = 1
+	critNotification = ops
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	now := time.Now()
+	s.Check(nil, now)
+	st := s.status["a{}"]
+	if st == nil || st.Last().Status != StCritical || !st.NeedAck {
+		t.Fatalf("expected state to be recorded, got %+v", st)
+	}
+	if len(s.notifications) != 0 {
+		t.Fatalf("expected notifications to be withheld, got %v", s.notifications)
+	}
+	delete(s.status, "a{}")
+	s.Check(nil, now.Add(time.Minute))
+	if len(s.notifications) != 1 {
+		t.Errorf("expected a notification after the suppression window, got %v", s.notifications)
+	}
+}
+
+func TestCanary(t *testing.T) {
+	parse := func(crit string) *conf.Conf {
+		c, err := conf.New("test.conf", `tsdbHost = localhost:4242
+notification ops {
+	print = true
+}
+alert a {
+	crit = `+crit+`
+	critNotification = ops
+}`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.StateFile = ""
+		return c
+	}
+	s := new(Schedule)
+	s.Init(parse("0"))
+	s.Check(nil, time.Now())
+	c := parse("1")
+	ca, applied := s.Reload(c)
+	if applied {
+		t.Fatal("reload that pages applied without confirmation")
+	}
+	if len(ca.Changes) != 1 || ca.Changes[0].Running != StNormal || ca.Changes[0].Canary != StCritical {
+		t.Errorf("unexpected changes: %+v", ca.Changes)
+	}
+	if ca.Paging() != 1 {
+		t.Errorf("expected 1 notification, got %+v", ca.Notifications)
+	}
+	if st := s.status["a{}"].Last().Status; st != StNormal {
+		t.Errorf("canary changed running state to %v", st)
+	}
+	if s.PendingReload() != ca {
+		t.Error("expected pending reload")
+	}
+	if _, err := s.ConfirmReload(); err != nil {
+		t.Fatal(err)
+	}
+	if s.Conf != c || s.PendingReload() != nil {
+		t.Error("pending config not applied")
+	}
+	if _, err := s.ConfirmReload(); err == nil {
+		t.Error("expected error without a pending reload")
+	}
+	if _, applied := s.Reload(parse("0")); !applied {
+		t.Error("expected quiet reload to apply")
+	}
+}
+
+func TestReloadRemovedAlert(t *testing.T) {
+	parse := func(text string) *conf.Conf {
+		c, err := conf.New("test.conf", "tsdbHost = localhost:4242\n"+text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.StateFile = ""
+		return c
+	}
+	a := "alert a {\n\tcrit = 0\n}\n"
+	b := "alert b {\n\tcrit = 0\n}\n"
+	s := new(Schedule)
+	s.Init(parse(a + b))
+	s.Check(nil, time.Now())
+	if _, applied := s.Reload(parse(a)); !applied {
+		t.Fatal("expected reload to apply")
+	}
+	if s.status["b{}"] != nil {
+		t.Fatal("state of removed alert b kept")
+	}
+	if ar := s.ArchivedStates(); len(ar) != 1 || ar[0].AlertKey() != "b{}" {
+		t.Fatalf("expected b{} archived, got %v", ar)
+	}
+	if _, err := s.MarshalGroups(""); err != nil {
+		t.Error(err)
+	}
+	if _, applied := s.Reload(parse(a + b)); !applied {
+		t.Fatal("expected reload to apply")
+	}
+	if s.status["b{}"] == nil || len(s.ArchivedStates()) != 0 {
+		t.Error("archived state of re-added alert b not restored")
+	}
+}
+
+func TestNotifyThrottle(t *testing.T) {
+	var th notifyThrottle
+	a, b := &conf.Notification{Name: "a"}, &conf.Notification{Name: "b"}
+	now := time.Unix(600, 0)
+	for i, expect := range []bool{true, true, false, false} {
+		n := a
+		if i == 3 {
+			n = b
+		}
+		if th.allow(n, now, 2) != expect {
+			t.Errorf("%v: expected allow %v", i, expect)
+		}
+	}
+	if p := th.pending(); !p.Equal(time.Unix(660, 0)) {
+		t.Errorf("expected summary at 660, got %v", p.Unix())
+	}
+	if s := th.flush(now.Add(time.Second * 30)); s != nil {
+		t.Errorf("flushed before the minute was over: %v", s)
+	}
+	s := th.flush(now.Add(time.Minute))
+	if len(s) != 2 || s[a] != 1 || s[b] != 1 {
+		t.Errorf("unexpected suppressed counts: %v", s)
+	}
+	if !th.allow(a, now.Add(time.Minute), 2) || !th.pending().IsZero() {
+		t.Error("expected a new minute to allow notifications")
+	}
+	// Counts suppressed in a minute are kept for the summary when the next
+	// minute's notifications roll it over before a flush.
+	now = now.Add(time.Minute * 2)
+	th.allow(a, now, 1)
+	th.allow(a, now, 1)
+	if !th.allow(a, now.Add(time.Minute), 1) {
+		t.Error("expected a new minute to allow notifications")
+	}
+	if p := th.pending(); !p.Equal(now.Add(time.Minute)) {
+		t.Errorf("expected a due summary, got %v", p.Unix())
+	}
+	if s := th.flush(now.Add(time.Minute)); len(s) != 1 || s[a] != 1 {
+		t.Errorf("unexpected suppressed counts after rollover: %v", s)
+	}
+	if !th.allow(a, now, 0) {
+		t.Error("expected no limit for 0")
+	}
+}
+
+type fixedTSDB opentsdb.ResponseSet
+
+func (f fixedTSDB) Query(*opentsdb.Request) (opentsdb.ResponseSet, error) {
+	return opentsdb.ResponseSet(f), nil
+}
+
+func TestNewTSDB(t *testing.T) {
+	c, err := conf.New("", `tsdbHost = localhost:4242
+alert a {
+	crit = avg(q("avg:m{host=a}", "5m", "")) > 1
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	s.NewTSDB = func(trace string) expr.TSDBProvider {
+		return expr.Backends{OpenTSDBContext: fixedTSDB{{
+			Metric: "m",
+			Tags:   opentsdb.TagSet{"host": "a"},
+			DPS:    map[string]opentsdb.Point{"100": 2},
+		}}}
+	}
+	s.Check(nil, time.Now())
+	if st := s.status["a{host=a}"]; st == nil || st.Last().Status != StCritical {
+		t.Fatalf("expected a{host=a} critical from the mocked backend, got %+v", st)
+	}
+}
+
+func TestSilenceAudit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bosun")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c, err := conf.New("", `tsdbHost = localhost:4242
+alert a {
+	crit = 1
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	now := time.Now().UTC()
+	for _, host := range []string{"x", "y"} {
+		if _, err := s.AddSilence(now, now.Add(time.Hour), "a", "host="+host, "", 0, false, 0, "alice", "maintenance on "+host, true, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var cleared string
+	for id, si := range s.Silence {
+		if si.Tags["host"] == "y" {
+			cleared = id
+		}
+	}
+	if err := s.ClearSilence(cleared, "bob", "done"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.ClearSilence(cleared, "bob", "done"); err == nil {
+		t.Error("expected error clearing an unknown silence")
+	}
+	s.Lock()
+	c.StateFile = filepath.Join(dir, "bosun.state")
+	err = s.writeState()
+	s.Unlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := new(Schedule)
+	r.Init(c)
+	r.RestoreState()
+	c.StateFile = ""
+	if len(r.Silence) != 1 {
+		t.Fatalf("expected 1 silence, got %v", r.Silence)
+	}
+	for _, si := range r.Silence {
+		if si.User != "alice" || si.Message != "maintenance on x" || si.Created.IsZero() {
+			t.Errorf("unexpected silence: %+v", si)
+		}
+	}
+	l := r.SilencesCleared()
+	if len(l) != 1 || l[0].ID != cleared || l[0].User != "bob" || l[0].Message != "done" || l[0].Silence.Message != "maintenance on y" {
+		t.Errorf("unexpected cleared silences: %+v", l)
+	}
+}
+
+func TestLabels(t *testing.T) {
+	c, err := conf.New("", `tsdbHost = localhost:4242
+alert a {
+	label env = prod
+	label tier = gold
+	crit = 1
+}
+alert b {
+	label env = dev
+	crit = 1
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	for _, ak := range []expr.AlertKey{"a{host=x}", "b{host=x}"} {
+		s.status[ak] = &State{
+			Alert:   ak.Name(),
+			Group:   ak.Group(),
+			NeedAck: true,
+			Open:    true,
+			History: []Event{{Status: StCritical, Time: time.Now()}},
+		}
+	}
+	for filter, expect := range map[string]int{
+		"label:env=prod": 1,
+		"label:env":      2,
+		"label:tier":     1,
+		"!label:tier":    1,
+		"label:env=qa":   0,
+	} {
+		st, err := s.FilterStates(filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(st) != expect {
+			t.Errorf("%s: expected %d states, got %d", filter, expect, len(st))
+		}
+	}
+	groups, err := s.MarshalGroups("label:env=prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g := groups.Groups.NeedAck; len(g) != 1 || len(g[0].Children) != 1 || g[0].Children[0].Labels["tier"] != "gold" {
+		t.Errorf("expected labels in status: %+v", g)
+	}
+}
+
+func TestRecurrence(t *testing.T) {
+	at := func(s string) time.Time {
+		t, err := time.Parse("2006-01-02 15:04", s)
+		if err != nil {
+			panic(err)
+		}
+		return t
+	}
+	for spec, times := range map[string]map[string]bool{
+		"0 2 * * 0": {
+			"2015-03-01 02:00": true, // Sunday
+			"2015-03-01 02:01": false,
+			"2015-03-02 02:00": false,
+		},
+		"*/15 9-17 * * 1-5": {
+			"2015-03-02 09:45": true,
+			"2015-03-02 18:00": false,
+			"2015-03-01 10:00": false,
+		},
+		"30 0 1,15 * 7": {
+			"2015-03-15 00:30": true,
+			"2015-03-08 00:30": true, // Sunday
+			"2015-03-09 00:30": false,
+		},
+	} {
+		r, err := ParseRecurrence(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for s, expect := range times {
+			if got := r.Match(at(s)); got != expect {
+				t.Errorf("%s at %s: expected %v", spec, s, expect)
+			}
+		}
+	}
+	for _, spec := range []string{"0 2 * *", "60 * * * *", "0 2 * * mon", "5-1 * * * *"} {
+		if _, err := ParseRecurrence(spec); err == nil {
+			t.Errorf("%s: expected error", spec)
+		}
+	}
+	si := &Silence{
+		Start:      at("2015-01-01 00:00"),
+		End:        at("2016-01-01 00:00"),
+		Alert:      "a",
+		Recurrence: "0 2 * * 0",
+		Length:     2 * time.Hour,
+	}
+	if until, ok := si.ActiveUntil(at("2015-03-01 03:30")); !ok || !until.Equal(at("2015-03-01 04:00")) {
+		t.Errorf("expected silence until 04:00, got %v %v", until, ok)
+	}
+	for _, s := range []string{"2015-03-01 04:00", "2015-03-01 01:59", "2015-03-02 03:00", "2016-01-03 03:00"} {
+		if si.Silenced(at(s), "a", nil) {
+			t.Errorf("silenced at %s", s)
+		}
+	}
+	if _, err := newSilence(time.Now(), time.Now().Add(time.Hour), "a", "", "0 2 * * 0", 0, "", ""); err == nil {
+		t.Error("expected error for recurrence without length")
+	}
+}
+
+func TestSilenceMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		match   search.MatchFlags
+		tagv    string
+		expect  bool
+	}{
+		{"web-*", 0, "WEB-1", false},
+		{"web-*", search.IgnoreCase, "WEB-1", true},
+		{"web", 0, "ny-web-1", false},
+		{"web", search.Substring, "ny-web-1", true},
+		{`/web-\d/`, 0, "ny-web-1", false},
+		{`/web-\d/`, search.Substring, "ny-web-1", true},
+		{`/web-\d/`, search.IgnoreCase, "WEB-1", true},
+		{`/web-\d/`, search.IgnoreCase, "web-x", false},
+	}
+	for _, test := range tests {
+		si := &Silence{Tags: opentsdb.TagSet{"host": test.pattern}, Match: test.match}
+		if got := si.Matches("a", opentsdb.TagSet{"host": test.tagv}); got != test.expect {
+			t.Errorf("%s with flags %d against %s: expected %v, got %v", test.pattern, test.match, test.tagv, test.expect, got)
+		}
+	}
+	now := time.Now()
+	exact := &Silence{Start: now, End: now.Add(time.Hour), Tags: opentsdb.TagSet{"host": "web"}}
+	loose := &Silence{Start: now, End: now.Add(time.Hour), Tags: opentsdb.TagSet{"host": "web"}, Match: search.Substring}
+	if exact.Contains(loose) || !loose.Contains(exact) {
+		t.Error("expected only the substring silence to contain the other")
+	}
+	if exact.ID() == loose.ID() {
+		t.Error("expected match flags to change the silence ID")
+	}
+}
+
+func TestSilenceTags(t *testing.T) {
+	c, err := conf.New("", `tsdbHost = localhost:4242
+alert a {
+	crit = 1
+}
+alert b {
+	crit = 1
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	for _, ak := range []expr.AlertKey{"a{host=web-1}", "b{host=web-22}", "a{host=db-1}", "b{host=web-x}"} {
+		s.status[ak] = &State{Alert: ak.Name(), Group: ak.Group(), Touched: time.Now()}
+	}
+	now := time.Now().UTC()
+	if _, err := s.AddSilence(now, now.Add(time.Hour), "", `host=/web-(bad/`, "", 0, false, 0, "", "", true, ""); err == nil {
+		t.Error("expected error for invalid regexp")
+	}
+	if _, err := s.AddSilence(now, now.Add(time.Hour), "", `host=/web-\d+/`, "", 0, true, 0, "", "", true, ""); err != nil {
+		t.Fatal(err)
+	}
+	silenced := s.Silenced()
+	if len(silenced) != 2 {
+		t.Fatalf("expected 2 silenced alert keys, got %v", silenced)
+	}
+	for _, ak := range []expr.AlertKey{"a{host=web-1}", "b{host=web-22}"} {
+		if _, ok := silenced[ak]; !ok {
+			t.Errorf("%s not silenced", ak)
+		}
+	}
+	r := s.NewRunHistory(time.Now())
+	r.Events["a{host=web-1}"] = &Event{Status: StUnknown}
+	r.Events["a{host=db-1}"] = &Event{Status: StUnknown}
+	s.RunHistory(r)
+	for i := 0; i < 100; i++ {
+		s.Lock()
+		forgotten := s.status["a{host=web-1}"] == nil
+		s.Unlock()
+		if forgotten {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	s.Lock()
+	defer s.Unlock()
+	if s.status["a{host=web-1}"] != nil {
+		t.Error("silenced unknown not forgotten")
+	}
+	if s.status["a{host=db-1}"] == nil {
+		t.Error("unsilenced unknown forgotten")
+	}
+}
+
+func TestCritHistory(t *testing.T) {
+	c, err := conf.New("", `tsdbHost = localhost:4242
+alert a {
+	crit = avg(q("avg:m{host=*}", "5m", "")) > 10
+}
+alert b {
+	crit = avg(q("avg:m{host=*}", "5m", "")) > critQuantile("a", .99, "1d") && critQuantile("a", .5, "1w") > 0
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := critRetention(c)
+	if len(r) != 1 || r["a"] != 7*24*time.Hour {
+		t.Errorf("unexpected retention: %v", r)
+	}
+	if o := critOperand(c.Alerts["a"].Crit); o.String() != `avg(q("avg:m{host=*}", "5m", ""))` {
+		t.Errorf("unexpected operand: %s", o)
+	}
+	var h critHistory
+	now := time.Now()
+	for i := 10; i >= 0; i-- {
+		h.add("a{host=x}", now.Add(-time.Duration(i)*time.Hour), float64(i), 5*time.Hour)
+	}
+	h.add("b{host=x}", now, 1, time.Hour)
+	if v := h.since("a", now.Add(-2*time.Hour)); len(v) != 1 || len(v["a{host=x}"]) != 3 {
+		t.Errorf("unexpected values: %v", v)
+	}
+	if n := len(h.values["a{host=x}"]); n != 6 {
+		t.Errorf("expected 6 values kept, got %d", n)
+	}
+	h.prune(func(ak expr.AlertKey) bool { return ak.Name() == "a" })
+	if _, ok := h.values["b{host=x}"]; ok || len(h.values) != 1 {
+		t.Errorf("unexpected values after prune: %v", h.values)
+	}
+}
+
+func TestDepends(t *testing.T) {
+	testSched(t, &schedTest{
+		conf: `alert disk {
+			depends = down
+			crit = avg(q("avg:disk{host=*,disk=*}", "5m", "")) > 90
+		}
+		alert down {
+			crit = avg(q("avg:up{host=*}", "5m", "")) == 0
+		}`,
+		queries: map[string]opentsdb.ResponseSet{
+			`q("avg:up{host=*}", "2000/01/01-11:55:00", "2000/01/01-12:00:00")`: {
+				{
+					Metric: "up",
+					Tags:   opentsdb.TagSet{"host": "a"},
+					DPS:    map[string]opentsdb.Point{"0": 0},
+				},
+				{
+					Metric: "up",
+					Tags:   opentsdb.TagSet{"host": "b"},
+					DPS:    map[string]opentsdb.Point{"0": 1},
+				},
+			},
+			`q("avg:disk{disk=*,host=*}", "2000/01/01-11:55:00", "2000/01/01-12:00:00")`: {
+				{
+					Metric: "disk",
+					Tags:   opentsdb.TagSet{"host": "a", "disk": "/"},
+					DPS:    map[string]opentsdb.Point{"0": 95},
+				},
+				{
+					Metric: "disk",
+					Tags:   opentsdb.TagSet{"host": "b", "disk": "/"},
+					DPS:    map[string]opentsdb.Point{"0": 95},
+				},
+			},
+		},
+		state: map[schedState]bool{
+			schedState{"down{host=a}", "critical"}:        true,
+			schedState{"disk{disk=/,host=b}", "critical"}: true,
+		},
+	})
+}
+
+func TestTracedCacheRoute(t *testing.T) {
+	server := func(dc, dps string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `[{"metric":"m","tags":{"dc":"%s","host":"a"},"dps":%s}]`, dc, dps)
+		}))
+	}
+	ts1, ts2 := server("ny", `{"1":1}`), server("lon", `{"1":2}`)
+	defer ts1.Close()
+	defer ts2.Close()
+	ny, _ := url.Parse(ts1.URL)
+	lon, _ := url.Parse(ts2.URL)
+	c, err := conf.New("test", fmt.Sprintf(`tsdbHost = localhost:4242
+tsdb ny {
+	tags = dc=ny
+	host = %s
+}
+tsdb lon {
+	tags = dc=lon
+	host = %s
+}
+`, ny.Host, lon.Host))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := NewTracedCache("", 1<<20, "")
+	cache.Route = c.TSDBHosts
+	req := func(tags opentsdb.TagSet) *opentsdb.Request {
+		return &opentsdb.Request{Start: "1h-ago", Queries: []*opentsdb.Query{{Metric: "m", Aggregator: "sum", Tags: tags}}}
+	}
+	// Summed per backend, the two series for host=a cannot be merged.
+	if _, err := cache.Query(req(opentsdb.TagSet{"host": "*"})); err == nil {
+		t.Errorf("expected error for query not grouped by dc")
+	}
+	rs, err := cache.Query(req(opentsdb.TagSet{"dc": "*", "host": "*"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) != 2 {
+		t.Fatalf("expected a series per dc, got %v", rs)
+	}
+	for _, r := range rs {
+		want := map[string]opentsdb.Point{"ny": 1, "lon": 2}[r.Tags["dc"]]
+		if len(r.DPS) != 1 || r.DPS["1"] != want {
+			t.Errorf("%v: expected %v, got %v", r.Tags, want, r.DPS)
+		}
+	}
+}
+
+func TestInternals(t *testing.T) {
+	c, err := conf.New("test", `tsdbHost = localhost:4242
+alert fast {
+	crit = 1
+}
+alert slow {
+	crit = 1
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	now := time.Now()
+	s.internals.alert("fast", now, time.Millisecond)
+	s.internals.alert("slow", now, time.Second)
+	s.internals.alert("removed", now, time.Minute)
+	for i := 0; i < internalsHistory+5; i++ {
+		s.internals.cycle(now.Add(time.Duration(i)*time.Minute), time.Second)
+	}
+	in := s.Internals(1)
+	if len(in.SlowAlerts) != 1 || in.SlowAlerts[0].Alert != "slow" {
+		t.Errorf("expected slow alert, got %v", in.SlowAlerts)
+	}
+	if len(in.Cycles) != internalsHistory {
+		t.Errorf("expected %d cycles, got %d", internalsHistory, len(in.Cycles))
+	} else if !in.Cycles[0].Start.After(in.Cycles[1].Start) {
+		t.Errorf("expected most recent cycle first")
+	}
+	if in.Config != "test" {
+		t.Errorf("unexpected config: %s", in.Config)
+	}
+}
+
+func TestIncidents(t *testing.T) {
+	c, err := conf.New("test", `tsdbHost = localhost:4242
+alert a {
+	crit = 1
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	ak := expr.AlertKey("a{host=x}")
+	s.status[ak] = &State{Alert: ak.Name(), Group: ak.Group(), Touched: time.Now()}
+	run := func(st Status) {
+		r := s.NewRunHistory(time.Now())
+		r.Events[ak] = &Event{Status: st}
+		s.RunHistory(r)
+	}
+	run(StCritical)
+	run(StWarning)
+	run(StWarning)
+	run(StNormal)
+	if err := s.Action("u", "done", ActionClose, ak); err != nil {
+		t.Fatal(err)
+	}
+	run(StCritical)
+	l := s.Incidents("a", time.Now().Add(-time.Hour))
+	if len(l) != 2 {
+		t.Fatalf("expected 2 incidents, got %d", len(l))
+	}
+	last, first := l[0], l[1]
+	if first.Id != 1 || last.Id != 2 {
+		t.Errorf("unexpected ids: %d, %d", first.Id, last.Id)
+	}
+	if first.Active() || len(first.Events) != 3 || len(first.Actions) != 1 {
+		t.Errorf("unexpected first incident: %+v", first)
+	}
+	if !last.Active() || len(last.Events) != 1 {
+		t.Errorf("unexpected last incident: %+v", last)
+	}
+	if id := s.status[ak].Incident; id != 2 {
+		t.Errorf("expected state incident 2, got %d", id)
+	}
+	if len(s.Incidents("b", time.Time{})) != 0 {
+		t.Errorf("expected no incidents of b")
+	}
+}
+
+func TestActions(t *testing.T) {
+	c, err := conf.New("test", `tsdbHost = localhost:4242
+alert a {
+	crit = 1
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	ak := expr.AlertKey("a{host=x}")
+	s.status[ak] = &State{Alert: ak.Name(), Group: ak.Group(), Open: true, NeedAck: true}
+	s.status[ak].Append(&Event{Status: StCritical})
+	s.AddNotification(ak, &conf.Notification{Name: "n"}, time.Now())
+	if err := s.Action("u", "looking", ActionAcknowledge, ak); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.Notifications[ak]; ok {
+		t.Error("acknowledged alert still renotifies")
+	}
+	if err := s.Action("u", "again", ActionAcknowledge, ak); err == nil {
+		t.Error("expected error acknowledging twice")
+	}
+	if err := s.Action("u", "", ActionClose, ak); err == nil {
+		t.Error("expected error closing active alert")
+	}
+	s.status[ak].Append(&Event{Status: StNormal})
+	if err := s.Action("v", "fixed", ActionClose, ak); err != nil {
+		t.Fatal(err)
+	}
+	actions, err := s.ActionHistory(ak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 2 || actions[0].Type != ActionAcknowledge || actions[1].User != "v" || actions[1].Message != "fixed" {
+		t.Errorf("unexpected actions: %+v", actions)
+	}
+	if _, err := s.ActionHistory("a{host=y}"); err == nil {
+		t.Error("expected error for unknown alert key")
+	}
+}
+
+func TestProvisioning(t *testing.T) {
+	c, err := conf.New("test", `tsdbHost = localhost:4242
+provisionGrace = 15m
+alert a {
+	crit = 1
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	s.Search.Index(opentsdb.MultiDataPoint{{Metric: "m", Timestamp: 1, Value: 1, Tags: opentsdb.TagSet{"host": "old"}}})
+	s.Search.Index(opentsdb.MultiDataPoint{{Metric: "m", Timestamp: 1, Value: 1, Tags: opentsdb.TagSet{"host": "new"}}})
+	for host, expect := range map[string]bool{"old": false, "new": true} {
+		st := &State{Alert: "a", Group: opentsdb.TagSet{"host": host}}
+		if got := s.provisioning(st); got != expect {
+			t.Errorf("host %s: expected provisioning %v", host, expect)
+		}
+	}
+	s.Conf.ProvisionGrace = 0
+	if s.provisioning(&State{Alert: "a", Group: opentsdb.TagSet{"host": "new"}}) {
+		t.Error("expected no grace when disabled")
+	}
+}
+
+func TestTemplateFallback(t *testing.T) {
+	c, err := conf.New("test", `tsdbHost = localhost:4242
+template t {
+	subject = {{.Missing}}
+	body = {{.Missing}}
+}
+alert a {
+	template = t
+	crit = 1
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	a := c.Alerts["a"]
+	st := &State{Alert: "a", Group: opentsdb.TagSet{"host": "x"}}
+	st.Append(&Event{Status: StCritical, Crit: &Result{Result: &expr.Result{Computations: expr.Computations{{"1", 1}}}, Expr: "1"}})
+	rh := s.NewRunHistory(time.Now())
+	body := new(bytes.Buffer)
+	_, err = s.ExecuteBody(body, rh, a, st, nil, true)
+	if err == nil {
+		t.Fatal("expected body template error")
+	}
+	body.Reset()
+	if _, err := s.executeFallbackBody(body, rh, a, st, nil, false, err); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"critical: a{host=x}", "Missing", "/action?"} {
+		if !strings.Contains(body.String(), want) {
+			t.Errorf("fallback body missing %q: %s", want, body)
+		}
+	}
+	if _, err := s.Data(rh, st, a, true).GraphTrigger(); err == nil || !strings.Contains(err.Error(), "no series") {
+		t.Errorf("expected no series error, got %v", err)
+	}
+	if s := fallbackSubject(st); s != "critical: a{host=x}" {
+		t.Errorf("bad fallback subject: %s", s)
+	}
+	text := new(bytes.Buffer)
+	if err := s.ExecuteTextBody(text, rh, a, st, nil); err != nil || text.Len() != 0 {
+		t.Errorf("expected empty text body, got %q, %v", text, err)
+	}
+}
+
+func TestDedupOrder(t *testing.T) {
+	state := func(name string, status Status) *State {
+		return &State{Alert: name, History: []Event{{Status: status}}}
+	}
+	l := dedupOrder{state("a", StInfo), state("b", StNormal), state("c", StWarning), state("d", StCritical)}
+	sort.Sort(l)
+	var got []string
+	for _, st := range l {
+		got = append(got, st.Alert)
+	}
+	if g := strings.Join(got, ","); g != "d,c,a,b" {
+		t.Errorf("expected d,c,a,b, got %s", g)
+	}
+}
+
+func TestDedup(t *testing.T) {
+	c, err := conf.New("test", `tsdbHost = localhost:4242
+notification n {
+	print = true
+}
+alert a {
+	crit = 1
+	dedup = down-{host}
+}
+alert b {
+	warn = 1
+	dedup = down-{host}
+}
+alert c {
+	crit = 1
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	n := c.Notifications["n"]
+	state := func(alert, host string, status Status) *State {
+		st := &State{Alert: alert, Group: opentsdb.TagSet{"host": host}}
+		st.Append(&Event{Status: status})
+		s.status[st.AlertKey()] = st
+		return st
+	}
+	a, b, c2, other := state("a", "x", StCritical), state("b", "x", StWarning), state("c", "x", StCritical), state("b", "y", StWarning)
+	send := s.dedup(n, []*State{b, a, c2, other})
+	if len(send) != 3 {
+		t.Fatalf("expected 3 notifications, got %v", send)
+	}
+	if rel, ok := send[a.AlertKey()]; !ok || len(rel) != 1 || rel[0] != b {
+		t.Errorf("expected b collapsed into a, got %v", send)
+	}
+	if _, ok := send[b.AlertKey()]; ok {
+		t.Error("b should not be sent")
+	}
+	s.openIncident(a.AlertKey(), a)
+	if send := s.dedup(n, []*State{b}); len(send) != 0 {
+		t.Errorf("expected b collapsed into active a, got %v", send)
+	}
+	s.incidents[a.Incident].End = &time.Time{}
+	if send := s.dedup(n, []*State{b}); len(send) != 1 {
+		t.Errorf("expected b sent after a ended, got %v", send)
+	}
+	subject, body := bytes.NewBufferString("s"), new(bytes.Buffer)
+	text := []byte("t")
+	addRelated(subject, body, &text, []*State{b})
+	if subject.String() != "s (+1 related)" || !strings.Contains(body.String(), "b{host=x}") || !strings.Contains(string(text), "warning: b{host=x}") {
+		t.Errorf("bad related: %q, %q, %q", subject, body, text)
+	}
+}
+
+func TestInfo(t *testing.T) {
+	c, err := conf.New("test", `tsdbHost = localhost:4242
+notification chat {
+	print = true
+}
+notification pager {
+	print = true
+}
+alert a {
+	warn = 0
+	info = 1
+	infoNotification = chat
+	warnNotification = pager
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	r := s.NewRunHistory(time.Now())
+	s.CheckAlert(new(miniprofiler.Profile), r, c.Alerts["a"])
+	ak := expr.AlertKey("a{}")
+	if ev := r.Events[ak]; ev == nil || ev.Status != StInfo || ev.Info == nil {
+		t.Fatalf("expected info event, got %+v", ev)
+	}
+	s.RunHistory(r)
+	if n := s.Notifications[ak]; len(n) != 0 {
+		t.Errorf("unexpected pending notifications: %v", n)
+	}
+	if ns := s.notifications[c.Notifications["chat"]]; len(ns) != 1 {
+		t.Errorf("expected info notification, got %v", s.notifications)
+	}
+	r = s.NewRunHistory(time.Now())
+	r.Events[ak] = &Event{Status: StWarning}
+	s.RunHistory(r)
+	if ns := s.notifications[c.Notifications["pager"]]; len(ns) != 1 {
+		t.Errorf("expected escalation from info to warning to notify, got %v", s.notifications)
+	}
+	if StInfo.Severity() <= StNormal.Severity() || StWarning.Severity() <= StInfo.Severity() {
+		t.Error("bad severity order")
+	}
+}
+
+func TestSnooze(t *testing.T) {
+	c, err := conf.New("test", `tsdbHost = localhost:4242
+alert a {
+	crit = 1
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	ak := expr.AlertKey("a{host=x}")
+	s.Status(ak)
+	r := s.NewRunHistory(time.Now())
+	r.Events[ak] = &Event{Status: StCritical}
+	s.RunHistory(r)
+	if err := s.Snooze("u", "", ak, time.Minute); err == nil {
+		t.Error("expected error for unsupported duration")
+	}
+	if err := s.Snooze("u", "", ak, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	st := s.status[ak]
+	if !s.snoozed(st) {
+		t.Error("expected snoozed")
+	}
+	if !st.NeedAck {
+		t.Error("snooze should not acknowledge")
+	}
+	actions, err := s.ActionHistory(ak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 1 || actions[0].Type != ActionSnooze || actions[0].Message != "snoozed for 1h0m0s" {
+		t.Errorf("unexpected actions: %+v", actions)
+	}
+	st.SnoozedUntil = time.Now().Add(-time.Second)
+	if s.snoozed(st) {
+		t.Error("expected snooze to expire")
+	}
+}
+
+func TestStateDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bosun")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c, err := conf.New("test", `
+		tsdbHost = localhost:4242
+		alert a {
+			crit = 1
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = filepath.Join(dir, "bosun.state")
+	s := new(Schedule)
+	s.Init(c)
+	for _, host := range []string{"x", "y"} {
+		ak := expr.NewAlertKey("a", opentsdb.TagSet{"host": host, "dc": "ny"})
+		s.status[ak] = &State{Alert: "a", Group: opentsdb.TagSet{"host": host, "dc": "ny"}, Touched: time.Now()}
+	}
+	if err := s.writeState(); err != nil {
+		t.Fatal(err)
+	}
+	fi, err := os.Stat(c.StateFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Unchanged state writes nothing.
+	if err := s.writeState(); err != nil {
+		t.Fatal(err)
+	}
+	if fi2, _ := os.Stat(c.StateFile); fi2.Size() != fi.Size() {
+		t.Fatalf("unchanged state grew the file from %d to %d bytes", fi.Size(), fi2.Size())
+	}
+	// A change to one alert key appends only its record.
+	s.status["a{dc=ny,host=x}"].Subject = "changed"
+	delete(s.status, "a{dc=ny,host=y}")
+	if err := s.writeState(); err != nil {
+		t.Fatal(err)
+	}
+	fi2, _ := os.Stat(c.StateFile)
+	if grew := fi2.Size() - fi.Size(); grew <= 0 || grew >= fi.Size() {
+		t.Fatalf("expected an incremental write, file grew %d bytes", grew)
+	}
+	// A torn last record is discarded.
+	f, err := os.OpenFile(c.StateFile, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write([]byte{0, 0, 1, 0, 1, 2})
+	f.Close()
+	r := new(Schedule)
+	r.Init(c)
+	r.RestoreState()
+	if len(r.status) != 1 || r.status["a{dc=ny,host=x}"] == nil || r.status["a{dc=ny,host=x}"].Subject != "changed" {
+		t.Fatalf("unexpected restored status: %v", r.status)
+	}
+	db, err := readStateDB(c.StateFile, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi3, _ := os.Stat(c.StateFile); fi3.Size() != fi2.Size() {
+		t.Errorf("expected torn record truncated to %d bytes, got %d", fi2.Size(), fi3.Size())
+	}
+	// Compaction keeps only live records.
+	for i := 0; db.size <= compactSize; i++ {
+		if err := db.Put("test", "k", strings.Repeat("v", 1000+i%2)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := db.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+	if fi3, _ := os.Stat(c.StateFile); fi3.Size() >= compactSize {
+		t.Errorf("expected compacted file, got %d bytes", fi3.Size())
+	}
+	db, err = readStateDB(c.StateFile, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(db.Keys("status")) != 1 || len(db.Get("test", "k")) == 0 {
+		t.Errorf("unexpected compacted state: %v", db.buckets)
+	}
+	// A gob state file is read and replaced by the first save.
+	var buf bytes.Buffer
+	if err := s.encodeState(&buf); err != nil {
+		t.Fatal(err)
+	}
+	legacy := filepath.Join(dir, "legacy.state")
+	if err := ioutil.WriteFile(legacy, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = legacy
+	r = new(Schedule)
+	r.Init(c)
+	r.RestoreState()
+	if r.status["a{dc=ny,host=x}"] == nil {
+		t.Fatal("gob state not restored")
+	}
+	if err := r.writeState(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(legacy + ".gob"); err != nil {
+		t.Error(err)
+	}
+	if _, err := readStateDB(legacy, false); err != nil {
+		t.Error(err)
+	}
+	c.StateFile = ""
+}
+
+// fakeRedis serves the Redis commands used for shared state.
+type fakeRedis struct {
+	sync.Mutex
+	strings map[string]string
+	hashes  map[string]map[string]string
+	sets    map[string]map[string]bool
+	err     string // If set, every command fails with it
+}
+
+func newFakeRedis(t *testing.T) (f *fakeRedis, addr string, close func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f = &fakeRedis{
+		strings: make(map[string]string),
+		hashes:  make(map[string]map[string]string),
+		sets:    make(map[string]map[string]bool),
+	}
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(c)
+		}
+	}()
+	return f, l.Addr().String(), func() { l.Close() }
+}
+
+func (f *fakeRedis) serve(c net.Conn) {
+	defer c.Close()
+	r := bufio.NewReader(c)
+	for {
+		req, err := readReply(r)
+		if err != nil {
+			return
+		}
+		var args []string
+		for _, a := range req.([]interface{}) {
+			args = append(args, string(a.([]byte)))
+		}
+		f.Lock()
+		var reply interface{}
+		if f.err != "" {
+			reply = redisError(f.err)
+		} else {
+			reply = f.do(args)
+		}
+		f.Unlock()
+		switch reply := reply.(type) {
+		case redisError:
+			fmt.Fprintf(c, "-%s\r\n", string(reply))
+		case nil:
+			io.WriteString(c, "$-1\r\n")
+		case int:
+			fmt.Fprintf(c, ":%d\r\n", reply)
+		case string:
+			fmt.Fprintf(c, "+%s\r\n", reply)
+		case []string:
+			fmt.Fprintf(c, "*%d\r\n", len(reply))
+			for _, s := range reply {
+				fmt.Fprintf(c, "$%d\r\n%s\r\n", len(s), s)
+			}
+		}
+	}
+}
+
+func (f *fakeRedis) do(args []string) interface{} {
+	switch args[0] {
+	case "SET":
+		if _, present := f.strings[args[1]]; present {
+			return nil
+		}
+		f.strings[args[1]] = args[2]
+		return "OK"
+	case "EVAL":
+		if f.strings[args[3]] != args[4] {
+			return 0
+		}
+		if args[1] == releaseScript {
+			delete(f.strings, args[3])
+		}
+		return 1
+	case "HSET":
+		if f.hashes[args[1]] == nil {
+			f.hashes[args[1]] = make(map[string]string)
+		}
+		f.hashes[args[1]][args[2]] = args[3]
+		return 1
+	case "HDEL":
+		delete(f.hashes[args[1]], args[2])
+		return 1
+	case "HGETALL":
+		var r []string
+		for k, v := range f.hashes[args[1]] {
+			r = append(r, k, v)
+		}
+		return r
+	case "SADD":
+		if f.sets[args[1]] == nil {
+			f.sets[args[1]] = make(map[string]bool)
+		}
+		f.sets[args[1]][args[2]] = true
+		return 1
+	case "SMEMBERS":
+		var r []string
+		for m := range f.sets[args[1]] {
+			r = append(r, m)
+		}
+		return r
+	}
+	return nil
+}
+
+func TestRedisHA(t *testing.T) {
+	redis, addr, closeRedis := newFakeRedis(t)
+	defer closeRedis()
+	parse := func() *conf.Conf {
+		c, err := conf.New("test", `
+			tsdbHost = localhost:4242
+			redisHost = `+addr+`
+			alert a {
+				crit = 1
+			}
+		`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	a, b, q := new(Schedule), new(Schedule), new(Schedule)
+	a.Init(parse())
+	b.Init(parse())
+	qc := parse()
+	qc.Quiet = true
+	q.Init(qc)
+	q.lease()
+	if !q.Standby() {
+		t.Fatal("expected instance with quiet config to stay standby")
+	}
+	a.lease()
+	b.lease()
+	if a.Quiet() || !b.Quiet() || !b.Standby() {
+		t.Fatalf("expected a active and b standby, got a quiet %v, b quiet %v", a.Quiet(), b.Quiet())
+	}
+	a.status["a{host=x}"] = &State{Alert: "a", Group: opentsdb.TagSet{"host": "x"}, Touched: time.Now()}
+	a.Silence["s"] = &Silence{User: "alice"}
+	a.save()
+	b.reload()
+	if b.status["a{host=x}"] == nil || b.Silence["s"] == nil || b.Silence["s"].User != "alice" {
+		t.Fatalf("state not shared: %v %v", b.status, b.Silence)
+	}
+	b.Silence["t"] = &Silence{User: "bob"}
+	b.save()
+	b.reload()
+	if b.Silence["t"] != nil {
+		t.Error("standby wrote the shared state")
+	}
+	a.SetQuiet(true)
+	b.lease()
+	if !a.Standby() || b.Standby() || b.Quiet() {
+		t.Fatal("expected quiet a to hand over to b")
+	}
+	delete(b.status, "a{host=x}")
+	b.save()
+	a.reload()
+	if len(a.status) != 0 {
+		t.Errorf("expected deleted status, got %v", a.status)
+	}
+	a.SetQuiet(false)
+	if !a.Standby() {
+		t.Error("expected a to stay standby while b holds the lease")
+	}
+	redis.Lock()
+	redis.err = "ERR unavailable"
+	redis.Unlock()
+	b.lease()
+	if b.Standby() {
+		t.Error("expected b to stay active within its lease")
+	}
+	b.haLock.Lock()
+	b.haRenewed = time.Now().Add(-haLease)
+	b.haLock.Unlock()
+	b.lease()
+	if !b.Standby() {
+		t.Error("expected b to step down after its lease expired")
+	}
+}
+
+func TestRedisShards(t *testing.T) {
+	redis, addr, closeRedis := newFakeRedis(t)
+	defer closeRedis()
+	var alerts string
+	for i := 0; i < 10; i++ {
+		alerts += fmt.Sprintf("alert a%d {\n\tcrit = 1\n}\n", i)
+	}
+	member := func(name string) *Schedule {
+		c, err := conf.New("test", "tsdbHost = localhost:4242\nredisHost = "+addr+"\nshardName = "+name+"\n"+alerts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s := new(Schedule)
+		s.Init(c)
+		return s
+	}
+	a, b := member("a"), member("b")
+	a.heartbeat()
+	b.heartbeat()
+	a.heartbeat()
+	var aOwned, bOwned string
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("a%d", i)
+		if a.OwnsAlert(name) == b.OwnsAlert(name) {
+			t.Fatalf("%s: expected exactly one owner", name)
+		}
+		if a.OwnsAlert(name) {
+			aOwned = name
+		} else {
+			bOwned = name
+		}
+	}
+	if aOwned == "" || bOwned == "" {
+		t.Fatal("expected both members to own alerts")
+	}
+	ak := expr.AlertKey(aOwned + "{host=x}")
+	a.status[ak] = &State{Alert: aOwned, Group: opentsdb.TagSet{"host": "x"}, Touched: time.Now(), Open: true, NeedAck: true}
+	// Mirrored states are not written by the members that do not own them.
+	b.status[expr.AlertKey(aOwned+"{host=y}")] = &State{Alert: aOwned, Group: opentsdb.TagSet{"host": "y"}}
+	a.save()
+	b.save()
+	b.mirrorShards()
+	if b.status[ak] == nil || len(b.status) != 1 {
+		t.Fatalf("expected b to mirror the state of a, got %v", b.status)
+	}
+	if err := b.Action("bob", "", ActionAcknowledge, ak); err == nil {
+		t.Error("expected error acting on an alert owned by another member")
+	}
+	if err := a.Action("alice", "", ActionAcknowledge, ak); err != nil {
+		t.Error(err)
+	}
+	a.Silence["s"] = &Silence{User: "alice"}
+	a.syncSilences()
+	b.syncSilences()
+	if b.Silence["s"] == nil || b.Silence["s"].User != "alice" {
+		t.Fatalf("expected silence shared with b, got %v", b.Silence)
+	}
+	delete(b.Silence, "s")
+	b.syncSilences()
+	a.syncSilences()
+	if len(a.Silence) != 0 {
+		t.Errorf("expected silence cleared on a, got %v", a.Silence)
+	}
+	redis.Lock()
+	redis.hashes[redisShards]["b"] = "0"
+	redis.Unlock()
+	a.heartbeat()
+	if !a.OwnsAlert(bOwned) {
+		t.Errorf("expected a to take over %s after b stopped", bOwned)
+	}
+}
+
+func TestExprGraphLegend(t *testing.T) {
+	s := new(Schedule)
+	for _, legend := range []string{"", "none", "obc", "ort"} {
+		if _, err := s.ExprGraph(nil, nil, "q", time.Now(), GraphOptions{Legend: legend}); err != nil {
+			t.Errorf("legend %q: %v", legend, err)
+		}
+	}
+	for _, legend := range []string{"x", "it", "itx", "ocl"} {
+		if _, err := s.ExprGraph(nil, nil, "q", time.Now(), GraphOptions{Legend: legend}); err == nil {
+			t.Errorf("legend %q: expected error", legend)
+		}
+	}
+}
+
+type errTSDB struct{}
+
+func (errTSDB) Query(*opentsdb.Request) (opentsdb.ResponseSet, error) {
+	return nil, fmt.Errorf("tsdb unavailable")
+}
+
+func TestDryRunHooks(t *testing.T) {
+	hooks := make(chan HookEvent, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ev HookEvent
+		json.NewDecoder(r.Body).Decode(&ev)
+		hooks <- ev
+	}))
+	defer ts.Close()
+	c, err := conf.New("", `tsdbHost = localhost:4242
+eventHook = `+ts.URL+`
+alert a {
+	crit = 1
+}
+alert b {
+	crit = avg(q("avg:m{host=a}", "5m", "")) > 1
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	s.NewTSDB = func(trace string) expr.TSDBProvider {
+		return expr.Backends{OpenTSDBContext: errTSDB{}}
+	}
+	s.DryRun(time.Now())
+	if !s.readOnly {
+		t.Error("expected dry run to leave the schedule read only")
+	}
+	select {
+	case ev := <-hooks:
+		t.Errorf("dry run sent hook %+v", ev)
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+
+func TestSummary(t *testing.T) {
+	c, err := conf.New("", "tsdbHost = localhost:4242\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	s := new(Schedule)
+	s.Init(c)
+	now := time.Now().UTC()
+	add := func(ak expr.AlertKey, open, needAck bool, subject string, events ...Status) {
+		st := &State{Alert: ak.Name(), Group: ak.Group(), Open: open, NeedAck: needAck, Subject: subject}
+		for i, status := range events {
+			st.History = append(st.History, Event{Status: status, Time: now.Add(time.Duration(i) * time.Minute)})
+		}
+		s.status[ak] = st
+	}
+	add("a{dc=ny,host=web1}", true, true, "web1 down", StCritical)
+	add("a{dc=ny,host=web2}", true, false, "web2 was down", StCritical, StNormal)
+	add("b{dc=la,host=db1}", true, true, "db1 slow", StNormal, StWarning, StNormal, StWarning)
+	add("b{dc=la,host=db2}", false, false, "", StWarning, StNormal, StNormal, StNormal, StNormal)
+	add("c{}", false, false, "", StNormal)
+
+	sum := s.Summary("dc", 2)
+	if !reflect.DeepEqual(sum.Status, map[string]int{"critical": 2, "warning": 1}) {
+		t.Errorf("unexpected status counts: %v", sum.Status)
+	}
+	if sum.NeedAck != 2 || sum.Active != 2 {
+		t.Errorf("expected 2 unacknowledged and 2 active, got %d and %d", sum.NeedAck, sum.Active)
+	}
+	byTag := map[string]map[string]int{"ny": {"critical": 2}, "la": {"warning": 1}}
+	if !reflect.DeepEqual(sum.ByTag, byTag) {
+		t.Errorf("unexpected counts by dc: %v", sum.ByTag)
+	}
+	// The most recent changes first, closed alert keys included.
+	if len(sum.Recent) != 2 || sum.Recent[0].AlertKey != "b{dc=la,host=db2}" || sum.Recent[1].AlertKey != "b{dc=la,host=db1}" {
+		t.Fatalf("unexpected recent changes: %+v", sum.Recent)
+	}
+	if r := sum.Recent[1]; r.Status != StWarning || r.Subject != "db1 slow" || !r.Time.Equal(now.Add(3*time.Minute)) {
+		t.Errorf("unexpected change: %+v", r)
+	}
+	if sum := s.Summary("", -1); sum.ByTag != nil || len(sum.Recent) != 5 {
+		t.Errorf("expected no tag counts and all changes, got %v and %d", sum.ByTag, len(sum.Recent))
+	}
+}
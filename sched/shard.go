@@ -0,0 +1,380 @@
+package sched
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/gob"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/collect"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+// With shardMembers, shard membership is fixed by the config: a member that
+// stops checking keeps its share of the alerts, which then go unchecked. To
+// detect this, each member puts shardMetric after every check, and looks in
+// the TSDB for the members that have not put it recently.
+//
+// With a redisHost, members instead register in Redis by shardName. Each
+// puts the time its heartbeat expires in redisShards every haFreq, and
+// alerts are hashed over the members whose heartbeat has not expired, so
+// the alerts of a member that stops are taken over by the others. Each
+// member writes its state under its own prefix, with only the states and
+// notifications of the alerts it owns, and mirrors the states of the alerts
+// of the others, so that the dashboard of any member shows all alerts.
+// Silences are shared by all members; actions are taken on the member that
+// owns the alert.
+
+const (
+	shardMetric = "shard.checked"
+	// shardMissed is how many check cycles a member may miss before it is
+	// considered down.
+	shardMissed = 3
+
+	redisShards   = redisPrefix + "shards"
+	redisSilences = redisPrefix + "silences"
+)
+
+// shardPrefix returns the prefix of the Redis keys of the state of member.
+func shardPrefix(member string) string {
+	return redisPrefix + "shard:" + member + ":"
+}
+
+// OwnsAlert reports whether this evaluator is responsible for checking the
+// named alert. With no shard members every alert is owned. Ownership is
+// assigned by rendezvous hashing of the alert name over the members, so
+// adding or removing a member only moves the alerts that member gains or
+// loses.
+func (s *Schedule) OwnsAlert(name string) bool {
+	return s.alertOwner(name) == s.Conf.ShardName
+}
+
+// alertOwner returns the shard member that checks the named alert, or "" if
+// alerts are not sharded.
+func (s *Schedule) alertOwner(name string) string {
+	members := s.Conf.ShardMembers
+	if s.Conf.RedisHost != "" && s.Conf.ShardName != "" {
+		s.haLock.Lock()
+		members = s.shardLive
+		s.haLock.Unlock()
+		if len(members) == 0 {
+			// Before the first heartbeat, or while Redis is
+			// unavailable at start, s checks every alert.
+			return s.Conf.ShardName
+		}
+	}
+	if len(members) == 0 {
+		return ""
+	}
+	return shardOwner(name, members)
+}
+
+// shardOwner returns the member with the highest hash weight for name.
+func shardOwner(name string, members []string) string {
+	var owner string
+	var max uint64
+	for _, m := range members {
+		sum := sha1.Sum([]byte(m + "|" + name))
+		if w := binary.BigEndian.Uint64(sum[:8]); owner == "" || w > max {
+			owner, max = m, w
+		}
+	}
+	return owner
+}
+
+// checkShards records that s checked at now, and reports the shard members
+// that have not checked in the last shardMissed check cycles. Members are
+// reported when they go down and when they come back; the notification
+// goes out from the first listed member still checking, so that it is sent
+// once.
+func (s *Schedule) checkShards(now time.Time) {
+	collect.Put(shardMetric, opentsdb.TagSet{"member": s.Conf.ShardName}, now.Unix())
+	window := s.Conf.CheckFrequency * shardMissed
+	if s.shardStart.IsZero() {
+		s.shardStart = now
+	}
+	if now.Sub(s.shardStart) < window {
+		// Members started at the same time may not have checked yet.
+		return
+	}
+	live, err := s.shardsLive(now, window)
+	if err != nil {
+		slog.Errorln("sched: shard members:", err)
+		return
+	}
+	if s.shardsDown == nil {
+		s.shardsDown = make(map[string]bool)
+	}
+	var down, up []string
+	for _, m := range s.Conf.ShardMembers {
+		if m == s.Conf.ShardName || live[m] != s.shardsDown[m] {
+			continue
+		}
+		if live[m] {
+			up = append(up, m)
+			delete(s.shardsDown, m)
+		} else {
+			down = append(down, m)
+			s.shardsDown[m] = true
+		}
+	}
+	collect.Put("shard.down", nil, len(s.shardsDown))
+	var msgs []string
+	if len(down) > 0 {
+		msgs = append(msgs, fmt.Sprintf("shard members %s have not checked in %v; their alerts are unchecked", strings.Join(down, ", "), window))
+	}
+	if len(up) > 0 {
+		msgs = append(msgs, fmt.Sprintf("shard members %s are checking again", strings.Join(up, ", ")))
+	}
+	if len(msgs) == 0 {
+		return
+	}
+	send := true
+	for _, m := range s.Conf.ShardMembers {
+		if m == s.Conf.ShardName {
+			break
+		}
+		if live[m] {
+			// An earlier member sends the notification.
+			send = false
+			break
+		}
+	}
+	s.reportShards(strings.Join(msgs, "; "), send)
+}
+
+// reportShards logs a change of shard membership and, if send is set, sends
+// it as a hook and the shardAlert notification.
+func (s *Schedule) reportShards(msg string, send bool) {
+	log.Println("sched:", msg)
+	if !send {
+		return
+	}
+	s.Hook(HookShard, "", msg, 0)
+	if n := s.Conf.Notifications[s.Conf.ShardAlert]; n != nil {
+		n.Notify([]byte("bosun: "+msg), []byte(msg), s.Conf, "bosun.shard")
+	}
+}
+
+// shardsLive returns the shard members that put shardMetric in the window
+// before now.
+func (s *Schedule) shardsLive(now time.Time, window time.Duration) (map[string]bool, error) {
+	req := &opentsdb.Request{
+		Start: now.Add(-window).Unix(),
+		End:   now.Unix(),
+		Queries: []*opentsdb.Query{{
+			Aggregator: "max",
+			Metric:     "bosun." + shardMetric,
+			Tags:       opentsdb.TagSet{"member": "*"},
+		}},
+	}
+	rs, err := s.TSDB(NewTraceID()).OpenTSDB().Query(req)
+	if err != nil {
+		return nil, err
+	}
+	live := make(map[string]bool)
+	for _, r := range rs {
+		if len(r.DPS) > 0 {
+			live[r.Tags["member"]] = true
+		}
+	}
+	return live, nil
+}
+
+// Shards renews the heartbeat of s in Redis and mirrors the alert states and
+// silences of the other members every haFreq. It does not return.
+func (s *Schedule) Shards() {
+	for {
+		s.heartbeat()
+		s.mirrorShards()
+		s.syncSilences()
+		time.Sleep(haFreq)
+	}
+}
+
+// heartbeat renews the heartbeat of s in Redis and updates the live members,
+// reporting those that joined or left. If Redis is unavailable the live
+// members are unchanged.
+func (s *Schedule) heartbeat() {
+	now := time.Now()
+	ms := func(t time.Time) int64 { return t.UnixNano() / int64(time.Millisecond) }
+	name := s.Conf.ShardName
+	rc := s.redis()
+	replies, err := rc.Pipeline([][]string{
+		{"HSET", redisShards, name, strconv.FormatInt(ms(now.Add(haLease)), 10)},
+		{"HGETALL", redisShards},
+	})
+	for i := 0; err == nil && i < len(replies); i++ {
+		if e, ok := replies[i].(redisError); ok {
+			err = e
+		}
+	}
+	if err != nil {
+		slog.Errorln("sched: shard heartbeat:", err)
+		return
+	}
+	kvs, _ := replies[1].([]interface{})
+	var live []string
+	for i := 0; i+1 < len(kvs); i += 2 {
+		m := string(kvs[i].([]byte))
+		expires, _ := strconv.ParseInt(string(kvs[i+1].([]byte)), 10, 64)
+		if m == name || expires > ms(now) {
+			live = append(live, m)
+		} else if _, err := rc.Do("HDEL", redisShards, m); err != nil {
+			slog.Errorln("sched: shard heartbeat:", err)
+		}
+	}
+	sort.Strings(live)
+	s.haLock.Lock()
+	prev := s.shardLive
+	s.shardLive = live
+	s.haLock.Unlock()
+	was := make(map[string]bool)
+	for _, m := range prev {
+		was[m] = true
+	}
+	var joined, left []string
+	for _, m := range live {
+		if !was[m] {
+			joined = append(joined, m)
+		}
+		delete(was, m)
+	}
+	for m := range was {
+		left = append(left, m)
+	}
+	sort.Strings(left)
+	collect.Put("shard.live", nil, len(live))
+	var msgs []string
+	if len(joined) > 0 && prev != nil {
+		msgs = append(msgs, fmt.Sprintf("shard members %s joined", strings.Join(joined, ", ")))
+	}
+	if len(left) > 0 {
+		msgs = append(msgs, fmt.Sprintf("shard members %s have not renewed their heartbeat in %v; their alerts moved to the others", strings.Join(left, ", "), haLease))
+	}
+	if len(msgs) > 0 {
+		// The first live member sends the notification.
+		s.reportShards(strings.Join(msgs, "; "), live[0] == name)
+	}
+}
+
+// mirrorShards replaces the states of the alerts s does not own with those
+// last written to Redis by the other live members.
+func (s *Schedule) mirrorShards() {
+	s.haLock.Lock()
+	members := s.shardLive
+	s.haLock.Unlock()
+	rc := s.redis()
+	status := make(States)
+	for _, m := range members {
+		if m == s.Conf.ShardName {
+			continue
+		}
+		r, err := rc.Do("HGETALL", shardPrefix(m)+"state:status")
+		if err != nil {
+			slog.Errorln("sched: mirroring shards:", err)
+			return
+		}
+		kvs, _ := r.([]interface{})
+		for i := 0; i+1 < len(kvs); i += 2 {
+			st := new(State)
+			if err := gob.NewDecoder(bytes.NewReader(kvs[i+1].([]byte))).Decode(st); err != nil {
+				slog.Errorf("sched: mirroring shard %s: %v", m, err)
+				continue
+			}
+			status[expr.AlertKey(kvs[i].([]byte))] = st
+		}
+	}
+	s.Lock()
+	defer s.Unlock()
+	for ak := range s.status {
+		if _, ok := status[ak]; !ok && !s.OwnsAlert(ak.Name()) {
+			delete(s.status, ak)
+		}
+	}
+	for ak, st := range status {
+		if !s.OwnsAlert(ak.Name()) {
+			s.status[ak] = st
+		}
+	}
+}
+
+// syncSilences merges the silences of s with those shared by the members in
+// Redis: silences set or cleared on s since the last sync are set or cleared
+// in Redis, and those set or cleared by other members are set or cleared on
+// s.
+func (s *Schedule) syncSilences() {
+	rc := s.redis()
+	r, err := rc.Do("HGETALL", redisSilences)
+	if err != nil {
+		slog.Errorln("sched: syncing silences:", err)
+		return
+	}
+	kvs, _ := r.([]interface{})
+	shared := make(map[string][]byte)
+	for i := 0; i+1 < len(kvs); i += 2 {
+		shared[string(kvs[i].([]byte))] = kvs[i+1].([]byte)
+	}
+	var cmds [][]string
+	s.Lock()
+	for id, si := range s.Silence {
+		if _, ok := shared[id]; ok {
+			continue
+		}
+		if s.silenceSync[id] {
+			// Cleared by another member.
+			delete(s.Silence, id)
+			continue
+		}
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(si); err != nil {
+			slog.Errorln("sched: syncing silences:", err)
+			continue
+		}
+		cmds = append(cmds, []string{"HSET", redisSilences, id, buf.String()})
+	}
+	for id, b := range shared {
+		if _, ok := s.Silence[id]; ok {
+			continue
+		}
+		if s.silenceSync[id] {
+			// Cleared on s.
+			cmds = append(cmds, []string{"HDEL", redisSilences, id})
+			continue
+		}
+		si := new(Silence)
+		if err := gob.NewDecoder(bytes.NewReader(b)).Decode(si); err != nil {
+			slog.Errorln("sched: syncing silences:", err)
+			continue
+		}
+		s.Silence[id] = si
+	}
+	synced := make(map[string]bool)
+	for id := range s.Silence {
+		synced[id] = true
+	}
+	s.Unlock()
+	if len(cmds) > 0 {
+		replies, err := rc.Pipeline(cmds)
+		for i := 0; err == nil && i < len(replies); i++ {
+			if e, ok := replies[i].(redisError); ok {
+				err = e
+			}
+		}
+		if err != nil {
+			slog.Errorln("sched: syncing silences:", err)
+			return
+		}
+	}
+	s.Lock()
+	s.silenceSync = synced
+	s.Unlock()
+}
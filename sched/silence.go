@@ -4,16 +4,38 @@ import (
 	"crypto/sha1"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
 	"github.com/bosun-monitor/bosun/expr"
+	"github.com/bosun-monitor/bosun/search"
 )
 
 type Silence struct {
 	Start, End time.Time
-	Alert      string
-	Tags       opentsdb.TagSet
+	// Alert, if set, restricts the silence to one alert. Tags match the
+	// groups of any alert: each value is a glob as for Match, or a regular
+	// expression if enclosed in slashes, such as host=/web-\d+/.
+	Alert string
+	Tags  opentsdb.TagSet
+	// User created the silence at Created, giving Message as the reason.
+	User    string
+	Message string
+	Created time.Time
+	// Recurrence, if set, is a cron-like spec (see ParseRecurrence) of the
+	// times between Start and End at which the silence begins, each time
+	// lasting Length.
+	Recurrence string
+	Length     time.Duration
+	// Forget, if set, forgets silenced alert keys that become unknown, so
+	// that hosts removed during the silence do not linger afterwards.
+	Forget bool
+	// Match modifies how Tags match: search.IgnoreCase ignores case, and
+	// search.Substring matches a pattern anywhere in a value.
+	Match search.MatchFlags
 }
 
 func (s *Silence) MarshalJSON() ([]byte, error) {
@@ -21,16 +43,52 @@ func (s *Silence) MarshalJSON() ([]byte, error) {
 		Start, End time.Time
 		Alert      string
 		Tags       string
+		User       string
+		Message    string
+		Created    time.Time
+		Recurrence string        `json:",omitempty"`
+		Length     time.Duration `json:",omitempty"`
+		Forget     bool          `json:",omitempty"`
 	}{
-		Start: s.Start,
-		End:   s.End,
-		Alert: s.Alert,
-		Tags:  s.Tags.Tags(),
+		Start:      s.Start,
+		End:        s.End,
+		Alert:      s.Alert,
+		Tags:       s.Tags.Tags(),
+		User:       s.User,
+		Message:    s.Message,
+		Created:    s.Created,
+		Recurrence: s.Recurrence,
+		Length:     s.Length,
+		Forget:     s.Forget,
 	})
 }
 
-func (s *Silence) Silenced(now time.Time, alert string, tags opentsdb.TagSet) bool {
+// ActiveUntil returns whether s is in effect at now and, if so, when that
+// ends: End, or for recurring silences the end of the current occurrence.
+func (s *Silence) ActiveUntil(now time.Time) (time.Time, bool) {
 	if now.Before(s.Start) || now.After(s.End) {
+		return time.Time{}, false
+	}
+	if s.Recurrence == "" {
+		return s.End, true
+	}
+	r, err := ParseRecurrence(s.Recurrence)
+	if err != nil {
+		return time.Time{}, false
+	}
+	o, ok := r.Last(now, s.Length)
+	if !ok {
+		return time.Time{}, false
+	}
+	end := o.Add(s.Length)
+	if end.After(s.End) {
+		end = s.End
+	}
+	return end, true
+}
+
+func (s *Silence) Silenced(now time.Time, alert string, tags opentsdb.TagSet) bool {
+	if _, ok := s.ActiveUntil(now); !ok {
 		return false
 	}
 	return s.Matches(alert, tags)
@@ -45,20 +103,103 @@ func (s *Silence) Matches(alert string, tags opentsdb.TagSet) bool {
 		if !ok {
 			return false
 		}
-		matched, _ := Match(pattern, tagv)
-		if !matched {
+		if !matchTag(pattern, tagv, s.Match) {
 			return false
 		}
 	}
 	return true
 }
 
+var tagRegexps = struct {
+	sync.Mutex
+	m map[string]*regexp.Regexp
+}{m: make(map[string]*regexp.Regexp)}
+
+// tagRegexp returns the compiled regular expression of a tag pattern enclosed
+// in slashes, anchored to match whole values unless flags has
+// search.Substring. It returns nil for other patterns.
+func tagRegexp(pattern string, flags search.MatchFlags) (*regexp.Regexp, error) {
+	if len(pattern) < 2 || pattern[0] != '/' || pattern[len(pattern)-1] != '/' {
+		return nil, nil
+	}
+	key := fmt.Sprintf("%d%s", flags, pattern)
+	tagRegexps.Lock()
+	defer tagRegexps.Unlock()
+	if re := tagRegexps.m[key]; re != nil {
+		return re, nil
+	}
+	v := "(?:" + pattern[1:len(pattern)-1] + ")"
+	if flags&search.Substring == 0 {
+		v = "^" + v + "$"
+	}
+	if flags&search.IgnoreCase != 0 {
+		v = "(?i)" + v
+	}
+	re, err := regexp.Compile(v)
+	if err != nil {
+		return nil, err
+	}
+	tagRegexps.m[key] = re
+	return re, nil
+}
+
+// matchTag returns whether tagv matches pattern, a regular expression or
+// glob, with flags as for search.MatchFlag.
+func matchTag(pattern, tagv string, flags search.MatchFlags) bool {
+	re, err := tagRegexp(pattern, flags)
+	if err != nil {
+		return false
+	}
+	if re != nil {
+		return re.MatchString(tagv)
+	}
+	if flags&search.IgnoreCase != 0 {
+		pattern, tagv = strings.ToLower(pattern), strings.ToLower(tagv)
+	}
+	if flags&search.Substring != 0 {
+		pattern = "*" + pattern + "*"
+	}
+	matched, _ := Match(pattern, tagv)
+	return matched
+}
+
 func (s Silence) ID() string {
 	h := sha1.New()
 	fmt.Fprintf(h, "%s|%s|%s%s", s.Start, s.End, s.Alert, s.Tags)
+	if s.Recurrence != "" {
+		fmt.Fprintf(h, "|%s|%s", s.Recurrence, s.Length)
+	}
+	if s.Forget {
+		fmt.Fprint(h, "|forget")
+	}
+	if s.Match != 0 {
+		fmt.Fprintf(h, "|match%d", s.Match)
+	}
 	return fmt.Sprintf("%x", h.Sum(nil))
 }
 
+// forgetSilenced returns the alert keys silenced by a silence with Forget set.
+func (s *Schedule) forgetSilenced() map[expr.AlertKey]bool {
+	aks := make(map[expr.AlertKey]bool)
+	now := time.Now()
+	s.Lock()
+	defer s.Unlock()
+	for _, si := range s.Silence {
+		if !si.Forget {
+			continue
+		}
+		if _, ok := si.ActiveUntil(now); !ok {
+			continue
+		}
+		for ak := range s.status {
+			if si.Matches(ak.Name(), ak.Group()) {
+				aks[ak] = true
+			}
+		}
+	}
+	return aks
+}
+
 // Silenced returns all currently silenced AlertKeys and the time they will be
 // unsilenced.
 func (s *Schedule) Silenced() map[expr.AlertKey]time.Time {
@@ -66,10 +207,14 @@ func (s *Schedule) Silenced() map[expr.AlertKey]time.Time {
 	now := time.Now()
 	s.Lock()
 	for _, si := range s.Silence {
+		until, ok := si.ActiveUntil(now)
+		if !ok {
+			continue
+		}
 		for ak := range s.status {
-			if si.Silenced(now, ak.Name(), ak.Group()) {
-				if aks[ak].Before(si.End) {
-					aks[ak] = si.End
+			if si.Matches(ak.Name(), ak.Group()) {
+				if aks[ak].Before(until) {
+					aks[ak] = until
 				}
 			}
 		}
@@ -78,7 +223,7 @@ func (s *Schedule) Silenced() map[expr.AlertKey]time.Time {
 	return aks
 }
 
-func (s *Schedule) AddSilence(start, end time.Time, alert, tagList string, confirm bool, edit string) (map[expr.AlertKey]bool, error) {
+func newSilence(start, end time.Time, alert, tagList, recurrence string, length time.Duration, user, message string) (*Silence, error) {
 	if start.IsZero() || end.IsZero() {
 		return nil, fmt.Errorf("both start and end must be specified")
 	}
@@ -91,19 +236,49 @@ func (s *Schedule) AddSilence(start, end time.Time, alert, tagList string, confi
 	if alert == "" && tagList == "" {
 		return nil, fmt.Errorf("must specify either alert or tags")
 	}
+	if recurrence != "" {
+		if _, err := ParseRecurrence(recurrence); err != nil {
+			return nil, err
+		}
+		if length <= 0 {
+			return nil, fmt.Errorf("recurring silences require a length")
+		}
+	} else {
+		length = 0
+	}
 	si := &Silence{
-		Start: start,
-		End:   end,
-		Alert: alert,
-		Tags:  make(opentsdb.TagSet),
+		Start:      start,
+		End:        end,
+		Alert:      alert,
+		Tags:       make(opentsdb.TagSet),
+		User:       user,
+		Message:    message,
+		Created:    time.Now().UTC(),
+		Recurrence: recurrence,
+		Length:     length,
 	}
 	if tagList != "" {
 		tags, err := opentsdb.ParseTags(tagList)
 		if err != nil && tags == nil {
 			return nil, err
 		}
+		for _, v := range tags {
+			if _, err := tagRegexp(v, 0); err != nil {
+				return nil, err
+			}
+		}
 		si.Tags = tags
 	}
+	return si, nil
+}
+
+func (s *Schedule) AddSilence(start, end time.Time, alert, tagList, recurrence string, length time.Duration, forget bool, match search.MatchFlags, user, message string, confirm bool, edit string) (map[expr.AlertKey]bool, error) {
+	si, err := newSilence(start, end, alert, tagList, recurrence, length, user, message)
+	if err != nil {
+		return nil, err
+	}
+	si.Forget = forget
+	si.Match = match
 	s.Lock()
 	defer s.Unlock()
 	if confirm {
@@ -121,10 +296,160 @@ func (s *Schedule) AddSilence(start, end time.Time, alert, tagList string, confi
 	return aks, nil
 }
 
-func (s *Schedule) ClearSilence(id string) error {
+// Overlaps returns true if the time ranges of s and o intersect.
+func (s *Silence) Overlaps(o *Silence) bool {
+	return !s.End.Before(o.Start) && !o.End.Before(s.Start)
+}
+
+// Contains returns true if s covers all of o's time range and silences
+// everything o does: its alert is empty or the same, it has at least o's
+// match flags, and each of its tag patterns is also present in o. A
+// recurring s only contains silences with the same recurrence and no longer
+// length.
+func (s *Silence) Contains(o *Silence) bool {
+	if s.Start.After(o.Start) || s.End.Before(o.End) {
+		return false
+	}
+	if s.Recurrence != "" && (s.Recurrence != o.Recurrence || s.Length < o.Length) {
+		return false
+	}
+	if s.Alert != "" && s.Alert != o.Alert {
+		return false
+	}
+	if o.Match&^s.Match != 0 {
+		return false
+	}
+	for k, v := range s.Tags {
+		if o.Tags[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+type SilenceOverlap struct {
+	ID      string
+	Silence *Silence
+	// Contains is true if the existing silence already covers the new one.
+	Contains bool
+}
+
+// SilenceOverlaps returns the existing silences whose time range overlaps the
+// described silence and which match it or at least one of the same known
+// alert keys.
+func (s *Schedule) SilenceOverlaps(start, end time.Time, alert, tagList string) ([]*SilenceOverlap, error) {
+	si, err := newSilence(start, end, alert, tagList, "", 0, "", "")
+	if err != nil {
+		return nil, err
+	}
+	s.Lock()
+	defer s.Unlock()
+	var overlaps []*SilenceOverlap
+	for id, o := range s.Silence {
+		if !o.Overlaps(si) {
+			continue
+		}
+		shared := o.Contains(si) || si.Contains(o)
+		for ak := range s.status {
+			if shared {
+				break
+			}
+			shared = si.Matches(ak.Name(), ak.Group()) && o.Matches(ak.Name(), ak.Group())
+		}
+		if shared {
+			overlaps = append(overlaps, &SilenceOverlap{
+				ID:       id,
+				Silence:  o,
+				Contains: o.Contains(si),
+			})
+		}
+	}
+	return overlaps, nil
+}
+
+// ExtendSilence widens the time range of the existing silence id to also cover
+// start through end.
+func (s *Schedule) ExtendSilence(id string, start, end time.Time) error {
 	s.Lock()
+	defer s.Unlock()
+	si := s.Silence[id]
+	if si == nil {
+		return fmt.Errorf("unknown silence: %s", id)
+	}
+	n := *si
+	if start.Before(n.Start) {
+		n.Start = start
+	}
+	if end.After(n.End) {
+		n.End = end
+	}
 	delete(s.Silence, id)
+	s.Silence[n.ID()] = &n
+	s.Save()
+	return nil
+}
+
+// RedundantSilences returns the IDs of silences that are entirely contained by
+// another silence, mapped to the ID of a silence that contains them.
+func (s *Schedule) RedundantSilences() map[string]string {
+	s.Lock()
+	defer s.Unlock()
+	r := make(map[string]string)
+	for id, si := range s.Silence {
+		for oid, o := range s.Silence {
+			if id != oid && o.Contains(si) {
+				r[id] = oid
+				break
+			}
+		}
+	}
+	return r
+}
+
+// A ClearedSilence records the removal of a silence before its end.
+type ClearedSilence struct {
+	ID      string
+	Silence *Silence
+	User    string
+	Message string
+	Time    time.Time
+}
+
+// maxClearedSilences is the length of the cleared silence audit list.
+const maxClearedSilences = 1000
+
+// ClearSilence removes silence id and records user as having cleared it,
+// giving message as the reason.
+func (s *Schedule) ClearSilence(id, user, message string) error {
+	s.Lock()
+	si := s.Silence[id]
+	if si == nil {
+		s.Unlock()
+		return fmt.Errorf("unknown silence: %s", id)
+	}
+	delete(s.Silence, id)
+	s.SilenceLog = append(s.SilenceLog, &ClearedSilence{
+		ID:      id,
+		Silence: si,
+		User:    user,
+		Message: message,
+		Time:    time.Now().UTC(),
+	})
+	if n := len(s.SilenceLog) - maxClearedSilences; n > 0 {
+		s.SilenceLog = append([]*ClearedSilence(nil), s.SilenceLog[n:]...)
+	}
 	s.Unlock()
 	s.Save()
 	return nil
 }
+
+// SilencesCleared returns the cleared silence audit list, most recent first.
+func (s *Schedule) SilencesCleared() []*ClearedSilence {
+	s.Lock()
+	defer s.Unlock()
+	r := make([]*ClearedSilence, len(s.SilenceLog))
+	for i, c := range s.SilenceLog {
+		r[len(r)-1-i] = c
+	}
+	return r
+}
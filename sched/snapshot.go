@@ -0,0 +1,289 @@
+package sched
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/collect"
+)
+
+// An objectStore is an S3-compatible bucket addressed path style, such as
+// https://s3.amazonaws.com/bucket/prefix. Requests are signed with AWS
+// signature version 4.
+type objectStore struct {
+	endpoint  *url.URL // Scheme and host
+	bucket    string
+	prefix    string
+	region    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+}
+
+func newObjectStore(rawurl, region, accessKey, secretKey string) (*objectStore, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	sp := strings.SplitN(strings.Trim(u.Path, "/"), "/", 2)
+	if sp[0] == "" {
+		return nil, fmt.Errorf("snapshot: no bucket in %s", rawurl)
+	}
+	o := &objectStore{
+		endpoint:  &url.URL{Scheme: u.Scheme, Host: u.Host},
+		bucket:    sp[0],
+		region:    region,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		client:    &http.Client{Timeout: time.Minute * 5},
+	}
+	if len(sp) > 1 && sp[1] != "" {
+		o.prefix = strings.TrimSuffix(sp[1], "/") + "/"
+	}
+	if o.region == "" {
+		o.region = "us-east-1"
+	}
+	return o, nil
+}
+
+func (o *objectStore) do(method, key string, query url.Values, body []byte) (*http.Response, error) {
+	u := *o.endpoint
+	u.Path = "/" + o.bucket + "/" + key
+	if key == "" {
+		u.Path = "/" + o.bucket
+	}
+	u.RawQuery = strings.Replace(query.Encode(), "+", "%20", -1)
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	o.sign(req, body, time.Now().UTC())
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		b, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("snapshot: %s %s: %s: %s", method, key, resp.Status, b)
+	}
+	return resp, nil
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// sign adds AWS signature version 4 headers to req.
+func (o *objectStore) sign(req *http.Request, body []byte, now time.Time) {
+	sum := sha256.Sum256(body)
+	payload := hex.EncodeToString(sum[:])
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("x-amz-content-sha256", payload)
+	req.Header.Set("x-amz-date", amzDate)
+	const signed = "host;x-amz-content-sha256;x-amz-date"
+	canonical := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + payload,
+		"x-amz-date:" + amzDate,
+		"",
+		signed,
+		payload,
+	}, "\n")
+	scope := date + "/" + o.region + "/s3/aws4_request"
+	crSum := sha256.Sum256([]byte(canonical))
+	toSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(crSum[:])
+	key := hmacSHA256([]byte("AWS4"+o.secretKey), date)
+	key = hmacSHA256(key, o.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	sig := hex.EncodeToString(hmacSHA256(key, toSign))
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", o.accessKey, scope, signed, sig))
+}
+
+func (o *objectStore) put(key string, body []byte) error {
+	resp, err := o.do("PUT", o.prefix+key, nil, body)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (o *objectStore) get(key string) ([]byte, error) {
+	resp, err := o.do("GET", o.prefix+key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return ioutil.ReadAll(resp.Body)
+}
+
+func (o *objectStore) delete(key string) error {
+	resp, err := o.do("DELETE", o.prefix+key, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// list returns the keys below the prefix, without the prefix, sorted.
+func (o *objectStore) list() ([]string, error) {
+	var keys []string
+	token := ""
+	for {
+		q := url.Values{"list-type": {"2"}, "prefix": {o.prefix}}
+		if token != "" {
+			q.Set("continuation-token", token)
+		}
+		resp, err := o.do("GET", "", q, nil)
+		if err != nil {
+			return nil, err
+		}
+		var res struct {
+			Contents []struct {
+				Key string
+			}
+			IsTruncated           bool
+			NextContinuationToken string
+		}
+		err = xml.NewDecoder(resp.Body).Decode(&res)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range res.Contents {
+			keys = append(keys, strings.TrimPrefix(c.Key, o.prefix))
+		}
+		if !res.IsTruncated || res.NextContinuationToken == "" {
+			break
+		}
+		token = res.NextContinuationToken
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+const (
+	snapshotPrefix = "bosun-"
+	snapshotSuffix = ".state.gz"
+)
+
+func (s *Schedule) objectStore() (*objectStore, error) {
+	c := s.Conf
+	return newObjectStore(c.SnapshotURL, c.SnapshotRegion, c.SnapshotAccessKey, c.SnapshotSecretKey)
+}
+
+// Snapshot uploads the current state to the snapshot store and deletes all
+// but the newest SnapshotRetain snapshots.
+func (s *Schedule) Snapshot() error {
+	o, err := s.objectStore()
+	if err != nil {
+		return err
+	}
+	buf := new(bytes.Buffer)
+	s.Lock()
+	s.Search.Lock()
+	err = s.encodeState(buf)
+	s.Search.Unlock()
+	s.Unlock()
+	if err != nil {
+		return err
+	}
+	// Zero padded so that lexical order is time order.
+	key := fmt.Sprintf("%s%020d%s", snapshotPrefix, time.Now().Unix(), snapshotSuffix)
+	if err := o.put(key, buf.Bytes()); err != nil {
+		return err
+	}
+	log.Printf("sched: uploaded snapshot %s (%d bytes)", key, buf.Len())
+	keys, err := o.snapshots()
+	if err != nil {
+		return err
+	}
+	for len(keys) > s.Conf.SnapshotRetain {
+		if err := o.delete(keys[0]); err != nil {
+			return err
+		}
+		keys = keys[1:]
+	}
+	return nil
+}
+
+func (o *objectStore) snapshots() ([]string, error) {
+	all, err := o.list()
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, k := range all {
+		if strings.HasPrefix(k, snapshotPrefix) && strings.HasSuffix(k, snapshotSuffix) {
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
+
+// Snapshots periodically uploads state snapshots until the program exits.
+func (s *Schedule) Snapshots() {
+	for _ = range time.Tick(s.Conf.SnapshotInterval) {
+		if err := s.Snapshot(); err != nil {
+			slog.Errorln(err)
+			collect.Add("snapshot.errors", nil, 1)
+			continue
+		}
+		collect.Add("snapshot.uploads", nil, 1)
+	}
+}
+
+// restoreSnapshot downloads the newest snapshot to the state file if the
+// state file does not exist.
+func (s *Schedule) restoreSnapshot() error {
+	if _, err := os.Stat(s.Conf.StateFile); !os.IsNotExist(err) {
+		return nil
+	}
+	o, err := s.objectStore()
+	if err != nil {
+		return err
+	}
+	keys, err := o.snapshots()
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	key := keys[len(keys)-1]
+	b, err := o.get(key)
+	if err != nil {
+		return err
+	}
+	tmp := s.Conf.StateFile + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, s.Conf.StateFile); err != nil {
+		return err
+	}
+	log.Printf("sched: restored state from snapshot %s", key)
+	return nil
+}
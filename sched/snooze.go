@@ -0,0 +1,53 @@
+package sched
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+// SnoozeDurations are the lengths a snooze may have.
+var SnoozeDurations = []time.Duration{
+	time.Minute * 15,
+	time.Hour,
+	time.Hour * 4,
+}
+
+// Snooze withholds the notifications of ak for d, which must be one of
+// SnoozeDurations. Unlike a silence it matches only ak, and unlike an
+// acknowledgement it ends by itself. The snooze is recorded as an action.
+func (s *Schedule) Snooze(user, message string, ak expr.AlertKey, d time.Duration) error {
+	ok := false
+	for _, sd := range SnoozeDurations {
+		ok = ok || d == sd
+	}
+	if !ok {
+		return fmt.Errorf("snooze: unsupported duration %v", d)
+	}
+	s.Lock()
+	defer func() {
+		s.Unlock()
+		s.Save()
+	}()
+	st := s.status[ak]
+	if st == nil {
+		return fmt.Errorf("no such alert key: %v", ak)
+	}
+	st.SnoozedUntil = time.Now().UTC().Add(d)
+	if message == "" {
+		message = fmt.Sprintf("snoozed for %v", d)
+	}
+	s.logAction(user, message, ActionSnooze, st)
+	return nil
+}
+
+// snoozed reports whether notifications for st are withheld by a snooze.
+func (s *Schedule) snoozed(st *State) bool {
+	if !time.Now().Before(st.SnoozedUntil) {
+		return false
+	}
+	log.Printf("suppressing %s while snoozed until %s", st.AlertKey(), st.SnoozedUntil)
+	return true
+}
@@ -0,0 +1,407 @@
+package sched
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"hash/fnv"
+	"io"
+	"math"
+	"os"
+	"reflect"
+	"time"
+)
+
+// The state file is an append-only log of checksummed records. Each record
+// puts or deletes the value of a key in a bucket; loading replays the log
+// into memory. A save appends only the records whose value changed since the
+// previous save, so a crash loses at most the records of the save in
+// progress, and a torn record at the end of the file is discarded when the
+// file is loaded. The log is rewritten with only the live records when more
+// than half of it is overwritten records.
+
+const stateMagic = "bosunkv1"
+
+const (
+	opPut byte = iota
+	opDelete
+)
+
+// compactSize is the file size below which the log is never compacted.
+const compactSize = 1 << 20
+
+var errLegacyState = errors.New("sched: state file is not a key-value store")
+
+type stateDB struct {
+	path    string
+	f       *os.File // nil if read only
+	buckets map[string]map[string][]byte
+	sums    map[string]uint64 // bucket + "\x00" + key -> stateSum of the value written
+	pending bytes.Buffer
+	size    int64 // bytes of records in the file
+	live    int64 // bytes of records in the file not since overwritten
+	lens    map[string]int64
+	redis   *redisConn // commits go to Redis instead of the file if set
+	prefix  string     // of the Redis keys
+}
+
+// readStateDB loads the state file at path. If write is true the file is
+// created if it does not exist, a torn last record is truncated, and
+// subsequent commits append to it.
+func readStateDB(path string, write bool) (*stateDB, error) {
+	db := &stateDB{
+		path:    path,
+		buckets: make(map[string]map[string][]byte),
+		sums:    make(map[string]uint64),
+		lens:    make(map[string]int64),
+	}
+	flag := os.O_RDONLY
+	if write {
+		flag = os.O_RDWR | os.O_CREATE
+	}
+	f, err := os.OpenFile(path, flag, 0644)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if fi.Size() == 0 && write {
+		if _, err := io.WriteString(f, stateMagic); err != nil {
+			f.Close()
+			return nil, err
+		}
+		db.f = f
+		return db, nil
+	}
+	r := bufio.NewReader(f)
+	magic := make([]byte, len(stateMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != stateMagic {
+		f.Close()
+		return nil, errLegacyState
+	}
+	for {
+		op, bucket, key, value, n, err := readRecord(r)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			// A torn or corrupt record ends the log.
+			break
+		}
+		db.apply(op, bucket, key, value, n)
+	}
+	if !write {
+		f.Close()
+		return db, nil
+	}
+	if err := f.Truncate(int64(len(stateMagic)) + db.size); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if _, err := f.Seek(0, os.SEEK_END); err != nil {
+		f.Close()
+		return nil, err
+	}
+	db.f = f
+	return db, nil
+}
+
+func readRecord(r io.Reader) (op byte, bucket, key string, value []byte, n int64, err error) {
+	var hdr [8]byte
+	if _, err = io.ReadFull(r, hdr[:]); err != nil {
+		return
+	}
+	l := binary.BigEndian.Uint32(hdr[:4])
+	payload := make([]byte, l)
+	if _, err = io.ReadFull(r, payload); err != nil {
+		return
+	}
+	if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(hdr[4:]) {
+		err = fmt.Errorf("sched: state record checksum mismatch")
+		return
+	}
+	if len(payload) == 0 {
+		err = fmt.Errorf("sched: empty state record")
+		return
+	}
+	op = payload[0]
+	p := payload[1:]
+	var b, k []byte
+	if b, p, err = readBytes(p); err != nil {
+		return
+	}
+	if k, p, err = readBytes(p); err != nil {
+		return
+	}
+	return op, string(b), string(k), p, int64(len(hdr)) + int64(l), nil
+}
+
+func readBytes(p []byte) (b, rest []byte, err error) {
+	l, n := binary.Uvarint(p)
+	if n <= 0 || uint64(len(p)-n) < l {
+		return nil, nil, fmt.Errorf("sched: malformed state record")
+	}
+	return p[n : n+int(l)], p[n+int(l):], nil
+}
+
+// apply updates the in-memory values with a record of n bytes.
+func (db *stateDB) apply(op byte, bucket, key string, value []byte, n int64) {
+	id := bucket + "\x00" + key
+	db.size += n
+	db.live -= db.lens[id]
+	delete(db.lens, id)
+	b := db.buckets[bucket]
+	switch op {
+	case opPut:
+		if b == nil {
+			b = make(map[string][]byte)
+			db.buckets[bucket] = b
+		}
+		b[key] = value
+		db.lens[id] = n
+		db.live += n
+	case opDelete:
+		// The delete record itself is garbage as soon as it is replayed.
+		delete(b, key)
+		delete(db.sums, id)
+	}
+}
+
+func (db *stateDB) record(op byte, bucket, key string, value []byte) {
+	var payload bytes.Buffer
+	var l [binary.MaxVarintLen64]byte
+	payload.WriteByte(op)
+	payload.Write(l[:binary.PutUvarint(l[:], uint64(len(bucket)))])
+	payload.WriteString(bucket)
+	payload.Write(l[:binary.PutUvarint(l[:], uint64(len(key)))])
+	payload.WriteString(key)
+	payload.Write(value)
+	var hdr [8]byte
+	binary.BigEndian.PutUint32(hdr[:4], uint32(payload.Len()))
+	binary.BigEndian.PutUint32(hdr[4:], crc32.ChecksumIEEE(payload.Bytes()))
+	db.pending.Write(hdr[:])
+	db.pending.Write(payload.Bytes())
+	db.apply(op, bucket, key, value, int64(len(hdr)+payload.Len()))
+}
+
+// Get returns the value of key in bucket, or nil if there is none.
+func (db *stateDB) Get(bucket, key string) []byte {
+	return db.buckets[bucket][key]
+}
+
+// Keys returns the keys of bucket.
+func (db *stateDB) Keys(bucket string) []string {
+	var keys []string
+	for k := range db.buckets[bucket] {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Put gob encodes v as the value of key in bucket. Nothing is written if v
+// is unchanged since it was last put.
+func (db *stateDB) Put(bucket, key string, v interface{}) error {
+	id := bucket + "\x00" + key
+	sum := stateSum(v)
+	if _, present := db.buckets[bucket][key]; present {
+		if prev, ok := db.sums[id]; ok && prev == sum {
+			return nil
+		}
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return fmt.Errorf("sched: encoding %s %s: %v", bucket, key, err)
+	}
+	db.record(opPut, bucket, key, buf.Bytes())
+	db.sums[id] = sum
+	return nil
+}
+
+// Delete removes key from bucket.
+func (db *stateDB) Delete(bucket, key string) {
+	if _, present := db.buckets[bucket][key]; present {
+		db.record(opDelete, bucket, key, nil)
+	}
+}
+
+// Decode decodes the named part of the schedule state into v. It returns
+// io.EOF if db does not have it.
+func (db *stateDB) Decode(name string, v interface{}) error {
+	if !keyedState[name] {
+		raw := db.Get("state", name)
+		if raw == nil {
+			return io.EOF
+		}
+		return gob.NewDecoder(bytes.NewReader(raw)).Decode(v)
+	}
+	m := reflect.ValueOf(v).Elem()
+	if m.IsNil() {
+		m.Set(reflect.MakeMap(m.Type()))
+	}
+	for _, k := range db.Keys(name) {
+		e := reflect.New(m.Type().Elem())
+		if err := gob.NewDecoder(bytes.NewReader(db.Get(name, k))).DecodeValue(e); err != nil {
+			return fmt.Errorf("sched: decoding %s %s: %v", name, k, err)
+		}
+		m.SetMapIndex(reflect.ValueOf(k).Convert(m.Type().Key()), e.Elem())
+	}
+	return nil
+}
+
+// Commit appends the records of all puts and deletes since the last commit
+// to the file and syncs it, compacting the file first if it is mostly
+// overwritten records. It returns the number of bytes written.
+func (db *stateDB) Commit() (int, error) {
+	if db.redis != nil {
+		return db.commitRedis()
+	}
+	if db.f == nil {
+		return 0, fmt.Errorf("sched: state file %s is read only", db.path)
+	}
+	if db.size > compactSize && db.live < db.size/2 {
+		return db.compact()
+	}
+	n := db.pending.Len()
+	if n == 0 {
+		return 0, nil
+	}
+	if _, err := db.f.Write(db.pending.Bytes()); err != nil {
+		return 0, err
+	}
+	db.pending.Reset()
+	return n, db.f.Sync()
+}
+
+// compact writes the live records to a new file and moves it over the state
+// file.
+func (db *stateDB) compact() (int, error) {
+	tmp := db.path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return 0, err
+	}
+	w := bufio.NewWriter(f)
+	w.WriteString(stateMagic)
+	c := &stateDB{
+		buckets: make(map[string]map[string][]byte),
+		lens:    make(map[string]int64),
+	}
+	for bucket, b := range db.buckets {
+		for key, value := range b {
+			c.record(opPut, bucket, key, value)
+			if _, err := c.pending.WriteTo(w); err != nil {
+				f.Close()
+				return 0, err
+			}
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return 0, err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return 0, err
+	}
+	if err := os.Rename(tmp, db.path); err != nil {
+		f.Close()
+		return 0, err
+	}
+	db.f.Close()
+	db.f = f
+	db.size, db.live, db.lens = c.size, c.live, c.lens
+	db.pending.Reset()
+	return int(c.size) + len(stateMagic), nil
+}
+
+// Close closes the file.
+func (db *stateDB) Close() error {
+	if db.f == nil {
+		return nil
+	}
+	err := db.f.Close()
+	db.f = nil
+	return err
+}
+
+// stateSum returns a hash of the exported contents of v that, unlike its gob
+// encoding, does not depend on map iteration order.
+func stateSum(v interface{}) uint64 {
+	h := fnv.New64a()
+	sumValue(h, reflect.ValueOf(v))
+	return h.Sum64()
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func sumValue(h hash.Hash64, v reflect.Value) {
+	var b [8]byte
+	writeUint := func(u uint64) {
+		binary.BigEndian.PutUint64(b[:], u)
+		h.Write(b[:])
+	}
+	switch v.Kind() {
+	case reflect.Invalid:
+		h.Write([]byte{0})
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			h.Write([]byte{0})
+			return
+		}
+		h.Write([]byte{1})
+		if v.Kind() == reflect.Interface {
+			io.WriteString(h, v.Elem().Type().String())
+		}
+		sumValue(h, v.Elem())
+	case reflect.Struct:
+		if v.Type() == timeType {
+			writeUint(uint64(v.Interface().(time.Time).UnixNano()))
+			return
+		}
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				continue
+			}
+			sumValue(h, v.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		writeUint(uint64(v.Len()))
+		for i := 0; i < v.Len(); i++ {
+			sumValue(h, v.Index(i))
+		}
+	case reflect.Map:
+		// Entries are summed so that their order does not matter.
+		writeUint(uint64(v.Len()))
+		var sum uint64
+		for _, k := range v.MapKeys() {
+			e := fnv.New64a()
+			sumValue(e, k)
+			sumValue(e, v.MapIndex(k))
+			sum += e.Sum64()
+		}
+		writeUint(sum)
+	case reflect.String:
+		writeUint(uint64(v.Len()))
+		io.WriteString(h, v.String())
+	case reflect.Bool:
+		if v.Bool() {
+			writeUint(1)
+		} else {
+			writeUint(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeUint(math.Float64bits(v.Float()))
+	}
+}
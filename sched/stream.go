@@ -0,0 +1,103 @@
+package sched
+
+import (
+	"time"
+
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+// maxTransitions is the number of state transitions retained for Stream.
+const maxTransitions = 10000
+
+// A Transition is a change in the status of an alert key.
+type Transition struct {
+	Cursor   int64
+	AlertKey expr.AlertKey
+	From     Status
+	To       Status
+	Time     time.Time
+	Subject  string
+}
+
+// StreamPage is a batch of transitions returned by Stream.
+type StreamPage struct {
+	Transitions []*Transition
+	// Cursor is passed to the next call to Stream to resume after the last
+	// transition returned.
+	Cursor int64
+	// Missed is true if transitions after the requested cursor are no longer
+	// retained, for example after a restart. Clients should resynchronize
+	// from the status endpoints.
+	Missed bool
+}
+
+// streamNow returns the current time in microseconds, which is used as the base
+// for cursors so that they increase across restarts and fit in a JavaScript
+// number.
+func streamNow() int64 {
+	return time.Now().UnixNano() / int64(time.Microsecond)
+}
+
+// addTransition records a transition and wakes any waiting streams. s must be
+// locked.
+func (s *Schedule) addTransition(ak expr.AlertKey, from, to Status, subject string) {
+	t := &Transition{
+		Cursor:   streamNow(),
+		AlertKey: ak,
+		From:     from,
+		To:       to,
+		Time:     time.Now().UTC(),
+		Subject:  subject,
+	}
+	if t.Cursor <= s.streamCursor {
+		t.Cursor = s.streamCursor + 1
+	}
+	s.streamCursor = t.Cursor
+	s.transitions = append(s.transitions, t)
+	if len(s.transitions) > maxTransitions {
+		s.streamStart = s.transitions[0].Cursor
+		s.transitions = s.transitions[1:]
+	}
+	if s.streamWake != nil {
+		close(s.streamWake)
+		s.streamWake = nil
+	}
+}
+
+// Stream returns the transitions after cursor. If there are none it waits up
+// to wait for one to occur. A cursor of 0 returns no transitions and the
+// current cursor, from which to start.
+func (s *Schedule) Stream(cursor int64, wait time.Duration) *StreamPage {
+	timeout := time.After(wait)
+	for {
+		s.Lock()
+		p := &StreamPage{Cursor: s.streamCursor}
+		if cursor == 0 {
+			s.Unlock()
+			return p
+		}
+		if cursor < s.streamStart {
+			p.Missed = true
+			cursor = s.streamStart
+		}
+		for _, t := range s.transitions {
+			if t.Cursor > cursor {
+				p.Transitions = append(p.Transitions, t)
+			}
+		}
+		if len(p.Transitions) > 0 || p.Missed {
+			s.Unlock()
+			return p
+		}
+		if s.streamWake == nil {
+			s.streamWake = make(chan struct{})
+		}
+		wake := s.streamWake
+		s.Unlock()
+		select {
+		case <-wake:
+		case <-timeout:
+			return p
+		}
+	}
+}
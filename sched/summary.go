@@ -0,0 +1,76 @@
+package sched
+
+import (
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bradfitz/slice"
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+// Summary is a compact view of open alerts for status screens.
+type Summary struct {
+	// Status -> number of open alert keys.
+	Status map[string]int
+	// Tag value -> status -> number of open alert keys, for the requested tag
+	// key.
+	ByTag   map[string]map[string]int `json:",omitempty"`
+	NeedAck int
+	Active  int
+	Recent  []*SummaryChange
+}
+
+type SummaryChange struct {
+	AlertKey expr.AlertKey
+	Status   Status
+	Time     time.Time
+	Subject  string `json:",omitempty"`
+}
+
+// Summary returns counts of open alert keys by status, optionally grouped by
+// the values of tag key tagk, and the n most recent state changes.
+func (s *Schedule) Summary(tagk string, n int) *Summary {
+	sum := Summary{
+		Status: make(map[string]int),
+	}
+	if tagk != "" {
+		sum.ByTag = make(map[string]map[string]int)
+	}
+	s.Lock()
+	defer s.Unlock()
+	for ak, st := range s.status {
+		if st.Open {
+			status := st.AbnormalStatus().String()
+			sum.Status[status]++
+			if st.NeedAck {
+				sum.NeedAck++
+			}
+			if st.IsActive() {
+				sum.Active++
+			}
+			if tagk != "" {
+				v := st.Group[tagk]
+				if sum.ByTag[v] == nil {
+					sum.ByTag[v] = make(map[string]int)
+				}
+				sum.ByTag[v][status]++
+			}
+		}
+		if len(st.History) == 0 {
+			continue
+		}
+		last := st.Last()
+		sum.Recent = append(sum.Recent, &SummaryChange{
+			AlertKey: ak,
+			Status:   last.Status,
+			Time:     last.Time,
+			Subject:  st.Subject,
+		})
+	}
+	slice.Sort(sum.Recent, func(i, j int) bool {
+		return sum.Recent[i].Time.After(sum.Recent[j].Time)
+	})
+	if n >= 0 && len(sum.Recent) > n {
+		sum.Recent = sum.Recent[:n]
+	}
+	return &sum
+}
@@ -13,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
 
 	"github.com/bosun-monitor/bosun/conf"
@@ -27,6 +28,7 @@ type Context struct {
 	schedule    *Schedule
 	runHistory  *RunHistory
 	Attachments []*conf.Attachment
+	localizer
 }
 
 func (s *Schedule) Data(rh *RunHistory, st *State, a *conf.Alert, isEmail bool) *Context {
@@ -48,6 +50,23 @@ type unknownContext struct {
 	Group expr.AlertKeys
 
 	schedule *Schedule
+	localizer
+}
+
+// localizer provides template helpers that format times for the notification
+// being rendered.
+type localizer struct {
+	notification *conf.Notification
+}
+
+// LocalTime formats t in the notification's timezone and locale.
+func (l localizer) LocalTime(t time.Time) string {
+	return l.notification.FormatTime(t)
+}
+
+// LocalFormat formats t in the notification's timezone with a Go time layout.
+func (l localizer) LocalFormat(t time.Time, layout string) string {
+	return l.notification.In(t).Format(layout)
 }
 
 func (s *Schedule) unknownData(t time.Time, name string, group expr.AlertKeys) *unknownContext {
@@ -87,6 +106,12 @@ func (c *Context) Ack() string {
 	return u.String()
 }
 
+// Change returns the active change-management record for the alert's tags,
+// or nil if there is none.
+func (c *Context) Change() *ChangeRecord {
+	return c.schedule.ActiveChange(c.Group)
+}
+
 // HostView returns the URL to the host view page.
 func (c *Context) HostView(host string) string {
 	u := c.schedule.URL()
@@ -95,6 +120,17 @@ func (c *Context) HostView(host string) string {
 	return u.String()
 }
 
+// Alias returns the display name of the context's value for tag key tagk.
+func (c *Context) Alias(tagk string) string {
+	return c.schedule.Conf.Alias(tagk, c.Group[tagk])
+}
+
+// AliasGroup returns the context's tags with values replaced by their display
+// names.
+func (c *Context) AliasGroup() opentsdb.TagSet {
+	return c.schedule.Conf.AliasTags(c.Group)
+}
+
 func (c *Context) makeLink(path string, v *url.Values) (string, error) {
 	u := url.URL{
 		Scheme:   "http",
@@ -131,21 +167,119 @@ func (c *Context) Rule() (string, error) {
 	return c.makeLink("/rule", &p)
 }
 
-func (s *Schedule) ExecuteBody(w io.Writer, rh *RunHistory, a *conf.Alert, st *State, isEmail bool) ([]*conf.Attachment, error) {
+// ExecuteBody renders the body template of a for st. Times are localized
+// for notification n, which may be nil.
+func (s *Schedule) ExecuteBody(w io.Writer, rh *RunHistory, a *conf.Alert, st *State, n *conf.Notification, isEmail bool) ([]*conf.Attachment, error) {
 	t := a.Template
 	if t == nil || t.Body == nil {
 		return nil, nil
 	}
 	c := s.Data(rh, st, a, isEmail)
+	c.notification = n
 	return c.Attachments, t.Body.Execute(w, c)
 }
 
-func (s *Schedule) ExecuteSubject(w io.Writer, rh *RunHistory, a *conf.Alert, st *State) error {
+// ExecuteSubject renders the subject template of a for st. Times are
+// localized for notification n, which may be nil.
+func (s *Schedule) ExecuteSubject(w io.Writer, rh *RunHistory, a *conf.Alert, st *State, n *conf.Notification) error {
 	t := a.Template
 	if t == nil || t.Subject == nil {
 		return nil
 	}
-	return t.Subject.Execute(w, s.Data(rh, st, a, false))
+	c := s.Data(rh, st, a, false)
+	c.notification = n
+	return t.Subject.Execute(w, c)
+}
+
+// ExecuteTextBody renders the plain text body template of a for st. Times are
+// localized for notification n, which may be nil. It renders nothing if the
+// template has no text body.
+func (s *Schedule) ExecuteTextBody(w io.Writer, rh *RunHistory, a *conf.Alert, st *State, n *conf.Notification) error {
+	t := a.Template
+	if t == nil || t.TextBody == nil {
+		return nil
+	}
+	c := s.Data(rh, st, a, false)
+	c.notification = n
+	return t.TextBody.Execute(w, c)
+}
+
+// fallbackSubject is used in place of a subject template that fails to
+// render.
+func fallbackSubject(st *State) string {
+	return fmt.Sprintf("%s: %s", st.Last().Status, st.AlertKey())
+}
+
+// fallbackBody is rendered for alerts without a body template, and in place
+// of a body template that fails, so the notification still says what fired
+// and, for a failed template, why it did not render.
+var fallbackBody = template.Must(template.New("fallback").Parse(`
+{{- define "result"}}<p><b>{{.Expr}}</b></p>
+<table>{{range .Computations}}<tr><td>{{.Text}}</td><td>{{.Value}}</td></tr>{{end}}</table>
+{{end -}}
+<p>{{.Last.Status}}: {{.AlertKey}} at {{.Last.Time}}</p>
+{{with .Trigger}}<p>{{.}}</p>
+{{end -}}
+{{with .Last.Crit}}{{template "result" .}}{{end -}}
+{{with .Last.Warn}}{{template "result" .}}{{end -}}
+{{with .Last.Info}}{{template "result" .}}{{end -}}
+{{with .Last.Error}}{{template "result" .}}{{end -}}
+<p><a href="{{.Ack}}">Acknowledge alert</a></p>
+{{with .Err}}<p>The template of this alert failed to render: {{.}}</p>
+{{end -}}
+`))
+
+// executeFallbackBody renders fallbackBody for st with a graph of its
+// triggering series. err is the error of the alert's body template, if any.
+func (s *Schedule) executeFallbackBody(w io.Writer, rh *RunHistory, a *conf.Alert, st *State, n *conf.Notification, isEmail bool, err error) ([]*conf.Attachment, error) {
+	c := s.Data(rh, st, a, isEmail)
+	c.notification = n
+	d := struct {
+		*Context
+		Trigger interface{}
+		Err     string
+	}{Context: c}
+	if err != nil {
+		d.Err = err.Error()
+	}
+	g, gerr := c.GraphTrigger()
+	if gerr != nil {
+		slog.Errorf("%s: graph: %v", st.AlertKey(), gerr)
+	} else {
+		d.Trigger = g
+	}
+	return c.Attachments, fallbackBody.Execute(w, d)
+}
+
+// GraphTrigger graphs the first series queried by the expression that
+// triggered the alert, limited to the alert's tags.
+func (c *Context) GraphTrigger() (interface{}, error) {
+	var r *Result
+	switch ev := c.Last(); {
+	case ev.Crit != nil:
+		r = ev.Crit
+	case ev.Warn != nil:
+		r = ev.Warn
+	case ev.Info != nil:
+		r = ev.Info
+	}
+	if r == nil || r.Expr == "" {
+		return nil, fmt.Errorf("no triggering expression")
+	}
+	e, err := expr.New(r.Expr)
+	if err != nil {
+		return nil, err
+	}
+	var series parse.Node
+	parse.Walk(e.Tree.Root, func(n parse.Node) {
+		if f, ok := n.(*parse.FuncNode); ok && series == nil && f.Return() == parse.TYPE_SERIES {
+			series = f
+		}
+	})
+	if series == nil {
+		return nil, fmt.Errorf("%s: no series in expression", r.Expr)
+	}
+	return c.Graph(series.String())
 }
 
 func (c *Context) eval(v interface{}, filter bool, series bool, autods int) ([]*expr.Result, string, error) {
@@ -171,7 +305,9 @@ func (c *Context) eval(v interface{}, filter bool, series bool, autods int) ([]*
 	if series && e.Root.Return() != parse.TYPE_SERIES {
 		return nil, "", fmt.Errorf("egraph: requires an expression that returns a series")
 	}
-	res, _, err := e.Execute(c.runHistory.Context, nil, c.runHistory.Start, autods, c.Alert.UnjoinedOK, c.schedule.Search, c.schedule.Lookups, c.schedule.Conf.AlertSquelched(c.Alert))
+	o := c.schedule.alertOptions(c.runHistory, c.Alert)
+	o.Autods = autods
+	res, _, err := e.Execute(c.runHistory.TSDB, nil, c.runHistory.Start, c.runHistory.Search, o)
 	if err != nil {
 		return nil, "", fmt.Errorf("%s: %v", v, err)
 	}
@@ -236,6 +372,12 @@ func (c *Context) graph(v interface{}, filter bool) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	return c.render(res, title, fmt.Sprint(v))
+}
+
+// render returns res as an image: an attachment for emails, otherwise inline
+// SVG. alt describes the image.
+func (c *Context) render(res []*expr.Result, title, alt string) (interface{}, error) {
 	var buf bytes.Buffer
 	const width = 800
 	const height = 600
@@ -251,7 +393,7 @@ func (c *Context) graph(v interface{}, filter bool) (interface{}, error) {
 			ContentType: "image/png",
 		})
 		return template.HTML(fmt.Sprintf(`<img alt="%s" src="cid:%s" />`,
-			template.HTMLEscapeString(fmt.Sprint(v)),
+			template.HTMLEscapeString(alt),
 			name,
 		)), nil
 	}
@@ -269,6 +411,30 @@ func (c *Context) GraphAll(v interface{}) (interface{}, error) {
 	return c.graph(v, false)
 }
 
+// Bundle returns the series added under name by bundle() to the result of the
+// alert key.
+func (c *Context) Bundle(name string) (expr.Series, error) {
+	if c.Result == nil || c.Result.Result == nil {
+		return nil, fmt.Errorf("bundle %s: no result", name)
+	}
+	v, ok := c.Result.Bundle[name]
+	if !ok {
+		return nil, fmt.Errorf("bundle %s: no such series", name)
+	}
+	return v, nil
+}
+
+// GraphBundle graphs the series added under name by bundle() without
+// querying again.
+func (c *Context) GraphBundle(name string) (interface{}, error) {
+	v, err := c.Bundle(name)
+	if err != nil {
+		return nil, err
+	}
+	res := []*expr.Result{{Value: v, Group: c.Group}}
+	return c.render(res, name, name)
+}
+
 func (c *Context) GetMeta(metric, name string, v interface{}) (interface{}, error) {
 	var t opentsdb.TagSet
 	switch v := v.(type) {
@@ -0,0 +1,98 @@
+package sched
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/collect"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/conf"
+)
+
+// notifyThrottle caps the number of notifications sent per minute across all
+// notifications. Notifications over the cap are counted per notification and
+// replaced by a single summary message once the minute is over. It is
+// protected by the schedule lock.
+type notifyThrottle struct {
+	minute     int64 // Unix minute that sent and suppressed counts
+	sent       int
+	suppressed map[*conf.Notification]int
+	due        map[*conf.Notification]int // Suppressed in earlier minutes
+}
+
+// allow reports whether n may send a notification at now, and counts it
+// either as sent or as suppressed.
+func (t *notifyThrottle) allow(n *conf.Notification, now time.Time, limit int) bool {
+	if limit <= 0 {
+		return true
+	}
+	t.rollover(now)
+	if t.sent < limit {
+		t.sent++
+		return true
+	}
+	if t.suppressed == nil {
+		t.suppressed = make(map[*conf.Notification]int)
+	}
+	t.suppressed[n]++
+	return false
+}
+
+// rollover starts the minute of now if it is not the current one, moving the
+// suppressed counts of the old minute to those due for a summary.
+func (t *notifyThrottle) rollover(now time.Time) {
+	m := now.Unix() / 60
+	if m == t.minute {
+		return
+	}
+	t.minute, t.sent = m, 0
+	for n, c := range t.suppressed {
+		if t.due == nil {
+			t.due = make(map[*conf.Notification]int)
+		}
+		t.due[n] += c
+	}
+	t.suppressed = nil
+}
+
+// pending returns the time at which suppressed notifications are summarized,
+// or the zero time if there are none.
+func (t *notifyThrottle) pending() time.Time {
+	if len(t.due) != 0 {
+		return time.Unix(t.minute*60, 0)
+	}
+	if len(t.suppressed) == 0 {
+		return time.Time{}
+	}
+	return time.Unix((t.minute+1)*60, 0)
+}
+
+// flush returns and resets the suppressed counts of minutes over at now.
+func (t *notifyThrottle) flush(now time.Time) map[*conf.Notification]int {
+	t.rollover(now)
+	d := t.due
+	t.due = nil
+	return d
+}
+
+// throttled reports whether the notifications per minute cap prevents n from
+// sending now. s must be locked.
+func (s *Schedule) throttled(n *conf.Notification, ak string) bool {
+	if s.throttle.allow(n, time.Now(), s.Conf.NotifyPerMinute) {
+		return false
+	}
+	log.Printf("notificationsPerMinute suppressed %s via %s", ak, n.Name)
+	collect.Add("notifications.suppressed", opentsdb.TagSet{"notification": n.Name}, 1)
+	return true
+}
+
+// sendSuppressed sends one summary message through each notification whose
+// notifications were suppressed in an earlier minute. s must be locked.
+func (s *Schedule) sendSuppressed(now time.Time) {
+	for n, count := range s.throttle.flush(now) {
+		msg := fmt.Sprintf("%d notifications suppressed, see dashboard", count)
+		body := fmt.Sprintf("%d notifications via %s were suppressed because more than %d notifications per minute were sent. See the bosun dashboard for the current alerts.", count, n.Name, s.Conf.NotifyPerMinute)
+		n.Notify([]byte("bosun: "+msg), []byte(body), s.Conf, "bosun.suppressed")
+	}
+}
@@ -0,0 +1,144 @@
+package sched
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+)
+
+// TraceHeader is the HTTP header carrying trace IDs on TSDB queries and API
+// responses.
+const TraceHeader = "X-Bosun-Trace"
+
+// NewTraceID returns a random trace ID.
+func NewTraceID() string {
+	b := make([]byte, 8)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// A TracedCache is an opentsdb.Context that caches query results like
+// opentsdb.Cache and sends the current trace ID with each query.
+type TracedCache struct {
+	Host  string
+	Limit int64 // Response size limit in bytes
+	Trace string
+	// Route returns the hosts a request is sent to, whose results are
+	// merged. If nil, requests are sent to Host.
+	Route func(*opentsdb.Request) ([]string, error)
+
+	client *http.Client
+	cache  map[string]*tracedResult
+}
+
+type tracedResult struct {
+	opentsdb.ResponseSet
+	Err error
+}
+
+func NewTracedCache(host string, limit int64, trace string) *TracedCache {
+	c := &TracedCache{
+		Host:  host,
+		Limit: limit,
+		Trace: trace,
+		cache: make(map[string]*tracedResult),
+	}
+	c.client = &http.Client{
+		Timeout:   opentsdb.DefaultClient.Timeout,
+		Transport: traceTransport{c},
+	}
+	return c
+}
+
+type traceTransport struct {
+	c *TracedCache
+}
+
+func (t traceTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if t.c.Trace != "" {
+		r.Header.Set(TraceHeader, t.c.Trace)
+	}
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func (c *TracedCache) Query(r *opentsdb.Request) (tr opentsdb.ResponseSet, err error) {
+	b, err := json.Marshal(&r)
+	if err != nil {
+		return nil, err
+	}
+	s := string(b)
+	if v, ok := c.cache[s]; ok {
+		return v.ResponseSet, v.Err
+	}
+	defer func() {
+		c.cache[s] = &tracedResult{tr, err}
+	}()
+	hosts := []string{c.Host}
+	if c.Route != nil {
+		if hosts, err = c.Route(r); err != nil {
+			return nil, err
+		}
+	}
+	for _, host := range hosts {
+		var rs opentsdb.ResponseSet
+		rs, err = c.query(host, r)
+		if err != nil {
+			return nil, err
+		}
+		tr = mergeResponses(tr, rs)
+	}
+	return
+}
+
+func (c *TracedCache) query(host string, r *opentsdb.Request) (tr opentsdb.ResponseSet, err error) {
+	resp, err := r.QueryResponse(host, c.client)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	lr := &io.LimitedReader{R: resp.Body, N: c.Limit}
+	err = json.NewDecoder(lr).Decode(&tr)
+	if lr.N == 0 {
+		err = fmt.Errorf("TSDB response too large: limited to %E bytes", float64(c.Limit))
+		return
+	}
+	if err != nil {
+		return
+	}
+	opentsdb.FilterTags(r, tr)
+	return
+}
+
+// mergeResponses adds the series of b to a. Datapoints of series with the
+// same metric and tags in both, which are the same data held by both hosts,
+// are combined.
+func mergeResponses(a, b opentsdb.ResponseSet) opentsdb.ResponseSet {
+	if a == nil {
+		return b
+	}
+	series := make(map[string]*opentsdb.Response)
+	for _, r := range a {
+		series[r.Metric+r.Tags.String()] = r
+	}
+	for _, r := range b {
+		m, ok := series[r.Metric+r.Tags.String()]
+		if !ok {
+			a = append(a, r)
+			continue
+		}
+		if m.DPS == nil {
+			m.DPS = make(map[string]opentsdb.Point)
+		}
+		for k, v := range r.DPS {
+			m.DPS[k] = v
+		}
+	}
+	return a
+}
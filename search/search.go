@@ -1,7 +1,10 @@
 package search
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"regexp"
 	"sort"
 	"strings"
@@ -26,12 +29,95 @@ type Search struct {
 	MetricTags mtsmap
 
 	Last map[string]*pair
+	// tag key + tag value -> unix time first indexed, for tag pairs new to
+	// a non-empty index in the last firstSeenRetention
+	firstSeen map[duple]int64
+	// metrics, tag keys and tag values are stored once
+	strings interner
 
 	sync.RWMutex
-	read *Search
+	read *replica
 	copy bool
 }
 
+// interner returns a single instance of equal strings.
+type interner map[string]string
+
+func (in interner) intern(s string) string {
+	if i, ok := in[s]; ok {
+		return i
+	}
+	in[s] = s
+	return s
+}
+
+// replica is a copy of the index that answers queries while the index is
+// written. Sets are sorted slices, and tag sets are sorted key, value pairs.
+type replica struct {
+	metrics    []string
+	metric     map[duple][]string // tagk + tagv -> metrics
+	tagk       map[string][]string
+	tagv       map[duple][]string
+	metricTags map[string][]tagPairs
+}
+
+// tagPairs is a tag set as key, value, key, value... sorted by key.
+type tagPairs []string
+
+func (t tagPairs) get(k string) (string, bool) {
+	for i := 0; i < len(t); i += 2 {
+		if t[i] == k {
+			return t[i+1], true
+		}
+	}
+	return "", false
+}
+
+func (p present) sorted(in interner) []string {
+	r := make([]string, 0, len(p))
+	for k := range p {
+		r = append(r, in.intern(k))
+	}
+	sort.Strings(r)
+	return r
+}
+
+func newReplica(s *Search) *replica {
+	in := s.strings
+	r := &replica{
+		metric:     make(map[duple][]string, len(s.Metric)),
+		tagk:       make(map[string][]string, len(s.Tagk)),
+		tagv:       make(map[duple][]string, len(s.Tagv)),
+		metricTags: make(map[string][]tagPairs),
+	}
+	for q, p := range s.Metric {
+		r.metric[duple{in.intern(q.A), in.intern(q.B)}] = p.sorted(in)
+	}
+	for m, p := range s.Tagk {
+		m = in.intern(m)
+		r.metrics = append(r.metrics, m)
+		r.tagk[m] = p.sorted(in)
+	}
+	sort.Strings(r.metrics)
+	for q, p := range s.Tagv {
+		r.tagv[duple{in.intern(q.A), in.intern(q.B)}] = p.sorted(in)
+	}
+	for _, mts := range s.MetricTags {
+		keys := make([]string, 0, len(mts.Tags))
+		for k := range mts.Tags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		t := make(tagPairs, 0, len(keys)*2)
+		for _, k := range keys {
+			t = append(t, in.intern(k), in.intern(mts.Tags[k]))
+		}
+		m := in.intern(mts.Metric)
+		r.metricTags[m] = append(r.metricTags[m], t)
+	}
+	return r
+}
+
 type pair struct {
 	points [2]opentsdb.DataPoint
 	index  int
@@ -55,35 +141,6 @@ type duple struct {
 	A, B string
 }
 
-func (q qmap) Copy() qmap {
-	m := make(qmap)
-	for k, v := range q {
-		m[k] = v.Copy()
-	}
-	return m
-}
-func (s smap) Copy() smap {
-	m := make(smap)
-	for k, v := range s {
-		m[k] = v.Copy()
-	}
-	return m
-}
-func (t mtsmap) Copy() mtsmap {
-	m := make(mtsmap)
-	for k, v := range t {
-		m[k] = v
-	}
-	return m
-}
-func (p present) Copy() present {
-	m := make(present)
-	for k, v := range p {
-		m[k] = v
-	}
-	return m
-}
-
 func NewSearch() *Search {
 	s := Search{
 		Metric:     make(qmap),
@@ -91,19 +148,41 @@ func NewSearch() *Search {
 		Tagv:       make(qmap),
 		MetricTags: make(mtsmap),
 		Last:       make(map[string]*pair),
-		read:       new(Search),
+		firstSeen:  make(map[duple]int64),
+		strings:    make(interner),
+		read:       new(replica),
 	}
 	return &s
 }
 
 // Copies current data to the read replica.
 func (s *Search) Copy() {
-	r := new(Search)
-	r.Metric = s.Metric.Copy()
-	r.Tagk = s.Tagk.Copy()
-	r.Tagv = s.Tagv.Copy()
-	r.MetricTags = s.MetricTags.Copy()
-	s.read = r
+	if s.strings == nil {
+		s.strings = make(interner)
+	}
+	s.read = newReplica(s)
+}
+
+// DropReplica frees the read replica. Queries answered from it return
+// nothing until the next Copy, which follows within a minute of the next
+// Index.
+func (s *Search) DropReplica() {
+	s.Lock()
+	s.read = new(replica)
+	s.Unlock()
+}
+
+// Snapshot returns a Search whose queries are answered from the current read
+// replica, unaffected by later calls to Copy. It does not track new data
+// points, so GetLast always returns zero values.
+func (s *Search) Snapshot() *Search {
+	s.RLock()
+	r := s.read
+	s.RUnlock()
+	return &Search{
+		Last: make(map[string]*pair),
+		read: r,
+	}
 }
 
 func (s *Search) Index(mdp opentsdb.MultiDataPoint) {
@@ -115,29 +194,45 @@ func (s *Search) Index(mdp opentsdb.MultiDataPoint) {
 			s.Lock()
 			s.Copy()
 			s.copy = false
+			s.pruneFirstSeen(time.Now().Add(-firstSeenRetention).Unix())
 			s.Unlock()
 		}()
 	}
+	if s.strings == nil {
+		s.strings = make(interner)
+	}
+	known := len(s.Metric) > 0
+	now := time.Now().Unix()
 	for _, dp := range mdp {
 		var mts MetricTagSet
-		mts.Metric = dp.Metric
+		mts.Metric = s.strings.intern(dp.Metric)
 		mts.Tags = dp.Tags
 		key := mts.key()
-		s.MetricTags[key] = mts
+		if _, ok := s.MetricTags[key]; !ok {
+			mts.Tags = make(opentsdb.TagSet, len(dp.Tags))
+			for k, v := range dp.Tags {
+				mts.Tags[s.strings.intern(k)] = s.strings.intern(v)
+			}
+			s.MetricTags[key] = mts
+		}
 		var q duple
-		for k, v := range dp.Tags {
+		for k, v := range mts.Tags {
+			k, v = s.strings.intern(k), s.strings.intern(v)
 			q.A, q.B = k, v
 			if _, ok := s.Metric[q]; !ok {
 				s.Metric[q] = make(present)
+				if known {
+					s.firstSeen[q] = now
+				}
 			}
-			s.Metric[q][dp.Metric] = struct{}{}
+			s.Metric[q][mts.Metric] = struct{}{}
 
-			if _, ok := s.Tagk[dp.Metric]; !ok {
-				s.Tagk[dp.Metric] = make(present)
+			if _, ok := s.Tagk[mts.Metric]; !ok {
+				s.Tagk[mts.Metric] = make(present)
 			}
-			s.Tagk[dp.Metric][k] = struct{}{}
+			s.Tagk[mts.Metric][k] = struct{}{}
 
-			q.A, q.B = dp.Metric, k
+			q.A, q.B = mts.Metric, k
 			if _, ok := s.Tagv[q]; !ok {
 				s.Tagv[q] = make(present)
 			}
@@ -156,13 +251,35 @@ func (s *Search) Index(mdp opentsdb.MultiDataPoint) {
 	s.Unlock()
 }
 
+// MatchFlags modify the semantics of MatchFlag.
+type MatchFlags int
+
+const (
+	// IgnoreCase matches regardless of letter case.
+	IgnoreCase MatchFlags = 1 << iota
+	// Substring matches search anywhere in a value instead of against the
+	// entire value.
+	Substring
+)
+
 // Match returns all matching values against search. search is a regex, except
 // that `.` is literal, `*` can be used for `.*`, and the entire string is
 // searched (`^` and `&` added to ends of search).
 func Match(search string, values []string) ([]string, error) {
+	return MatchFlag(search, values, 0)
+}
+
+// MatchFlag is like Match, with flags changing case sensitivity and
+// anchoring.
+func MatchFlag(search string, values []string, flags MatchFlags) ([]string, error) {
 	v := strings.Replace(search, ".", `\.`, -1)
 	v = strings.Replace(v, "*", ".*", -1)
-	v = "^" + v + "$"
+	if flags&Substring == 0 {
+		v = "^" + v + "$"
+	}
+	if flags&IgnoreCase != 0 {
+		v = "(?i)" + v
+	}
 	re, err := regexp.Compile(v)
 	if err != nil {
 		return nil, err
@@ -176,38 +293,99 @@ func Match(search string, values []string) ([]string, error) {
 	return nvs, nil
 }
 
-var errNotFloat = fmt.Errorf("last: expected float64")
+// Errors returned by GetLast.
+var (
+	// ErrNoData means no data point has been seen for the series.
+	ErrNoData = errors.New("last: no data")
+	// ErrNeedTwoPoints means a counter has only one data point so far.
+	ErrNeedTwoPoints = errors.New("last: need two data points")
+)
+
+// A NotNumberError is returned by GetLast when the most recent value of a
+// series is not numeric.
+type NotNumberError struct {
+	Value interface{}
+}
+
+func (e *NotNumberError) Error() string {
+	return fmt.Sprintf("last: expected a number, got %T", e.Value)
+}
+
+// toFloat converts a data point value to a float64.
+func toFloat(v interface{}) (float64, error) {
+	switch v := v.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case uint32:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+	case json.Number:
+		return v.Float64()
+	}
+	return 0, &NotNumberError{v}
+}
 
-// Last returns the value of the most recent data point for the given metric and
-// tag. tags should be of the form "{key=val,key2=val2}". If diff is true, the
-// value is treated as a counter. err is non nil if there is no match.
+// CounterDelta returns the increase of a counter from prev to cur. A
+// decrease is a wrap if prev was within 10% of the 32 or 64 bit maximum, and
+// otherwise a reset, in which case the counter is assumed to have restarted
+// at zero.
+func CounterDelta(prev, cur float64) float64 {
+	if cur >= prev {
+		return cur - prev
+	}
+	for _, max := range []float64{math.MaxUint32, math.MaxUint64} {
+		if prev <= max && prev >= max*0.9 {
+			return max - prev + cur + 1
+		}
+	}
+	return cur
+}
+
+// GetLast returns the value of the most recent data point for the given
+// metric and tag. tags should be of the form "{key=val,key2=val2}". If diff
+// is true, the value is treated as a counter and its per second rate is
+// returned. err is ErrNoData if nothing has been seen, ErrNeedTwoPoints if
+// diff is true and only one point has been seen, or a *NotNumberError.
 func (s *Search) GetLast(metric, tags string, diff bool) (v float64, err error) {
 	s.RLock()
+	defer s.RUnlock()
 	p := s.Last[metric+tags]
-	if p != nil {
-		var ok bool
-		e := p.points[(p.index+1)%2]
-		v, ok = e.Value.(float64)
-		if !ok {
-			err = errNotFloat
-		}
-		if diff {
-			o := p.points[p.index%2]
-			ov, ok := o.Value.(float64)
-			if !ok {
-				err = errNotFloat
-			}
-			if o.Timestamp == 0 || e.Timestamp == 0 {
-				err = fmt.Errorf("last: need two data points")
-			}
-			v = (v - ov) / float64(e.Timestamp-o.Timestamp)
-		}
+	if p == nil {
+		return 0, ErrNoData
 	}
-	s.RUnlock()
-	return
+	e := p.points[(p.index+1)%2]
+	if e.Timestamp == 0 {
+		return 0, ErrNoData
+	}
+	if v, err = toFloat(e.Value); err != nil || !diff {
+		return
+	}
+	o := p.points[p.index%2]
+	if o.Timestamp == 0 || o.Timestamp == e.Timestamp {
+		return 0, ErrNeedTwoPoints
+	}
+	ov, err := toFloat(o.Value)
+	if err != nil {
+		return 0, err
+	}
+	return CounterDelta(ov, v) / float64(e.Timestamp-o.Timestamp), nil
 }
 
 func (s *Search) Expand(q *opentsdb.Query) error {
+	return s.ExpandFlag(q, 0)
+}
+
+// ExpandFlag is like Expand, matching wildcard tag values with MatchFlag.
+func (s *Search) ExpandFlag(q *opentsdb.Query, flags MatchFlags) error {
 	for k, ov := range q.Tags {
 		var nvs []string
 		for _, v := range strings.Split(ov, "|") {
@@ -216,7 +394,7 @@ func (s *Search) Expand(q *opentsdb.Query) error {
 				nvs = append(nvs, v)
 			} else {
 				vs := s.TagValuesByMetricTagKey(q.Metric, k)
-				ns, err := Match(v, vs)
+				ns, err := MatchFlag(v, vs, flags)
 				if err != nil {
 					return err
 				}
@@ -232,14 +410,125 @@ func (s *Search) Expand(q *opentsdb.Query) error {
 }
 
 func (s *Search) UniqueMetrics() []string {
-	metrics := make([]string, len(s.Tagk))
-	i := 0
-	for k := range s.read.Tagk {
-		metrics[i] = k
-		i++
+	return append([]string{}, s.read.metrics...)
+}
+
+// LastTimestamp returns the timestamp of the newest data point indexed for
+// metric and tags, or 0 if there is none.
+func (s *Search) LastTimestamp(metric string, tags opentsdb.TagSet) int64 {
+	mts := MetricTagSet{Metric: metric, Tags: tags}
+	s.RLock()
+	defer s.RUnlock()
+	p := s.Last[mts.key()]
+	if p == nil {
+		return 0
 	}
-	sort.Strings(metrics)
-	return metrics
+	var last int64
+	for _, dp := range p.points {
+		if dp.Timestamp > last {
+			last = dp.Timestamp
+		}
+	}
+	return last
+}
+
+// MetricStats summarizes the index entries of one metric.
+type MetricStats struct {
+	TagKeys  []string
+	Tagsets  int   // Distinct tag sets seen
+	Active   int   // Tag sets with a data point at or after the cutoff
+	LastSeen int64 // Unix time of the newest data point
+}
+
+// Stats returns MetricStats for every indexed metric. Tag sets whose newest
+// data point is at or after since are counted as active.
+func (s *Search) Stats(since int64) map[string]*MetricStats {
+	s.RLock()
+	defer s.RUnlock()
+	m := make(map[string]*MetricStats)
+	for key, mts := range s.MetricTags {
+		st := m[mts.Metric]
+		if st == nil {
+			st = new(MetricStats)
+			for k := range s.Tagk[mts.Metric] {
+				st.TagKeys = append(st.TagKeys, k)
+			}
+			sort.Strings(st.TagKeys)
+			m[mts.Metric] = st
+		}
+		st.Tagsets++
+		var last int64
+		if p := s.Last[key]; p != nil {
+			for _, dp := range p.points {
+				t := dp.Timestamp
+				if t > 1e12 {
+					// Milliseconds
+					t /= 1000
+				}
+				if t > last {
+					last = t
+				}
+			}
+		}
+		if last >= since {
+			st.Active++
+		}
+		if last > st.LastSeen {
+			st.LastSeen = last
+		}
+	}
+	return m
+}
+
+// firstSeenRetention is how long FirstSeen remembers new tag pairs.
+const firstSeenRetention = time.Hour * 24
+
+func (s *Search) pruneFirstSeen(before int64) {
+	for q, t := range s.firstSeen {
+		if t < before {
+			delete(s.firstSeen, q)
+		}
+	}
+}
+
+// FirstSeen returns when the tag pair tagk=tagv was first indexed, if it
+// was new to an already populated index in the last day. Otherwise it returns
+// the zero time.
+func (s *Search) FirstSeen(tagk, tagv string) time.Time {
+	s.RLock()
+	defer s.RUnlock()
+	t, ok := s.firstSeen[duple{tagk, tagv}]
+	if !ok {
+		return time.Time{}
+	}
+	return time.Unix(t, 0)
+}
+
+// IndexSize counts the entries of a Search.
+type IndexSize struct {
+	Metrics   int
+	TagKeys   int // Distinct metric and tag key pairs
+	TagValues int // Distinct metric, tag key and tag value triples
+	TagSets   int
+	Last      int // Tag sets with recent data points
+}
+
+// Size returns the number of entries in the index.
+func (s *Search) Size() IndexSize {
+	s.RLock()
+	defer s.RUnlock()
+	z := IndexSize{
+		Metrics: len(s.Tagk),
+		TagSets: len(s.MetricTags),
+		Last:    len(s.Last),
+	}
+	for _, keys := range s.Tagk {
+		z.TagKeys += len(keys)
+	}
+	for _, values := range s.Tagv {
+		z.TagValues += len(values)
+	}
+	return z
 }
 
 func (s *Search) TagValuesByTagKey(Tagk string) []string {
@@ -261,30 +550,15 @@ func (s *Search) TagValuesByTagKey(Tagk string) []string {
 }
 
 func (s *Search) MetricsByTagPair(Tagk, Tagv string) []string {
-	r := make([]string, 0)
-	for k := range s.read.Metric[duple{Tagk, Tagv}] {
-		r = append(r, k)
-	}
-	sort.Strings(r)
-	return r
+	return append([]string{}, s.read.metric[duple{Tagk, Tagv}]...)
 }
 
 func (s *Search) TagKeysByMetric(Metric string) []string {
-	r := make([]string, 0)
-	for k := range s.read.Tagk[Metric] {
-		r = append(r, k)
-	}
-	sort.Strings(r)
-	return r
+	return append([]string{}, s.read.tagk[Metric]...)
 }
 
 func (s *Search) tagValuesByMetricTagKey(Metric, Tagk string) []string {
-	r := make([]string, 0)
-	for k := range s.read.Tagv[duple{Metric, Tagk}] {
-		r = append(r, k)
-	}
-	sort.Strings(r)
-	return r
+	return append([]string{}, s.read.tagv[duple{Metric, Tagk}]...)
 }
 
 func (s *Search) TagValuesByMetricTagKey(Metric, Tagk string) []string {
@@ -293,22 +567,20 @@ func (s *Search) TagValuesByMetricTagKey(Metric, Tagk string) []string {
 
 func (s *Search) FilteredTagValuesByMetricTagKey(Metric, Tagk string, tsf map[string]string) []string {
 	tagvset := make(map[string]bool)
-	for _, mts := range s.read.MetricTags {
-		if Metric == mts.Metric {
-			match := true
-			if Tagv, ok := mts.Tags[Tagk]; ok {
-				for tpk, tpv := range tsf {
-					if v, ok := mts.Tags[tpk]; ok {
-						if !(v == tpv) {
-							match = false
-						}
-					} else {
+	for _, tags := range s.read.metricTags[Metric] {
+		match := true
+		if Tagv, ok := tags.get(Tagk); ok {
+			for tpk, tpv := range tsf {
+				if v, ok := tags.get(tpk); ok {
+					if !(v == tpv) {
 						match = false
 					}
+				} else {
+					match = false
 				}
-				if match {
-					tagvset[Tagv] = true
-				}
+			}
+			if match {
+				tagvset[Tagv] = true
 			}
 		}
 	}
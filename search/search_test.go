@@ -0,0 +1,142 @@
+package search
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+)
+
+func TestGetLast(t *testing.T) {
+	s := NewSearch()
+	if _, err := s.GetLast("m", "{host=a}", false); err != ErrNoData {
+		t.Fatalf("expected ErrNoData, got %v", err)
+	}
+	tags := opentsdb.TagSet{"host": "a"}
+	s.Index(opentsdb.MultiDataPoint{{Metric: "m", Timestamp: 10, Value: int64(4294967290), Tags: tags}})
+	if v, err := s.GetLast("m", "{host=a}", false); err != nil || v != 4294967290 {
+		t.Fatalf("expected integer value, got %v, %v", v, err)
+	}
+	if _, err := s.GetLast("m", "{host=a}", true); err != ErrNeedTwoPoints {
+		t.Fatalf("expected ErrNeedTwoPoints, got %v", err)
+	}
+	// Wraps past the 32 bit maximum.
+	s.Index(opentsdb.MultiDataPoint{{Metric: "m", Timestamp: 20, Value: 4.0, Tags: tags}})
+	if v, err := s.GetLast("m", "{host=a}", true); err != nil || v != 1 {
+		t.Fatalf("expected rate 1 after wrap, got %v, %v", v, err)
+	}
+	s.Index(opentsdb.MultiDataPoint{{Metric: "m", Timestamp: 30, Value: "x", Tags: tags}})
+	if _, err := s.GetLast("m", "{host=a}", false); err == nil {
+		t.Fatal("expected error")
+	} else if _, ok := err.(*NotNumberError); !ok {
+		t.Fatalf("expected NotNumberError, got %v", err)
+	}
+}
+
+func TestMatchFlag(t *testing.T) {
+	values := []string{"ny-web01", "NY-web02", "la-web01"}
+	tests := []struct {
+		search string
+		flags  MatchFlags
+		expect int
+	}{
+		{"ny-*", 0, 1},
+		{"ny-*", IgnoreCase, 2},
+		{"web01", 0, 0},
+		{"web01", Substring, 2},
+		{"NY", Substring | IgnoreCase, 2},
+	}
+	for _, test := range tests {
+		m, err := MatchFlag(test.search, values, test.flags)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(m) != test.expect {
+			t.Errorf("%q with flags %d: expected %d matches, got %v", test.search, test.flags, test.expect, m)
+		}
+	}
+}
+
+func TestExpandFlag(t *testing.T) {
+	s := NewSearch()
+	s.Index(opentsdb.MultiDataPoint{
+		{Metric: "cpu", Timestamp: 10, Value: 1, Tags: opentsdb.TagSet{"host": "ny-web01"}},
+		{Metric: "cpu", Timestamp: 10, Value: 1, Tags: opentsdb.TagSet{"host": "NY-web02"}},
+		{Metric: "cpu", Timestamp: 10, Value: 1, Tags: opentsdb.TagSet{"host": "la-db01"}},
+	})
+	s.Lock()
+	s.Copy()
+	s.Unlock()
+	tests := []struct {
+		tag    string
+		flags  MatchFlags
+		expect string
+	}{
+		{"ny-*", 0, "ny-web01"},
+		{"ny-*", IgnoreCase, "NY-web02|ny-web01"},
+		{"web*", Substring, "NY-web02|ny-web01"},
+	}
+	for _, test := range tests {
+		q := &opentsdb.Query{Metric: "cpu", Tags: opentsdb.TagSet{"host": test.tag}}
+		if err := s.ExpandFlag(q, test.flags); err != nil {
+			t.Fatal(err)
+		}
+		if q.Tags["host"] != test.expect {
+			t.Errorf("%q with flags %d: expected %s, got %s", test.tag, test.flags, test.expect, q.Tags["host"])
+		}
+	}
+	q := &opentsdb.Query{Metric: "cpu", Tags: opentsdb.TagSet{"host": "web*"}}
+	if err := s.ExpandFlag(q, 0); err == nil {
+		t.Error("expected error for unmatched anchored pattern")
+	}
+}
+
+func TestFirstSeen(t *testing.T) {
+	s := NewSearch()
+	s.Index(opentsdb.MultiDataPoint{{Metric: "m", Timestamp: 10, Value: 1, Tags: opentsdb.TagSet{"host": "a"}}})
+	if !s.FirstSeen("host", "a").IsZero() {
+		t.Error("tag pairs of an empty index should not be new")
+	}
+	s.Index(opentsdb.MultiDataPoint{{Metric: "m", Timestamp: 10, Value: 1, Tags: opentsdb.TagSet{"host": "b"}}})
+	if s.FirstSeen("host", "b").IsZero() {
+		t.Error("expected host=b to be new")
+	}
+	s.pruneFirstSeen(time.Now().Add(time.Minute).Unix())
+	if !s.FirstSeen("host", "b").IsZero() {
+		t.Error("expected host=b to be pruned")
+	}
+}
+
+func TestReplica(t *testing.T) {
+	s := NewSearch()
+	s.Index(opentsdb.MultiDataPoint{
+		{Metric: "cpu", Timestamp: 10, Value: 1, Tags: opentsdb.TagSet{"host": "b", "dc": "ny"}},
+		{Metric: "cpu", Timestamp: 10, Value: 1, Tags: opentsdb.TagSet{"host": "a", "dc": "ny"}},
+		{Metric: "cpu", Timestamp: 10, Value: 1, Tags: opentsdb.TagSet{"host": "c", "dc": "la"}},
+		{Metric: "mem", Timestamp: 10, Value: 1, Tags: opentsdb.TagSet{"host": "a"}},
+	})
+	if m := s.UniqueMetrics(); len(m) != 0 {
+		t.Fatalf("expected empty replica before Copy, got %v", m)
+	}
+	s.Lock()
+	s.Copy()
+	s.Unlock()
+	eq := func(name string, got []string, expect ...string) {
+		if strings.Join(got, ",") != strings.Join(expect, ",") {
+			t.Errorf("%s: expected %v, got %v", name, expect, got)
+		}
+	}
+	eq("metrics", s.UniqueMetrics(), "cpu", "mem")
+	eq("metrics of host=a", s.MetricsByTagPair("host", "a"), "cpu", "mem")
+	eq("tag keys of cpu", s.TagKeysByMetric("cpu"), "dc", "host")
+	eq("hosts of cpu", s.TagValuesByMetricTagKey("cpu", "host"), "a", "b", "c")
+	eq("hosts", s.TagValuesByTagKey("host"), "a", "b", "c")
+	eq("hosts of cpu in ny", s.FilteredTagValuesByMetricTagKey("cpu", "host", map[string]string{"dc": "ny"}), "a", "b")
+	if m := s.MetricsByTagPair("host", "x"); m == nil || len(m) != 0 {
+		t.Errorf("expected empty non-nil metrics, got %#v", m)
+	}
+	if len(s.strings) != 9 {
+		t.Errorf("expected 9 interned strings, got %v", s.strings)
+	}
+}
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -99,14 +100,18 @@ func Graph(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interf
 			}
 		}
 		queries[i] = fmt.Sprintf(`q("%v", "%v", "%v")`, q, start, end)
-		if err := schedule.Search.Expand(q); err != nil {
+		if err := schedule.Search.ExpandFlag(q, matchFlags(r.URL.Query())); err != nil {
 			return nil, err
 		}
 	}
+	tsdb := tsdbProvider(w).OpenTSDB()
+	if tsdb == nil {
+		return nil, fmt.Errorf("tsdbHost not configured")
+	}
 	var tr opentsdb.ResponseSet
 	b, _ := json.MarshalIndent(oreq, "", "  ")
 	t.StepCustomTiming("tsdb", "query", string(b), func() {
-		tr, err = oreq.Query(schedule.Conf.TsdbHost)
+		tr, err = tsdb.Query(oreq)
 	})
 	if err != nil {
 		return nil, err
@@ -167,10 +172,37 @@ func ExprGraph(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (in
 	if err != nil {
 		return nil, err
 	}
-	q := string(b)
+	format := vars["format"]
+	if format == "" {
+		format = "svg"
+	}
+	return renderExpr(t, w, r, string(b), format)
+}
+
+// Render renders the expression in the q parameter as an svg or png chart.
+// Optional parameters are width, height, legend (a key position or "none"),
+// start and end (durations ago, such as 1h, or unix timestamps), and autods.
+func Render(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	format := r.FormValue("format")
+	if format == "" {
+		format = "png"
+	}
+	return renderExpr(t, w, r, r.FormValue("q"), format)
+}
+
+func renderExpr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request, q, format string) (interface{}, error) {
 	if len(q) == 0 {
 		return nil, fmt.Errorf("missing expression")
 	}
+	var contentType string
+	switch format {
+	case "svg":
+		contentType = "image/svg+xml"
+	case "png":
+		contentType = "image/png"
+	default:
+		return nil, fmt.Errorf("unknown graph format: %s", format)
+	}
 	autods := 1000
 	if a := r.FormValue("autods"); a != "" {
 		i, err := strconv.Atoi(a)
@@ -179,6 +211,22 @@ func ExprGraph(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (in
 		}
 		autods = i
 	}
+	width, height := 800, 600
+	for _, d := range []struct {
+		name string
+		v    *int
+	}{{"width", &width}, {"height", &height}} {
+		if v := r.FormValue(d.name); v != "" {
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, err
+			}
+			if i < 50 || i > 4000 {
+				return nil, fmt.Errorf("%s must be between 50 and 4000", d.name)
+			}
+			*d.v = i
+		}
+	}
 	now := time.Now().UTC()
 	if n := r.FormValue("now"); n != "" {
 		i, err := strconv.ParseInt(n, 10, 64)
@@ -187,22 +235,64 @@ func ExprGraph(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (in
 		}
 		now = time.Unix(i, 0).UTC()
 	}
+	o := sched.GraphOptions{Legend: r.FormValue("legend")}
+	var err error
+	if o.End, err = graphTime(r.FormValue("end"), now); err != nil {
+		return nil, err
+	}
+	if !o.End.IsZero() {
+		now = o.End
+	}
+	if o.Start, err = graphTime(r.FormValue("start"), now); err != nil {
+		return nil, err
+	}
+	if !o.Start.IsZero() {
+		if o.End.IsZero() {
+			o.End = now
+		}
+		if !o.Start.Before(o.End) {
+			return nil, fmt.Errorf("start must be before end")
+		}
+	}
 	e, err := expr.New(q)
 	if err != nil {
 		return nil, err
 	} else if e.Root.Return() != parse.TYPE_SERIES {
 		return nil, fmt.Errorf("egraph: requires an expression that returns a series")
 	}
-	res, _, err := e.Execute(opentsdb.NewCache(schedule.Conf.TsdbHost, schedule.Conf.ResponseLimit), t, now, autods, false, schedule.Search, schedule.Lookups, nil)
+	eo := schedule.ExprOptions()
+	eo.Autods = autods
+	eo.Match = matchFlags(r.URL.Query())
+	eo.Start = o.Start
+	res, _, err := e.Execute(tsdbProvider(w), t, now, schedule.Search, eo)
 	if err != nil {
 		return nil, err
 	}
-	if err := schedule.ExprSVG(t, w, 800, 600, res.Results, q, now); err != nil {
+	buf := new(bytes.Buffer)
+	if err := schedule.ExprRender(t, buf, format, width, height, res.Results, q, now, o); err != nil {
 		return nil, err
 	}
+	w.Header().Set("Content-Type", contentType)
+	buf.WriteTo(w)
 	return nil, nil
 }
 
+// graphTime parses v as a unix timestamp or as a duration before now. An
+// empty v gives the zero time.
+func graphTime(v string, now time.Time) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+	if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(i, 0).UTC(), nil
+	}
+	d, err := opentsdb.ParseDuration(v)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return now.Add(-time.Duration(d)), nil
+}
+
 func makeChart(r opentsdb.ResponseSet, m_units map[string]string) ([]*chartSeries, error) {
 	var series []*chartSeries
 	for _, resp := range r {
@@ -0,0 +1,223 @@
+package web
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/MiniProfiler/go/miniprofiler"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/expr"
+	"github.com/bosun-monitor/bosun/expr/parse"
+	"github.com/bosun-monitor/bosun/sched"
+)
+
+// embedSignature returns the signature of a request for path with the query
+// parameters v, ignoring any sig parameter. The path is signed so that a
+// signature is only valid for the endpoint it was issued for.
+func embedSignature(path string, v url.Values) string {
+	c := make(url.Values)
+	for k, vs := range v {
+		if k != "sig" {
+			c[k] = vs
+		}
+	}
+	mac := hmac.New(sha256.New, []byte(schedule.Conf.EmbedKey))
+	mac.Write([]byte(path + "?" + c.Encode()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// embedCheck verifies the signature and expiry of an embed request and sets
+// the framing and CORS headers. Embeds are disabled unless embedKey is set.
+func embedCheck(w http.ResponseWriter, r *http.Request) (refresh time.Duration, err error) {
+	if schedule.Conf.EmbedKey == "" {
+		return 0, fmt.Errorf("embedding disabled: embedKey not set")
+	}
+	r.ParseForm()
+	sig, err := hex.DecodeString(r.Form.Get("sig"))
+	if err != nil || !hmac.Equal(sig, mustHex(embedSignature(r.URL.Path, r.Form))) {
+		return 0, fmt.Errorf("bad signature")
+	}
+	if e := r.Form.Get("expires"); e != "" {
+		i, err := strconv.ParseInt(e, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		if time.Now().Unix() > i {
+			return 0, fmt.Errorf("embed URL expired")
+		}
+	}
+	refresh = schedule.Conf.EmbedRefresh
+	if v := r.Form.Get("refresh"); v != "" {
+		d, err := opentsdb.ParseDuration(v)
+		if err != nil {
+			return 0, err
+		}
+		refresh = time.Duration(d)
+	}
+	if refresh != 0 && refresh < time.Second*10 {
+		refresh = time.Second * 10
+	}
+	if a := schedule.Conf.EmbedAncestors; a != "" {
+		w.Header().Set("Content-Security-Policy", "frame-ancestors "+a)
+	}
+	if o := schedule.Conf.EmbedOrigin; o != "" {
+		w.Header().Set("Access-Control-Allow-Origin", o)
+	}
+	return refresh, nil
+}
+
+func mustHex(s string) []byte {
+	b, _ := hex.DecodeString(s)
+	return b
+}
+
+// EmbedSign returns a signed URL for the embed endpoint given by the path
+// parameter (graph or status). All other parameters are copied to the URL. A
+// duration parameter makes the URL expire after that long. Signing requires
+// the apiToken, as anyone able to sign can embed any expression.
+func EmbedSign(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if schedule.Conf.EmbedKey == "" {
+		return nil, fmt.Errorf("embedding disabled: embedKey not set")
+	}
+	if schedule.Conf.APIToken == "" {
+		return nil, fmt.Errorf("embed signing disabled: apiToken not set")
+	}
+	if err := checkToken(r); err != nil {
+		return nil, err
+	}
+	r.ParseForm()
+	p := r.Form.Get("path")
+	switch p {
+	case "graph", "status":
+	default:
+		return nil, fmt.Errorf("unknown embed path: %s", p)
+	}
+	v := make(url.Values)
+	for k, vs := range r.Form {
+		switch k {
+		case "path", "duration", "sig", "expires":
+		default:
+			v[k] = vs
+		}
+	}
+	if d := r.Form.Get("duration"); d != "" {
+		od, err := opentsdb.ParseDuration(d)
+		if err != nil {
+			return nil, err
+		}
+		v.Set("expires", strconv.FormatInt(time.Now().Add(time.Duration(od)).Unix(), 10))
+	}
+	path := "/api/embed/" + p
+	v.Set("sig", embedSignature(path, v))
+	return path + "?" + v.Encode(), nil
+}
+
+var embedTemplate = template.Must(template.New("embed").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+{{if .Refresh}}<meta http-equiv="refresh" content="{{.Refresh}}">{{end}}
+<title>{{.Title}}</title>
+<style>
+body { margin: 0; font-family: sans-serif; font-size: 13px; }
+table { border-collapse: collapse; width: 100%; }
+td { padding: 2px 6px; border-bottom: 1px solid #eee; }
+.normal { background: #dff0d8; }
+.warning { background: #fcf8e3; }
+.critical, .error { background: #f2dede; }
+.unknown { background: #d9edf7; }
+</style>
+</head>
+<body>
+{{.Body}}
+{{with .States}}<table>
+{{range .}}<tr class="{{.Last.Status}}"><td>{{.Last.Status}}</td><td>{{.AlertKey}}</td><td>{{.Subject}}</td></tr>
+{{end}}</table>{{end}}
+{{if .Empty}}<p>No open alerts.</p>{{end}}
+</body>
+</html>
+`))
+
+type embedData struct {
+	Title   string
+	Refresh int
+	Body    template.HTML
+	States  []sched.State
+	Empty   bool
+}
+
+// EmbedGraph renders a chromeless page with an SVG graph of the expression in
+// the q parameter, which must return a series.
+func EmbedGraph(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) {
+	refresh, err := embedCheck(w, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	q := r.Form.Get("q")
+	e, err := expr.New(q)
+	if err != nil {
+		serveError(w, err)
+		return
+	} else if e.Root.Return() != parse.TYPE_SERIES {
+		serveError(w, fmt.Errorf("embed: requires an expression that returns a series"))
+		return
+	}
+	width, height := 800, 600
+	if v, err := strconv.Atoi(r.Form.Get("width")); err == nil && v > 0 && v <= 4000 {
+		width = v
+	}
+	if v, err := strconv.Atoi(r.Form.Get("height")); err == nil && v > 0 && v <= 4000 {
+		height = v
+	}
+	now := time.Now().UTC()
+	o := schedule.ExprOptions()
+	o.Autods = 1000
+	res, _, err := e.Execute(tsdbProvider(w), t, now, schedule.Search, o)
+	if err != nil {
+		serveError(w, err)
+		return
+	}
+	buf := new(bytes.Buffer)
+	if err := schedule.ExprSVG(t, buf, width, height, res.Results, q, now); err != nil {
+		serveError(w, err)
+		return
+	}
+	embedTemplate.Execute(w, embedData{
+		Title:   q,
+		Refresh: int(refresh.Seconds()),
+		Body:    template.HTML(buf.String()),
+	})
+}
+
+// EmbedStatus renders a chromeless table of the open alerts matching the
+// filter parameter.
+func EmbedStatus(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) {
+	refresh, err := embedCheck(w, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	filter := r.Form.Get("filter")
+	states, err := schedule.FilterStates(filter)
+	if err != nil {
+		serveError(w, err)
+		return
+	}
+	sort.Sort(sched.StatesByStatus(states))
+	embedTemplate.Execute(w, embedData{
+		Title:   "bosun status",
+		Refresh: int(refresh.Seconds()),
+		States:  states,
+		Empty:   len(states) == 0,
+	})
+}
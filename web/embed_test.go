@@ -0,0 +1,67 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/bosun-monitor/bosun/conf"
+)
+
+func TestEmbedSign(t *testing.T) {
+	schedule.Init(&conf.Conf{EmbedKey: "key", APIToken: "token"})
+	sign := func(token, query string) (string, error) {
+		r, _ := http.NewRequest("GET", "/api/embed/sign?"+query, nil)
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		v, err := EmbedSign(nil, httptest.NewRecorder(), r)
+		if err != nil {
+			return "", err
+		}
+		return v.(string), nil
+	}
+	check := func(u string) error {
+		r, _ := http.NewRequest("GET", u, nil)
+		_, err := embedCheck(httptest.NewRecorder(), r)
+		return err
+	}
+	if _, err := sign("", "path=graph&q=1"); err == nil {
+		t.Error("signed without the API token")
+	}
+	if _, err := sign("wrong", "path=graph&q=1"); err == nil {
+		t.Error("signed with a bad API token")
+	}
+	u, err := sign("token", "path=graph&q=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(u, "/api/embed/graph?") {
+		t.Fatalf("unexpected URL %s", u)
+	}
+	if err := check(u); err != nil {
+		t.Errorf("signed URL rejected: %v", err)
+	}
+	if err := check(strings.Replace(u, "/api/embed/graph", "/api/embed/status", 1)); err == nil {
+		t.Error("graph signature accepted on another endpoint")
+	}
+	if err := check(strings.Replace(u, "q=1", "q=2", 1)); err == nil {
+		t.Error("signature accepted for changed parameters")
+	}
+	u, err = sign("token", "path=status&duration=-1h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := check(u); err == nil || !strings.Contains(err.Error(), "expired") {
+		t.Errorf("expected expired URL, got %v", err)
+	}
+	schedule.Init(&conf.Conf{EmbedKey: "key"})
+	if _, err := sign("", "path=graph&q=1"); err == nil {
+		t.Error("signed without an apiToken configured")
+	}
+	if err := check("/api/embed/graph?" + url.Values{"q": {"1"}}.Encode()); err == nil {
+		t.Error("unsigned URL accepted")
+	}
+}
@@ -2,7 +2,11 @@ package web
 
 import (
 	"bytes"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
+	"math"
 	"net/http"
 	"net/mail"
 	"net/url"
@@ -13,6 +17,7 @@ import (
 	"time"
 
 	"github.com/bosun-monitor/bosun/_third_party/github.com/MiniProfiler/go/miniprofiler"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bradfitz/slice"
 	"github.com/bosun-monitor/bosun/conf"
@@ -29,7 +34,9 @@ func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interfa
 	if err != nil {
 		return nil, err
 	}
-	res, queries, err := e.Execute(opentsdb.NewCache(schedule.Conf.TsdbHost, schedule.Conf.ResponseLimit), t, now, 0, false, schedule.Search, schedule.Lookups, nil)
+	o := schedule.ExprOptions()
+	o.Match = matchFlags(r.URL.Query())
+	res, queries, err := e.Execute(tsdbProvider(w), t, now, schedule.Search, o)
 	if err != nil {
 		return nil, err
 	}
@@ -55,6 +62,131 @@ func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interfa
 	return ret, nil
 }
 
+// ExprExport evaluates the expression q and writes one row per group and
+// timestamp, as CSV (the default) or, with format=ndjson, newline-delimited
+// JSON. Number results are reported at the evaluation time.
+func ExprExport(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) {
+	e, err := expr.New(r.FormValue("q"))
+	if err != nil {
+		serveError(w, err)
+		return
+	}
+	now, err := getTime(r)
+	if err != nil {
+		serveError(w, err)
+		return
+	}
+	o := schedule.ExprOptions()
+	o.Match = matchFlags(r.URL.Query())
+	res, _, err := e.Execute(tsdbProvider(w), t, now, schedule.Search, o)
+	if err != nil {
+		serveError(w, err)
+		return
+	}
+	rows := exportRows(res.Results, now)
+	switch format := r.FormValue("format"); format {
+	case "", "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		err = writeCSV(w, rows)
+	case "ndjson":
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		err = writeNDJSON(w, rows)
+	default:
+		err = fmt.Errorf("unknown format: %s", format)
+		serveError(w, err)
+		return
+	}
+	if err != nil {
+		slog.Errorln(err)
+	}
+}
+
+type exportRow struct {
+	Group     opentsdb.TagSet `json:"group"`
+	Timestamp int64           `json:"timestamp"`
+	Value     *float64        `json:"value"`
+}
+
+// exportRows flattens results into rows sorted by group and time. NaN and
+// infinite values have a nil Value.
+func exportRows(results []*expr.Result, now time.Time) []*exportRow {
+	var rows []*exportRow
+	add := func(g opentsdb.TagSet, ts int64, v float64) {
+		row := &exportRow{Group: g, Timestamp: ts}
+		if !math.IsNaN(v) && !math.IsInf(v, 0) {
+			row.Value = &v
+		}
+		rows = append(rows, row)
+	}
+	for _, res := range results {
+		switch v := res.Value.(type) {
+		case expr.Number:
+			add(res.Group, now.Unix(), float64(v))
+		case expr.Scalar:
+			add(res.Group, now.Unix(), float64(v))
+		case expr.Series:
+			for k, p := range v {
+				ts, err := strconv.ParseInt(k, 10, 64)
+				if err != nil {
+					continue
+				}
+				add(res.Group, ts, float64(p))
+			}
+		}
+	}
+	slice.Sort(rows, func(i, j int) bool {
+		if a, b := rows[i].Group.String(), rows[j].Group.String(); a != b {
+			return a < b
+		}
+		return rows[i].Timestamp < rows[j].Timestamp
+	})
+	return rows
+}
+
+// writeCSV writes rows with one column per tag key, then timestamp and value.
+func writeCSV(w io.Writer, rows []*exportRow) error {
+	seen := make(map[string]bool)
+	var tagks []string
+	for _, row := range rows {
+		for k := range row.Group {
+			if !seen[k] {
+				seen[k] = true
+				tagks = append(tagks, k)
+			}
+		}
+	}
+	sort.Strings(tagks)
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append(tagks, "timestamp", "value")); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		rec := make([]string, 0, len(tagks)+2)
+		for _, k := range tagks {
+			rec = append(rec, row.Group[k])
+		}
+		rec = append(rec, strconv.FormatInt(row.Timestamp, 10), "")
+		if row.Value != nil {
+			rec[len(rec)-1] = strconv.FormatFloat(*row.Value, 'g', -1, 64)
+		}
+		if err := cw.Write(rec); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeNDJSON(w io.Writer, rows []*exportRow) error {
+	enc := json.NewEncoder(w)
+	for _, row := range rows {
+		if err := enc.Encode(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func getTime(r *http.Request) (now time.Time, err error) {
 	now = time.Now().UTC()
 	if fd := r.FormValue("date"); len(fd) > 0 {
@@ -73,20 +205,27 @@ type Res struct {
 	Key expr.AlertKey
 }
 
-func procRule(t miniprofiler.Timer, c *conf.Conf, a *conf.Alert, now time.Time, summary bool, email string, template_group string) (*ruleResult, error) {
+func procRule(t miniprofiler.Timer, test *ruleTest, c *conf.Conf, a *conf.Alert, now time.Time, summary bool, email string, template_group string) (*ruleResult, error) {
 	s := &sched.Schedule{}
 	s.Init(c)
 	s.Metadata = schedule.Metadata
 	s.Search = schedule.Search
 	rh := s.NewRunHistory(now)
+	rh.TSDB = testTSDB{rh.TSDB, test}
 	if _, err := s.CheckExpr(t, rh, a, a.Warn, sched.StWarning, nil); err != nil {
 		return nil, err
 	}
 	if _, err := s.CheckExpr(t, rh, a, a.Crit, sched.StCritical, nil); err != nil {
 		return nil, err
 	}
+	if _, err := s.CheckExpr(t, rh, a, a.Severity, sched.StNone, nil); err != nil {
+		return nil, err
+	}
+	if _, err := s.CheckExpr(t, rh, a, a.Info, sched.StInfo, nil); err != nil {
+		return nil, err
+	}
 	keys := make(expr.AlertKeys, len(rh.Events))
-	errors, criticals, warnings, normals := make([]expr.AlertKey, 0), make([]expr.AlertKey, 0), make([]expr.AlertKey, 0), make([]expr.AlertKey, 0)
+	errors, criticals, warnings, infos, normals := make([]expr.AlertKey, 0), make([]expr.AlertKey, 0), make([]expr.AlertKey, 0), make([]expr.AlertKey, 0), make([]expr.AlertKey, 0)
 	i := 0
 	for k, v := range rh.Events {
 		v.Time = now
@@ -95,6 +234,8 @@ func procRule(t miniprofiler.Timer, c *conf.Conf, a *conf.Alert, now time.Time,
 		switch v.Status {
 		case sched.StNormal:
 			normals = append(normals, k)
+		case sched.StInfo:
+			infos = append(infos, k)
 		case sched.StWarning:
 			warnings = append(warnings, k)
 		case sched.StCritical:
@@ -132,10 +273,10 @@ func procRule(t miniprofiler.Timer, c *conf.Conf, a *conf.Alert, now time.Time,
 				warning = append(warning, fmt.Sprintf("template group %s was not a subset of any result", template_group))
 			}
 		}
-		if _, err := s.ExecuteBody(body, rh, a, instance, false); err != nil {
+		if _, err := s.ExecuteBody(body, rh, a, instance, nil, false); err != nil {
 			warning = append(warning, err.Error())
 		}
-		if err := s.ExecuteSubject(subject, rh, a, instance); err != nil {
+		if err := s.ExecuteSubject(subject, rh, a, instance, nil); err != nil {
 			warning = append(warning, err.Error())
 		}
 		data = s.Data(rh, instance, a, false)
@@ -148,14 +289,17 @@ func procRule(t miniprofiler.Timer, c *conf.Conf, a *conf.Alert, now time.Time,
 				Email: []*mail.Address{m},
 			}
 			email := new(bytes.Buffer)
-			attachments, err := s.ExecuteBody(email, rh, a, instance, true)
-			n.DoEmail(subject.Bytes(), email.Bytes(), schedule.Conf, string(instance.AlertKey()), attachments...)
+			attachments, err := s.ExecuteBody(email, rh, a, instance, nil, true)
+			if err := n.DoEmail(subject.Bytes(), email.Bytes(), schedule.Conf, string(instance.AlertKey()), instance.Incident, attachments...); err != nil {
+				warning = append(warning, err.Error())
+			}
 		}
 	}
 	return &ruleResult{
 		errors,
 		criticals,
 		warnings,
+		infos,
 		normals,
 		now,
 		body.String(),
@@ -170,6 +314,7 @@ type ruleResult struct {
 	Errors    []expr.AlertKey
 	Criticals []expr.AlertKey
 	Warnings  []expr.AlertKey
+	Infos     []expr.AlertKey
 	Normals   []expr.AlertKey
 	Time      time.Time
 
@@ -222,15 +367,25 @@ func Rule(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interfa
 			fmt.Fprintf(&buf, "%s=%s\n", k, v)
 		}
 	}
+	for _, v := range schedule.Conf.Rosters {
+		fmt.Fprintln(&buf, v.Def)
+	}
+	for _, v := range schedule.Conf.Providers {
+		fmt.Fprintln(&buf, v.Def)
+	}
 	for _, v := range schedule.Conf.Notifications {
 		fmt.Fprintln(&buf, v.Def)
 	}
+	for _, v := range schedule.Conf.Aliases {
+		fmt.Fprintln(&buf, v.Def)
+	}
 	fmt.Fprintf(&buf, "%s\n", r.FormValue("template"))
 	fmt.Fprintf(&buf, "%s\n", r.FormValue("alert"))
 	c, err := conf.New("Test Config", buf.String())
 	if err != nil {
 		return nil, err
 	}
+	defer c.Close()
 	if len(c.Alerts) != 1 {
 		return nil, fmt.Errorf("exactly one alert must be defined")
 	}
@@ -238,6 +393,18 @@ func Rule(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interfa
 	// Set a to the first alert.
 	for _, a = range c.Alerts {
 	}
+	test, err := rules.enter(ruleUser(r), schedule.Conf.RuleTestWorkers, schedule.Conf.RuleTestQueue)
+	if err != nil {
+		return nil, err
+	}
+	defer rules.leave(test)
+	go func() {
+		select {
+		case <-r.Context().Done():
+			test.stop()
+		case <-test.cancel:
+		}
+	}()
 	ch := make(chan int)
 	errch := make(chan error, intervals)
 	resch := make(chan *ruleResult, intervals)
@@ -249,16 +416,22 @@ func Rule(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interfa
 	worker := func() {
 		wg.Add(1)
 		for interval := range ch {
+			if err := rules.acquire(test); err != nil {
+				resch <- nil
+				errch <- err
+				continue
+			}
 			t.Step(fmt.Sprintf("interval %v", interval), func(t miniprofiler.Timer) {
 				now := from.Add(diff * time.Duration(interval))
-				res, err := procRule(t, c, a, now, interval != 0, r.FormValue("email"), r.FormValue("template_group"))
+				res, err := procRule(t, test, c, a, now, interval != 0, r.FormValue("email"), r.FormValue("template_group"))
 				resch <- res
 				errch <- err
 			})
+			rules.release()
 		}
 		defer wg.Done()
 	}
-	for i := 0; i < 20; i++ {
+	for i := 0; i < schedule.Conf.RuleTestPerUser; i++ {
 		go worker()
 	}
 	for i := 0; i < intervals; i++ {
@@ -273,9 +446,9 @@ func Rule(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interfa
 		Result *sched.Event
 	}
 	type Set struct {
-		Error, Critical, Warning, Normal int
-		Time                             string
-		Results                          []*Result `json:",omitempty"`
+		Error, Critical, Warning, Info, Normal int
+		Time                                   string
+		Results                                []*Result `json:",omitempty"`
 	}
 	type History struct {
 		Time, EndTime string
@@ -295,10 +468,12 @@ func Rule(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interfa
 	}{
 		AlertHistory: make(map[expr.AlertKey]*Histories),
 	}
+	seen := make(map[string]bool)
 	for err := range errch {
-		if err == nil {
+		if err == nil || seen[err.Error()] {
 			continue
 		}
+		seen[err.Error()] = true
 		ret.Errors = append(ret.Errors, err.Error())
 	}
 	for res := range resch {
@@ -309,6 +484,7 @@ func Rule(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interfa
 			Error:    len(res.Errors),
 			Critical: len(res.Criticals),
 			Warning:  len(res.Warnings),
+			Info:     len(res.Infos),
 			Normal:   len(res.Normals),
 			Time:     res.Time.Format(tsdbFormat),
 		}
@@ -326,7 +502,7 @@ func Rule(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interfa
 				a := set.Results[i]
 				b := set.Results[j]
 				if a.Result.Status != b.Result.Status {
-					return a.Result.Status > b.Result.Status
+					return a.Result.Status.Severity() > b.Result.Status.Severity()
 				}
 				return a.Group < b.Group
 			})
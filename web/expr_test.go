@@ -0,0 +1,84 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/conf"
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+func TestRuleRoster(t *testing.T) {
+	c, err := conf.New("test", `tsdbHost = localhost:4242
+smtpHost = localhost:25
+emailFrom = bosun@example.com
+roster ops {
+	rotation = alice@example.com
+	start = 2026-01-05T09:00:00Z
+	shift = 1w
+}
+notification ops {
+	roster = ops
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StateFile = ""
+	schedule.Init(c)
+	form := url.Values{
+		"template": {"template t {\n\tsubject = s\n\tbody = b\n}"},
+		"alert":    {"alert a {\n\ttemplate = t\n\tcrit = 1\n\tcritNotification = ops\n}"},
+	}
+	r, _ := http.NewRequest("POST", "/api/rule", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	JSON(Rule).ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("%d: %s", w.Code, w.Body)
+	}
+	var res struct {
+		Errors []string
+		Sets   []struct{ Critical int }
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Errors) != 0 || len(res.Sets) != 1 || res.Sets[0].Critical != 1 {
+		t.Errorf("unexpected result: %s", w.Body)
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	now := time.Unix(1000, 0)
+	results := []*expr.Result{
+		{Group: opentsdb.TagSet{"host": "b", "dc": "ny"}, Value: expr.Series{"20": 2, "10": 1.5}},
+		{Group: opentsdb.TagSet{"host": `a,"x"`}, Value: expr.Number(math.NaN())},
+		{Group: opentsdb.TagSet{"host": "c"}, Value: expr.Scalar(3)},
+	}
+	rows := exportRows(results, now)
+	var buf bytes.Buffer
+	if err := writeCSV(&buf, rows); err != nil {
+		t.Fatal(err)
+	}
+	// Tag columns are sorted before timestamp and value; rows are ordered by
+	// group string, then timestamp; NaN is empty; quotes and commas are
+	// escaped.
+	expect := `dc,host,timestamp,value
+ny,b,10,1.5
+ny,b,20,2
+,"a,""x""",1000,
+,c,1000,3
+`
+	if buf.String() != expect {
+		t.Errorf("expected:\n%s\ngot:\n%s", expect, buf.String())
+	}
+}
@@ -0,0 +1,185 @@
+package web
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/expr"
+	"github.com/bosun-monitor/bosun/graphite"
+)
+
+// rules bounds rule test evaluations so that users testing long ranges do not
+// starve the check cycle of TSDB and CPU time.
+var rules ruleQueue
+
+// A ruleQueue limits the rule test intervals evaluated at once to
+// ruleTestWorkers, and the rule tests running or waiting to ruleTestQueue.
+// Both limits are those of the latest test, so that reloads apply. Each user
+// has at most one rule test; starting another cancels it.
+type ruleQueue struct {
+	sync.Mutex
+	workers int
+	running int
+	wake    chan struct{} // Closed when a slot is released
+	queued  int
+	users   map[string]*ruleTest
+}
+
+// A ruleTest is a rule test admitted to the queue.
+type ruleTest struct {
+	user   string
+	cancel chan struct{}
+	once   sync.Once
+}
+
+// stop cancels the evaluations of t that have not started, and the queries
+// of running ones that have not been sent.
+func (t *ruleTest) stop() {
+	t.once.Do(func() { close(t.cancel) })
+}
+
+// cancelled returns an error if t is cancelled.
+func (t *ruleTest) cancelled() error {
+	select {
+	case <-t.cancel:
+		return fmt.Errorf("rule test cancelled")
+	default:
+		return nil
+	}
+}
+
+// ruleUser identifies the user of a rule test by the action-user cookie of
+// the web UI, or else by remote address.
+func ruleUser(r *http.Request) string {
+	if c, err := r.Cookie("action-user"); err == nil && c.Value != "" {
+		return c.Value
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// enter admits a rule test of user, cancelling any earlier one of the same
+// user. It fails if max rule tests are already running or waiting. leave must
+// be called when the test is done.
+func (q *ruleQueue) enter(user string, workers, max int) (*ruleTest, error) {
+	q.Lock()
+	defer q.Unlock()
+	if q.users == nil {
+		q.users = make(map[string]*ruleTest)
+	}
+	q.workers = workers
+	if old := q.users[user]; old != nil {
+		old.stop()
+	}
+	if q.queued >= max {
+		return nil, fmt.Errorf("rule test queue full: %d tests running or waiting", q.queued)
+	}
+	t := &ruleTest{
+		user:   user,
+		cancel: make(chan struct{}),
+	}
+	q.users[user] = t
+	q.queued++
+	return t, nil
+}
+
+// leave removes t from the queue.
+func (q *ruleQueue) leave(t *ruleTest) {
+	t.stop()
+	q.Lock()
+	q.queued--
+	if q.users[t.user] == t {
+		delete(q.users, t.user)
+	}
+	q.Unlock()
+}
+
+// acquire waits for a free evaluation slot for t. It fails if t is cancelled
+// first. release must be called when the evaluation is done.
+func (q *ruleQueue) acquire(t *ruleTest) error {
+	for {
+		select {
+		case <-t.cancel:
+			return fmt.Errorf("rule test cancelled")
+		default:
+		}
+		q.Lock()
+		if q.running < q.workers {
+			q.running++
+			q.Unlock()
+			return nil
+		}
+		if q.wake == nil {
+			q.wake = make(chan struct{})
+		}
+		wake := q.wake
+		q.Unlock()
+		select {
+		case <-wake:
+		case <-t.cancel:
+			return fmt.Errorf("rule test cancelled")
+		}
+	}
+}
+
+func (q *ruleQueue) release() {
+	q.Lock()
+	q.running--
+	if q.wake != nil {
+		close(q.wake)
+		q.wake = nil
+	}
+	q.Unlock()
+}
+
+// testTSDB fails the queries of a rule test once it is cancelled, so that
+// its running evaluations stop at their next query. A query already sent
+// runs to completion.
+type testTSDB struct {
+	expr.TSDBProvider
+	t *ruleTest
+}
+
+func (p testTSDB) OpenTSDB() opentsdb.Context {
+	if c := p.TSDBProvider.OpenTSDB(); c != nil {
+		return testOpenTSDB{c, p.t}
+	}
+	return nil
+}
+
+func (p testTSDB) Graphite() graphite.Context {
+	if c := p.TSDBProvider.Graphite(); c != nil {
+		return testGraphite{c, p.t}
+	}
+	return nil
+}
+
+type testOpenTSDB struct {
+	opentsdb.Context
+	t *ruleTest
+}
+
+func (c testOpenTSDB) Query(r *opentsdb.Request) (opentsdb.ResponseSet, error) {
+	if err := c.t.cancelled(); err != nil {
+		return nil, err
+	}
+	return c.Context.Query(r)
+}
+
+type testGraphite struct {
+	graphite.Context
+	t *ruleTest
+}
+
+func (c testGraphite) Query(r *graphite.Request) (graphite.Response, error) {
+	if err := c.t.cancelled(); err != nil {
+		return nil, err
+	}
+	return c.Context.Query(r)
+}
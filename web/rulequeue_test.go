@@ -0,0 +1,107 @@
+package web
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
+	"github.com/bosun-monitor/bosun/expr"
+)
+
+func TestRuleQueue(t *testing.T) {
+	var q ruleQueue
+	a, err := q.enter("a", 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := q.acquire(a); err != nil {
+		t.Fatal(err)
+	}
+	b, err := q.enter("b", 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := q.enter("c", 1, 2); err == nil {
+		t.Error("expected full queue")
+	}
+	// b waits for the slot held by a until a new test of b cancels it.
+	done := make(chan error)
+	go func() { done <- q.acquire(b) }()
+	b2, err := q.enter("b", 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err == nil {
+		t.Error("expected cancelled acquire")
+	}
+	q.leave(b)
+	q.release()
+	q.leave(a)
+	if err := q.acquire(b2); err != nil {
+		t.Fatal(err)
+	}
+	q.release()
+	q.leave(b2)
+	if q.queued != 0 || len(q.users) != 0 {
+		t.Errorf("queue not empty: %d, %v", q.queued, q.users)
+	}
+}
+
+func TestRuleQueueWorkers(t *testing.T) {
+	var q ruleQueue
+	a, err := q.enter("a", 1, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := q.acquire(a); err != nil {
+		t.Fatal(err)
+	}
+	// A reload raising ruleTestWorkers applies to the next test.
+	b, err := q.enter("b", 2, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() { done <- q.acquire(b) }()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("acquire blocked despite a second worker")
+	}
+	q.release()
+	q.release()
+	q.leave(a)
+	q.leave(b)
+}
+
+// countTSDB counts the queries it is sent.
+type countTSDB struct{ n *int }
+
+func (c countTSDB) Query(r *opentsdb.Request) (opentsdb.ResponseSet, error) {
+	*c.n++
+	return nil, nil
+}
+
+func TestRuleTestCancel(t *testing.T) {
+	var q ruleQueue
+	test, err := q.enter("a", 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var n int
+	p := testTSDB{expr.Backends{OpenTSDBContext: countTSDB{&n}}, test}
+	if _, err := p.OpenTSDB().Query(&opentsdb.Request{}); err != nil || n != 1 {
+		t.Fatalf("expected query to be sent: %v, %d", err, n)
+	}
+	if p.Graphite() != nil {
+		t.Error("expected no graphite context")
+	}
+	test.stop()
+	if _, err := p.OpenTSDB().Query(&opentsdb.Request{}); err == nil || n != 1 {
+		t.Errorf("expected cancelled query not to be sent: %v, %d", err, n)
+	}
+	q.leave(test)
+}
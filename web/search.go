@@ -2,41 +2,93 @@ package web
 
 import (
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/bosun-monitor/bosun/_third_party/github.com/MiniProfiler/go/miniprofiler"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/gorilla/mux"
+	"github.com/bosun-monitor/bosun/search"
 )
 
+// matchParams are the query parameters read by filterMatch.
+var matchParams = map[string]bool{
+	"match":     true,
+	"icase":     true,
+	"substring": true,
+}
+
+// matchFlags returns the search.MatchFlags of query parameters q:
+// icase=true ignores case and substring=true matches anywhere in a value
+// rather than the whole value.
+func matchFlags(q url.Values) search.MatchFlags {
+	var flags search.MatchFlags
+	if q.Get("icase") == "true" {
+		flags |= search.IgnoreCase
+	}
+	if q.Get("substring") == "true" {
+		flags |= search.Substring
+	}
+	return flags
+}
+
+// filterMatch filters values by the match query parameter, if present, with
+// search.MatchFlag and the request's matchFlags.
+func filterMatch(r *http.Request, values []string) ([]string, error) {
+	return filterQuery(r.URL.Query(), values)
+}
+
+// filterQuery is like filterMatch for query parameters q.
+func filterQuery(q url.Values, values []string) ([]string, error) {
+	m := q.Get("match")
+	if m == "" {
+		return values, nil
+	}
+	matched, err := search.MatchFlag(m, values, matchFlags(q))
+	if matched == nil && err == nil {
+		matched = []string{}
+	}
+	return matched, err
+}
+
 // A Sorted List of Available Metrics
 func UniqueMetrics(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	values := schedule.Search.UniqueMetrics()
-	return values, nil
+	return filterMatch(r, values)
 }
 
 func TagKeysByMetric(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	vars := mux.Vars(r)
 	metric := vars["metric"]
 	keys := schedule.Search.TagKeysByMetric(metric)
-	return keys, nil
+	return filterMatch(r, keys)
 }
 
 func TagValuesByMetricTagKey(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	vars := mux.Vars(r)
 	metric := vars["metric"]
 	tagk := vars["tagk"]
-	q := r.URL.Query()
-	var values []string
-	if len(q) > 0 {
-		tsf := make(map[string]string)
-		for k, v := range q {
+	// Query parameters filter by tag, except match parameters that are not
+	// also tag keys of the metric.
+	keys := make(map[string]bool)
+	for _, k := range schedule.Search.TagKeysByMetric(metric) {
+		keys[k] = true
+	}
+	tsf := make(map[string]string)
+	mq := make(url.Values)
+	for k, v := range r.URL.Query() {
+		if matchParams[k] && !keys[k] {
+			mq[k] = v
+		} else {
 			tsf[k] = strings.Join(v, "")
 		}
+	}
+	var values []string
+	if len(tsf) > 0 {
 		values = schedule.Search.FilteredTagValuesByMetricTagKey(metric, tagk, tsf)
 	} else {
 		values = schedule.Search.TagValuesByMetricTagKey(metric, tagk)
 	}
-	return values, nil
+	return filterQuery(mq, values)
 }
 
 func MetricsByTagPair(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
@@ -44,12 +96,12 @@ func MetricsByTagPair(t miniprofiler.Timer, w http.ResponseWriter, r *http.Reque
 	tagk := vars["tagk"]
 	tagv := vars["tagv"]
 	values := schedule.Search.MetricsByTagPair(tagk, tagv)
-	return values, nil
+	return filterMatch(r, values)
 }
 
 func TagValuesByTagKey(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	vars := mux.Vars(r)
 	tagk := vars["tagk"]
 	values := schedule.Search.TagValuesByTagKey(tagk)
-	return values, nil
+	return filterMatch(r, values)
 }
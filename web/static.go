@@ -141,7 +141,7 @@ var data = map[string]*file{
 
 	"/js/.editorconfig": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\x8a֊\xe5\xca\xccKI\xcd+\x89/.\xa9\xccIU\xb0U(IL\xe2*)\xca̍/)J\xcc\xcc\xc9\xccK\x8f/\xcf\xc8,I-.HL\x06K\x17\x95\xa6r\x01\x02\x00\x00\xff\xff\x1d\v\xb3\xba7\x00\x00\x00", size: 55, local: "web/static/js/.editorconfig"},
 
-	"/js/0-bosun.ts": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xac\x19\xfds۶\xf5g\xe9\xaf\xc0\xd4\xf4H\xcd6\xe5\x8f\xd8]\xa5\xba=\xcfn\x9b\xecꥱ\x93\xddm:_\x8f&!\t1I\xd0\x00(\xd9I\xfd\xbf\xef=\x00$A\x89\x94\x9d\xddzW\a|\xdfxx_\x80F\xa3\x11\xf9A\xd0\x19\x154\x8b(\xc9C\xb58\x1d\x84ټHB\x11ā\x92\x032\xfa\xb1\xbf\x8djO\xf0B\xd1\x17\xd2\xca0c\x8a}~\x8e\xfc\x96s%\x95\b\xf3g\xe8R\x9e\xd2L\xbd\x88h/.D\xa8\x18\xcf\xf6f\\\xa4\xe1sL\xf1\xd13\x04E\x16S!#.\x9c\xbd\xf4\x97\xa1 \xb7\\\x16\xd9Y\x9e\x93SR\xfa1\xe5q\x91P\xdf+Q\xde.\x99\xf6{^6\xbfB\xd7y\xbb\xb0֨s\x9e)\xc1\x93\x04\x04k`:\x8f\x04\r\x83l~\x8d\xbe\xd0 X[\x17\xc2\xe7\xcdp\xd2\xef\x97B\x83\x88g36\xf7\xa7\xde+}\"\xbf\v\xbed`#(\xf3^%<қw\x80\xb3\"\x8b\x10\xe47\xc9\xc7$\x9b\a\xe6H\xdf^\xb9\x88]\xb2!EӾ\xfdm\r:$_\xfa\xbd\r\xda`\xa1\xd2\xe4\xf8\x92\xc7\xd4W\xa2\xa0`y\xaf\xa98\xe8\xf7z\xab\x05\xcd|o\x04ց\x88^O1\x95\xd01\xf1.B\xb9\xb8塈\xd1\x05\x00\xa6i\x9e\x84\x8a~\x14\t \xf3P(\x16&r\x14\x97TZ\x95!\x8d*\x8f\xbabΕ0\xf8\xa7\xa1\xa3\x95\x81\\\xb9\xae\xfa\xad\x01nQ\xabٺTj\xf6vu\xf4!\x17\xeb\xda~\x06\x18\x95\x12ܶU%\xb2viD\x11\xed\n\xe7\x10C\x8bu\x8d\xbf\x1a\xe0\x16e\x9a\xadK\x9bfoW\xb7\xe0R\xadk{\x030\xf2/FW[5\"g\x97B\x94P\xe9\xeb\t\x9a\xf00~\x97]\xd3PD\x8b1\x99\x01;ݰD@\xf2\xad[r\xa5a[\x8c@\xa6.#\x90\xf9k\x8d\x90,\xc1\xea\xb1n\xc7u\t\xdeb\x8ae\xed\xb2Ɗh?\x05S\x14ֵ\x9ek\xa8-\x88[u\x1b\xfe.\xd5FN\xbb\xe602\u009b\x9aϢgU\x1a\xc6.\x95F@G\xc81\xa9\xb8x\xdc\xd0\t\x9c\x8a\xbc)\x91\xdb\"\xcf\xd0t\x06\x9fA\xb7+ϋ\x8dp\xbf\bUH~\x06\x01۵\x02g\x97\xc6\xdf\v\xb5\xa6\x8d\xab\x05\x15+&\xa9\xff\xc5\x04_\xcc\x04\x8d\xd4\a\x0e\xd4#K7\xe9?\xe9\xd6\xc02E\xc5,\x84\x9e\x05\xa5\x9c\xab\xeb\x88\xe7\x94\xd0\aE\xb3X\xea\xd2m  \xc8Z\f=\x97es(\xcdr\xc1\x85JXv7\x86~\xc6\x13\x1af \xd3\xe95\xa2ȰєE^\xf7\x18Q\xaah4\x97\x92\xa4\xd9+\xae\xa9X\xb2\x88BO\xa9\xb8Ǝ\x91\xa6\x85T\xa8\xe0\x15H\xb2m\xed|\x01]\x95^\x17Q\x04\x85\xd2\xd5D\x97\xd0\xe5wIT\b\xa1\x17PI\x97\x8c\x17R\xcbr\x85\xe9\xbdBw\xb6\x94\xc1+#\xd8\xc0'M\xda\xca\x0f@\xaf\xf3\x1a\xf0\xb5\u007f\xabv\xef4\uebb6\xdf\xe8\xedd\xba~>\u007fG\x9a-\a\x84I\xb1\x04\x1f\xf9\xcb\xf2\x94\x86\xe4\xf4GP\xf5\b\x16}\x92\xe8_D\xc1\xb7\x86W\ay\xabx\u0601\x82\xa2\x01\xddxCd\x85\xcfÌ&\xe7I(e7\x8db)\xc4F\x1cCP\xc3\x01\x17\xe9-\x15\xd3\x1b\x8c\x9fhAq\xf3ck\xa1\xa0\xf7\u007f\xcc\x04O\xffHAVڐuE\xef\v*\x95&\x9aA\xf7\x83\n\xea\xcfX\xa20\xfe7v\n\xb3O\xaau\xf9\x0e\x10\x922\aH\x98$?U\xe1\xea\xa2\xf1\fi\xb6\xc6S\a\xb8\x06\x94\xc1\xed\x1cSPg\xa2\xef\xe9\xf31\xa9H \xf0\xa5\x8ds\x1b\x94z\xb5P*\u05cb\xfb-\xe9 m\xa8\xd7\xe7\xads\xa0\xd0\xfek\x0e^u\x8e\xa0d\x93?o`U\xc3\xef\r\xf0\xfd\x8b\xb2I\xfe\xbf2\xc9\bB\xa7W\x93ܓ\x1e\xe7\f\xc2D*\xa4\xc1Z\xcc /\x9b\x11\xff/6ݬx#\x14\xce^\x15\"\x83\x10J\x12L\xc1'Kܤ\r\xb0\xd1\xd2\xf8C]F\xc9\xe9\xa9SH=\xb2C\x96\xf0\xbfg\xcaiS\xf4\x17R\xe6\x10ZM\x9eJ5M\xcdO\xf5F0\xab\xec6\xca\xdc\xf9R\xd3\xff\xe3\xfa\xdd?\x03\xb3=6{\xf4\x97\xbbZ\x02\x1c<!ް)\bs\xb0[\x90IÏWo\xcfy\x9a\xf3\f\xb6\xe9#\x83\xbf\x1c\xae\x891\x84\xed\x8e햵\\\x93Rg\"JJ7$aM\x13\x80\xca\xe8\xaaLM\x1fEhĽE\xbc/\xa8x4\xe0\xfb \xa5 !\x02L\x8a\xdf\"\x00\x1e\xc1\xa8\f\xf2B.\xfc{Md\xcd\x13MS\xea\x02\x83\xa6H\x15\xaaB\x96\xf6訛\xb1\a\xc0\f4\xddޠ2Q\xae\x98\x8a\x16%\x87=\xe5(\x94\x94\f\"\x01w\xa3(L\x06cbuZ1;d\x10c\xb8\x8b\xc1\xa4\xa6.\xb2\xbb\x8c\xaf\xb26b\x96\u0378K\xba\nE\x06f\xb5\x91\x96(\x87:\xc3+f\xab\x11Ҥ\x9bKL\x85\xe0\xe29\x83c:\v\x8bD\xb5Q\x19\xcc\xc0\xc63\xfa\x17\x8f\xaa\xac\xc0\xbf4J\xa9\x1b\a\xbaآ\xe7[\xaam\x19\t1\xe0_\xdd\a1^~\xcdy7\xe5b[ԋI]\x19l\x8d\xae\xa3\x06\xafº\x86\x05s\xaap2\xcc\xd9(ęL\xfed\x98O1q[b\xd7`ɟ\u007f\x92\xc1`8D7\x04\xd6\u007f~\x8c\xa3\x955\xb4\xaeI\xd66Ї\xf8\x89\x8bs:\x95E\a\x1f\x00t\x96\xc50\xa5QC\x1a\x83W$O\x96\xd6v(kZ\xa7> \x1f\xfe\xd6\n\x91\xf2\x13\x8c]\b-i\xf1\x9f<\x98\xb1\f\x1aѣ\xef\x94I7\x05\xe2 \x87\xe4cf\x90(\x8f\xea3Xt\xb4o\xbf\xb8`s\xf8\xfen\xbf\x04$l\xbeP\x00\xf1\xbey}\x1b\x1e\xc6\xdf{\x16\x1e\x87\xe2N\x83\x0ffǇߟ\x94\xe0\x94\xc6\x1azt|Bok(\x84\b\x80A\xd5Hk\xb0\xe0[\xb8\xdd!\xf9\xe11\xf9\xab\xa6\xb1\xf0\x88\x89(\xa1\x98\x97S\xb0}zp\xbc\xbfK\xf4\x1fTz\x83S\xe6\xf4\xb8\x05\xa6?5B\x1b\xed\x106\x817\xe5Η\xb8\xd5\xf8(\x904Aoz\xdf$|\xce=t{\x10\xe69\x8cA\xbe\a4\x16\xa0\x144\xe2\x05E!Ю\xe4g\a\xbab\xb1Z\x18 6\xf7\xe5\xdcJ<K\x12\xdf\xc3\xf98\xb8\xb5R\xf0\xe4\xfd鴶\x86L\xe5\xe7ѡ\xddō\xa6\xa18\x97\xf9\r+P\x86kF\x84e\v\xfb\xfcm\xc3:ǎV\x93\xc1\xfb\x87\x0eF<\x00T\x9f\x81\v|l\x01B*\xe0\xe8\xe1\xc7N\x0f)kA<=\xb8\x99\x90'\x87\xfa\xb1\x9bt_\x93n:\t_\xb5\x82\x98\x85)\xcfb\xd7S\xe5\x91vz\x06\x19]\x17\xc4\xeb\xba1;\xf4acX^\x12\xcc\xf5\xd8F\x1b\xf6j\r\x00-u\x00\x1a\xf2\x15\x86\xe5~\x03,\xc9\x0e\x88 \x89fYU̫M\xe4\xdeK\xb1v\xf9\x1fo\xe2\x8c\nR\xd7\xd2M\xefCj9@(\x97\xfcN\x8f~\xab\x05\x83\x19p\x03\xb5WF\xc3A\x99]]\xc1\xe9\bp\xbc~\xf4\x9c\xeb;\x83ràҒ\xe0\xb8-.\xbd\x83\xfd\xfdo\xbd\xcd\xfdn\xcay\xe8\f\xac\xf2\x9cZ\x83˔\x13g\x83\xb6\xbetm̥\xb7[\xeb\xd6\f!\xed(wX\xb6d\xc1A;\v>ȝ\x1c\x06\xd5q\xbd4\xff\x0e\xd7\xf2\xef\xe5\xa1q\xe8\x86\x06\x86\xbd\x12a&\x19\xce\xe1\x17\xf6}\x06\x9b\xc1q\xd9\fls=\xe7E\x86\xf5|ߙ\xbb\r\x06\x1bz\x95w.\xf5\xce\xce\xc4\x0e\xd5M\x19\xa7\xe4\xc0\x8eP1?s[\xb7\x1d&\xcak\x01q\xd0\xf50\xef\xcaj\xcc\xdb\xe5x\x8d\xc5}Q\xccfp\x11.\x0f]\x8f\x11\x9d\x01Ҍ\x90\xca廄\xb5\xb8\x9eY\xbf\xf7\x82\xdao\xbe\x95b\xdd\xe7oz\xd4\x10|}`\xfd/\x91\xf55\x01\xa4\x1dC\x15\xce%p\xed\xf1+\x87\xef\xb6D\x85\x1e\xeb\xfb\xeem\fO\x1eF\x8f\xf2\xa5\xa2q\xe9\x02\xb8=\x9d\x96\x00\xea=\x11\n\fd#6~$\xfb-\\{{\xeb\xa3&ޥ\xf5\xb6`\u0383\x1e^ݭ\xbd!\xb6\x9b\xdaHs\x11oD\xa8\x99\vs\x0e\xb7\f\x0f\x97r<\x1a\xadV\xab`\xce\xf9\x1c\xee\xf19\xc3kx:*Db\xb9\xa9\x18-\x0f\xf0\xbb|lKx6\xd7\x0fj1\x8f\n\xfds\xd0ǫ\xdf\xec{Y봈\xdb\xd4\xf3\x1f\x8b\xed\xee\xcck@\xb0\f\x93\xa2\x1a\x0eYl\xc7\xc7\xf6\xc7 \xbcC\x1a\x02\xe7\xc0\xeamU2\x01{\xad\xc3\x1c\x8e\xec\n\xe7y_\x0fLZA\x90\xd0l\xae\x16f~\xb4\x03\xa4Ι'{g[\u007fŻԿd]õ\a\xee[\xa0Ǝ\xfe\xbf\xe8\x1f\xb4\xea\x11\xff\xa9\xdf/\u007f\x18s\t\xb0\xfd\xfe\x1b\xfe\x1b]^\x8e..\xf6\u07bc\x19\xa7\xe9\x18\xfaŤ\"Ƿ:|\xb0\xa2\xf6\xd9VP\xb8fÕ\x19\xf77\xd6\xfb\x9a\x15\x10\xb0\xb0\x86\xfb\x11\xf9V\x0e\xd0\xcdy(A\xf7\xe0[\xb9\x17ι\x86H\xfc\x8c\r6\xd5\xeb\u052cݏ\x85^/\xcc\xda\xfd\x88\xf5:6k\xf7\xe3R\xaf3\xb3v?\x1e\xf5\xfaѬ\xcb\x0fp\xe0n\x1f\x1d\xd9\x1f\x8d\xc8/0s\x13\xfd\x8ab\xa2\xeb\xbe`\xe2N\xa6p\xdf\b\xb8\x98\x8f>\xe1;7\xbf\xc3K+>\x1c\xf4\xfb1\x85V*h\xf5$B\xa8\x8c\u009c\xfa\xc6\xc3\xd5M\xa9v\xf9&G\x91=\xcbS\x1f,^A\xf43,\xff\xf5\xf2õ\xc6\xfb\xc3ƁVr\xf1\x17ALD4\xd7\xcf\xc2\x14\x8a\x83\x8e[\x8c\xaaGs\x19Ɯ\xa4\x0f9\x83\xcb\fh\xb1\xf1nq\xf6\xe6\xa0\xfb\x04\xde\xe4/\xaar\x8f\xc0\xc0\x06\xb3\xaf?\xe6\xf6c\b#\x92\x96\x00\x95\xed\xf05\xfc9\xd9/\xff\xc0య\xdf)zV!\xde\xd7'\xa5\xf6\xd3\x01\xcez(\xaa\xb11\fnSq\xbe4\x19\a\xa6\xa6U\x89l\x0e\x050֕\xb8]4f\xa0\x05[\xa0\xde=BKA;h\x81\xfe\x91v4h\xfa\x0e\\\x17;\x9e\xab\x9c\x85\x1f?\xbfo\xd5Sއ\xf0\x01gͮ@\xe6\t\x83\xb25\xd1\xef=3.\x88\x8f\xa4LWU\xf8\xe7\a\xe0\xb2)\x0e\x9f;;\xb5\xff\xf1\xb1$\n\xa7\fkc\x0f&\x04\xb8\xb1\xfaQ\x10-Bq\xa6|(\xb9\xa7\xf8\xaaE\xbc\xa1!\x84*vk\"\xc1?\u0605O\xa7j\xe0\xd1F\x01\xcbb\xfa\xf0n\xe6\x9bm\x18v\x90b\x9bK\x15\x88\xae CjE9BmWi\xbc\x89\xb9\x1e\xa4\"\x94t݅-!9\x18쒽\x83\xa1f\x86\xfc\xc3\a\xa7q\x99\x80R\x85\xd1\x1d_B\xe8'|\xa5\xab{8:8><\xf9\xee\xbb\xe3ף\xbf\x9d\xbc><:q~i0o\x008\xeb\x02\x87l<\xa6\x0eM\x8dҦV@\xfdslc@1\x8f\xf1L\x9e\t\x11>Z|\xf3iP?Y\xbb\x8f\x81\xe67]\x99\xb0\b\xa2?\xb0\xaa\xfd\xb2*ÿ\xff\r\x00\x00\xff\xff.\xb3\xe6R7!\x00\x00", size: 8503, local: "web/static/js/0-bosun.ts"},
+	"/js/0-bosun.ts": {compressed: "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xac9\x7fs\xdb6\xb2\x7f\x8b\x9fb\x9f\x9a\x0e\xa9Z\xa6d'N_\xa5\xaa\x9d\xbc\xa4m\U000a6e76vz39O\xa6\x03\x93\x90\x84\x06\x04h\x00\x94\xec\xb8\xfe\xee7\v\x80$(Qvzs\xff\xd8\xe0\xeebw\xb1\xbf\xb0XM&\x13\xf8V\xd1%UTd\x14Jb\u058b!\x11\xab\x8a\x13\x95\xe6\xa9\xd1C\x98|\x17=Du\xacde\xe8g\xd2j\"\x98a\x9f\x1e#\xbf\x92\xd2h\xa3H\xf9\b]!\v*\xccg\x11\x1d\xe7\x95\"\x86Iq\xbc\x94\xaa \x8fmʟ>BP\x89\x9c*\x9dI\x15\x9c%\xda\x10\x05WRW\xe2EY\xc2\x02j;\x162\xaf8M\xe2\x1a\x15\x8f\xe12\x1a\xc4bu\x8e\xa6\x8b\xc7\xd1\xc0\xa1^Ja\x94\xe4\x9c*m\x81\xc5*S\x94\xa4bu\x81\xb6\xb0 \xb1\xba\xf0&\x8c\xc7ч\xd1<\x8aj\xa6i&Œ\xad\x92\xcb\xf8\x89\xf5ȯJnXNU<\x86\xf8\t\x97\x99=|\x00\\V\"CP\xd2%\x9f\x81X\xa5Υo\xceC\xc4\x18\xf6\xb8X\xda7?\xef@Gp\x17\r\xf6hӵ)\xf8\xd9[\x99\xd3Ĩ\x8a\x8e\xe6Ѡ+8\x8d\x06\x83횊$\x9e\xc4cd1\x18\x18f8\x9dA\xfc\x8a\xe8\xf5\x95$*G\x13\f\x06\x86\x16%'\x86\xfe\xae\xf8\f\xe2\x92(\xc3\bד\xbc\xa6\xb2\xa2\x1ci\xd6X4d\xf3\xd2(\x87\xbf\x1f\x05R\x990T\t\xc2\xf5\xae\xf87-\xe2\x01\xf1\xcd\xf6C\xe2\x1b6\a\xc4\x1bZ\xec\x8bv\xc0\x87\xc4\"\xc5A\x91\x88\xec\x17GoJ\xb5+퇛RQ\xad\x99\x14\x0f\x8aĭ\x87$\"\x8b~\x81+E\xca\xf5\xaeğ\x1c\xf0\x01av\xdb!iv{\xbf\xb8\xb5\xd4fW\xdak\xa9\r\xfc\x93\xd1\xed\x83\x12q\xe7!\x81ȡ\x917P\x94K\x92\xff\".(Q\xd9z\x06K\xc25\xdd\xd3DU\x9c\xeejrna\x0f(\x81\x9b\x0e)\x81\x9b\xff\xae\x12\x9aq,^\xbbz\\\xd4\xe0\aT\xf1[\x0fi\xe3Y\xf4{\xc1դ]\xa9/-\xd4\xd7\xe3\ae\xbb\xfd\x87D;>\xfd\x92I\xe6\x98w%\xbf\xc8\x1e\x15\xe96\x1e\x12\xe9\x18\x1c\b9\xa6\x8dT\xb7{29U\x06^\xd7ȇ\"\xcf\xd1\x1c\f>\x87\xee\x17^Vf\xbfl\x1a\x02?\b\xf3\x88Բ:\x18\xee\xbfVfG\x9a4k\xaa\xb6L\xd3\xe4\xce\x05_\xce\x14\xcd\xcc;9\x83x\xe2\xe9\xe6ѽ\xbd\x99lE\\\x92\x8c\u009bs)\xcdE&K\n\xf4\xc6P\x91k{s8\xc8]Tk\xac\x8dbb5\x8f\x06z-\x95\xe1L|\x9c\xc1\x95\x94\x9c\x121\x8f\ue0ebNU\x02\xef\xb9\xfa\x8e\xb1W\x9c\xaaEt\ued9a\xa4{U]P\xb5a\x19\x1dC\xbbk\x16(\xe9n\xb0\x06\x95>\x91\"\xf1\xb7\xea\xcb5\x11+zQe\x19\xd5:\x94D7T\x981d\x95RvQ*\xbaa\xb2ҖW\xc8̞\x15\x165e\xfa\xc41v\xf0y\x97\xb6\xb1\x03,\\^ϣ\xc0\xbeM\xb7\x11\xf4\r\x87\xba\x8eNk\x01\x97\xbb\xfe\xf9?\xa4y\xc0A\x98\x14\x1b:\x83dS{i\x04\x8b\uf008\xdby4\xf8SK\xe1PD\xdcZx\xe3\xc8+#\xc9\x01\x14\x15\x99\xcc\xf7Y6\xf8\x92\b\xca_r\xa2\xf5a\x1a\xc3\nJD\x9e\x13Cg \xaa⊪\xcb\x0f\x18?ٚ\xe2\xe1g^CE\xaf\xffX*Y\xfcQ\xcc ):\xbc\xce\xe9uE\xb5\xb1DKE\xf5z\x06ɒqCU\x87\xcc\xf1!\x82\x15VV\x12\x00\xb5\x91\xe5\f\x12\xc2\xf9\xf7M\xb8\x86h\xf4!\x15;{\xda\x00\xb7\x80:\xb8\x037\xa5m&&\xb1\xf5\x8fKE\xb8\x8c\x9fh\x1f\xe7>(\xedjmLi\x17\xd7\x0f\xa4\x83\xf6\xa1\xde\xfa\xdb\xe6@e\xe8^\xdf\xd7\xe6\brv\xf9\xf3ژ\xb2\x85_;\xe0o\x9f\x95M\xfa\xbf\x95I\x8e\x11\x1a\xbdi$1%j\x84\x8bTX\xec\xc6\f\xeeeKH\xfeǧ\x9bg\xef\x98\x0e\x145\x95\x12 *\xce1\x05\xef=q\x976ŋ\x96\xe6\xef\xda2\n\x8bEPHc8\x82\r\x1cA\xec\xcai\x97\xf5\x1d\xd49\x84Z\xc3}-\xa6+\xf9\xbe=\bf\x15,:\xb9s\xd7\xd2\xff\xff\xc5/\xffH\xdd\xf1\xd8\xf26ٌ-\x871\xc4\x00\xf1\xa8\xcb\bs\xf00#\x97\x86\xbf\x9f\xbfy)\x8bR\n*L\x82\x1b\x92\xcdh\x87\x8d#\xec7\xeca^\x9b\x1d.m&\xc2b7\x15\x91\x13\xd64\x05\v\x10t[\xa7f2\x9a{ĵG\xfcVQu\xeb\xc0\xd7iA\x8db\x19,\xa0\xc0o\x95^WT1\xaaӲ\xd2\xeb\xe4z4o\xd5S]U\xda\x02\x83\xaahCL\xa5k}l\xd4-\xd9\r,`h鎇\x8d\x8az\xcbL\xb6\xaewx/gDS\x18f\x8a\x19\x96\x11>\x9c\x81\x97\xe9\xd9\x1c\xc10\xc7pW\xc3yK]\x89\x8fBnE\x1f1\x13K\x19\x92n\x89\x12L\xac\xfaHkT@-\xf0\x85۫\x84v\xe9\x16\x12S\xa5\xa4zL\xe1\x9c.I\xc5M\x1f\x95\xc3\f}<\xa3}\xd1Uu\x05\xfe\xb1SJ\xc38\xb0\xc5\x16\x16\xbdն\x8e\x84\x1c\x16\xf0\xe4:\xcd\xf1\xed\xed\xfc\xdd\xe5\x8bע]\xcc\xdb\xca\xe0kt\x1b5\xf8\x12\xb75,]Q\x83\x9da\xc9&\x84Se\xf4\xf7n\xf3\x02\x13\xb7'v\x1d\x16\xfe\xfa\v\x86\xc3\xd1\b͐z\xfb%9\xb6V^Ѷ&y\xdd`\x01\x88\x9f\x87\xb8\xe0\xa6\xf2\xe8\xf4\x1d+\xe8\v\x91\xbf\"\x86:\xd2<UTK\xbe\xf1\xba\x0f\xee\x9dL렄*\xd5\nD\xca?if\x10Z\xd3\xe2\xbf2]2A8\xbfM\x822\x19\xa6@\x9e\x96J\x16\xcc5\x12\xb5\xab>\xc1\x02\x9eN\xfd\x97Tl\x05\v\xf8zZ\x038[\xad\r, \xfe\xe2\xd9\x159Ϳ\x89=<'\xea\xa3\x05\x9f,\xcfN\xbfy^\x83\v\x9a[\xe8ӳ\xe7\xf4\xaa\x85V\x1cy\xe8O0\xb1\x12<\xf8j\xa5\b\x92\x9f\x9e\xc1W\x96\xc6\xc33\xa62N1//\xa3\xc1\xe0\xf2\xe4l:\x06\xfb\a\x85~\xc0.\xf3\xf2\xac\af?-\xc2*\x1d\x10v\x81\x1f\xea\x93o\xf0\xa8\xf9\xd3TS\x8e\u058c\xbf\xe0r%c4{Jʒ\x8a<\x89\xf5f\xe5\x01ƨ$^Sd\x12\x8fA\x7f\n\xa0[\x96\x9b\xb5\x03\xe2\xe5\xbeYy\x8e/8ObE3\x93^y.\xe8\xf9\xe4\xf2\xb2\xd5\x06.\xf5\xa7ɩ?\xc5\aKC\x85\xc1p\x0f\xb5@\x1e\xa1\x1a\x19\x96-\xbc\xe7\xafVq\xbf\x1e\xbd*\xc3\x04N\x03\x8c\xba\x89\xc7\xce\a!\xf0\xb6\a\xb8d\x1c[\x8f$\x0f\ue43a\x16\xe4\x97'\x1f\xe6p\x1fP\xdf\x1e&\x9dZ\xd2}#\xe1P-\xcd\x19)\xa4\xc8CK\xd5.=h\x19\xdc\x18\x9a ߕ\x8d\xd9a\x9d\x8da\xf9\x160\xd7s\x1fmxW[\xc0\xc9ٴ\r@G\xbeŰ\x9cv\xc0\x1a\x8e\x16\x10\x03\xb7[\xb6\xcd\xe6\xed>\xf2\xf8s\xb1~\xf9\xafx\x1e\xb4\nz\x1e\xf9\xe4\xefZ\xbf\xa0\xa1K\xb4Q\xf2\xa3m\xfd\xb6kfh\xbc\x87:\xae\xa3\xe1\xa4ήC\xc1\x190\b\xac\xfe\xf41\xd3\x1f\f\xca=\x85jMҳ\xbe\xb8\x8cO\xa6\xd3/\xe3\xfd\xf3\xee\xf3\xb99\x18X\xb5\x9fz\x83˕\x93\xe0\x80\x0e\xa0\x0f\x1d,\xa4\xf7G\xbby(\xa4\x03\xe1\xc1\x96ۇ\x12\xa6w\v\x0e䞟\xa6\x8d\xbb>7\xffNw\xf2\xef\xf3C\xe34\f\r\f{\xa3\x88\xd0\f\xfb\xf0W~>\x83\x97\xc1Y}\x19\xf8\xcb\xf5\xa5\xac\x04\xd6\xf3i\xd0w;\f^\xe8Mޅ\xd4GGs\xdfTwy,\xe0ķP\xb9|\x11^ݾ\x99\xa8\x9f\x05\x10\xa0\xdbf>\xe4\xd5\xe9\xb7=\x87\x01\x16\xf7u\xb5\\r\xda8\x1dQ\x87\x03\xa4\x1b!\x8d\xc9\xc7\xc0zLϼ\xdd\aik\xb7\xc4s\xf1\xe6K\xf6-\xea\b\xfe~`\xfd'\x91\xf5w\x02\xc8\x1a\x86\x1a\xecKde\x92\xc6\xe0㞨\xb0m}\x14\xbe\xc6\xd0\xf3\x84\xf3zR\xd1yt\x11νwz\x02hp\x0f\x94k\n{\xb1\xf1\x1dL{v\x1d\x1fﶚ\xf8\x96\xb6\xc7Z\xc0\x93$\xfe\xa2y[\xc7#\xbcnZ%\xddC\xbc\x13\xa1\xae/,\xa56I\x8cK=\x9bL\xb6\xdbm\xba\x92r\xc5))\x19>ËI\xa5\xb8\xdfM\xd5ds\x82\xdf\xf5\xb0\x8dK\xb1\xb2\x03\xb5\\f\x95\xfd5\xea\xf7\xf3\x9f\xfd\xbc\xac\xb7[\xc4c\" e\xb9?\x9d\x9b\x06\xa4\x1b«\xa69d\xb9o\x1f\xfb\x87A\xf8\x86t\x04\x81\xc3\xdac5<55\x176̙\x14\xe7\xd8\xcf'\xd3q- \xe5T\xac\xcc\xda\xf5\x8f\xbe\x81\xb49s\xef\xdfl\xbbS\xbc\xb7\xf6\x87\xb4\vC\f\xcbP\x8co\xfd\x7f\xb4\xbf\xa7\xb5-\xfe}\x14տ˅\x04x\xfd\xbe\x7f\xff\xfe\xfd\xe4\xed\xdbɫWǯ_ϊb\xa6u<o\xc8qV\x87\x03+\xeaǶ\x8ar\x82Of<\xdf̞kY\x99J\xd1\x19\xbe\x8f\xe0K=D3\x97D\x9b\x19\f\xbf\xd4\xc7d%-D\xe3g\uec05]\x17n\x1d~\xac\xedz\xed\xd6\xe1Gn\u05f9[\x87\x1fo\xedZ\xb8u\xf8qk\u05f7n]\x7fD\x83\xfbq\x84\x86\x8c&\x13\xf8Q\xc9\x02\xec\x14\xc5E\xd7u\xc5\xd4G]Ȝ\xa6R\xad&\x7f\xe2\x9c[~\xc4G+\x0e\x0e\xa2(\xa7\x19'\x8a6#\x11\xa0:#%M\x9c\x85\x9b\x97Rk\xf2\xfd\x1d\x95xtO\xebX|\x82\xa0}\x8d\xfc\xe9\xed\xbb\v\x8bOF\x1d\x876|3Em\"\xa2\xba\x89 \x05\x1d\x83\x8d[\x8c\xaa[\xf7\x18Ɯ\xa47%STϣ\xc8ǻ\xc7\xf9\x97\x83\xa1\xfe%\xff\xaa)\xf7\bL}0'\xf6c\xe5?Fp\xe48\xc0Wp\xfa\f\xbe\x82\xe7\xd3\xfa\xcf\xc9t:\xb5s\x8a\x81\x17\x88\xef\xf5y-}1\xc4^\x0fYu\x0e6\x8f\xea\x8as\xd7\xdd8t5\xadId\xe7\x14X\xd4\xe6\xc7\xe3\xa22C\xcb\xd8\x03\xed\xe9\x11Z3:B\r\xecoēa\xd7v\x8a\x92<\xb0\\c,\xfc\xf8\xe1\xb7^9\xf5{\b\a8;z\xa5\xba\xe4\xcc$\xf1\xdc\xce{\x96RA\x82\xa4\xccVU`\xf0-dħ\xf8\x1c\xd8\xd1Qk\x7f\x1c\x96d\xe4\x92am\x1cl\u05ccSH\xb24[\x13\xf5\xc2$\xd3\x11,p\xaa\x05\xf1\xc8\x11\xa6\xba\xbar\x91\x90\x9c\x8c!\v\xab\x06\xba6K\x99\xc8\xe9\xcd/\xcb\xc4\x1d\xc3m\x9f\x8e\xea˥\tĐ\x91#\xf5\xac\x02\xa6\xfeV\xe9\xcc\xc4B\vRE4\xdd5aOH\x0e\x87c8>\x19\xd9͓\t\xe0\xc0iV'\xa06$\xfb(7T-\xb9\xdc\xda\xeaN&'g\xa7Ͽ\xfe\xfa\xec\xd9\xe4\x7f\x9f?;}\xfa<\xf8\xa5\xc1\xcd\x00\xb0\xd7\xddP\xa5;\xc3\xd4\x11\xdc5\xaa6@\xfbsl\xa7Aq\xc3x\xa6_(En=\xbe;\x1a\xb4#\xebp\x18h\x89R\xcdYF\x93Q\xeaE'uU\x1eͣ\x7f\x0f\x00\xac\xf1\x8d\xf2\xb6!\x00\x00", size: 8630, local: "web/static/js/0-bosun.ts"},
 
 	"/js/action.ts": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xfflR\xc1n\xdb0\f=\xdb_\xa1\x15\x05,\x03\x99\x9b]St@\x11\fX\xb0\xed\xb2n\xa7\xa0\aUaR\xa1\xaedPr\xd0`ȿ\x8f\xa4\xec\xd8\x05z2\xf9\xde#\xfdD\xd2\xf9\x04\xb87\x16\xd4\xe6\xde&\x17\xfc\x83\r\x1d(xK\xe0wQm\xbe\xbdu\x98\xa1\u007fe\x91N\x1d\xacTL\xe8\xfc\xe1\xb6,\xfa\b8K_!Fs\x98\v^\xe0\x14\xc7t\xfbH@\xec\x9f^]Z)]\xab\xbb\xaf\xea\x18\xdc\xee\xb6<\x97\xe5S\x88\xbd_\a\x9f0\xb4-`l\xec%\xd6U\xb6\xb5N\xd8V\v\xb5\xad\xae#\xbb\xa1\xb0\xba~N\xa9\x93\xa0\rְH\x12\f}b~\xdf{\xa9Թb\xf5\xee\x81\v%\xd5+\xe5\x0f\xcd\xe6;E\x0f\x80Gg\x19\x1f\x9be\xee\xe7\x90M\xbc\xf4\x17R\xa2f\xf3\x9b?\x03_\xf3\x98\x8e\x06U\x04\x83\xf6Y\xddM\xfd\x9a\f\xe9\x9a\xe6\x90-5<@\x92 \x98\xdd:\x84\x17\a\xfaʈ\xc7\xcf\xcc\\͔<yR\xe6\x16\x92\x11\xe7\xf6J\u007f2\xfeз\x06\x1b\x17\xef\x11\xcdI\x0f\x12\x1a}-f\xc6\x0e\xbc\v객x^\xc8YA\x1b\xe1\x03\xdd$c\xd5\xc5G^ \xf1y\x83\\ǯݙd\b\xe4\xb4\xf8#G2\xf3\xbd`\xf4\xaf\xdc\xca\xec݂\xfe\x1aOf \x86\x13\x12\xee\x87\x1c\xcf\xcc\x15\xa3grSX\x1aX\x82\x8f&\xb6x\xf7\a\xf5e\xb9\\\xf2\x10\vYvӅ\x98tuc:w\x93\x8b\xe8J\xd8y\u037f\xa3\xa7YK\xbf\xd7Z\xa0\xf1uT{Y`\x8f-\x95Wұ8\xe7*@\f\xa8\xb5|fE\x86N7\r\xe8 \xe79ҵ?R\xf0?\x00\x00\xff\xfffW\xd6`u\x03\x00\x00", size: 885, local: "web/static/js/action.ts"},
 
@@ -173,7 +173,7 @@ var data = map[string]*file{
 
 	"/js/bootstrap.min.js": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xe4}{s\xe3Ƒ\xf8\xff\xf9\x14$\xec\x9f\x16X\x82\x10\xb5\x1b\xff\x92\x03\x8de\xd9\xebMe\xaf\xfc\xba\xec&\xae;E\xb9\x1a\x00\x03\x12\x12E\xd0\x04(y#1\x9f\xfd\xba\xe7=\x83\x01\xa9\xb5\x13\xdf\x1fWv\xad\xf0\x18\xf4\xcc\xf4\xf4\xbb{\x86\xe7\xcfǿ\x19=\x1f}\xd94]\xdb\xed\xc8vt\xf72y\x91\xccF\xe1\xaa\xeb\xb6\xe9\xf9\xf9\x92v\xb9|\x97\x14\xcdm\x84\xad_7\xdb\x0f\xbbz\xb9\xeaF/f\x17\x17S\xf8緣\xf7\xf7u\xd7\xd1]<z\xbb)\x12l\xf4u]\xd0MK\xcb\xd1~S\xd2\xdd蛷\xef9\xd0\x16\xa1\xd6\xddj\x9f#\xbc\xf3\xee>o\xcfU\x17\xe7\xf9\xba\xc9\xcfoI\v\xa0ο~\xfb\xfaͷ\xef\xde`\x97翩\xab0@HU\xbd\xa1e\x90e݇-m\xaa\xd1\xf5\u007f\xec\xe9\xeeC\u052dv\xcd\xfdhC\xefGov\xbbf\x17\x06jB\xcf\xdaѿ\x93;\xf2\xae\xd8\xd5\xdbn\xb4\xa3?\xee\xeb\x1dm\xc5wA4\x9fT\xfbM\xd1\xd5\xcd&$\xd1C\xb0o\xe9\b>\xab\x8b.\x98\xcb\x17\xa3<\x8c\x1e\xee\xc8nD\xb2\xb2)\xf6\xb7t\xd3%Ŏ\x92\x8e\xbeYS\xbc\v\x035\xfe \x8a\xf3\xec\xe1\a\x9a\xdf\xd4\xdd\xfb\x1dٴ5BH\x83{\xe7ɛM\x19\xc4\xdf4\u007f7\xdbt\xea\x9a\xe2\xdb\xef\xccw\x8d\xf5\xe9\xa8q\xdav\x83`\x0e\xf3\nЁ\xa3/F5L%\x02<\xde5u9\x9a\x8d\xb3\x8c$m\xf7aM/\x8b\xabhG\xbb\xfdn\xf3\x00\x9f\xa49\xdc\x1f\xe6\xfc\xc1\xf8\xe2@\x92j\x93\xd0\xdb\xfd\x1a&l\x8d\"S\x98\xcb9~\x8al|\x11\x97Y\xb7\xaa\xdb9\t\xf1O\x94\xc0(\x00=\xad3s\xf5e\xf4\x00\x1f\xcd\x0e\xd1\x1c\xbf\xa7\x99\xf9\xfc\xf1\x91\x84e\x94\xc0b,\x97t\x17\xc2X\xf7\xdbm\xb3\xeb\x12=\xc1\x04\x86\x1bɡ\x8eZڽ\xafoi\xb3\xefB\x1a\xe7Q\x8c\x038\xc4$4\x80\xfa\x80d\xb0\xbc\xb1\xef\xc5\xd9\x19tJ\xefp\xb9\xdb--j\xb2N\x9c\x89d\x0fy\xbd)\xdf\x03%\xa6CËK\xba\xa6KD\xdd\xd1V+\xb2)\xd7451*fE\xc2<\xe9\xc8\x0e\xf80J\xea\x96cu\x91'\xfc\x83\xef\xf2kq\xb5K\xc8v\xbb\xfe\xc0\xde\xc7М\x91i\x1b\xa5|\xad\x0f\x87\b\xfe\v\x05\xb3\xc4O\xa2y=\x04\x84\x99PR\xacLT\xf2\x05\x17\xcb\x1cӬHJ\xd2\x11\\\xeb\x84\xc0p:`-\xfa\xf8\xd8{\n-\x91KK\xfe]\x14\a\xd8\xf3f\xa9\x19:?;\xa3\x97\xf9UR\x90\xf5:,pؼ\xa7g\x97\biZ\xd6\xedmݶY\xc0\xc1]=\x8bm:\x04t!хA\xb1\xae\x8b\x9b .\x18\x19$źi)\x90J\x99\xfc\xe5͟\u07bd\xfd\xee\xdb,`r.\x88\xcbd\xbbk\xba\x06{\xe7\xad,p\n\x1f\x05L\xb9JJ\xda!\x1a4U\x06\xec\x932ѳNv\xf4\xb6\xb9\xa3!\x1fwi`\xa8LH\xd7\xc1'l\x1e|I9\x92B\xfdn\xb5\xa3U\x80\x8d)\xa0\x01@mפ\xa0\xe1y\xf2<\\d\x9f\\\xfe\xed\xaf\xed\xd5\xf3O\xa3\xf38\b\"\xce2\x15\xc0\xa7\xd1\x1c\x90\x96\xc3<\x18\xb1~E+\xb2_w@\xd4U\xb2\xa6\x9be\xb7\x82\x1e*\xe8aE\xda\xd7kҶ\xa1\xc0]\xb4(S\x98=١\b\x8b\xb0\xb9\x9cU\x0e\x92\xe1\xcd\x1d\x93ll~\xc6\xf4@\xbe\x01\x19\x8a>\xbe\xe7=\xd22\x8c\xb0\x0f1u\xd1I\xbd\t\x86\xf8\xaa2\xc6R\x91\x92\xc2P\xaa\x01IQD^\xd9\x13^|6\x8bRX\x14XS.:\x98\x98b\x83\x9c\xeb\xcb,\x8f\xf5M\xf2\xba\xd9\x00\xb5틮\xd9e\xa5\xf9b\xd3\xc0\xab\n\b\xa63%\x90d@\r\x8d*\x99\"\x15\x81Ak\nI\x8c\xe4\xa7d[#\xf5\xf5\xc8\xeb_\xc0\x866\x91)\x86\xcb\xf7]\xd7\xe0\"TY\xd0\xe4\xd7\x14\x00\x9b\\\x96#\xe9\xf5\x9b\v\x06-\xb8$\xa9\x90E\xbbf\xb9\\S\xf88_Є߄Q\x8a\x8c\x9a\x80\xcc}\xd7\xc1\xe2\xc00\x15\xa7j\xfe\x89\xcb聍\xfaS\xca5e\x86\xec\xc99\xb2\xd9b\x9b\x16\u058d\xfeԁ\b\f\x1f\x0eq\x91|\xf5\xe6\x0f_\xfc\xf9\xeb\xf7\xef\xe0Kެn\xbfnH\t\x12\x02t\xcba^\xf4\xd8W\u007f\x92=\xacy\xcb\xf7\x000\r\xc4M\x92$\x01\x02֫ \x87\xecQ_\x01H\x17\x92\xaf\xc1\xbe\x10jL\r\x9ca\xb6fT\xbd\xdd#\xeb\x04wd\x1d\xa4\xc1\xaa\xbb]\x83:\x93h\aN\x9cd\x01\x0e \x887\xfb\xf5:ː)P1\xc1\xa3\xb33\x89m\xf5\b\xfa\xb9\xa4W\xc8|\xec\xaf\xf8\x04\xc4\xdf\xc2\xc4\x11ܧ\xf80\x8a\r\x15GpJ?}0i!0\x10\xc0V+tQ8\x03z$e\xc9Y\x0fX\x8b\t\x9d\x02\x98,J\xed\xa6\xa0\xfbz\xe8\x87oM\x06/\xa4\xa8\xfb\x82\x01\x01N\x8c9\x11\xce\"\x1b\xe1\x9cb2\x87h\t\x8e&\xb7\xb1\xcc9\xa4\xedB!\xecŗ\x01'\xcd\x16\xa4}4\a\xfb%\x17rM.\x9b\r\x03\f\xc4R\xad\xd3<\xd8\xc1\xf0\x1b\xc0\x06\x1b\xd16\fpHA\x04\xf3\x93\x0f\x8a\x15-n`\xc9\xe1\x99\rȐ\x970\xf0;\x94R0\xe8\x8b4\x17]$\xf2\xb1-\xf7\xe4S\x10~ggn'\xf1\xf8T\x1f\xa6n\x01վ\x84G\a\xe2\x0e\x8d#\xc6\xf9\x92K\u0092KB\x8e\xb2\xb9q-e!\xbf\xb3\x84aa\xbd9-\r\x05\xc0\xf2\x948\x14\x00\xb5<\xb4\xd6\xf5oraQ\x8b\xab\x9e\n-\xd3\ni\xf8\xccM\xe5\x95w \xd3@ݔ\xc0t\x92^\x82\x84=E\xe5\xc4\xec\x86R\t\xad\x88\x91\xa2\xad\x18\xff\x15\xa6Ѐ\f.Ȯ\x01\xa0k&\x85\x87D\x9d\x90\x1d\xb1WJG\xf12\xeb\x1bI\x8b<\xad\x92v]\x97ԑ\xe2\xaaC\x8f\x1c\xdf\xecos\xba3\xa1 s\xc2\xec\xd2\xe5\x82^.A\x0e\x01\xd0\x1a\xf4\xf9\x0e\x84\x1bJ\xf7-\x01P`\xed\x14\x1f\n\x14\xf9>\t_\xf8$<\xa3\x82\x1b\xfa\xa1l\xee7\x895()\xb5\xd87\xa2E,LA\x0e\aX\xab.\bPe\xeb\xe5k\x05k\xaa\x1b\x06\x8eJ\x116\x1f\x1b\xbd\x90\xe3\x88*\x94b\\\xa8\x89)\x8a\x0e8\xff\x88\x9b\xba\xa3\xb7m\x86\x828\x0eV\xc0\xd4\f]\x06p\x0e\xd5\xe5G\x9c\xef-\x0e\v\xad\xa1ݑ)\xb3\xafń\xf5WkJ\xee葯\x18\xfe\xc5W\xa7\x94\xa0\x9c\\\xfa\x19}\x19\xb3\xeeR1\x91\xf8\x1e\x1c\xd4\x14\xbc-K:\x8bE\xc8L>h\xc1\x97\a\x12'\xc9\xfd\xaa.@\xcc\x16\x04\xf8\xe2\xe5︎@nBE\a\x1e\xf0͜\xbf\xf97\xfef\x03\xe4\xadޔ\x9c\xdbR\xce8\a\xd2\xe7Bk\x18l\x8a\x99\xc7\xffɁ\xd5\xcd\xe5\x1c_H\xcb@\x11*|Ivo\xc5mh\xbd\xb4)\xc3\xf8fl\xc0T\xcaN\xd2\x05\xa8Y\x05\xceZ\b\x9c\xa1\xd0r^\xb8\x91\xf4:ͩ\x81\x04{\vT\xf5vSҟ,4\x9b2E\x10\x1eQ\xf6xR\xac\xeau\t\xd7@\xf3\xf8.P\f\x82\r\xa1G\x80\x16\x92\xc7G\x93\x86{\x8a\xd7c\xe30q \xb8\xc2\x1cY\xe8a\x06\x87\xf5\xb0g\xa5\xf4\"\xe9v\xe7\xaf\xccqq\xcd<\xbdx|\x9c\xbd\xca\x17\xdc\xfbLM\x16\\\xb8\x9c\x03V?\xbe\xb2\xc9\xdf\f\x04p\x19u\x88\xd2\x12m\x1a\xbdnZ0\xe9\x0e\xc0\x10}U.\x02\\'\xb0ΐ䀕Bc\x80hF9hb\xc0\x9eDz3\xb9\x06\x0eb\x18Y\x8c\x18\x85\x835\xc0Qpv6\x10Sp4\xf9\xa9\xf0F\xac%@\b\x03p\x88?;I\xfb\xf6\\q\xa4\x1e\xadn-\x90\xbbh\xb0@\f\x9f.\xda`\xb6?\x03\x14G\x92c\x92\xe3+G\xabp\xa5z\x9a\x0e1\xf4\x00\x1a\x10\xd6\x15=^[\xc0\xa3\xea\xdcHc8X\xd3\n\x89\x82\x05.\x83xe\xbe\xaa\xea]\x8b\xef\xc0\u0080W5\x0fa\x81\xa59\xa6\xca\xd4\xc4;\x8b\xe7Q\x9a\x8a\xb0\xd9\xfc\b\xc3\x04\xd1\xe5\n\x86v\x80\xef\xa9\xcf\xdc\xf3\xa0\rd\x1c3\xe5\xae3z9\xbb\x8ao\xb47\xce\x10es\n\xe0\x1d\xbd\xe3\xf2=3\x95\xd2븬w\x94\xe11]\x1e\x98\xb9\xec'\xb8\x9b(\x1e\xdfx]y6W{<\xb3\xb8\x12*Op^OY+<\xf5^<\xc9Xf\xf3]g\x8aU\xf5\xe7J\fF\x97}\x99E\xa3\xabh\x0e\xd2|\xad\xbd\x1am\x0e#\xc8[\x1bw\x1f\x85:e\xf1\xfa\xd8xȌg+\x04\x9e\x02,\xb6\x1a\x12\xd8p\xb8\x90ISU\xa0Y~\xa8\xcbne\xfaaKxmݕ\xa7\xa3\xa6\xd4B\xe3e\x1e/\xaf\x92\xeb\xa6\x06e1B\x17\xa2\x8f\r\xc7y\xbb\x94/\xec\x0f\xe3ڠA\xd3\xdb4\xba\xae\xfb\xb4t\v\xfc\f^\xdfP\xa8\x86\xbe|\x0e\xa6:\x8eFcpZ\xeew\x04/\x80\"\xa0˂\x86\xb3xz\x11\x81#\x1a\x96~\x121\x91\xa4\x1f:|\x13\xfb\x89\xfd\x16\xc3[bф\xce\xe0\xc2\xd1\xf2\x99$ḙ;\xe97\xc9{\x9f\xe7\xa4ޝ\xf6\x9d\x14ؓޓ\x02\xaa\xfd\xa7\x80\xfbO\x8cȮ\xe2\x91q\a>\xd5U\xd0\xf7\xa2@:J\xbf\x84\x85\n}!H\xe6KQ+\x00\x19a\xac\xc2\x17}\x9c\xa8\xe8#\xc8\b3\x8c\xa7\x1d\x1d\xde\xf3\xd2\xf2v*\xe9\xe2Pq\x11\x81\xfc\xb5\x06#'\x01\xa2\x00Tg\xb8\xd4\x1a\x0e\r>\xe1\xd6U1p\xc7\n\xe3\x87\x1e\a\v̈́\x95\xd7\xdd;\x80+\x1eރLi\xee9\x8a1Nb\xb1\x13\x91\x11\x87\x1d*\"=\x99\xabg\xd1\xf1\xc0\xf7\\\x8c\xac\x88\v9\xb3\u007fM\xa8}ȿl\xd6k\xb2m\xe9/\xf0/\xe7c\x8a\x18\xe5\xfe\xf2\xd9YЮ\x9a{Ԋ\xb0\ny6F\xd9e;\x98\xb2G\x8f\x839\x10\xcb\xf7{\x8e\xbf<6\xa8\xa5\t2?\xf3\xd9\x1cW\rMier\xf1[\xa9c\xecF\x91c\xd0Kl\xf0~D\xc8\xf3\x94\xeb\xc5\xdb\xf5<\xac\x12d(\x8c\xb3\xd9\xf4C`CZ\xe4\x1euD\xa0U\xd0B<\x01W\x8e2\xf3ű\x9f`\xc9L\xe0\xcaV\xb10$\x9d\x1eO\u007f\x18\xabW\x94\xad\x14&@M,\x1a\x1b\xb6&\n\xb0&\x8a\x01k´\xe4䒘w\xc2Bx\x05\x064\xd9\xd0\xf5\b.p<\xd8Y\x89r\xc8\f\xf6\r\x10?\xb6e\x19\x13k\xc2\xd2:\xd3A\xa1\x15S\u0383\x14\x8d$\xc4)U\x18\x92j\xe9\xc0\xad\xb5'n\xa9(=\x10CE\x89\x87\xc8\x10\xd1eu\x15μT\xcb-\xbd\xa5\xb9z\xa7;b0\xfb]\xd1\x11\xe2\r\xfb\n\x02og\xb3\x01\xf5Ȗzc\xaf\xf5\x81ٿ>\xe3G\x9a\xacK\x8eV.\aq\x16+\xa0\x9d\x82\xdc\xd2\xf5k\x026\xe2e\xd0\x16;\x00\a\x82V\x9a\x18ST\x1c\x1e\xff\xafg\xe9H\xa7{)C%^\xc3\xe2\xe5g36[\v\"\x18Z`p\x83зYdey\x18\xc3,r\x9aC\x8c\xe4\xd8JZ\xe3O\xe0\x10\x90mc\u007f\xea\xcc\nc\x0fR\xdce\xe1N\xb4\xc0ā\xb6+\xff\xc8$\x83\xb3\xc2~r\x1c\xa4\xde~\x02o\x90d\xcb\x1e\xc9>\x91\xd6\x00i%u\x88\xed\xa3\xa8<\xd0)\u007f\x1fyr\xa7\xb3\x87\xaa\xd9P-\x82\xa4\xb5\xf2$\xad\xa5\xa5A\xf1.\x81\xf5\xb3\x1c\xd8\xec\xf2\x18=-\xb8<J\xb9\xcaE5iY\xa3b\xbas\xebNY\xa3\xe2\xdek\x8d\xcawO\xb0F%\xd8\xd3֨\x04:\x10\xcd\xcf\xf4\xfa\xf8\xc2\xf9\x96!:`\x86\xf6M\xb7\x9fc\x9a\x82\xd3O\xc2\n\r̥\xd7N\xaa\xb3\xd5Bf\aRi\x8f\xc6\xd7\xf6\xa0\xb8f\x82\xd67\xd9\xf5\xd9\x19\t\xaf\x99Y\xba\xb2i\x1cFwsvv\xc3\xf5\xd7 2.\r\x80Y\xf0lr=y\xc6\xec\xcaMӁ\xff\xea\xa1\xef\x125\xd4\xe5\xb2G,\xb2%\xc604\xbb\x00ݘ_*[y\x19\xd7?\xcb\x10\x05\x9b\xede\x06\xe6\x1f\x8f\xfd\xc2\x14\xb1\xc0@\x155`9\x8d\xdf$.\xb3\"$\xb2\xaa\x83f\x8eo͈\xcbJ\xe34[\xbaay\xb8\xd2Wv %k\xb9k\xb6\x18\x9e\x06k\xf3H\xe9\x81\xe31r\xd0>\x89c\x81;\xc0\xffF}\x87\x8a\x90\xe6\xde:\x8d\x02P\xa1߉:\x8d\"+\xce\xce\xce?\xb9\xfcb\xfa_d\xfa\xf7\xab\xf3\xa4\xc3lT\x11a\xe2\xeft\xf1F\x89_\x13h.řa\xee,\xca4W\xb1`=\xcc\xf2\xe3\xcarJ\xb3,GM>\x9a\x97Ve\x8e\xc6J\xc9L\xfa\xe5\xb1✲_\x9c\x03\xe4\xae`LsR\xdc\xe0\rf\xc5\x1d\x9eP\xfd\x80\x80X\x1e\xa9ڱW\xca0\xc1A@.{\x16\xf8\xf2\x88\x10.\xa5\xd5(\xdd5\x1e\xd3\xc3D~\"s\xfd\xf1(Ui\u007f\xa1\x8b+\xa0e\x8a\x82\xa4\xea\x11,\xcb?\x03\x1f\x8c\x97\xc0Eͦk\xf6Ū\x05\"\xe9\x80IG\xaa<P^\x88\x02A\xb0\xbc+#W\xb9!w9\xd9M\xe1\x8f\x19-\x0e\x9f}^\xd6w\xa3\x02{Ӹ\xd2\xf8<\u007f\x05B\xa3\u07b4t\xd7}Qu\x182\x0e\x8d<\x92(wʥ%\xe6\xe5?\xe6\xb3K\xb6(\xfb\x96\xbeF\xfa\nK\x12\xfc֊\fyj\xfe\xaa`\xb6\x98\x84\xab켴ˆ\xca\xc84\xbb9\xa8:Ã\xb5\x92\xbd\xb4T\xcel\xb6\xf3\xf0\xe5\xef\x1f\u007f;{|\xf1\xbbH0[\x8eM_7%\x8d\x1cG\x99W\n\xf4\x8a\xa2\xf2\xa4\xed\x9a\xed\xf70\x04\xb2$\x9ci\xe2qy\x92$(\x90D\x89$A\xbd$1\x06e\x00\xd6\xe3\x8bߡ\xe4\x94\x03\x12|\xca\x1f2q\x8a.\n\xd3\x16U\xd4G_iV\x95\xe1jʵ\fF\xeb:Eu\x01\x03\xbc\x03\xc1\xb8\x8bһ\xba\xadax#\x82\xd1j*5\x10\x98\xdb\xc0f@q{`\xb1\xc9j\xf2,\x1e\x89g\xeb\xba\xed\xf2\xe6'\xf6\x98\r\xb8\xb6ܪ\xeb\xac\x16\x19\xa5\x1a`\xad\x91\xba\x82T\x8c+\x9a\xbf\xfc\xbd1\xab\xb3\xb3\xebW3\xf8g:\x8d\u007f;\xb3\x9f\u007f^\xab\xf4\x0f\xdcM&\xf1?\xaeAh^g3\x8c+\xd2\x1fA\x89\xf6&\rV\xd4A\xf9\x0e`\x8fHҘ[w\xb2ZK\xde[F\xcf\xd2yw\xda\xe8Q`W\xa7\x8c\x1e\x05T\x1b=C\x82ʌҩ\x87\xa3\xaa\xd9\xdd\x1a\x91&\xc2JP]\xfa;\x9c\x06Yy\xc4\\/\xcd\xee\xfbΠ\x01N\x17\x1e\x92\xf01\xdeG[\x0e\x18\xcf9\xa5\x98l\x1bP\xe9\x9fۦ$kn\xb8\xf9\x03>\xf4D\x04\xabz|쁃.\x8c\xf0\xd4ҫ\xcd\x16XU\x05l\x9d.Y\b\x05\xc3`\xf8\x17\x9e\x1c+up\xca\f>͛\xf2C\xa6I(\xc1{'QhĶ>\x95\x02]\xa4\xaa\xdaw(\x16\x8d\xe8\x15\xf7\x9aAA\xb0D\x81\xf4\xa3d`\n\r\x9d\xaeW\u007f r,l\xe6\xd3\x02\xd4\x123^\x13\x8cs\x86\x9e\xcfcO\xf1\u0600\xb7\x86 x}\xabX\xa5\xc3\x13\xeb\x10\xe44\xd3\xf1,\x06\x9a\xca\x1b\xb2+\xf1\x1a\x11\xdc\v\x91\xb9z\xdbI\x8e\v$\xf1\xdc/\x9a\x86*勫E\xdcl\xa2\x15\rs\x13\xdf=\xa5'\xc8\xc5Q\x98\xf9\xc1\x8dT(\xbd\xa9*\x13٠\xd0\xfa\x1c(\x88\xb5\x16x,\x16\x92U\x82\xbd\x93k\xac2iH4\x86/\xc0W\x92\xeb\x17A\x16\xb4\xeb}Eۂlu6άF\xe1\xc2DTK\x1b\xd3t˨\xf9c\x90\x01V\x9d\x03\xe2\xd8*ˑ\xab\xe9\tK{\xd3c\x85\xcf\xe7奾s\xe3\x9d*v\x90\x85\xca\xc6;\xb2\x85ٗ\uf6f0\xe0\xd8\xc1оz\xc9\x16>\x12\xbc\xf2\x1e\xc6\x05:\xa64\xbbu\xf3m\x85/&\x82~\x950\xe8ɮ&S\xee(\x041\xa6\x1c\x8a\x84n@z\x17\xf4\x0f\xa8\xa9\xc2H\x95\x19\x1b\xe4\xb39N?\xe5\xa2\x18`Ѳ&\xeb\x06\xa3\x1b\xa7\xb7GxxR\xe8N\xf5\x80\x0e\xa6\xde^ΰJ\xfa\x89 \xa2c\x113\xee\x19\xfa쩾\xd3&\xe5\xf9` \xccd\f\xb0\x8d\xfdn\x9d.A\x15\fta\xb2\x8a\xe5\xf0\xf5\xb9\x85U\xd8\x0e\xf3\x8b\xa5\xf0\xabJ\xe0\xa3\xde\f\x8f\xbe\x17\x19\xf3\xd2\xcdL\x80\x1bྡz\xf8\xa1 \x91\xa8\x8d\xff\x98\x88\xa9b\xdfo\xb0\xc7\xe3!-$\x0e\xbb}\xe8\x12\x81\xc9\x03\x99\x950;\x89@&\x86z\x8f\xfb\xaa\x878\xba\a87íI\xdc\xf3\x96\x05N\x06rB\xa26\xc4H\xb11\xa0\xbc\xa4\x91)U\x96=5F\f\xbd(\xa6\"KKoJ\r\xd6[\vf\x80\xed\xb7\x1eI\xeb\x9b(\xba\x02D\xba\x02\x866\x93#Lm\xcd\xe2t\x86x\x1e\xe8\xcdûlA\xfd)'G\xb3\xf11\f\vzr4\x8a+\a\xe0\f\x813̗\xd2\xd8\xe9Y\x1a\xb2#I\xfd\xf2^\x87\x9a\x1cs\t-$\xbb\x8b\xbc\a\xdc[\xe56\xccX\xfco\x1a\x04*l\xef_}ّ\xb2b\xbd\\\\\xea\xe0\xbf\x1a\x99\xe3\xd5sA%ߎ\x9eM\xcaɳ`\xc4<{\xa5\ued10\xfb\x18\xbd\xee\xa36\xc9'\x8c\x9d\x8a\xfd\x0eu-WO [\xc1\x1a\x06\xf7\xa3p\xcb[\xe5\xd8\x16.O&\x8c\x99t\x00\xdc|g\x88\x11#B\xae\xa8:\xa6\xee\"\xbb\xda\xd9!\x01[C\xc7\xe3\\\x05\x1d\x16NK\xbf0̏m\x1aʁ\xdd\xe8\x1a\x93e2\x05d/\xfa\xa7z\xb5\xbd\x94i\x8cLl\xc1\xb2ԵM\xf7\xa8 Ambh\xff\x17\x88\xfe\x13ӭ\x8eM\xb7\x92\xd3\x15㰫n-cԜ\x88\xe5\xcd$@up\xcd\xd6\xeaU\xc6+)\x92z\xb3\xa1\xdcG\x91\xa6\xae\xe3\xb9x\x9e\t\x91vKI\xbb\xdfQC?\xbb&\xbc\xa1\xbc\xfb\"\f\xacƖ\xbe\x05\x8bð\aXy\xd1\x16\b\a<\xbc)/\xf1\x03\x13|\x16_̄\x1do\x0fD\xad\xf5\xc0\xb71\x99x\xbeꉸ\xa1q\x9e\x00\x1e\xb8\xf2\xdaEH\u007f\xceC;\x8fA\xb8\x00\x1d\x02{#\xd9|Kn\xa9\x142j\xe4S\x01<\xb0<\r&m@H\xcceJ\xd3`\xc7)1\x17|n\x95)\xe3\xd7Ƚ\x82\x17\xb08\x0f\xa0Ĺ\x95\xbab#\x98\xebK\x99\xb4b7\xbe\x8c\x15\u007fq:rá\x9d\xccUqp\x83\x89*\xe5\xf3\xf86\x9d\xf0\xf8DoG&VOy7p>>\x0en\xd5T\xc5R,\x92\xec\x86;t\x12JG=\xb8{\x9e\x8e\xcf?\x11\xd1M\nf05\xea\xa7d-\x0fn\x89\xc1\x90%\t\"\xbeɨ\xb7\xf5\x93\x17V\xdb>\xae\xad\x1c\xfc^\xab\xf8\xd2\xd5\xef\xa6O»\x961Ȁe\xe4z6\x17\xfcg\x94mqM\xf0+\x16FuM\xb3\xee\xea\xed\x91ݏ!}|\fJ@\xf2\xae\xf9\x10\x8c\xb3\x1c\x1d\x0e\xbb0D\xc2\xf8\x98J'O䈀>\x12\x19rhl\xa9[~C7,\xd4\xcco:^q\xc9o\xd8\xc6\r\xbe[ю'\xe9pQ\xbd\xa9A\f\xa8\xa1bg\xa7B3dS߲\xd0#\xc6c\x18\xd9\"\xcc\x14\x80\x00\x00܍\x82\xac\x99\xa2\xbfEo\xb7\xa8VRˈ\x91}\x8dx\x1cQ\u07be\U000b4652ݮ\xb9\x87W\xe7\xf0\xceۀi\x11Հ\xfd\xfb,\x16\xc4$6\xae\x8c8I\xc5]ݭ)\x18k\xb8\xa5\x9e|Hg1n\xbe\xc4ab\xc0\x8b\xd4\x00\ao\xeejz\x8fZ6}P3\tPh\x05\xb1\x10\xc2\xe9\xcc\xc9\xdc#\x06\xad8\x9f*M\x93\v#c7l\xfd\xf2^h\xafp\xca\xd6\xd8\r\b\x87\xef\xf8\xbd\x8a\x18}*\xc7f\x9b\\\xf2)怼/\x129\x13\xa1>\xdd\xf7\x91:\xe3\xc1\xa6.ɔI\xbb]#\x91\x8c\x02\x1ex\xe5^Ӽ\x9aN\xe7\xb2X\x93^VWh\xbe\x8a\xccC\x96-\xa3!\xdb3\x98(\\ءI9J\xdb\x17\xe5BNX\x82six\x05\xb7d\xb3\a\xb12\x86\x8e\x1eD\x96Cn\xb7Z.\x8c\xbdT`\x9d\v\x1f\x123\x1d\xbd6l\xe7\x94l\x03l\x13\xf4ʋ\xc2\xd5$\xf8\xd81\xb3\x9e\xedmi\x06\xc0\xfa\xe3\x01\xb2aJ/\xf4\xe0\xfd\x80\x9bz\xff\xed+}4\xdb\xc7\x0f\x8a9\x04\n5\xc7\x06\xc1AX\xe2U\xfd\xd3{\xe4\x16\xd7\xde\x03\xa2\x142\xbf\xf5\xa8X-#z_\tR\xf6\xee\x92\xe9\x8f\xd5\xe8\xa6\x17\x9c\x14\x8a,G\xed\xc0\x18\xf9쬿1Q\xbe\t\xc5U\xf6\xc0\xc2\xc6\xe2.FGCޠ\x9a\xf1L\x92\x1f\xba\xd1\x1b\xb6,\xe1\xc2\xfcB\xd6\x1f\xabe\xebȵ\xc0\x9d<L\xf3XO\re\x8a\xf2\xa2\xb8$W\xe3\f\xb7\x92\xe5p\x95\x95Q\u007f\\\x8c\xaa<\xbej>\xaa\xc1 \"\x9b\x02\xa7\xcec\xc4\xdaBZ\xe4)\x9e\x00byo\x91VQ\x06\x1d\xaa\xc1\xf3J\x05\xd4X.0\x17\x90\xb1Z\x16\xbe\xb0>\xfb\x89\xbd\xe2\xde\xf1\x98mB\x92[\x05\n\xa9\xc20\x86j(0\xf4\x98\xe0\x89\xa4{\xb1\xc2\xce\x03\x16\xd8e\x15\\\x1aN\xe6߇\x80\xf0p[\xb7\xee\x02\xa1\xf1\xc0\xf0\xc1\xed\x88=\x8f0\x16\xaa\x1b\xe8\x95a\xec\xf9\u007fxePp\x9e^\x1a\xe4\xba'.\r\x02쯍\x8cu\xf9\x00\xb3\xb5\xd1\r\x86k\x9b\x9d\x12Li\xe1Z\xe8\x96q\x18t\xa7y2,\x94\xbb\xfb\x85J\x1f\xcaw\x89\xfa\t\xac\x82\x16VE\x1b\x0eUuĽ0\b/9\xf0\xdb\xd5\xe3B\x061t=tL\xa5ͷ՛\xd7`A\xff\xfc\xf6\xabИ\x0e\xf7Di\xa7\xe6\x12\xcbZ\xb5\x9ay\xffnͧ\x0eL\x83y[\xec꜖\xf9\a\xddP\"_\x99\x81\xe8\xbf萋\xc8\xd2p\xbb \x90\x98\xd7\xc2ق\xa1\xacǅ\xff\xb1\x0e\a\xb1-P}\x94\xa5\xfe\xef\xe2Uv\xfe\xd7vA\xf6]\xb3\x80\xbf\xe75\x96\xedq\x97h\th\xc6\x1d\"\xd9R9]+\xf4\xb2\xc0\x9aG\x03\x96\xed1\x91\xc7\xf4\xa0\xf3\xfd\x00O\xc1X\xc4\xdd\u007f\xf0\xa7\xac\xc1\x18\x02\xeb1\xc8\xd7\r\x18:\x87\xc8\xdcy\xe5\xe7#\xee]X\xe3T\x16\xe7\x82:\x81\xbb^\x8b(\xa5VՎ\x85\x81H\xec\xf3\x93\v\xff}ã7!\x16\x1e\xf6\xf6\x8c\xad\xcdG\xbcܗ\x95rp\xa6\xb8͖\xf1Ɖx\xca\xfcZ\xdc\xf4\xbb\xd8DsX\u07fc\xe9\xba\xe6\x16-\xaa\xb3\xb3k0ֶ\x93\xeb\x84\xef1\x98\xac\xa7\x8d\x88\u007f\xbcjĳ\x05C1\xf7\x14\xf4'\xaa\xddt\xfd\xf9l!Aʝ\x96\xa2\x1d\xbb\x99\xdc\x00(\xb6\x95Am\xc7d\u007fD\x1b\xbc\x9e\xde|ް\x8b\x85\xf8>]\xc6\xf6n\xb7[k͘\xb7\xb5U\xd3{M\xd6\x05\v\x85\x95\xdf14\x85\xcb\xf8:\xbe\x89ׂ\x8b\xd8!Y\xdfK*\v\xb7\xf1\x92/\xc1\x8fV\xec\xebX\x85|0)9[\x82/n\xc8P\x16\x9d>\x1a\xe3\x03&\xef\xc7\xf7\xe8@H\xef\xc7c!\xbd\x1fqO\x93%$\xedi\r\xef\xa4\xe5\x82\x06\x1ce\x97\xb4*\xf3\x91\xa8$_\xea@\x9bؾw\v\xea\xa6\xdeL9\x9b]̰\xc6v\xa0\t[U\xd6f^\xb7ߒoa\xa1\x18\xcfb)\x10\xbb_\xe1\xfd\n\xefs$\xa1\x8c\xfd;Y\xc69[\xfb\x8c\xff\x99\xacb\x19\x98B\xf9'V\x14G\x1ak\x9b\x13}\x83ejF\x13J\xcd\xf7ߐn\x95\xec\x9a=ƺ\xb0\x87\x88\xcb\x01\xeb1>A\xb7\xfd\x80F)摝\xf83#\x90\xba\x8f\xb3\xeb>\xce\xe6\x823\n\xa0\xe6qV1\x03VϮ\x9a^s`7\x8a\\\xff\"\\\xb8/\xca\xeb}\vD\v\xea\x1e$P\x11\xe7q\x1dC\xe3\x1b\xce\t\x02\x1b\x19\xbfM94\xb8\x83?b\xe3\xach\xf8\xe29\xbf\x98\xd2I\x9d\xe2\rrg5\xb9\x8eoe\v\xc9v\xcc\xd7ߨ\xa7Ƽ\xe0\xa59\xa5`^\x8a)\xaa̭\x10\xbb_\xa0o\x1f\xaec\xc4\xc2\xe5\xe6*\xbe\xed\x85Gu3+\x1a\xa2*iXt@\x88\xe9\"&\x8b\xcff\xcfË)9ϣI\xf0\xff0/\xd3\x0f\v\v\x1d8\xb0\x95\x8a\xd3w\xae\xd0+\x9c \fE\xf2\xf4\xbb\x1dv\x10[\x8a\xa5\xcc\xc6x\u0082\x1f\xe9\x04\b\xc2\xcd\xe1W8gb\a\xfe\x91s\xf1(E\xc0\xe0\x88\x8b\xba\x11\xe2p$\x82\xceǷ\x9dXgJ\"u\x8d\x1d+\xa9T\xca+\xf6\xe5\xeduX.\x98\x14\\\x0e\x1d\xfa\x19/\xc9\xe6\xbd̼\xcf(\xb55\x86q\xa4S߄\x18J\xe9ST\xba>\xb3G\x1e\x00\xe0\xee\xe9=r6\xdc\x11a9\xb4/\xfaT\xba'vci*z欖t\x9bOlԛ\x83\xd0\xe0F\x16\vI1\xd3C\x04\x04\xc7\xd2J\"f\xac\xb8\x01<\xd5\x1b\xb2\x9e\x8a\xf6\x11\xba\x94\xc3\xef\xe3>xUo Z\xf4XC\x9b\xbaCg2hv\xe8\xb9\xcb\xd2&\xb0\xcb=\xb2\xdc\xc9@\v\xd38G\xf9[f\xc1\x97\xdf}\xf5\x9f\xcc\xca\xef\xc8\x123\x0fzS\x8e\x0e\ax\xec\xc7\x02{\xfc\x12e/ \xec5\xcb3\xfc\x89\x16\xddb\xe0y\x18\xa5l\xb9\x1e\xb8\x89\x91\x96\x8b!{\\W\x03=>\xda\xe9+\xf5\x02D\xa7.\x19\x8abf\x89\x00D\xbd;\x98=\xc1\xc3\xed\x12paDz\v\x1ar\xeb\xc7j\xc9\x1f\xe9\xa6\u007f\x14\xf7\x87\xb8\\X&\xe7\x01[p\x01ꦹ<\xf6\x8a#(uU\xa5\xb6\xd4\b\x87ϵ@.\x06\x12\v\xcd\xc0\x14E\xce\xe7q\xfebZ\x9c\xbf8Hs\xcd\xfcnZ\x1e\xfd@\x18eޞ\xa0Qy\xfe\xc2\xfc|Z\x1c\xd2'5\x94\xfd\x1czh\xf0\xeaA\x0f.x\x90\xd3\xc6\xef\\%rU\x80U\x96^ӹ\xb1\x99\xb3\x1fo\xf5G[E\x9c\x18\xb3\x87˾\xd9\xect\x84\x9d\x9f3\xd1\xff\x88\xc3\x11i\x1b\x12ɨ&\xc7v5]\n\xc2\x03?F\x1a\x03\xf2Ѥ\x9c\xaf>_\xe2Cv\x1e\xd76c\xd7\xd3UZ\xbfZr\xabH \x14\x13\x14\xba\x81z<\xady\x86WTr\x8b5\xa9\xc0\x83\x10X\xaf&\xc5\xfc\x1az`\xaa\x9er\x03\x8b\xdfM\xaf\xd3\x1b\xe8䞧DC\xeb\xddD<\x9f\xde\xc8\"\xfd\x11\xed\xad\x9b_\\\xba\xe7\xfa\xc9X\x9b@\xb5\x12\x14\xf6V\x1bWJ>>\x86^\xf1\xc1^/\xc4_\xee`\xe6̕\x14\x8f\xfaL\x06\xfe\xb4U[Z6#2\xc9\xfe\xf1\x8f\xf0\x82\xfe\xff\xe7\xdc\x16$\xc0շ\x98W\xbb_\xd5 #\x95\xfc\x80\xaf\x85x\xf9\xf2\xc3[Lu\xeaM\xd9N\tk\xbd\x1d\x92\xbc\xa8\xcd2u\x85\xe7\x1a\xdbQz\x91eq\xc6Ml\xbb\xc9\x01\xc9\xdf\x1a\xd7BV3\xd5\xefZ;<\v\xe3\xc0\xbf#뺴\xce\xc1\xec\x17b\t\a\xf2ۦ\xa42\xc9o\x16\vy\xd2Q2~ͷR;\xf1O\f\xbd\xf4\xba\xd3I\x16w\xdb|{\xbc\xfd\x85\xaf\x86\xf8\x8dx;\xf8\x95yw\xbc\b\xd9*&\xc2#vC\xdcX\xf5\xb4\x88_\xfc\xab\x87\xfa\xd0^\xe4\x8b\xef\xec\x1b,xx3,\x90AX\f\x1awn٨\xe6YP\xab\x90\xc5\f\x1c\x9a\x89IiM12p\x8a\xd3,\x14\b\x8b\xef+\x1fz\xacj\x01A\xa5s\xf3FV\f\x88[_̀|u\xbaj@\xc2\x14u\x03\xbfZ\x0ez\xdblY\xba\xea\x97\xe4\xa0%\x8c\u007fZ\x0e\x9a\xe7\x8b\x15X\x9e/\xe6[\xfd5\xaa\xfa\xe7\xe9\u007f\xcf?\xd0\a\xe8K\xf4_\xb7\xac\xa4\xfbH\xbeٴ\a\x87\x16U\xef\xf5x0\x12Ң\\Fe\xbc\xe4\x99\xde\xdc\xd0\xc5T\xb0?C-gw,Cmg\xa6W/\x9do\x85\x12\x82\xf7\xab\x97\xaf<\xb0\xd5.\v;u}\x88L\xc6z\xda\xd4U\xf3ȮͲH\xfe\x97g\xf1~\xae\xf3l$\xcaT\x00Z;\xd46\xba\x9e\xeaP;\x1f\xeb\x1d+\xb0\x9c݇\xd0\v\xc6%\xf7BA\xe6\x11X\xddE\xe1\xf7ٽ\x0e\xfbH\xb8\xa2\xfe鰮1z?\xdc\xc0\x93\xb2\xf8\x18_L\xa8m\x13\xb9}\x1f\xe1\xe8\xb2)#+\xf7\x1bY\xa6\x89\xa5\x10\xed\xb7\xad\xf2D4X\xa8+n_\x11f_\xa9\x87\xff|K\xc5k\xa1\x9c0\xa9\xa4E\xc2̫!\x9bJZ*\xd0\xc6R:b\x1d\xe7\xe6\x8dT:\xe2֧t\xe4\xab\xd3JG\xc2\xfcyJ\xc7pv\xec\xf3cwMAۖ\a/D\xad\x83\xd8\u007fƫ\\\xe4|\xb9k\xf1Fը`\x95\n+\xdb\xe2\xad\x16܍M\xdd\xd2\x15S`\xe5\xfd\x13\x97d\xa9A\xe6`[\x94\xea\aA4\tF\xb8\xbfy\xb4\xaeG\xafpw*o\xc7\\\xdb6\xbb\x14\t \xde^\xdf\xf3\xd3ܸ\x85\xd3\xdb\t\xc7\x1dku\x9e\x8851V\x96\u009f`x\x8b_\xb5\xdb\x0f\xb8\xbf_\xc6*+\xd0V+i\xb1\n\xfc\x85\xd6\xce\xff\xe2gkxݡW\xc7\x17gg\x85s>\xb39D\xa6\xd1\xf3#\x1a\xbd@\x8d^\xf0\xf3\xb3\xf2\x9e\x8a͍\x92.\x8e\xe1\xf4b\x86\xcbf\t\x8fw&\x12\x87\xb8\xc9B*\xda\xfd&\xea\x1f\x1f\x99\x8btK~2\nn\xddF\xf1\x89\xa8\fo\x15\xd9\xc3\x13\x8b\xd3O\xef\x8a\b4\xa8\xfal6\xc7J\xc5\x1f\x18\xb9\x86\xfeѢ4\xcbQ3s\x17=\x90\x1aˡ\x15#\xf2\x13=\x8d.-\x02\x94r\xdaDh\x18\x19\xd9\\\x83\x1d\xb9X\xb3X&\x02\x04\xfa\xb6\xcf\xf5\xc8J\x17\x96\xf6JP\xcf\xff\xf6Ib\x14\x86\xb2\x9f\xfe\x10\xebX\xe1\xe6Uy\x96@\xd5+м\xbcd[]#\x168(bzu\xf5\xf8\xc8RVQ\xd26;#y\xcf\fE)\xc7\x00\xb7St\xad\x0f\xfd#?dR\x00Xj\xdf\xf2\xd2\x18\\\t\xdc\xc4Αh<\xbf\xb8b\xbbCr\xeb\xf0XƇÑ\x83\xc1\xa5\x9bX\x92\x87\x0f\xc20Q\xecՑ\x01q\xbb\xf9\xa4\x98\xfa:\x91\x11=\x99\x95\x17\x13\x94yy1/\x19\x132e\x96*80\tf\x8cY G\x06寲R\x1d\x9f5\xceB\x92U\x97\x95\xda4\u007f%\x8b\x14\x18l\xfcU\r\xc2\xe2KKp\x15>g\xb9_\xf7ۙ\xf7\x13\xac\x06$\xbaΏ`\x9d\x1f|Q]\x92+\x80\xf4\n \xe1E8\x86\xbf\x93\v \x04\x06\x1c/{\xc0\xf0\x13g\xe5\xe4;\xcbO\xeeK\xf1\\\x1e\xbb\xac\xe9\x9f\xc7\x12\xda?o\xbaz\xed\xd3\x1f\xf1\x93\x0e\x89\x15K-\xc1Ndm7\xef6x6\xc9\xf1|\x9d\xf8\xd9\xc4m\x86\\\xa4\xde\xe3\xcf\x061\x95(\x06\x15\x06\xeb:\xf0\x9d\x9b:W\xbf\x90c\x9cq\xc2v\xd2\xeb\xa3;\xec\x9f\"X\xd7\xc6I+\x1e\x88\xd6A\x0f\x12\x9b\xb6\xf6\xb2\xfdc\xf5|n\xdfJ\x9bD=\xb0\f\x96\xdc}y\xdadѐu\x85\xbd{l\xa65P\xf3\xa0\x01\xcfa\x9a\bI\x1e\x027t\x92f\xae\x8e\xeb(D(\x0f\xeb\x02\xff\x17N\xd2\xecH\xce\u007f\x8d\xc9yf9\xde\x1f{ȥ\f>\x19\xa7\x00\xf8\x8b\xb3O\xd5;\x99`b<\x86H\x91\xdb~-\x8e\x061\x893\xc2\xe3D\xb2\xdc\xd1(\xecDEv\xa4\x96s\x8cQ\x99\x95C\xa7j\xa9\x13\x8b\xf0\xf4:\xc9\t\x8cW<gY˓R\xec3\x9fS<U\x1blA<\xab\x8e\x9dR\xea\x94n1,;ۧ\xe9A\x14SIN\xa9`\x00Ց\xe3\xf3\xf0ЯR\x16yH\xf1\x95\x9b\\\x89\xc75E\xb1\xdd`\x89G_\xc8\x1a\x19\x03\xe9\xba\xdf\a\\\x94T\x97\u007f\xb0\xc1\xf6\xc6z\xe8\x1d\a\xe7\x11\x93<\x83\xa1\x88\x95\xb2_\x14\xf3\n:}\"\xa6\xb5\xaax2\xe6q\t\t\xbc\xe39#y\xb9`\x01r\xabx!w\x8a\x1cл\xeb\xb9\xcc\f\xe0\x90\xf8\xc3\xdd\xe0O\x16z\xb89\xbf\f\xe5ٚ\x85\x9e\xa0\x1e$\xd2\xe0S\u007f.l\xbe\x1c\xfc\xc10z,\x19L\xd9֘^*\xda\x0eG\x92|./T\x18\x92\xe4\xde\x10$<~B\xf8\x11\xe0\x9cܲ\x84\xa0\x067,!ѩ\xe3\x9e\xe5\xc3m\xbd\xeemb\xf2\xec\x04\x12{p\xa4\xcc\xe3g\r\xfe\x9a\x82\x95TU\xfdӓ\u007f\x85\x8c\xb7\xfeg\x9d-\xfcă\x84?\x15փ\x1bD\x10!w\xdb\xc9d#4\xd6\xca\xfe\xa5\x16\xdcP)s\x85\xe6\xef\xbc蟈{\xf2\xe7?\xd4݊\x89ʯ\x9bf\xebݍ`\x1c+\xc3\xc0\xca=D\xfbͶ\x16\xa5\x80[\xact\x91\xb9e\xedZ[\x1d\r\x9dl\xfc\xa77\xef\u07bc\xcf\x02\x06z\xc4\xfe\xc5\xd2/q%\xd2\xd1\xd6\xc6\"ᅂ\xab\xce%#7\x1e\xfa\xe5\x06\xe6\x98\xec3Y{c\xb6~\x1f\xc1|q\xe4<^1rK\x0eq\"\x9c\x9bq3>H\xab \xc0\xfd=2\x99\xb7\x9f\x0f\x8dB\xe4w\x89g[\xadw\x19\xcd\xe9z~\xccm\x88\x86\xe2\v\xdf\xc6\xdd~نơ\x9a\x82\xed\tj\xa3Kˡ\x95\xe3,\xf9\x10\xe3\xfe\xc0\x85DL\\\xf9\x1c,\xb6\xdf\x11\xf0\x12\x83k\x9fpB\x99K\xe6W\x152\x15+\x00\x84\x96\x91\xafJd\xc9\xca\x05\x19\x14\xa7d\xd6\xdb|ŀ\xc9\xce\xdc/D\x05\x1f\x92\xff\xd8\xe0\x10PI\x13}\aN\x10\xcb\xc1\x8f/Rސ\x9dֆ\xfe\xb2=u\x89\xaeWY>]\xe9jW\xfe\r\f{\t>\x9e\xa8\x93\x1d_(\xcfP\xf0\xe78\xcb\xea\xe8\xa1?\x10\xbb\v\xfe[\tX\xa1\x17\x04\xb2DX\x90\xf0$\xac\x17\xc14\x98\xd4X\x1dg\xfc4\xc8\xf5$H\xb4\xb0\x1d8\xba\xe8\x06\xbe8zX\x91\x94\"ul\xc8\x11]yR/\xa4\x9bm\xb2\xb0\xac\xd0\xf9\x18\x86\xbc6\u007f\xd8W\xcc@ٟb\xaaq \x86\x13`v\xd3\x18E\xef\u05f7\xf80x\t\xcaԿZ\xd3\x15\x1epc\xff\xde\x03\x03?ח\xea\xd7B\x99\x88\xf6\xe8{\xfe\xe2\t\xbf\x16ʠ\r;QG\xdc%>\xf5\x93\xbf;\xa0O\xf6\x8cT}f&/\x1e\x1fA\xcdɛ/\x19\xda\xd89\xab\xa2\x92\x96#2\xb3\x1bD\xea\x83\xf7x&\x87n\x8d\xf5&\xc6+eP\xa01k9h\xf3\xff\t\x00\x00\xff\xff\x83A\xfc;K|\x00\x00", size: 31819, local: "web/static/js/bootstrap.min.js"},
 
-	"/js/bosun.js": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xec}\xff\u007f\xdb6\x92\xe8Ϸ\u007f\x05\xa3\xa6!\xd5ؔ\xed6i\u05ce\x9b\x97&鶷M\xdbK\xdc\xde\xee\xb9~9J\xa4$\xd6\x14)\x93\x94mm\xea\xff\xfd\xcd\f\x00\x12_I\xdaI\xf7z\xef\xb3\xfa\xb4\xb1\x84/\x03`0\x18\ff\x06\x83\xc9d\xe2=)\x93yR&\xf9,\xf1\xd6Q\xbd<\x1eE\xf9b\x93Ee\x18\x87u5\xf2&_\xfe\xa9\xab\xd4nYl\xead`\xd9*\xca\xd3:\xfdG_\xf1iQ\xd4U]F\xeb\x9er\xabb\x95\xe4\xf5\xa0B\xbb\xf1\xa6\x8c\xea\xb4\xc8w\xe7E\xb9\x8a\xfa*ş\xf6\x14\xd8\xe4qRV\xb3\xa2\x94\xc6r\x19\x95\u07b4\xa86\xf9\xb3\xf5\xda;\xf6\x04\x1aWE\xbcɒ\xc0\x17Y\xfe\x8ew\xfa'\x0f>~\xbex\x8d\xc8\xf3w\xd8O*\xf0\xbc\xc8\xeb\xb2\xc82\x80.\xd2W\x8bY\x99Da\xbex\x838\x11\xa9\xf0\x93c\x13R\xce\xc6G\u007f\x12\xf0\xc3Y\x91\xcf\xd3Ep\xeaߧ\xb9\xf9\xb1,.S\xe8-\xb4\xeb\xdfϊ\x19\xa1AJ\x9co\xf2\x19&y\x81Z~\xc73J\x8f\xbdwԸ\x91\x11.\xebU\xf6\xe8U\x11'A]n\x12\xe8\r\x15S\xe0\x85W\xcb$\x0f\xfc\t4ɠ\xe0\xa7N\xeb,9\xf4\xfc\x17Q\xb5\x9c\x16Q\x19\xf3\xe1Q^\xb2ZgQ\x9d\xfcTfPb\x1d\x95u\x1ae\xd5$\x16E\xa9Q\xa9\xfc\xacA\x9d\f\xf0y]f>\x95\xb9\x19\x8b.\xa4\x00\xba\xb2\xf6\xe3[\x96\xd3\xd7\a\x02\xd0\xd9>\x01\xb2\xb5\x9d\\\xafKk\xd3/!#\xa9*\xc0j\u007f\xfb\b\xa4\xb3y\x04fk}\x01\x14\xb4\xb46\xff\x17\x96\xd3\xd72\x01\xe8l\x9a\x00\xd9\xda^\x16Umm\xfa\x1b\xc8\xf0~N\x93\xab\xfe\xe6\x11Fg\xeb\b\x8b\x1ao\xf3\xcb$+\xa2\xf8\x87\xfcM\x12\x95\xb3\xe5\xa17\a@\x89ַ\x12\x96\xa8\xb5o\xaf)\xa3\xaf[X\xbd\xb3[\b\xe6\x0eݪ\xd2\f\x99\x8e\xb5goD^_\xe78\x90\xce\xfeq`\xb6ic\xec\xc4څ\xe7\x94\xc59k\u007fG\x18\xa4\xce~0\x88\xb6nD3\u0588\xa5\x1b\xcff\xc3\xdag :\xdbg\xa0\xacԛVuQn\xed\x1d\x80ʵ\xf7\x8d(\xd1KĬ`7\x1d\xb32\xb6\x9e\xac7\xf6e\xf4\"\xaa#\xef%\xc0\x18\xd0\x05\x80\xd1\xd9\xfc\x8f\x9bZi\xba\xa8\x97Iy\x95VI\xf0N\"\xe08-\x93Y}R@\x85\x89(z\xf4\xa7\x1be7*79nEbǠ]\xa8\x84\r\xfeͬX'\xea\xf6#\xca\xecxm\x89f\xcbiR\xc2\xfb\x05`\x81\xed-ϗ\xb0\xcb&o6\xb3\x19pN\x05Xr\t\xbb\xfe\x8e7۔%}\x01\xdez\x99\x16\x9bj,!N\x82I8\x84M\x9b\x97\x0f\xef3\xf8,\xfd\xc8V\xa3Z\x16e\x9d\xa5\xf99Ԣ\xa5{\xa4\"\xa0\x91\x06\xa4\x1d\xdd%\x15(\x9b\xbew\xda\xe0OJ\x0f\xdb\xe9\t\xfc\xaf(\x93؉\a\xb8\xad8*9R\xe8۲\xae\xd7\xf4\xe5\xa2\v\xe3Ts\x87o\xd4\xf0\x17k\xc1\x9f\v\xeb\fT\x1f\x18\xfb\f\x1e\x90\xf9Z\x16\x1an\x84\xf0\xc0\xb2q\xcd^\xe2̴\xc0/e(\xe9\xdc\v\xee\xf1\xc9\xe2\xcd\xc9ٌN\xebM\x99{\xf9&\xcbڙ\xbcQ \xa8\x00Bd\xccI|\xd2.\x1d\xef\xf8XZ<\xbe\xf7л\x84\xff}\xb6\x84\x1c\xed\xbd\xf3X\xdf\x0f=\x1c\x9dwck\xdb\xe8ڍ2\xf8_+\x18\xafs\xe8\xbc\xf2\xbf\xbf\xf9\xe1{\xc0b\x99\xe6\x8bt\xbe\r.w\b\x1cL:\b\x89c\x1b\xd4i]D\xbdPa7\x00i\xee\xa7\xd7\xdf>/V\xeb\"\a\xac\x04X\x0f\xcaZa\xb2\xe2w\x81zi\x85W&\x17o\xe7e\xb1z\xbbR`\xaed\x98\xb8\xc2J\xc8Γ+\xefur\xb1I\xaa:\x18\x1f)\xd9\x17<\xfb?6I\xb9\x953/\xc2U\x02(\x9bA\xfe\xaaM-C\x80R\xa6I\x15\xae7\xd52\xb8\x90*\xf0\x01\x94\xb6ή\xa3<ɞgQU)\x9d\xad\xea\xa8\xdeTD\xfc\xf3\xf4Z'Z\x96\n\x84u\xec]\x16i\xec\xedA\x01O$z#\x82\xb9;:\x92\x88\xa5\xbaJ\xeb\xd9R\xc0թn\x16U\x897\x9a\x95p$\x98E\xd9\xe8PɔF\xc0\x9bx\xe8\x8db\\\xbd\xe5\xe8\xc8\x02f\x93\x9f\xe7\xc5U>\x04J\x9a\xcf\v+\x8c\xab\xa8́&\x87\xc0\x10Em`r<\xaf\r\x1aO\xc5\x18\x91\x15JR\x96Eyg\xa4\xc4\xc9<\xdad\xf5\x90\xea\xac\xe4H_\xec\x9c`\x90&\xab\xd92A\xde\xffu\x9a\xd5I\xa9Q\xfd\x1c\x8e\x00K\x85\x8a\xe6TL\xa7\xfb\x18\xcaܿ\bc<\x96\xcat\xad\x02G@R+RKpt\x84Sp\xa2\xaf\x17<\xb7\xd2&\x10.\x92\x1a\x85\xaeu:\x89P\xb8\xa9\x9e2@\xc7\xc8\xfb,\xab\x98\xe5z\xbf\xfd\xe6\x8dF\xe3qȧ\"h\x87\x11\x83p\xa2\x93\xac\xd8\x01x\x9f\xa1m,ud+S\xa7\xab$\xcac\xc8\x17\xc5\xc2\x13Hz\x96\xc7 \xf5$\xdal\x01\x1e\xab\"\xbbTF\a2\fр\xd4%\xf8\xad\xf7\b\xab\xfe\n\"\r\xe5ɕ\xdb\xef\xebp\x9e\xe6Q\x96m\x03i\x033\x19E\x1c\xae\x81}\xa5\x8dX \xcd\xff?`\x04\x9f\xee\xb5\tE\x99. \xe9\xf3=)-K\x17\xcb\x1a\x12\xfd\x8f>\x9bF\a\xf1\x9f\xfd6+\x8e\xcas\xcaٟ?:\xf8\xf3c)g\x95Ĕ\xf1\xe9\xa3\xc7\xc9T\xc9\x00\x92\x84\x1chyB\xad\xb59S8\xcfa\xa5\x83G\xde'T\xac͚\xa5\xe5,K\x90\xa5\x9d6\x83;\xdd\u007f\xb4\xb7\xe3\xd1?؍\xb3Vz<}\xe4̡DʦQ\x19\x95\x8c\xac3\tY\x97\x88\x9a\xf8ӰJ2\x9c\x17\xff\xa3\xacX\x14\xfe8\x8c\xd6\xeb$\x8f\x03\x1f\n௺\x06\xb1h\x99 \x10\x10D\xaa\u007f\x88\xa4\xab4\xae\x97,\x85\x01\x85\xf2\x1cֳ,\v|\x94_\xc3)\x82@\x9a\nNOۮx\xa7\x84\xaf\x03>\xa03 \xa0\xbc\xc6\xd5ִ\x8d\x95\x9b\xc6g\xc8\xffQ֚\xb6\x1d\x92Z7\xbb\x88\xb0Ery\rI4\x17M\xcaVO\x81%\x96)BVl\xd9`\xe3\xd3\xfd3!G\xf1z\xdb\x01\x95\xf6\xceT\xe1K\xc3\x12\xea\xbc\xc28\x8dVE\x1e7\xa8\x12\xd3jA\f\x96o\x90\x10\xbbۧ\x19F\x92}\xe5!_\x899\r\xa2hE\tЄB\x96\xa2\xce\x15R잙Wy\x0f\x01\x98\x97Q\xe5\xab\x06̕\xa3\xc4\ueb4a\xf0\xaf\xff\xe5\x1bk\xbd\xd20\xceg\n\x96\xa3H\x01\xf1\xac8'\xc1\xfcj\x99\x82\x84\xae\xa6\xef\n:\xd9\x17\xab\xb0\x83X\x05\x00i\x12>uτ\x9dD\xd5N\x88\xd6\xc3G\x06\x95\xfa\xfb{{\x1f\xfbڸ\xb4\xea\xd7\xfd\xf4\xa5L\x94\x83\xc6\x18\xbb\x11\x03\xe3\xcc\xc7\x1cPS\x8c\x0fi@\xeb@\xddZ\aD\xe5!Kc\xdfQ\x19\x95x\x8f\x0f\xc2f\xc6n\xb7J\x0f\xf4U:\x90B\x0e4\n\xc1\xd5P\x97Q^\xa5\xd8\xda\v\xae\x8a\xc1\xdd䑴\x9b\xf0\xdd\xfey\xb1\xc9q\x1f\xd8SOX,S\x11:\xe4>˕\x1f><R\x84X\x15\xf0\xb1\xb7ol\xa8\xc53S\xd2PD\xa2\xa6Q\xa9\xa8~\xbe\x93\x9b\xb1\x9f\xb6l\x87+\xdc5\x96\x9b\xf9\x1c\x8eق\x9c\xa4U>\x80\xfa\x94i\xdc\xf1R\xc7A/U\xa4\x8cv6\x80j\x85\xd5!0\xe7h\b\x05wQ\xf1`J\xee\xa2f\x05H7\xe9*`\x04\xf9\xb2\xfa\x12V\x93\x1a\xc528L\a\xcdt\xeeX\bT\x9c\xf9d:D9J!B\x10\xb2tJ\x80$\xed\xc4D)\\\x01\xa3\x1d\xea\xb5\xfa\xf8\xb1/\x04\x95l\x12\x00e\x12\xf7\x97Ԝ\x13\xd8\xeen\x97ď\xaa\"\x94\xacA|i\xd4F\xfe\xb8\xd9u\x05\x020+ɝ\v\x91\t\xe6\xeb\x02\x8e\xb9>~\xad\x0e'\x93\xab\xab\xabpQ\x14\x8b,\x01Q\x1d\x95D\xabɦ\xcc8\xa4\xa4\x9c\\\xee\xe3oE]\x88\x9f\xac\xc8\x17\xa4\x11\x8c\x8bن\x8ch?\xbd\xfeN\xa6\x88a\x02<b\x89d\xf1\xd4 \x15\xa2\x06\xecFx\x19e\x9bFhO\xe3#\xa3\x98C\xa9\x86Z\x13\xb3\xb0Dav,\x99\x1d\x80*oh\xa5C\xd9\xd7x\xbc\vHH\xa5ބY\x92/\xea\xe5\xd8l\xe8FK\xbb1\b\xfe\x86k\xfa\x84\x19\x92\x1d\xfd\xbe&\xfb\"\n5\u007f\x87\xcf\xe4իɋ\x17\xbb\xdf|s\xb8Z\x1d\xc2\xe6۔F]'\xea\xff\x92V\xa5]&Y\x84\n#\x1cߡ4\xa2\xf9\x06V\x1d\xa4\xc0Q\xdb\xfb\xb8\x1a\xb5\xe2\xf3:\xaajH\xfe\xb8ڍ\x16\x85\x94^ab,\x97\\Q\xcaJN1\x93\x96\x94\xb2\x94S̤\x98Rb9\xc5LzE)\xb9\x9cb&m)e+\xa7\x88$\xce\x1c\x10\xd1\xcd$\xa3\r\x14\x17[q\x9e&A\x1e\xad\x80\xaf\x10e\xe1Tn\x1bm\b.\xb7\xe4z\x9d\xc2\t\x90M\x12#\xd1me\x1c\xa0ٖ\x87ʡ\x17\xda\xf6\x84Y!\xa7\xb3\x80~,\xf8\x8f1H\x80\x04\r\x98\xe8\xc1g\xf0\xcf\xe3=\xf1\x0f\bJ{c\t\b\xef\x04\xaar\x8eď\xe3\x11\n\xb9\b\xb0.\xfe\xf2\xea\xe4\r\xe9\xec\x02\x85\x17\x12\xf3yg\x832\x92\x8b5\xcbvF\xf8\x80\xfc\xa4\x9aEk\x86\x18\xec\xe5\x88\xda≄'L\x15\xe0\x1eb\xa7Ȑ=\x01\xb07-\x92\x01Ǳ\x84b\x19\xab\xf8\xfb\xe5\u007fX[\x92Ό\xa8X\xd4:\x17V\xeb,\x05\x96u$\xb4\x91\xf3\xa2\xf4\x02,\x9d\x12\v\x86?O\xa0\"_\x89\xf0\xf3\xe1C}\xb2PM7\x8bNSi\xd7\x01))K\xbc`\x16ΖQ\xf9\xac\x0e\x80;\xe3\xc6\x00\xa2\xfaXY\xb6T\x15\xd8ٔiH\x83\xfd\x1d\xf8i,z\xa4\x92Y\x98\xe6qr\xfd\xc3<`Ce\x00\xf7ƶ\x1dp\x93s,ȠY5\x0e\\jF\x99`E\xe3+a>)\xa3*\xb1\xa0\xdeB\xf6\xa3ю\xb7\xbb\x8fv\x86?M&\x1ejI\x0f=\xdc\x0f`;\xa8\xeahv^\\&\xe5<+\xaeh7\x88&\xfb\x8f\x0e\x1e\u007f\xfe\xf9\xa3\xcf&_<\xfe\xec\xe0\xd3ǭe\x86\xe9l\xf0\x8c\x00\x15*\xd520nx\x12u\xb7\xcd ˷E@cV\x8d\xb4zV\x96і\x97r\x88\x0f\xa7g\xb6mW\bSdY\xaf\xb2t\x06\xcb-\xe4]\v$\x8e\xdbg\x1b\x91,x\x9aq\xa41\x89h\xb6(f2\xb1XE\xb85D1KAayUTd\xbcŽ]\x94\nYR\xa0\x9a16\x15i\xe3\xa4\xf55b\xd6\xc8]\xcc\x19\xa9\x85\xeb\xed\x1a\x974\x03D\xbfZ^f\xa0\x9a\x97:O\xb6c\x8bu\x05\x92I\x83Ӗ:\xeb`7j\xa5\xb6\x8eMZ\xdbLWi\xed\x94U8\x8bEv\xa0\xd2\xc0\t\x8c\xe6P\x1e莒\xfdS\x85\xe6G\tij\xf6+\x90I\xa2E\v`\xc5~\xab\x85\xfe\n\xdd?\x94\xc7\xd2R[KxʺR\xe6bGi\x9e1\xf6#\xab\x10\xc6ԣ\xc2.M\x02\xd2P\xcdgC- \x9d\xa1OL\xaf\xa6\xb20t\x95\xa4\x97\xe5Y\xa64~#\xdba]kE\xb2\xb6\xff\x11\xd6\n\xb1zf\x82kɏ9\r\xbc\xad\x93\xebZV\x8f\xe6\xc9\xdb\x12\x17ɤN@\b\n~\x89\x1f\x8e',\xbf.\xb7\x12\xa6ZxQ]L\x03a\"\x94)\x1a\xfa\x82f\x95dl\xad\xe6\xfbrYZ\x82\x16;#\xd1{2\x97ʷ\xc4Ҫҹ+\xc5P*a\x00U\x9d8P\x87\xd0?\xbb\xe5_\x03\xc1\xbe\x84E\xd4+\xa2%\x0f\xa0\x8f\x1d:n\xf9L\xad\xacz\tLk,W\x0f1\x89\x9b)4V\x8e\x8a\xe9\xa3U\x8b,\xcfũ5\xf3\xac\x88|\x8bs\xffT\xea\x93\xcb:a\xf6~\xb0\x8dB\x1cqP\xbb1\x1a\xd9\x0e\x1a\xfa\xa8F?GY\x1a\x8f\\\xa7\a\xfchl\xd7\x05ɴ\x86\xe0\x87\x14\xfa\xe2<\xb5B\xda\r\xf8r\xb0\x9cb\xd89V\xdd1Vc\xef\xc1\x03/Xq\xf9\x04\x8e\xb6\xfb\xc6Vm\x9f\x94U\xa3]v\x8f\xed\xe6\u058cL\x98\x901\x0f\xe5K\xfa\xfb\xdbo\x9e\xff\x12\xbf\xf9V\xde&\xd5\x03\x8a\v\xc6C\xb8\x9d\xea\x128\x80\xe1\xf5\xb3\xb9\xbb\x88\x02\xe8\\\x00\xe2\"\xb2\x8a\xef\xd8W_\xc9\x17x\xf0\xd5买\xe8q\xbe(\x1b\xf6\x98\x13\x84\\β\xec\x1a\x00\xb2\x10\xc2\x12G\xaa>\xc6\xe0\x1e\xac\x94\xbf\xa3\x81\x829\xc2e\xca+3\u007f61X\xe9H\xc1\x92m|\x80\xac\x9d\x81\xda\xf1\xb0F/\xa7\x0e\xdef`\xd1?\xea\"'9\xfbF\xf1Q1\xcd\u007f\xb7\x86\xfdS\x1eM\xe1\x18R\x17\xde<\xc1M\x84\xd9J\x0f\xc9^\x00\x85,\xa4+O)\xc8&qq\xa5*|\xee\x93\xeb\x8c.`\xb3T\xac\xf0\x9c\xdc,\x8e\xbd\xfdO\r\a\x00E\xa2\xe1\x13k\x992<>hB\xcd{\xccz;(I\xe15[&\xb3\xf3\xb73\x10\xe2\xcf\xc9$)\xf9G\tV\x8c%\x9c\x1b\x05\x1d\xc7d\x18Õ\xbezӪ\x16ɜ\xe0כ\x1c]\x0f<t\xd0\xf4\x9ec\xe50\f}\xa3\x86\x8d\x9aܦt}\xbb*7\xb9\xb4\xe9\xb3\x151\xc8\x1em\xb2\xc6\xdbJ\x02}\x14\xdd1U\x1a\fr\xd4\x1a\x1fyp\xe6$U-\x1b7\xb4\xda-{\xe2I\x93\xf9\t\xa6\x97I\xe0\xd7\xd5k\xdaު\xae\xe3f;\x06\xd5}q\xd2:\xdc2 \xba\x0f#\xaa\x0e\x0fe\x17\x1cƶ\x93,Y\xedx\xa8\xe56\xdcf\xd8\xd8\xd2\xea\r\xf9\xfd\xb8\x1d\x98T\xca\xf3\xf0\xe4R̩\f\xa9\x1d\x8a)\xba\rh\xb85\\\xbe\xe4\xf3\xab\x81\x17\x14W65at r\xa8\xef\x87:\x1b\x90\xc0\x00ʎu\xc0J\xe1\x9aT\x8d\xfe\xb1\x96\xbc\x84\x1d\x82\xdc@\x8f}\x89w\xf6N\x8a\xdc\xf4\xfb\xcf\f2\x81\xd6\xf9\xc36\x17B\x12\xe2*\xd5M=\x93*\xec\xd0\xe0\x986\xd6\"\x18\xb5\x05\u007f\x8c\xca\b\xa1\x8c\x1e\xa0j\x8e\xe9\xcdL\x11r\x15\xb2\x8b#\xc1\bպ\xbb\xaf^\xed\xbex1\x1a\x93\x02\xec\x01B\xe9\xafG:\xe0\xd1ةVn{\xd5\xed\xa0'>C\x1d\xf5\x9a\x96\x1c\xd4H&\xc4\x06Un\xc5uӝ\xf9\xaa\x16v\x9c\xb6gt\x00J\xe7\xf3v:\x1a\xdb\xd7%\x88U\xab4\xcb\xd2*\x01q,\xae|\xe9\xb87o\x05\x98W\xe8d\x10M+\x84\xf9\xc4{\xbc\x87[\x94\xc5t\nm\b\x94\xfaUuZ\x9d\xf96\xfd\x9aR,>\x8dϖ\xcb\xd3\xe5\xd9ju\xba:k*\xdd(C\"\x05\xaf2\x9c\x96\xb4 \x9d\xa8K\xeax^\\\xb5\xd9F\xee\xaa⸀b!~\x05\xa1\xbb͍\x16\x85ĉI\x13\x9a\xe7R\nb\x83C\xf8\xf2X\xb5;\xf1\xaa\xf8\xd7\xefP\xe4pxi\xee\xf9\x16\xe444)\xad\x10\xf2m\bPn\xc1\xba\x0f\x95If}\xc1\"\xd8<\x14\x1c\xfb\x88=\v\x1b\x1b\xd5\x15\xe2q4\x88}ݕY\u07ff\xa2C\x0f\x95\bY\x83;}\x8b\xa5s>\xf5\x82\xfcx\xdb\x12\x85\xe3\\\xc5;\xf02\x8fO\x1c\x1cʺ28\x93\x02\x99.\xca\f c\x95\\\xac\xc0Z+\xbf<K4G\xf6Z4\xa0\x87\xc0\xe2H\xf7NV\b^\xab\xef4\xa7\x8e5/\xbe\x839s\r\x14'.Ħ\x02:a\x0f\x01\xed8\b\x8b\x91&\x99bN \x01\xf7%\xb4\x82H\xf4#\xdf1\xda$\v\x81\x86\x93\xf2\x84Mc\xab9\xb2\x14\\\x96Li\xc3\xf5\xe7hN\x95\\\rI\x83~U\x94Y<\x03\xe9\xf8\x1c\xd5\x0e\x97 \xe0'\xec\xea\xd9Ӵ*\x8e\xfdnЀ\xf4f\xb5\x11_}\xf5\xeaŋ\x93o\xbeY\xad:z/j\xfa\x0f\xd6\xfb\xc7{\x8e\x16ĩ\x1e\x80\xbf\x8c`9\x18~\x92ʚ\xd8\xf1\x9c\xd3f6\x8a\\ 8\x87\u007f\x0e\x88-\x90V\xe5\xd2\xde\v\xddJ\xda\x02\x04Z@$\x05I\xd6K\t7\xb6\xb3\x84CP\x02\x0e\xf3&\xcdg\xff\\\x16C-~8\x1e\xd3.\x14\xa0\x8d\xb2X}_\\\x05\xc6n}+\x9c\x14EV\xa7\xeb\xdf\v'\x82\xd4\x12\xbe\xf4\xf0\xef)z\x9b\xf1v\x83w\x1eȃ3\xca<\xf4FӢ\xae\x8b\xd5\xe8VC\x00\xf9\x17XK\xa7\r\xea}F@\x18G%V\x8ch\x8f\x80\x91\xe8\xf3B\xde\xcf\x11\xd7\xf9Rq\xf6\xcbV\x0e\x01U/\xd2K(\xc9\n\xa9E\x9a\x8ea\xb9o\xd2Œ\x1cJII\xe7\"\x1b*\x99p\x9f_\x8eް\x9a\xa1\xe6\x8a'O<\x81v\xe6P\xc15\u007f\x93_\xf2\xc9b,4y\x0f\xbd}ǖ\xf6\xebf\x85\xce5\xd4\to\x17\xfd\xb4>\x91\x1au\x90(\xeb\xc0\t\x1c\xfb\xb0\xba\x8b\x90Y)\x1b\x9d3\xe4\xe0)5\x0e|B\u007f\x05;\xe1%\x1c\x85\x93\x8b\xb6'a\x14\xc7ty\"\xf01\x8dκ:s\xbcq#\xf8y\x96D\xa5\xd5\x03\xc4l\x9e\xc3\x0e\xcbdU\\&\xb7k\xd4\xca\x18\x90f{\xf9\x82\xd4K\xbb a\xad%j\xb6\x04d\x93C\xee\xceHaŝD\xd3\xdfk\xc1\x91\x18\x15\xb5\xc4|d.G\xae\xfd\x92\x15 \x97\x86w\x9f@\x11d\x85\xb3\xba\xcc\xfe\x9al]\xc8\xd2uBv\x14\xe1g2\xf1N\x96i\xe5\xc1\u007fU\xe1U\xcbt^\uf49f\xa97\x8bro\x9a\xc0\x9f\r\xae\xb9\xba\xf0\xcaM\xeeE\x1e^\xef\xf5\xf0\xf6\xa5GHÊ\xb3(˒\x98\xac\xef6\xf8\xf52a\xb5\xd6\xd1\"q\x8eH\xd1\xe7\xa1R\x1e\x13\xa9?\x1fd\x9c\xe2\u0090Ԕ\v(ݒ\xf9\xb3y\xc1E|\x10\x04^\xe2\x034\xbd`~N6b\x16\x1f\x9c}\xe4\x8euĘUw\xc9\n\x18r\xcdJW\xc2O\xeb\r\xa6\xb9\xab\t\xc0P\xeb\x92{`\xa0S\x17A\xa23\xf9/5J\xb9M\x1e\xcb脧6\xadu\a\xa4sԼS\x0e0Y7 \xd7\xe4\xe0\x87p\xbc\xff\xa9\x1b\xc9H\x16fO\xee\x19]\xe9\x92\xe4\xfa:\x81\x1f\x93R\xc4\xe7\x0f6\xcdTm3\xb5\xcerw-`\xeddC\xdeLC\xfc\xfa-\xf7\xec\x19\xfd\x92\x8f\xc6\xdd3\xa8x'a]\xe6\xa0D\x90\xd8.\v\xebtr\xea\xfdR\x9fMB4@\x06\x90u\x9a\x9e\x8d\x99\xe7R\xe7\xc4t\xf7\xf9\xaa\xe2=\xe6C\xc5\xc6w\xa0\xf1]\xeaF7\xf5\xf6\xac\x86\x1cW\xc3U\xf5;.\t\x82/|\xb8>\xf86\x95%o\x8a\xb2f6\xbb\x9a\xb9\u007f\xaa\xf69\x9e\xf8\x01w0\x01\xb2\xc7\xcd\xf4>\x89e \nc'+t\xbb-\x03\xfb\xea\xc4\xcc\xefR\xf4ش\x9d\xff\x9bA\x9a\x14dx\xa2Z\xd1\xe7V\xb87q\t\xfe7\xea\x94\x1d\xb1\x12\xfe\xa5N\xfe\xa3\xaa\x93\x1dk\x18\xc6\xe4<\xe0\x91\"Xh\x9f\xe3O\t\x8d|\x9c4\v\xa3\x8f\xff\xbe\xfb\xf1j\xf7\xe3\xf8\xe4㿍\xe4\x8b/U<\xfd\xba\xbb\xde\xe4\xe3\xd5\xe4\xe3x\xb7\xad״\x0e\x14V%\xe4\xfa[Y\xd4\xcc2\r\xe09W\xf6\x11\xbei:\xb0\x8a\xcaE\x9a+>nu\xb1>\xf4\xf6\xf7\xa40/(\xbb\xabI\xec\x80|\xe8}*\xa5e\xc9\x1cJ\x1d<\xda\xfb\x93\x84\xdc\x0f\xa6Ϩ\x96\xcc\xce\xfc\xee\xe6Ȓ;\x83\xf3[\xb4\xae\xd4+@\xc6m\x17\r\x1alyP\xfe\x9e\x9a\xe2$\x91\xb62?A\xf9d*\u007f\xdbFU٬q\xbd\x8c\x1dGi\x96\x1b\xf0\xe2\xaeS½\x8e\xfc\x16\x9fCDg\xd2>\xe65\xb7\t\x02m\xf1\x1fM\x0f\xec'\xb9{\xbc\x98p\xfd}\xef~\bx\xb8yH;Q\xb4\xe3M\xbb\x81{\x11\x8a\xfc\xfc\x9a\x01\xaee8\f\aSr\x13\xb5\xb4\xeb\xe4h-\x0e\xf87\xbbz\x81\xc4\x0f\xa9T\xb8\x8a\xd6A\x0fÑ\xfaz\xe9\x92\x1fm\x1d\xc3\xf6\xb8\x87\xea][k\\Z\x87\xb45\x8dʥP\xcd<\xda\xdb\xf3&|\xb4NaG\xae@F\xadU\x9a\aM\xe2\x8e\xf7\xd9#KKf\xa5\xe8Z\xae\xb4o\xab\xc4oH7\x9a#\xa5c\xde'\x12Ї\x9cW\x85h\xb9o~0Fd\x87\xdb\xf4\xa6m`W\x06\xb2;\x04\b\xd4\xfdO\xbc\xb9(tjt\x8d\xd1ev\xb9\xe2%\x9bJM\x13\xc8\x1c\xdb_\xa5]e\x85 \xae\xdf \xb9K\xbbA\x85\xbf\x99\x1e6,\xe9\xee\x0f\xde\xf0\xa6\x96\xce\x1cݸ~v\x9d\xf2E\x8f\x97\x04#\xf8\x05\x95\tP\xc0\xe0\x8f\xc3\x02\xc8\x0em\x10l\xec6U>\r\x17d\x9az\xeb\x1a\xae~\xb5\xbdѪڮ\xb677\xc99n\xcc\xfb\xe4b\x96\xda\xfamm\xba\x80\x87\x1b#z\xb8\xd1\x0f\x14\xb5\xc8\xda'c\xf8\xa1\xe7\xef\xf8:\xa9\xf8c\xdb\xf0\b3F;\xcd\x05\xe3k\x0f\xd1\xe6\xd5\xd9.\xfe\xed\xeb\xc7\x1e\xb5\xdaP\xaa\xbdE\x86\xfa0.V\x11,\xa7S\xeb\x02\ad\xe2Zcˠ\xf3&\xa3\xfc\x11&cV9\x0e\xb9\xbc,ן\r;rKBŌ\xe2St\x9c\xaf\\\x96\x93\x9b\xf1\x8esl\xc0\x12\xee>6\xa8\xfc\xc1\xc6&\xac\x95\x1ffx\xae\x95\x98%\x8b\x84N\x99\x1d\x8b\x84\xe9\x935\xf2\x03\xbacum\x84$\xfc\x1d\xde6\xe0\xd9\x17\r$3d6\x12f\xd0\xde\x14\xd3%a\x01\x94I4\x03\xa0\xb2xq\xaee%\"]\x84\xd7!_<ʕbT<\x06ģ\x1c\xdd\xc0\vQ\x9co\xcbK\xd4,\xac[\x10\xf9n\xaa\xf8Hb49\xcb\xcdg\xf1\xa1\xb6\xe4{\xd4!l9\x95\xb8FM\xb5\xb9\xb9\x82\x13\xde\xc0\b\x05\xb7\xa3n\x9co\x8a\x1f\x11\xbe\xa1\xd0H.\xca\xeb\x0fX\xe0h\x80\xf1\x9e\xa0]\x011[\xddN\x1a\x97Bo\xa4\xf2>l\xb0m#G0\xfa\xf7\xee`\xe3P\x00\x1b\xe7\xed\xfb\x8fA\xdeVŦ\"CI\x88\x18k~\xbd\xbdֳ{.\x9f\xcb\x1fy\x8d\xb0\xc5\xc0\x88\xc4.\x97J\x9d!\x0fE_\xbb\x91\xffkWS\xa4ã\x808\x14K\x8b\\[pw!R\xf9\xd5\u0379\xe4\x13\x8d\xbf(\x8b\r\xd9\xc3\xe9\xb4c\xbf\x92l\xab\x98\xc6C\xcb߇\x85\x90Y\x85\x84\xa6\xdcm\xae<ˣO\xe8\xce\xf9\xe8#\xd4%\xa4qG\v\xf8aǗ>\xb0\xf8\x99\x15yUd\xe8T\xba\b\xfc\xbc@\x1a\x87\xb9\xe8\xda\fħO1\x8d\x1f\xb7r\x1a?\xf7\xf1\xea\xfb*\x83\x85S\xc4[\xf4\xa9mL\x94IX\xcc\xe7t\x17 T\x032\x19\r8\tmؙ\xc0\xe4yY4M\xb2\x86\xeb\xe1\xd9\xc4dst\U000e6443\xe0\xc7n\x94ϖ\x05Ee\xa6\x8dJbN{M\xe8\x1c\xfc\xe5\uf18f\x92U\x1bN\aW\x9b\x1f\x1e\xc8i[}a8\x196\xe7\x17\x01\xae\x84\xf0\xd1X\xe6M\xb6ѳ%:`m\v9\xe3\xae(\xac\x92\xb5\xc0\x1f\x93pz6\x8a\xbb\x8dx\xbf\u007f\xc0\x1a\u007f\u07b7\u038b`\xd2WL\x14\xeff\x96f3Mǥb\xce\x05MG\x12X\xc8\\\xfeM\xc9\x03)@\x81\x0e+\a\xf5\x12\x04\x01\x94\x88\xecD-\t:\x86Dsm\xe3\xff\xae\xcb<\xf8qh\xfcH}\xb7\xaa\u007f\xcaӚ\xee\x9a\xfaH\xa0ȫ\xfdW\xf8\xcf_\xf0\x9f\x13\xfc\xe7G\xfc\xe7\xa5\u007f&\xf9\x92\xe6P1\x00\x91\x03C\x92\xc0!f3\x9f\xa7\xd7;^\xb1\xae\x1bE\x16~\a\xa8\xf4\xe7\xb7\xdf\x1a\r\x16\xf9`2\a\x8c*\xf9:+`<B BN\x96V\xdfG\xdf\a9\xddt\xe2\xbe\xda\x15\xf3\xd4f\x97\xb5}\x8bί\x92\xd5{\b\x04\xdb\fa\x03\xc8\xe3V)\x9f\x8b\xf39\xa5\a\xb9\xd4\xe6\xbd|l\x80\xa4\x01yO=\u007f\x8f\xee\x84\xf0߇\xf0۷t\x16\xc6w/\xad\xbeN\x01\x93\t\xfc6\xc0\xc1\xdeՎ>\x12=A\x9fY\xb9\x1f\x11z\x8d\xee\xeb\xb7\x13\xf3\xcdjJ\xd7\u007f\xa8\xce<+\x8a\x92\xb9\xdc\"\x1b\x8f\xc6\xde\xc4k~Q|!\x896\"o«\xad\x8b\xab\x80M\x95\x04\x85A\x96+`/\x04E\x9c\xb2\xec3C1\xc9Pq\xec\xe9\x05\x1b4\x19\xe4\xd6\f\x1d\x87\x11\x01\x9b\xff:\xbdN\xe2\xe0\x912\xf6'\xde~\xb2\xfbH\x99^^\xda\x16o\x810\x93\xe0A<\x87\x9a{8S \x1f\x1c6\xae\xb8\xe2\xba>\x14y\xe8\x05\x0f˱Ի\x1b\xf3*=\x92\xf3\xb0{\xf46\xa5\xb3X\fx;\aV\n\x06\x06\x91\xafT\xc8\xcau\xd1\xe0t['\x9d\xb7\x05\x86\xb6x\xf0\x19\xb4\xf8\x156\xe9\x11e\x8b\xa8\xb5}\xed\xa7\xdd79n\xd5\xfctp\xf3\x8am\x81G\xaeG\xdb\xe0U\x9a\xc7\xc5\x15\xb2\x19\x04\xfd\xb5\xb8\xc0$\x19\nY\x89\x1d\xa4:\xf5\xa6\xdc\xef\xa2\xcf\xff\xa2[\x9do5\xb6\xe1&h\xb5\x9f\xb1\x86\x8d\x8c\x05\x06\xfa\x89\xe0peɛ\x96\x9bjI\xd6[̜\x92\xb5\xd6V\xe4%b\x1c\n\xa0$\xa2f't\xc3\xed+,\x04%\xfe\x8f\x96\xbb\x8a\xae-\xad\xae\xd2\xdci绛+\x91\xac\xe4|\xc8\xe4f\xae.\x02\x96\xb9\xffh\xefȨ\xf1^\xeaKYui\xe6\\ٓ\xb7\x92\ue469\x1d\xd1}\v\xbd\xbe\x84\xe6Q\xe8r\x8dݺOui)mURv\xab%\xa9\x93\x1d\xcaͭ\xa6\xdcD\x12F\xed\x03\x1c\xbc\xaa\xa0Qd\x03\xe1\v\xe4N\xbc\x83\xbd1+\xc0E\x8avU\x99M#64\x83\x90\bhL\x13\xdaбM\x12\"\xdf\x19\x1f\x1d7}\xbb\xff\f\xbf\xa1,\xc6e\xf1\xf0\x14\x9f)䝛Y\xce\x18\xbf&t\xfc\xa5C\xbf1\xc6K\v\xab\xadD?\x01\xd7\u05ed\x12\x19\xc4FJE=\x00\xfeM\xf43\x1a6\x14n\xfb\xe4o\xceV\xd8\xf4\x05\x18\xe3\xad\xc3a\xa0\x01{=\x10\xec\xb5\x00K\xf1\xe7,\x17Gu\xe8\xa4_\xa3Pn\x03u\xdbV\x1d\xb6\"g\xf30\x94\xd6E\x8a\xc7\xecb\xfd\xbb+\xbdy\x80\x87JS\xaca\x92\x14\x8dv\x96\xa5\xeb\x1f\xe5\xe0\xa7)F?\xa5d\xdfq\x92iF\xcf\xf5@f\xb3\x0e\x85\x1e\xaf\xbf.R<(\xed\x92\xd3\x16i?\xa3,\x93\x14j\xe9z\x97±B\x06\xc6\x1a\xf9\bS\x8c\xd1\rR翷\x1a\xbf\xa7\x95-o\xc5\xc4\x00\x0e\x001\xcf\u038dN\xa5\xa6\x91m\x8e\x83\xad7\xad\x9eL?|n\xaaz\x8b\xd1يu4K\xeb\xad|\xf6S\x0e\x82J\x8e>\x93\x1a\x11KD\xd7\xc0\x9fmʊ\xe9\x00\xf84\xfa\xaa\xb6k\x8b\x189)\x16\x8bLW\xaf\xb4\xfa\U00053fb5F\x9ag\xcb\x16\x92\x15\xb3FM}\"\xa1\xc0R\x1eˊ>\xcf\xf1\xb0\x85]f\xfb\x83\t\x98p\xcc/\xdf\f\x82\xdeT0\x9a a\xcb\xd6\xc6`Ӏ\xc6\xf9\xd8IX`\x1f\x9d\xb2)dsQ\x1bDA+\xaf\xc8\xe8\xdeu\xb3\xa3\x17e\x8cW\xae\xdb-\xdd\xe0\x9a\xfeG\xc9g\xfb\xd1\xfe\xcc7\xcd\x1c\xfeG\x9f~\xfey2\xfd\u009a\xf5Y\x1c\xcd?\x8b\xacY\u007f\xfe\xe2\xb3$\xfaԚ5\x9f\u007f>\xdf۳fE\x8f\x1f=>\xb0\xb75\xff\xfc\x8b\xfd\xe9\xdc\xde\x16}\xb4,\x9b\xb8B\xda\a<\xbeYd/\xca\xdb\xda\xf3\x8a,v\xd4Zb\xdc2'\x9bk\xd60\x15kW\xa8\xc9\xfe\xf2\"O\xda\xfc8\xad\xd6Y\xb4m3\x1c\r\xff\x88p\xa0u\xfa!oS\x87Z\xc4a$\x18\xdd\x16\xdf@y\r\x84h\x05\xa2\xb0|-\x8e\xb2\x03\x16_A&,\xaeJ\x14k\xa5\xc8\xeb݊\x9e\x81\x83}\xf2`}m\x038/f\x1b}\xeb\xb2\xe0\x96\x8a\xf5\xe3V\x85O\x95\x1a\xa8([\x18%\f}\x97U\xdbE\x9c\x9e\xbbrI\xca-STkho]\x1bW\v\x9a\xfc-˷\x05\xaci]\x01m\x11\u007f\xc4'.\xa3\xab\xef\x88_\xd8$\xc9.=\x19\t\xdaȹ\xff+)\v{L\x85\x06%\x12\x87\xb7\"E\x86s\xaf\xf9a\x11_\xa1\xb7\xfd2i;&\x00\xf76\xac\x97%\x9c\x14\xa0\xe5n\xbb\x82\b\xffX\xb5VMUw\x8bfC\xa1\xbem\x11;\xc8(\xd4<\x97\xf0=40L{L]1\xd4\xc3VC\xb0\xe8\x9a\x13\xc8uZ\a\xe2>\x90\xcbQ\xe3:5\u052f\x8c\x02m^\n\xb8Q2#/\v\xe3곻\xd4t\x91\xf8:u\x99\x8e\x91\xea\xafS)\xce\xe8\x84$m{a8\x85\xbdHɽ\x9dy\xcc\b\x99\xcbY\xfa{\n\x1b\t_\x9e\xe3~\xe6,\xf67*\xf3w'\xae\xd0F<`F\x99\xdd\r\x97\xe7\x14o\xfc\xd7A\x1c\xbe jpy\x9b\x93\n\x14*|\t+\x9f\x8a\xf6x\xcfQ\x1dj@)\x8e\x97\xc9\x1c\x96\x1dg?\x13E\x82p\xb0\x1bQ\x98\xf1&j\xf2\x14ڷ0\x161\x9a\xb5\xf5\x1e\x93\xf8$B\x8cƷŀJ\x89Ku\x18\xac\x12FPl\x89\xa0`\xddP\x15\xd7\xc21\x00=7\"\xd6ukE\b\x88qڏsf=\xe4\xa4ۦ^gWY\x85Xh\x99\xab\vX+\x8d\xe2\x18{\xb0˙\xf7\x0e\xbb[,\xe5mE\xde\x16\xf3:\xda`\b\x8e\xbd'b!\xf4\xd9,\xdb\xf5\x02\xd5\xdcpyѿQ\xb9\xeb\xder\u007f\xa7r\x16?B\xad\x1cMڱ\xa7Ϟck\xd2*?\xe7\x02(\t\xa2\x9c\x02\xbaL\x9b\x03\xc9\xff\xa6=\x80 \xe0a\xe6{Ω{\xbabc\xd8\\\x84\xe9=\x95#\xf2\x9b\xe38b\xd8\xe5\v\xd6Jl\x8d\x14ğ\xdc\xe0(sաC\x06\xbby\xcdff<\x1c\x00\xf1\xb5\xea5W+Rg\xbf\xe4\x1cx\xe2\x1d\xb8\xca\u007fE\xaa8V\xe1\xefP\xa1U\x9duu\xb1=j\x8a\x16\x9fz\xbb\x8f\xbcC\xef\x91\xe2Z\"\xa0C\xe6\x17\x90\xb9\xff\xc8n`na\x90\xa9\x19\xcd\x1bL\x13\xec\x18eΞrk\xbb\x83\t\xae\xddq:\xa50'1E\xc9\xfe\xea\xab\xe2\xdaV\xb0\x11\x8e\xa5\x91M\xa7\xe15\xbf\x00܌\bҶrZ\xeb'3\r\x1b\xc5\u0081~\xb2\x86L\xb1\x13\x1e\xb8\xf6p\xbaׁ\x9c\xc7.\x0e^\x8b\xb9tQ\xbf\xe5Ђ\x1fsm1qX8q1qX\x8cz\x1f:{\xdd\xfc:\x90\u007fm\xf7\x15\xbd\u0081K!$:\f\xd3\x02\x12\xd0\x1bv\xc5˽\x11\x93\x97t[Խe\xb1Bx\xa9\xeb\x9eR\xa3\x8b\xb9\xf2\x97rvY\xb8;\x01\xc0͞x\xf9\xa0-\xfe\x02\xe3\xa2\xf0\x802\xb6\x1av\x86\xd6\xea\vh)\xdb\xf6lM<\x87#\x9b\xbeϩ\xb7\x11P^u]B\x10/\x0e\xe9a\x10\xb8-\xa9\vn\xb7\\\xcd9\xea\x95\xdd-\x1a:]&x\xb0\xdb\xf1\xa4\xe71\xc5\xe7*\x9c\xa6L_\xc5\xcf~\xddMu\xf9\x1a\xe9,[\n݈\xb0\xed\xf0\x86:v\xd3YK\x14~rl\xbe\xa2\xa1\"c\xc8\n{O?q.\xc9\x0f\xf0\x06'#\x8b\xea\xf9m\x1f\xe0=~\x1ep\f\xad9\xaf\n.e\x91S-\xb7\xb7\xf1\xacnw\xfe\xb6Z/*\xabs\x8akT̪#\xb6\xaf\xc76)pؑҭ\xd0a\xe2?\x93\xb0\xd9w%\xdca\x97\xd1!6\xce\xf57c\x9a]\a\xa5\xf2\xebA֛\x1f֨\xe9\x97\xe4lq)\x8e\x0e\xc7\x1f\x840\xc5z\xe9BY\xfb\xdc\x11a\xd7\xd5\xdf~\xf5\xc4\xed\xee2]3\xbfyO~\xccO\xfep\xe7\xf7[\x1e\xde\xdb~\xb4\xde\xf3/\xb4\xcaC\xdd\xcbgVUN3*\xa7\xd3\x1e?\xc5t:\u037f߰\xc8q\xfe\x0f3,\x87>\xeb\x1e\xaeCW\x9f\xf8\x1a\xe5D0 \x883~\xd4\v\x17\xbc\xaeC\xbcڮ\x88\xb6\xeeFE\xefEA\r\x9a]\xa7\xa9\xa1n\x9dl\x18\xd15\x1f\xc6\xed\xa9\xe6\xbd(\xe6\x83\x0e\x83G\x9b\vh8\xbb49\xa8Q\xd2\xdd$\xf0C\xa4â\xfb9\xb4\xa0\f֠\x1d\x8b\xa8`\xf7\x98\xea\x98\xe5\xa97\x0f]\xb9ؑF\xbd\xe9\xea\f\x15\xc2F,\xafq\x19\x1d\xb1\xca\xeb\xf6\x8e\xe3\xa7y\xf6\x8b\xfa\xf9\xa4\xaf\x81\xc8q \xb07`g\xcaۖ)c\x8fw\b\xacc}\xb7\x1b\xd8\xf7\xe4&ǩ\x13\xa7\xd6\xd5Q\x0e\x1e5=\x8d\xd7\f\x94\x1f2\x93]-F\u05fd-\xee\xcb-F\x96#\x97\x85v\x14n\xb3ur\x9bVw\xdf\xf8\x8b\xd0\xe3\xec\xe4\x1a\xd2\x15G)\xdc\xeeq\x0f\x97`\xaf\xdf\xf1\x15?\xef{\x19G\xa9\xbfu\xd7\xdfvԷ\xb9\x8f\x8fH\xbd=\xda\xf1F[\xf2<\x1f\x89\xf4F\xb7\x82yeQ\xa3H\xb4\xfb\xe7\xbdqS`\x8b/\a)vh\x96~\x8d\xe9A\xab\x9a\x18\x8b\f|\x9e\xcb\x1b\xed'\xab\x11w\x05\u007f\x1bn\xf2\xf4Bb\x8d\xb7\xbe{\x8b\xbe\x9e6V6\x0e\u007f-`\xe6GG\x9e\x11\xd4\x00?\xb8^\xf8\xe3\xec\xe4\xf5[/+\xd5\a\x9f\x1d\xb4\xffI&\x88[x.\xe1\xa7\xd7{\t?\xe2\xe9\xf9\xce\xc7t\x9bgB\a\x8a1\xd2\xf8\x86\xea\x10\r#\nG\xad\xaa(\xfb\x1d\xdbw\xe79\\\xb7\xf0\xd3龅\x9f?8z\x870\xa4f\b}V+Q\xb0\xf7\x01f\xcb8ȹ\x8dI/V\x91âǥ\xe7\x00TƖ\x00\xc53\xedWT\x0e\xf1\xc8\xe2\xe7\xeb\x80I\xb1͍\xb5Vb\xa5\xd8(vm\xb0|\x9b\x8485w\xf2aܕ~\x8c\xf5g\x94\x9d\xeeW\xede\x0e\xf23\xe8ӫ\xe0\x87\xa9\xb7M\u007f\x83)ƌ\xb5ά\xdc\xca\xc6\xee0>\xb4\x11\x9bE^o\x03\xc9D\xbe\x942\x18\x87^\xc8\xd4t\xed\xeao\x9f)\xfeh>\x9f\xab\\a\xb7\xf5\x96\xf2\xc3\xfd\x83GR\xade\xb4NvKXo\t]\xbe@\x97\xb8\x12\xc6\xf02^ح\xb3\x03\x0e,n\xf3\xbc\xeb\xd2\n~\xc8\xe8\xe7҇\xb6\x99\x86\x1a\xb3\xcdza\x88\xaf\xcd\xe4q'N\xa7\r\x9d\x01@\xc3(\x16丵\x06\x88m{\xe8\xd1C?\t:ʳT\xfb]\x9fV}k\x94\xb7\x1a\xec$\xf5\xab\x1aE\x9a\a\xafm\xeb\xf2x\xd4J2t\xc1\xb6Gw{L\xb4\x92\x9b\xe4SNA\x0fo\xa9z\xe6Q\x8d\x1a\xd7\xf6!*n\xa9\x0eCR\x8f\xba\xba\xefnf\x9f\xfb\xc7\np\xea9C\xe9˥[\x85\x10\x9f\xb6.\xbd\x18\x9f\x86\x98\x87\x16\x16\xc1\xa0\xb05\xe7\"\xb0\x84\bs\xbd\x90\xf4\xf2z]\xfe\x11_\x83c\t\x9d\x0f\xbb\xf1W\x91\x12\x18\x81r\xc1\xe7C<\xeef>\x8d\x83\xcd\xf8\xfc\x1d5?\xba\\\x04\x17\xc1(B7/\x98\xc3\xc3\x02\x90\xba\u07bc[\x16U}\xfc\t\xe1\xfb\x93\x1b\x14\xa3\x1f\x91\\\x8eQ\xbe\xbe\xf4\xbe\xd8\xf3\xe5\x15\xe4|p\x8d?S\xcbGG\xbf\xf0e,\xf5i(\xbc.\xd0\x16\xa2_f!\xec\xb2g\u007f\xb3\xad\xe4o\xe0\xd8s\xeb\b-|>\u007fqE\x05\xaa\xbd}\xa3\xbf{\x83m>\xbdp\xbd\xca\xd6`\x1a6v\x16,\xad\xfb\xf962\xcdPa\x8a\x90\xd6]\xb8f\xc1\xf4\xfb\x1fy\xb3=\xba\x16\xf27j\x8e\xf4R\xed\t\x84\x8a\xfd\a\xfbi\x14c\xc0\xde\xf2w<\xa9\xe8I\U000ca9e0\xb4&\x99ί\xc2SI\x8fB\xc8/\x95_.\xdenJ\x8c\x86\xcf\x11\xbb\xc0;O\x13D\x01Ѡ\x8cJ,\xfc4/\xae\bA\xd25=\xbc\xf8\x1f\xe6\x18j\x9c{\x17i\x1c\x837E\x90ɩ\xfe9\xca\x04\x81\x8c\x0f\xa9ƍ1憈\x9aE5\xe0\xfd7\xdb\xdbo&:m\xa0\xbb\xdf>R\xdf,\xe2u\x14\xd2\xed|\xa8\xb0s\xc1K\vJ^\xc3f%$\xd8\xf6\xf9,\xb1x\xb5ǳ\xccjH\xbam5\xb1\x9c\xf5j\xc4PC\xf5\xd15~Ϭ\x19\x94\x98C\xa0G\xfd:\xa8\x98f\xf91^= H\xa9\x05\x9a\xc1\x8b\xfe\x86\x8e\x99\x14\x8e\xec\xa5\xd7SML\xd3\xe1\xc5\xe1\xcf\xec\xc5nI_\x10e;^m\xdc\xf9\xc2\x0f\x11\xde\x1a/͜>\xac+z\xed\xbb\xefbK\xb3\xacz\xcc:6ۉ\xb9\x87\xe7\xccs\xc7\u007f\xe7\xf7\r\xeb/\x18%B\x1eV\x1d-.a\\\xd1\xc2\xfa\xea\x02v\x13\x81\xcb\x0f0:d\x1d\xea\x03Z\xcdw,\x16r\xf3\x84(\x8ac\xcb\xcd\xd3\rؙn\xbc5\xad\xdc\xf8\xa6\xd8[\t\x96g\xf6\xf0\x05]U$E\x8b\x91\x87\xe7\xe0C\x82\xac6\xad6@dH\xdet\xb4\x81\xa9\xdc\xd4|\x92\x94\x95\xb7\xec\x93\x1f\xf6)=\xf5eɦ;\x8a \x85\xa89\x89\x16o\x88\x8dX\xb8P\x93\xc2\n5\xe9\xcaK<,\x0f/\xb7\x06-ȟ\xfb\x01\xfe\xec\x04\xf7\xb3\f\xec5\xb0\x9c\x1f\xd6\x14\xa4\xb5\a\xa6T\xd2\x0eZ* \xb7\x80;\xe0\xb6\a6\x95\t.d$\xa3\xa7e\x18-\x16e\xb2`\x1a\\\xef\x02O\x02\x17r\x1a\x8a0\xd5f%Q$\xd5Z%u\x99\xce\xda\x1a\xfc\xb7$\xf04EK&<\xf1\x82%翚7vS\xb2E\x94TA$\"\x03N\xaeT,\xc8TEU\xee]\x84x\xc0\xbd\x8c\xf0f\xb2NS\x93\x897\x8df\xe7<\x96n\xe2\xb5%)\x88\xbcwap\xb2{M\xd7l܁2% \xb0z\x17\xd1f\x91\xe8o\xd0)\xbf\x1a#\x87>j8\x06lr\xed\xddЎ\x96x\xe9\xfe\xb6\x06A\xc3~8A\xb5\xdf,\x00M`|\xee\xa44\xa4\x8chS\x17\xbeMxa\x00\xa4I\x8f++)\xc5\x15\x17\xb2\x9bb\xba\x98\xdd\x14\x05v+\xc1\xa3_\x9cz\xc4jW\x8a\x03\x93yQ\xc9l\xa6Im\x86\xa0\x86,\xa0\xe5\x14\xaeˢ.P\xc0d\x00\x9c\xa2L3ټ\xc7\x18\x0f\x83\xfdԧ\x9a%\x03\x13\xad\xa2՚n\"\xcb\xf5D\xe0$\x9efås~d\x98\xbe1\r|G\xb0\rK\x9eX\xfb\xf0ح\xfdk\xd1Y\vE\xbf\x92\xedO2[\xe8\xe7\x03ڊ\x97'I\x18\x004\x02\xd4qJ\x8a\xff\x11B\x1d\x99\x9ai\xb93\xf6HQ\x16}7\x83\xc8\xc7v'\xa0.Dݺ\xc2+»վgT\x01Q2\xa9\u007f\xe6A\xea-\xc6d\xe7H\x89\xa7\xf5\x8c\xd3r\xbf\xc6\x02S\xa16\xbe\xab\x11\xd1);f\x02G\xbc\xaaoK\xe7\xa5\x02cK\x13o\x1c\xf8\xfb\xcb\xdd\xf6\x89\xbe&\xbb=>\nQ\x99u\x8aÓ\xa8\u007f\rg\x9en\xaa\u007f\x8b\x109ݷ\xcd(\xaf\x17챧\v\xe4\xa6E\xd0Zz\xab@#V]\xae\x95띦gC\xde\x13#+\x1d6\xfdV\xabl\xceN\xb3\x82Xt\x1e\xa7ᐑ\x01\x8b\xdac\xa1\x03\xf1\xa1\r\xd3\tD|\xe2$K\x80d.\xd2\xd3\xf3.\xaf\x1cgN\x87\x01\x8a\xf5sZ\x14Y\x12\xe5\u007f\xfc\x8e\xb2\ak{\xfa\xf9\x03\x15BY\x19_\xe5\x1c\xe2,\xf7;\xf7\u007f\xc0;\x12\xf8GȬlY\xc9\xeb\x9b\xce\x0f\xaf\xd9\xc3\xe3=\xcaP\n+s{m\xa8お\x1e\xcd莧?a!4l\x8d@Llc\x04\x121j\x11WiN\u007f\"4ݣ\xea\x11\xff\xc4\xc9%\xfe\xf9G\xbajJ\xadD\xc14ǲg\x8a\n$\xaet\xe8X\xf4C\xb6\x80\f\xfam\xd1l\xa8\xa7#\x14İ4c\xeb;\xedN\xb6÷I\xb5\xfe,ʟA\r\xefX\x89\xfb5Hu\xfck\xab\x11\xfd\xb5\x12\x0f]\x92\r\x82%N\x1f\u007f&\x13\xf1\xaf\x9a\"\x19\xb3e\x16Ma\xa7\x9a\xcd\xe1W\xef\xa9\xf7\xefo~\xf8>\xa4\xf0c\xc1\xafc\xef\x90I\v\x82\xe2\xa41\xa4\xf8\xf6\x8c\xc7#\x95}\x8b\x1aʦ\xd7\xcb\bC\x82\x90+Ꞧs]\\\xcaۄ8\x90\xe2\xfb\"\xf1[<\xdd[r\x91\xdbn߮\xa5\fS\xa1\xc3\xf6\x19Ηe\x02\xbd\xd0B\xe8\xa90Y\xb0\u007f\x1c\xa18\xcc\xd9uj|\xfb\x13\xedT\xadAF(\xcd\xc8\xf8\"\xf2\x13a~\x11\xc4\x0esM\xc28\x9f\x04\xfe\xfb\x1el\xa8\xb4ǫ\xca\xe7\x92-\xe3\xb6x\x93p\x8c\xe6\xe5M\xe2\x1b\x93N\x9ep\xc6 \x99\xb7\xd8ö\x8c<\xf1r\xed\xe8\xdaV\x9bĠ\x87m\x19\x9dl\xc4[\xaboW\xd1ZQ\xa3\x8c\xaa\xd1!\xfe\xd3*OF+LY\xc9)KLY\xca)1\xa6\xc4r\xca\x15\xa6\\\xc9)9\xa6\xbc\x92S\xb6\x98\xb2\x1d\xc9l\x92\xdfh\xa2WV'\xff7\xf8%~8\x0e~\xb9\x1a\xa3\xe4r\u007fr\xa4\x8b<\xd9I\xf1lZ\x05+K\x1c/\xf5\x19d|a\xa8.\xa3Y\x1dH\xc1\xf9VhK\xdcQpq\xba:=8;k\x8cX\xcarkڅ&O\nh\\\x8d\x1f6\x99|_\xd4\x1e\n\x05\xb3\x9a\x16\x16\xaa\x87A\x8a\xc0g\xd7\xe8\xb8\xc2_\x01\xf1\xbe.\xd0\xecJ\x87\x8f\x1d\xef\xd7\r,\xde\xd1\xc1\xde\xfeg#\xef*\xcd2|\xea\xed2\xca\xd2X\x11\xac\xe8v\x97\xf4\xfc\xc7N\xf3\xa05s1\xe1o\xc0\xb4/w\x1aJ\xaa\xda:\x927Wњn\xf7V\xfa\xf9\xec\x9eq\x16\xb3\xe3UETۄ\xe89^3\xa3\xf9\f\x93\xebd\xa6܋\xa1'\xa5\x1d\xadHSk\x83\xccKI\x13aѿ\xbd!i\x0e\x87\xe7>\x8ajL\xa2\xc1\x87\x9c.k\xb8e\x8e\xa1\x17\x86TU\xe7\xcdӟ\xc5\xf1\t٫:\xbb X\xb2\xca\xe4\x8c\a\x1d\xb4l\xd2E7<\xd0\xda<\x1cr\xbe\xe5\xb0\x1d/\xa9h=HmP\xfe\x92\xd4'\xd1\xe2\xaf_m_\t]\x97\x04\f+Z\x00ҎqJ\xb9\x82U3m\xa0B \x02\x9e\xceݩ\x1aW\xa5\xa9Ds\x8f%:4\xa4|\u007f>e\x85\xec!\x8fue\xbbd9\xd2̅\xb8\xaf\x91M\x8b\xb79\xc0z'Fv\xe1\x1aԑQ\x94\xebgl\n\x19\xfc\b\x8d\xe2[^N\u007f2\xc7v\u0092l\x0e֓\x95h\x9a{gD\xd6\x03\x91\xd2\xf0iG\xfch\xd27\x92b\xc9%\x85\xb7\x10X9\xf8\xde'K\v\xc0\xf7x\xf9~\xd0\xfe \x8b\x04\xa3\xe5\x9f+\xb2\x1e\x11\xedv\xe9\fu\x81\x85u\xdf0\x18\x9d\xdb_Ǥ\xb7T\xa3\xac\xb3\xebP\x15;\x0f\xc5ns\xba\xc0υ\xd0\xec\xe1\x1f5k\x82\xc1S\xcf\x13\xafڔ\x89\x87v\u007f|!4ʮ\xa2mE[\xd2<-!\rꅶE$Ku\xed\n\x96\xcf^4\xd7G\x03\xeb\x0e}+\x88\x85KEY\t{\xec\xf4\x9d\xe6\xec\u007fw\xd0\r\x80F\xbf<\x1d\fx\x10\xdc\xe6M#\xed=\xa3\x0e{\xe0\x10\xeb3~4\v\xb4\xff\x139'\xe1ۯ\xf3\x04\x15\x14lQV\xec־f\x9f\x96\xdbә\x19ԋp\xadO \xe9)\x03r|7\xde\xd6\x1e\x82,\x8a.\xc3\x18\xa9y=;\xd6\x03_*\xa1\xd8a\x8e\xc5\xde\xf0\xe0\x01\xae\r\x16\xac\xe0X\xe8\xe6ݺ\x19\xd13;\xa7\xea^P\xce=\x84\xa7\xf4\xcde\xcfL\xc2_s\xa6n\xda#\x81u\xf37\x94\x1b\x8a\\\xa1ʨ\x96\x19\x87\x01\xf8\xb7p:\xe1\xb4\xc5w\x05q\xa4\xba\xb5\xd7\xc4`\x9a\x15Xh@7\xdc\xf9\\pg\xb9\x15s{\xbe\xa4\xed\x99L\xcb\xf4\xadK\x8e\x18J\xe2\xb4y\x12ϲӇ,ٜ\x9e\x9f)\xc8\x1a\x8a\xb0\xf7A\x9aB>*\xfa\x0076Up\xab'0t\x02Rv#\b\xcb\xf2\xafY\x8aɿ\xad\xd8ۖЗ\xbe:\x192\x13X븠\x9d~\x1d\xdae;\xd6\xfa\x10\x0f\x89\vE)\xa0?\xba \t[l\xeft\xec\xa7.I\xcc\xd0G뢀C\tM\xbc\r\x19\x995\xb7m\x9a\x11\xd3\xe5mٖ\xa6Daǂ\x8b\xb1}W\xe0\x1bW)Ӏrp\x12\x06t\xb7\xa2\x1f\x89U&\xb4\xbb\x10\xc0\x85ey\v\xf8+\x9a\x1fI\xf6<2I\xc58:\xb6c{?q\xee\x1c\xc3\xfd@\xa2k\x1eW\xa7\x90\xe9\x14F\x87߁\xe6\xfa\xe8\xb25I0VEt\x80Mt\t\x12\xd2t2\xc3L\xd0\xe9\xbf5}\xfc\x99p\x15#\x1d!\xb3]\xa4\xf3mP\x8e\xbb\x1dƘ\xbe\xab\xf5\xfd\xe2\xfa\xaf\xa7\xde\x06z;O\xf3$\xc6\xd8-L\x15\xd6\t\x88k\xc2ZHB5\xf6\x94k\xc6\x00N\x03s\xac\x1d\x12I\x1bf\xdc\xfekuec\x80\xd2\xfe\x92\x02\xeb\xa3\x12t\x93e\x1a8R\x8f9\xc1\xa1J\xed\xa9\xa4J\xeb\x02g\x8e\x13\xdagA{:\xb1\x01`)\xfe\xea\xf5@\xff\xb9\x96\u007f\x9b뎖\x83\xce\x02\xccxe\xa6C/=\xd9 \xf4\x9b\xfa\xfc\xfa\x0f\xd8W\x92\x10\xef\a\xfeGt\x1f\x03D\t\x1eWCz\x91@\xde|\x82\xbcx\xcd|#\x95}\x9b[\x12P\xb2\x9a%Y \xdb<4\x95\xd0=+\x00\x02b\xb8^\xf2\x82V7\n18n\x90\x95\x8f\x86\xb6\xf3\xb2\x1d\u007f֣3\xf6R+\x0e\xa7g\xc5\xe7\xe3\x98\xfbwظD\xd3)\xf4n{ ~\x11\x9aS\x17纹\xfdr\xf0\x1f\xc0\x97\x1e\x8fdu\xad\xc8Sz\xab\xa5\x02ME\xd7}M)\xebHo\xca\xee\xbbM9\x9a\xc4G\x94\xf3\x14\xdbB\xa6\xe6j\xd4\xca\xe9ؔ\x8d\xe9\xda\x16'\xf3\x87\xd2l\x10Z\x1fҚ\x1c(&Z\xfd\xb4\xdf$更D\xd7Ji\x1ba\xf0\x02WQ)\xe8\xfb\xfb\xc2{\xad\xf8\xb8\x9b\xf4\x80\x1f\x87[\x93\t\xaf\x13\xca@\u007fry\xe0\x86\x13\xb4V\xa0\x11l->\x9c\x1b\xc5X\x16M\xab\x9f\xcaL\x17\xb7\xb1\xcc\x06u\xf70\x8b\xc1\xde\x0e|\xa7=\xf2\x87y\xe0?\xf5\xd9\xf5\xbb\xa7\xbe^\x05\x97\x95 \x8d\xd6r\xd67\xe7\xd6\xfe3\xff\xf6\xcd{\v\xf6\x9a\xe8ދ\xc2^_r\t\x82\xecO.>\xd2Y\x92\xef\xb26z\x93\x990NF=\xf8E7\\\x8e\x96\x00P\xd4u\x8cke\x06\xe4W~\xb9\x0e1\xec;\x02'yb,\xdf\x0f2\x9eX\xf9\xb1Xo\xd6]\xef\xbc\xc8;\x1f3\xcb\x1c\xe2SO\xad\xfd\xc9\xfa\xd4Ɇ\x1e\xfb\xb7?\x14R'\xab5^\x0f\x85\x02O\xa6\x9b\xba\x86F\xe9\xca\xec\xf1hZ\xe7 ]\xe5\xbb\xdc\x1e3\xa2\xb5\xb3\x8b\xcf\xc2\x1d\x8f\x10Q<\x01j\xcf(P\x17Ԡ0y\xa3/\x13\xe0\xa7\xf1\x93\t\x03\xf7\xa5\xff\xbe\xaf\x92\xf0n\xe1#{>\xfb\xee\xb3:]!\xc1\b\x1a\x12\xbb\x8cM\xf8\xe9\f\x05\xe4\xc8k\x91?D\xfe\xa5\xb3\x18\v(\xed?I\xf3\xf5\xa6\xa6g\xb1\x00a\x908\xf2\x8a\xfc9F\x9c\x87\x9f\xcc\xf9\x90\xae\x81\x8e\x8fF\xd0B\x14\x17y\xb6=\x1e\x89o#\xf6\xca\xf4\xf1\xe8AV\x1fE\xde\x12h\xfe\xf8\xc1Ŧ\xa8\x8f\x90\a\xe0\"\xc6\x1b:\x94\xf0`\x01\xffC\xa9t\xb5\xf0\xaarf)\x16\xae\xf3\xc51\xfc\xaf\x96\x9fD\xf8m\xf4\xa5E\xc1\xcc\xd0\x1c\xae\x8b5އ\r\x1cj\xa9\"\xc7\xcb}\x874b\x139]JC!\x06\xba_\x1b\xa2\xb7[\xbfi\x9e\xad\x1f\xb0\"\x04%\xffD\xd4>Y\x83d\x97\u0092\x9b\xd0[\x98\xfc=\xf9\x10\xc9\xd77Zw\xf9\xa6\xf0\xf6\xffPw\xf5\xf8\x9b\xec\xef$\x15\x9b\x1eu\x8b\xef\x10\xa8\xbe\x97IZ?&\xb6Ŕ㾾\n\xb0\xc1\xd3K\xd3N\xa2\xf29m\xbf\xa6Jm\x03j\xedVR\x87I\x8aV\x95\xa6\x86g/;v\x04\xbc\x10ϴE\xe7.Y\xe9\xb2q\x9c`\xe1.\xfc\a\xbe\xe3\xee\\EOْ\xf0\xc5:3DPb\x93\x85\xab7\x89\xdf\x12}\xe9&\xac^}1\xf0;CoB|\x880\x17\x9d[\x0f\xe3\x03\xd4E\xe2\x05`\r\u007f\xd6\xcdrI\xcfӪv\xf8\xa5\xedAm}-룻\xb4=x\xbdt\xbc\x16Iq\xa81:\x1f\x9cP\xda\xeevǢ^\x8awvQ\x93$\xea\x9c\x12\x943\xea\xf0\x10\xe6\xec\x10)u\xf8\x122\xfao$\xeb[\x90J\x148\x8f\x9e\xedF\x0f\x1f\xc1\xa1\x84\x802\x01N[\xc19Am\xc0\xae ѽ\x06\xb5v\xc7\xed\x95'ÅP\x1cK\xe8\x85`\xce\x16ɷG\x81`;}Z\xf0\xa7\xcb\xc4 ܿ\xc2\xed\x17ſgl]|\x8d\xef̙\xde车\x06\x97\x99\xe1f<\xe4\x8a\xf57EU\xffa\xd86\x1f\nY,\x1b/*\xfc\xa5d\x9b\u05cb\xd5l\xf2\xb7\x10\xb9\xf0\xe3\xb7\xdf\xd0_7\xaa\xab\x91R.\xd5n\t\xf2\xe4yeOom#z\x06\nk\x8a\xc6teS\x99J\xaa\xf7@\xd3p\xa9jk)\xf3\xa2\xbd]\xb4\x92S\x85+\x00\xb7h\x1e*\x88\xbb\x91ՃN\xa5\xb0P\x04+\x8a\xa6\xd6kDwZ\xe9\xb9\u007f\x0eh\xf6Հ\xfa\xcad\xd4j+V;\xd5\x04;/\x1bq\xf0\xf7\x9dmW8\xe9\x8dO\xbbcGS,\xa2h\xb1>\xc6&\x1f\xa0}\xa7G\x9fA]\xbb]\xdf\"NU\xb2a\xad\x95U\x1aE\\\xa3x\xdb\xdfۓ\xdeV\x9d\xad7o\x1dDDY\xba\xed\xa6]\x14,[r\xf4o\xfa\xd6R\x9cʪ\x18\"A2dq\x03\xb4\xb7wZE\xd7a{\xedJ-\x82\xd4\t'+r<\xd0HS\xe2\xd1\xf4\xf5\xcc>3\x92\x9e\a}e]\xb3\xa1\xe9xh\xa8\x92\x82g\xc8\x04\xb1x\x83\xad\xea\xc6\xee\x92fc\xf2R\xa5ӽ3n\xa0\xf6\xfc\x1f\x93r\x86\xb1\x1c~\xaa\x92\xd8\xd4qA\x17m\xaa\"\x99\x14V\xc9\xca5Ք\xe5\x9ej\x96\xad\xacx\xdb\x1c\x8b\xf9\x1d\x15xyq\x15\xd6E\x1de\x92gf\xdf\xf4\u074c\xc7\xef\xd5\xde\x060s\x97\xe6>\f\x91P\xa7\xffG\x88d\xbf!\x92\x11\x12\xc7\xc8 \x0e\xec\x1aM\x86ǟ\xab@\xb5)\x8b\xf8\x82v\x87\x1d\x9d\xe4^\x98\xe1\xee\x8cX-M\xfcb9d\xd1\xcd\xd8d\xd4\xd08r\a\xb5\xc3=\f\x94\f\xf0\xe9<\x9a%\x13\x98\xdb<\xa9Cz\xfe\xf7)E\x1a\xb9;'\xa7G\x9e\x10je\xd8\xd6\x1d\x16ƶ\x86,\x9a\xa4\xd6\xf8\x00\xf2\xee/.z\x9br'\xbb-\x9e\xbaDL\xfc\xb0\xa7\x9a\xeb\xb74fג\x15\x1f\xa9\xa0{\x01\xdb\n\xdb8w[\xce\xce\xc1\xc5G^y\xcd\xec\x8c쑉)R\f\x1d=\xdd\xf9\xfc\xf2\x1c\xaeV\xce\xf9y\r\xaf\xbdNw\bǕ\x1b;\b\xe7J\x87y\xc2\xc9\x03|\x03\x8d3\xddF\x91C\xb7?\x19Y\xdf\nQ\x924C\xe9\x87a\x12\xd2\x04ܒU\x88O\x1f\xcbh\xd0:Xcf;(s\xe0\x83\xc2\xe9\xb1\x10z^\xf3H\x91\xc68\f\x97\f\xb3\x97\xc0\x1d\xd6\xc0w`\x8e\xd6\x18Q\xf5\x13\xef\x8b[\xc4\x06\x16Ha\x01\t\x1bU\xfe\xa8\x99\xefc8-\x8c\xc6x\xb7b\xd7\x19}\xe2}\xc7\xd11\x96ݮ\xd7o\xbab@\xc6\rW\xc7\x018\x10bM\xed8f\xab\x80\xd3|0\\[O\r\x8e\xd7`\xd0e\xb2\xb2\xb9\x1btn\x02qZ\x9d\xe3\x1e\x80\u007f\xe1\x04\x15VkX\xcfl3\xbb\xf3nЧ\x19\xb2\xb3w\xa64A\x1b\xec$N.'\xd5re\xb5\xc3\x0e\xf3'\x9a\xf7\xf2\xf5\xf9 \x86>\xaf\x86\bJ\xe2#\xb3m\vB-\f\xbc\x83\xb3\"\x00`\xac\x86:\xc66\x8e\x0f\xd0CM\xb8\xfbp\x1dTN\xe7\xef\xb5o\u007f\x98-b\xfeG\xda\x1b\xecb\xa6\xaf\x9dAć=\x88\xfda\xe5L\xb5Ϛ\xbc\xd9t\xdc\x15Hy\xf6\xf6\x92z\xa3\x8d\x83\x1a\xae\xb2t\x96\x04\xb0'@W\xacPI=\xceN]D~\xe8\xb0K\xf0&|\x94\xf4r\x81\x93-J\x14\x15\n\xac\xd0\xdfA5D\xcf\xe9\xef\xa0\x1aZW埃\xeas\xdem\x97\xd4[L\xdb\x19x\xbf>\xf0\xdb:YU\x1d\nA\x87毹%\xfc?\xed\x83\xcc\xcc\x14wqB\xb6nl\xec\xc6\xc0\xf0\xdec\xf9ߡ\xef\x04\xd6\xdas9\xf6\x93\xd7\x1b\xa7\xc9\x19\xa6I\xbe\x93\xfe\xe2\xc7\x1e@/~\xb4\xc3y\xf1\xa3\x00\xd3Ib?n\xba5\xce\xfdJfE\xb3ܺe\xd3)\x89{\uf7b5\x1a\x95x\xcd\xf7p\xec\x1f&\xc6\xeb\xf0\xbc\xb1_\xe8\xb7\x19q\xf7\xe6W*\x15\xa5h\xbc&\xf0\xf1ڡ\xb3\xfa\xdf\xe3m/\xaeHn\xa6\xab\xd4\x1ds\xd0\x11GOr\xa2\xee2\xe3I\x93\xd2\xe7\"M\xee\xd10!\xf4\xfe\x915\x10\x059\xc5B\x11r\x8f\x0e\xf5\xd8q\x06\xa3\xeb\xe8\x0fL\xe2\xd0\xee\xe0\xc5\x13{w\b\x05,\xea?\xbfA/Y\u00a0\xea\x8e'ѐ +\xffo\xbe5Dq\x13T\xb0\xfb\b\xaf\x90\x8c\xe3x\x8d\x97]\xebh\xb5\x86Cye/r\xc9N\xe8\xd25h\x1cb\xd7i\x1d\xff\xed=dا\xc0tr\xaa\x88\xe2j\xfcE\xe3\x0eCSC\xcaW\x8f\xe6\x13\xd5\xe1R\xc6\xd6\xe1\x1a\x18$_\x88k\nuAkβ\x16\xfb\xfdJ\xed\xdelR\xbfh\af\xab\xa7V\x04\xac[:\x88\r\xf6\xa0\xa3\xbf\xec\x17P@UE\v\x9bw\x81\xb2\xb8\xe9~ТsqgQ%\x1fZp\x8dIߥG\x8c\xa5Տ\x8b\x03\xeb\xb1\xf5Aߌ%\"\x03iN\x12\xd0\x19Wp\x94\xb6Ǵ\xf1\f\xed0,\xe5\xd3\xf6\xebݻk\xdd!\xc4G\xd9)h}3p\xea\x12\x8fb|\t?\x81=\x8e\x9c\xe5\xf1\xd9S\xd7f\xa2!\t{N8\x8a\xd7=\xe8麃\xddsI\xea\\\xb6\xaa\xdd\xee.\x94\xf5\xad:*x\xb7Cu\xe7^->\xefs\xa3\xb9n\xef\xef\x98̶\xa6\xc9t^x6\b\xb7#\x86\xf9?\xf3\x8e\x97$\xe4\xd5U<e\xf4\xe4Y\x82\xb9\xfb=\x82\xd7\xebM\x96\xdc%\x80P5\xbb\x9d͟ny\xdc9\xb8:s\xac\xf0\xd4\xf07,\x8d\xc2\x1bZj\b\x8f6\xc9\xee\xcfSڲ<\u07b8\x1e\x9c\x9d\xc3dQ\x19\xd5\xc0\xe9︒{gg\xffF\r\xa2n\x84Oߗ\x8d\xb5L\x8c7\xa3\xab\xe48q\xd9\xe8\xd0ۓC\xa50\amHݗRgeZ\xa73*|`a\x06\xc9*J\xb3v\xb0\xec\xa7%\x0e;G\xc2\xdb\x05\xc6\x036\x91\xc3\xd3͊\x18J䅂\xcf&\xc5^\xf8Dq\xc9hR,]*T\xb8\xfc\xb7\xad\xa0\n\x93\xff\xb6\x14\xb4\xb8{\u0603Ó\x95\bD d=\"<N\x9b\x06\xd5\x1e\xa9\x02?\x0f\r#\x95n\x92x\xfckV^\x0e\xd3ψWf\x03:U\xfb\xecK\x8d\x17z\xde\xfd\x92\x93\xde\xe9\xdf$\x02\xc6\f\x91\x8cd\x80U\xe8}b\xa0UH\xe3Y7\xcaC\x01\xb2\xab\x92w\xac6\xc9\xcaY\xdf*\x90\xd7\r\xad\v=]q\\\xecx\xb9@\x06\xe4~\u00a0\x05\xdb\x03\xa1\xf9\xae\"\t\xc4\xc7_\xd9\x03\xae\xefޅ\xdf\xe1>\xfc\x86V\xda\xcd\xcd!\xa6\x90\x1b\x15\xe9\xbenn<\x98#H\xa28\xd8t&\xbf\xb9\x11P\xa6E\x8cn\\\xff\xfdd\xfd%S\x16jUE9\xc8?A\x11\xb8\xf9]#\xeb\xfeR\xfc\xfc\xb7w\xef\xe8\xc5^\xef>\b\x03\xf7/\xbd\xc3c\x8f5\xd7B\xf87\xa8S~\xf9\xa4\x8e\xbf|\xf7\xee\xfe\xf9\xcd͓\t|\xe5?/\xc5\xcf\t\x14\x91`&y,ua\xc2\xda\xfc\xef\xaeI\x97\x10\xa7\xe3R!\xe7j\x99\xce\xeb\x97<\xb0\xe6]\x82R\xa3\b\xc5\xd3\t\xd6_͠\x17<{]\xd2\xdf\x17\xcc\x1f\xdeu\x8dZӦۄ\x1e\xbc\v\xf7\x8c\x13\xb5\xbc\xfdp\x17<ˡX,\x01\xf9'ʉ\xbc\x86&\x1b\xc2\xe68O\xcbU\xe0\u007f\a\rQd\x8c\xa8\xaa\x8aY\n\xb8\x8b\xbd\xbc\xa8\xd3y\xcav\xab\xc6g\xda\v(P\x13:{_\xc1\xe2L\xa8\x92x\xbfè\x11eH\xect[\xc5.#\xb7s\xa7\xa54\xb2-u\x88 \xca\xc38\xeb\xc6[\xcdn\vv\xc6\x1c\xb2\x9e\xa5\xf4\x83\x89\xea\xc8l\xdc)\x92\x15\x03\x96ۣ\x88|\xed\x91\x02\xc6\x1e;oG\n\x14h5\x1b\xe6\xd1YY\xecS\xedMSy\xe7\xea}\xea@\xec\\j\xf5\x13Ǔ\af\xd7\v\xb5\xedvw\x1bPUm\xb7\xdd\xef\xfa\xab\x92\xf3\x9d\xb4\x1d\xca;`g\xcdf\xf7\x83\xfa\xfcv\x9e\xbeW\x8e\auAl\x8c\x06\x1c\x911\f\f\t2\xedX\x1a\xb9\xa6\x1f\x05\x8aD#aÐttP\x9c,ͻ\x87d\xff\xc3@\xe8\xca\xf1O'*\xe0ʞt\xba\x12Ԣ\xc1\xa1\x90$&\x14N\x1e\x1a\f6\xf3\xfa\xc5X\x84\f'\xb0\x9f1<\\`\xf0\x13\xdeQ%F\x89Z\xbf.ܵY,\x17\x00ᬭ\xb6N\xb1\xe5\xbb\x006\xddqz\x89\xabwZ\xf9\xa3\xbe\xd4\n=\xefU\x17\x1a\x06\x1bzT\xd1\xc2*Z\xe2\xbfʲ\u07be\xad\xd9&2\x10Y\xc0\xa2i\x15\xb0W\x87\x9fx\x8f\xf7\xf8[\xd7]0\x0f\x9c0\xddu\x1e\xeak˅\x0e\xf9e\x9d\x12\xcel\xcc8I\xac\xb3\xc71\x95K\x9f\xf4&\x11'\xfd\xbew\x89\x1a\x99\f+\xe1\f\xb8*\xd0\xec\b\xbdFs\x0e\x1d\xf3\xd6\nW5\xa0\x13W\xa5\x06\x11\xae\xba\x12\x17\xc2\xf2\xc4\x0fz\xc6CeT\f\xb0\xc5?\x10\x0f\xac\xb0\xf5\x0e2^|\xbb\xdd\xe5\xe0*i\x8cAᛤ\xb6_\xa4\xd5/\x18Pi\xf9V\x97Zk]\x163~/\x985}g\xfd\xa3\xe3\x82\xeam\ue7f6\x92\x82%\x14Tc\xd5R\xef\xa8j\n˦\rc`\x16\x03Ys\x02`(e?\rn\xceE|TB\x84 \xc1T\xf5\xb3\xea\x9bz\x951\x97\xa9\xaf \xd3\xdc\x00\xb8\xa5C3\xf2S\x05\xf6n:\xb3\x8c\xfb\x9e盕\x05\"\xa9\xf8K\xfca>\xc1\xd5\nMT\xea?\xd9\xcf\xca\"\xd0W\xcb\xe2J\x11ހ\x8ad\\\xc0OQ\xc6G\x01\x19\xc0X5\xe9\x8e=\xed\x8f\xc9\\pPڶw\xa7\x85ױ:\x04\xeex\xb6\xbc.\xd1\xcb\xc1xb\ud7ff\x9al\xb7\xb9y\xa0\x98@̺{!q \xff\xb8R#\x9e\xda.\x04^\x86eBב\x83Ip\xba\xf3\xee&\x18\x9f\x8d'\vT\xf5\xed\xff\xb29\xd8ۛ\xfaV\xd0\xd5\f5\x89\n\xf4T9\x84\xc5\xc5lC\xbb=\xcc\xdaˌ.;\u007f\xb5\xfd6fO\x88\xd1s\x1dP\x9e\x83\xf96\xaf\x8b\x9f\xd3D\x89W+\xad\x80@b\xa1\xa7i|f\xef\x10\x867e[D\xa7\xe5\xe0\x0f+ǡD\xda)I\xb9]\xcb\xfb\xe5&\xe6gDR\xcdm\x80\x0e\x14Y\xc8Ѯ)\xfa\xc4;\xb8\x85W<v{rLB\x16\xc8Z\x8f\xf7T,\xc7\xc2/\t\xa52\xfaR\xe2\xd58&\xecM\xda\xfe\x8d\xb5\xb1\xc6\xd0\v\xc3u4v\t\x80\xa6\x96/v\x90؋\xb6ȿH샐\x98\xacZ\xd5\xe6A\x9bRQ\xd02\xb3\x1dq|M}o\a=5m\xb0W(\xe1\xcf\xe3\xbdq\x1f\xbbq\xbd0\xda\xe8on\xe3\b\xd2\\\x8cnE?S\x80h \x8br'\"\xc1\xdc\xf9[\x9dQ\x03\xb4M\xe2#\x1b\x1b\x1a\xa3`\x90\x83؛4\x83\xfd\xfb\x0ev\xa4\xdf\xf7Ψ\xf0\xc1\xe5\x9azW\xa4\u007fa1\xd1\x02\xfdK\x04\xa9\xa9\xfa\x95bB\xbb(\x1b\xa5\x94\x02\xc2\xfdK\xba\xbaZ\xa9xf\x96\xcf\xc6\\\xb1P\xb3\x938\x95\xe0\xe3/\x96-\xc7\x10\u0081\xe0\x01\xdcа\x18t%\x8d\xca\xdf_*\xcac%jW\x97\xfd\xb8bӍw$\a\x91\xb4<%\xac\xaay\x8b\xe7CIV\xf2\x80\x16\xedۈ\xf2\xd8Px7x5\x9f\x04\xd9I\x89\x9b\x01\xc3j\x9d\xa50\xf4\x1d_cr\xf2\xfc\x1a\xea\x93\xc6`\xec\xdb|\xdd+\x0etr\xea\xed\xfcv\xf6p\"\"-\xfc\xa68\x04IO\xc0\xb5Q\x9dAt̀߸\xc2;\xe0GHtxqb42\xb1#\xc6\xcc\x0f8jeZ%\x87\xca\x12R\x9d\x81\x80\xd6\x0e\xa5\x05\xa4]\x05\xe5\xe4u\xa8ӛZ\x8cVɡ\xb2\x84l\xf7Ei\xbc\f3\x80}\xad\x1b\xb0\x10\x0e\xe55\xd2\x0e\xf3H\x97m%_\xba\x86\x89D\xf9Vc\r8\xdf-/\x90~\xc9&>\xdd\xf6,\xafj\xe9w\xbb\x8a\x85\xf5\x17\x15\xfa\r\xf1)\v|\xb6$\xa3Q\x8fn>N+4\xfc<g\xe6\tE\x0f\xafD3\xd9v\xe8\xf5\xb5P\x85\xba\x97\x94Xװ\xa7\x01o\xa6N\xdf\xca)\xc4\xe5\xdc.\xe8\xcc\xf3\x83\x1c\x8evc\xff\x90\xa9\x06t/|\xbb_\b\xecBo\xfe\x99\\c\x90\xbd\xc4\xd06\x13\x05\xb5J憠\xfa\xf5\xdby,i\xb7\x19\xdd\xddF\xb7\xae\xf3\xf5!Յ\x15F\xd9\u0086T$2o+6T?\xc0\x1e\xb1\xa8d\x83\xc4\xc2Z\xad#ޥX+\r\xf9\x0f2di\x04o\xa5(\xb5\fQ\xbdԌ\xfcH\x0f\xc1\x18\xb4h>\xac\x92\x00؆\x1d\x19\x19`\xb7\xe3\xe8M\x8b\xf2*\xcd\xe3\xe2*l̚ϩ\x12=\xccǻn\xb1E\xf6\xcaѷ\xe4(\xd4S@\xcf;8\xec\x1f\xc2\xff\x1f^\x91\xe2\xc2\x11\x0f\f\xe2\xd8-Ela\xee\xf6}9\xd6m\x12\xe2\x91\x1b\xa7\xf3\xdeMgL\xbag\xb3\xf3\xbfp\xebӀ \\\xd4c=\xfe\\4;\xa7\xf8s\xea\xeeG\x1ai\xf4\x11;\x96\x1bQ7\xf2\xd92\x897Yb\xa9\x8d\xa3\x88\xf28f\xa1\xeb\xbac\xdb\x19\x11\xc1f\xe7\v\xe6B\x81\xd1\xc0\xde'<\x1d'\xe2(\x87\x01\xbc\xe1Vlܒ\x19O\x9a\x9dӍ\xbd\xef\x93$\xae<(\x91\x17WY\x12/H\x81\xa4\xb9Ʋ\x1a\xeb(O\xb2\xe7\x18~\xaf\x01r\x1f_`@\xe7\x83&\xcbV\x11-\xcaF\x15L\xb4\x15fzJ\xa38K\xb6U@\xc5\x15J\xdb\xfa\x1b-\x82\x9feY\xb4\xaeT\x12\xb5F~\x92\xa0\xb1\x97\xce\xee\xa9)\xdaVjm\f\xe3\xe7Y<=\xac\x97&\xcd>\x06X\xea\xc8($\xf9\x86\b\x1f\x10<\x8b\b\xf5 #B\x10r\x92\xd9\xf9\xb7\xf15ʤmTgǍ1\xf2\xe3\xc3\n\x12A0\x8a?u\x80=\vyy\xb3\u007f\x02\xa08\r\xb2\xebm S\xc2pv\\\xddt\\\xee%\xa7\xc1\xbcQF\xad\xa2\xeb\xbb@Q\x9cY٩\x14\x1dZ\x8f\x11\xb4ӓUF7]fu̙\xfc\xc13z\x9a\xdb^\xbd\x10\x1f\xf7\xeb\x96\n\xd6\xd3\x06\xbf\xe8Rԅi\xcb\xf5c\x17)\x1b\xa4Ac2\xc1\xb2\xe2\x9bu\xacY.\b\xb8u\xc9\x113Q\b\x9d\xf7ن0\x9bk\xb1<\xf8>\x17\xe3;\"˅\x96[\x8c\x93\x15u\xcaBja\xe0\xb3ϳ\xa2J$Nk\u007f\x10\xa5)\x0e\xfb\x1c\xec\xaa\x03\xcb\xc3\xc1C*\xe9x\xed\xf8C\xa0\x9a^Hչ\x82\xcdu\x1b?$\x01-\u008e\xd9\x17\x9f\xee\xa5b_&\xb6\x91\xdbq$\xfa\xb2\b\x9f\x91l\x80\fr\xc1]\x15\x91#\xfa\x9b\x1c7\xb8\xdc\xf9\x8aMۜe\x1e\x1d\xd8vw\x9cu\xe5\xae\xcd\x1btq\xab\xf6o\xfa\xe9z\xb5\xc9@֘\x19\xfau\x8c\x06\xebr\xf2oL\x98T\xf6)\x05\x8e\xa5\xb7\xe0\xe1\x8b\xd95#\x9a\xa7DL\xb2\xa8\xc6\f\xff\x0e\xa40\xe2\"Yh\x00\x81\xb9nn۱$\xe03\xf0\xa4\xfe\xb5\xf8V\xca\x1f\nW\x8b\xc1\xc2Γ\xadˢ\xaaA\xbbMgt\x8c1Pϗi\x16\x83\x00\xb4\xa3\xb0ZH{ߞ\x12\x90ޞZ\xd2mi\\Іv\a0\xd5\xd6颛\xab\xcaD\xc7\xeb\xe8\xa1\xc7mx\xfb\x17\xa5\xf5u\xe6\xffsJ\xb3\x87o\x9e\x03\xdf\x02\n\"=\x120efEil'\x17\xaa\xa9\x84\x9bH.d\x9b\xc8\fP\x95H\xc7\f\xde\x13鉽s\xfd\xe0K\xefQ^\xe0\x93\xb1I\x19h\xbam\x02g\x8b\xa4{\x81\xef\x15\x14\x19\x1co\xdb\"6=\x81-ީ=z\xb0;\x101t\xf9614\x06\x84\rv\xbe\xb6t\x19\x9e\x14\x1b\x14H%e\x80H2\x94\x02]\x8dZ\xa3\xf9v\xb5\xcc[\x97\xc3\xe7\x06\xecwg\xbb\xae\xf0C\x97!\xbf\x12\xd9F\xfa\xd5\xe3\x04k\x17\"\xe5\x0f\xbb\xe8\xfcl\xc6\xec\x84tݙ\xff\xe8\b\x8a\xab6\x8f\x970\x9e\x89\xbd\xbb\xa9~\xba\xe7\x8a\veMe\xb4\xe5|\xfb\xca2\xf6n\xbad\xb5\xb8\xca\t\x8b\xa2\x8f\x98\x95n\xf9\xba\xb9\b\xd7e\xb1J\xabd\x88\x96\a\x05\xa9\x84\x96l\xb3ze\xc3&\xa59\x95>\x9d\x91י6\xf2\x03iZr\x16\x02F\x18\x1dd\xb6g\xdb\b\xb9\x1f\x99U\xa6\xe5\x83\nl\xb0\xc6a\xbdLri\xbdV\xd6\xc7M\x1a=9\xbb\xfaU\xbb\x0e\x16m7,b捌i\xcbs\x8b\xf8!DR\xe6ݚ\xd0\xddŸz\xeaV\xd2)\xec?\xa8\xbd\xb4\xf8\xb853\xe3\xdeM\xecB-\xb9ω}\xed\\\x9fB\xabh\xd3\xe9\x06&>$kXs\xe4.\rzW\xf7\xb6\x9ee\x96\xce\xdf\fX~\xcff\xe7\xef\xf5\xecG\x87\xa6\xd3\xf1\xe4\x81\xd1\x05:\x91\xfd.\x9d\x98!\xe4\xa1\xdd`'\xb3ߥ\x1fs\x02mv\xe4\xff\x05\x00\x00\xff\xff\xfd1\xb9\x1c\x9e-\x01\x00", size: 77214, local: "web/static/js/bosun.js"},
+	"/js/bosun.js": {compressed: "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec\xbdm{\xdb6\xd2(\xfcy\xfb+\x10m\x1a\x92\xb5L\xd9n\x9d\xed\xcaQ\xf2\xa4q\xbb\xed٦\xdb;I{\xb6\xc7\xf5ɡDHbM\x914\x01\xc9\xd2&\xfa\xef\xcf5\x03\x90\x04H\x80\xa4\x9ct\xef\xde\u05f5\xf9\x10\x8bx\x19\xbc\r\x06\x83\x99\xc1\xcch4\"Or:\xa79Mf\x94d\x01_N\x06A\xb2X\xc7A\xee\x87>g\x032z\xfaI[\xa9\xe3<]sڳ,\v\x92\x88G\xff\xea*>MS\xcex\x1ed\x1d\xe5V\xe9\x8a&\xbcW\xa1\xe3p\x9d\a<J\x93\xe3y\x9a\xaf\x82\xaeJ\xe1\xe7\x1d\x05\xd6IHs6Kse,\x9b 'Ӕ\xad\x93\xe7YF&\xa4\x98\xc6U\x1a\xaec\xea:E\x963$W\x9f\x10B\x88\x93,^\xc1\xe49C\xf1\x89\x05^\xa4\t\xcf\xd38\xa69+\xd2W\x8bYN\x03?Y\xbc\x869)R\x93\xc5k9\x9b\xce\xf0\x93k\xef\xe2\x93\x02\xbe?K\x93y\xb4p\xaf\x9c\x87\xb86?\xe6\xe9&\ni\xee\f\x89\xf30Ng8\rJ\xe2|\x9d\xcc \x89\xb8z\xf9!i\x94\xf6\xc8;l\xbc\x91\xe1/\xf9*>\x7f\x99\x86\xd4\xe5\xf9\x9az\x17\xa2\x98\x06Ͽ[\xd2\xc4uF\xcePB\x81\x7f<\xe21\x1d\x13\xe72`\xcbi\x1a\xe4\xa13\xac\xf2\xe8*\x8b\x03N\x7f\xca\xe31q\xb2 \xe7Q\x10\xb3QX\x14\xc5F\x95\xf2\xb3r\xeaT\x80/x\x1e;Xf\xef\x15]\x88\x12N\xf3$\x88\x99\xb1/\xdfU\xb9]})\x01\xb5\xf6\xa5\x04h\xec\v\xa7+K?DNg\x1f\xa0X{\xfbP\xc2\xd46\xddf\xb9\xb1鯷YN\x19\x8bҤ\xbb}\x00\xd2\xda<\x003\xb5\xbeȃlil\xfeo\"\xa7\xabe\x04\xd0\xda4\x022\xb5\xbdL\x1976\xfdm\xca8\xf99\xa2w\xdd\xcd\x03\x8c\xd6\xd6\x01\x166^\xe5\xe74N\x83\xf0\x1f\xc9k\x1a\xe4\xb3\xe5\x98̃\x98\xd1Z\xdf\xf2uL\x8d}{\x85\x19]݂\xea\xad\xdd\x020\xf7\xe8\x16\x8bb \x80ƞ\xbd.\xf2\xba:'\x81\xb4\xf6O\x023-\x9b m\xc6.\xbc\xc0,I\xe5\xbb;\" \xb5\xf6C@4u#\x98\x89F\f\xddx>\xeb\u05fe\x00\xd1ھ\x00e\xc4ވ\xf14ߙ;\x10Ӝ\x93o\x8b\x12\x9dH,\n\xb6\xe3\xb1(c\xeaI\xb6\xe6\x16z\xce\x03\xf2u\xc2\xfbt![\xb7o\xa3\x1f\xd7\\k:\xe5K\x9a\xdfE\x8c\xba\xef\x14\x04\x0e\xa3\x9c\xce\xf8\x9btL\x9cQQ\xf4⓽v2\xe6\xeb\x04\x8e\xc5\xe2\xf4\xc2\x131OS\xfez\x96fT?\n\x8b2CR\x95(\x8f\xbf2\xc5\x7f\x98&\xae<g_,\x83dA_\xafg3ʘ\x06\x8cnh\u0087d\xb6\xces\xfc\x91\xe5t\x13\xa5k\xe6)\x13\xa7\xc0\xc49$\x93\xa2\xbc\xffP\xc0\x17\xe9\x17\xa6\x1al\x99\xe6<\x8e\x92\x1b2\x11[\xf7B\x9f\x80\x923Q\xb8\v\x1b\x87\xa21 䪜?%ݯ\x96\xc7u\xbe\xc2L$'\xe4\xcay\xc8\xe4T\xcaI\xc1_K\xce3\xfcq\xdb6\xe3Xs(\x99\x86!\xc1ZC\xf2\xf0ָ\x02\xec#Ͼ\x80\xc7x\x9a\xa9\f\xcc^\xfe\x95ٰg7\xb02\x15\xf0\x8d\n%\x9a\x13\xf7\x81\\,ٜ\x9a-\xf0\x94\xaf\xf3\x84$\xeb8\xaeVr\xafA\xd0\x01\xf8@\x98i\xf8\xa6\xda:d2Q6\x8fC\x8eȆ\x1c\x11Gl!K{\xef\x88\xe8\xfb\x98\xc0\xe8\xc8\xde\xd4v\xa3k{m\xf0\xbf\xb14\xb1\x0f]V\xfe_\xaf\xff\xf1\x83\xcfx\x1e%\x8bh\xbes7C\x047$\x0e!\x8eg\x82:\xe5i\xd0\t\x95&\xb34\xa4?\xbd\xfa\xeeE\xba\xca҄&܅z\xee\xc63\xc2\x14\xc5\xef\x03uc\x84\x97\xd3۷\xf3<]\xbd]i0W*L\xd8a9\x99\x90\x84ޑW\xf4vM\x19w\xbd\v-\xfbVf\xffך\xe6;5\xf3\xd6_Q\x9eG32!\xab*5\xf7o\xd74\x8f(\xf3\xb35[\xba\xb7\xdeE}\x00\xb9\xa9\xb3Y\x90\xd0\xf8E\x1c0\xa6u\x96\xf1\x80\xaf\x19\"\xff<\xda֑V\xa4\x92\xc9dB6i\x14\x92\x13\x8f\xbc#E\"\x19 \xcc\xe3\xc1\x85\x82,\xec.\xe2\xb3e\x01\xb7\x8eu\xb3\x80Q2\x98\xe5\x11\x8ffA<\x18k\x99\xca\bd\x13Gd\x10\xc2\xee\xcd\a\x17\x060\xeb\xe4&I\xef\x92>P\xa2d\x9e\x1aa`Ƈ\x00\xb8\v\xf2$J\x16}`\x14EM`\x12\xb8|\xf6\x9a\x10&(\x99\x11\n\xcd\xf34\xbf\xf7\xac\x86t\x1e\xaccާ\xba(9\xa8S\v\x89q\x80\xd4l\xb6\xa4px|\x13Ŝ\xe6\xb5m3\xcf)[jh8\xc7b\xf5\x8d\x13\x92\tyx\xeb\x87p\xc7V7\x86\x0e\x1c\x00)\xad\xa8t9\x89V\x01\xa7\xf5\r\x97\x91\x898E\xfc\x05\xe5\xc0\xb5e\xd1(\x00\xee\x88=\x13\x80&@<\rd@\xe4\x92\xf7\xef\xc9`\xe0y\xbe\\\n\xb7\x1aF\x18\xf0\xa0\x8e\xf3\xc5\x11\"\xfbL&\x04J]\x98\xca\xf0hE\x83$\f\x03^\x14\xf3\xdfD+\xfa<\t/\x03Nk\xab\xe5甥\xf1F\x1b\xdd\xde\xf3\x11\a\x94.\xd1<\xaf\xf7\b\xaa\xfeFg\x1c\xf3\xd4\xca\xd5\xef̟GI\x10\xc7;W9\x01\x9b\x94&\xf4\xb3<]E%_\xa1\xac\xff\xbfȄ|~R%\xa4y\xb4 \x13\xf2\x97\x13%-\x8e\x16KN&\xc4\xf9\xf3\x17\xd3\xe0,\xfc\xabSe\x85A~\x839\xa7\xf3\xf3\xb3\xbf>VrV4Č\xcf\xcf\x1fө\x96\xb1\x8e\x01\x18\xfb\x17\x19akU\xcet\x91\aP\xe9\xec\x9c|\x86Ū\xacY\x94\xcfb\xca\xc8D\xca^\xe0\xdf\xd5\xe9\xf9ɐ\xe0\x7fЍ\xeb\x8a\xfd\xbc:\xb7\xe6`\"f\xe3\xa8\x1a\x95\x1aY\xd7\xcadm`j\xc2\xcf}FcX\x17\xe7\xcfq\xbaH\x1d\xcf\x0f\xb2\x8c&\xa1\xeb\xb0\xcd\x02\xbe8\xcf]gI\x01\x883$\xec_E\xd2]\x14\xf2\xa5H\x11@\xd9f!a=\x8fc\xd7\x01\x06؟\x02\b\xc0)\xf7\xea\xaa\xea\n\xb9\xc2\xf9:\x93\x03\xba\xf6|\x9ap\xd8me\xdbP\xb9l|\x06\a\b0kӅch\xbd\xd9E\x80]$\xe7[g(֢L\xd9\xd5S\xe6Q\x1ck\\Zh8\xa1ë\xd3\xeb\x82\x11\x93\xf5v=*\x9d\\\xeb\xdc[m\x96@\x80\xe7\x87Q\xb0J\x93\xb0\x9c\xaabY\r\x13\x03\xe5\xcbI\b\xed\xed\xe3\n\x03ʾ$@WB\x89\x83\xe4\x888\x98pz~\xa2\xa1eQ\xe7\x0e0\xf6\xa4\x99\xc7\xc8ф8$\xc6\xcaw%\x98;K\x89ナȟ\xff\xc7i\xecuV\x9bq\xb9R+Z\xae\x1d\xe3yz\x83\x9c\xfd\xdd2\xe2\xd4\xd1ӏ\v<9-va\v\xb2\x16\x00\x94E\xf8ܾ\x12f\x14\xd5;Q\xb4\xee\x9f7\xb0\xd49=9\xf9ԩ\x8d\xabV}ۍ_\xdaBYpL\x90\x9bb`\xe2\x8b5\aT\x16\x93C\xda\xf6\xc2\xeeZ\a\x8aʻ^\xfb\xc9\\9w\x86\xe4\xf1\x99_\xae\xd8a\xbb\xf4\xac\xbeK{b\xc8Y\rC`7\xf0<HX\x04\xad]JY\x0e\x9c&\xe7\xcai\"O\xfb\x17\xe9:\xe1dBN\xf4+\x9a\xc8Ԙ\x0e\xb5\xcfj壣\v\x8d\v\xd6\x01O\xc8i\xe3@M\x9f79\r\x8d%*\x1bU\x8a\xd6/\x88j3\xe6\xeb\x9a\xe9v\x06\xa7\xc6r=\x9fǴD\xa7\xaaX\x1f\xecӖqH\"s\xd3$Ҹ\x8cj5\\\xcf/T(ns\x8d\xfa`p\x1b\x16\xf7\xc6\xe46l6\x91\xad>`\n\xf4\xad3G\x8cr`\xcb\xd25w\xcb\xe5\x1c\x1a\x10\xb4\xb84~R\x93$hH\x18\xc4q\x1d\x13\x828\xae]\xb90EJpjR\x81Z\xfd:.W\x1bAG\x1b\x1a3\xdaD\xee\xa7\u061c\x15\xd8\xf1q\x1b\xc7\x0f\xb2&\xe0\xac]\xe7ϥ\xdc\xc9\xf1\xcaS\xb7\x98\x00Ȣ\x89u#\n\xc6<K\x19w\x1d\xf8\xc9ƣ\xd1\xddݝ\xbfH\xd3EL\x83,\x02)\xd3j\xb4\xcec\t\x89\xe6\xa3\xcd)|k\xf2F\xf8\x17\xa7\xc9\x02E\x8aa:[\xa3F\xf0\xa7W߫\x18я\x81\x8f\xe6\"ݏ\x1a\xa8\x02\xff\xb0\x1b\xfe&\x88\xd7%\xd3\x1e\x85\x17\x8db\x16\xa9\x1c\x88]\x9a\x85\x15\f3\xcfR\xb3\x03\x8c\xf2\u05f8ӣ4y\x05\xd7;\xf7dX\xf4Əi\xb2\xe0K\xaf\xd9о\x96\xb6o \xfc^\x8a\n\v\x9d\xaa\xb8\xfa}\x83\xcaR2!\xce/\xbf\xfc\xf2\xcb\xe8\xe5\xcb\xd1\xe5\xe5\xf1\xb7ߎW\xab1cNY\x1a\x84\xa5 @\xa4\x95L<\xa7q\x00\x12'\x18\xdfX\x19\xd1|\xcd\xd79\x1d\xc3U\x9b|\xca\x06\x15\xfb\x9c\x05\x8c\x8f\xc9\xe0Sv\x1c,R%\x9dAb\xa8\x96\\a\xcaJMi&-1e\xa9\xa64\x93BL\tՔf\xd2KLIԔf\xd2\x0eSvjJ\x91$\x89\x03Lt\xb9ȠЅ͖\xdeD\xd4M\x82\x15\x1d\x12\xc4,X\xca])N\x81\xedF\xb7Y\x94Sɔ\t\x14\xad\nT\xb7(N\xa5t\xe9\xb2v<A\x96/\xf1\xccŏ\x85\xfc\xf0ȑ\x80F>#g_\x90\xcf\xc8\xe3\x93\xe2\xbfӓ\x93\x13O\x01\";\x01\xb2\xa0\x8b\xe2c2\x00&\x17\x00\xf2\xf4o/\u07fcF\xa1\x9f\xab\xd1B$>\xefLP\x06j\xb1r\xdb\xcep>ȄP6\v211\xd0\xcb\x01\xb6%\x13q\x9e \xb5\x00w\x04\x9dB\xad\xfchp\xf1ɾ\x9a\xe4\x9c\x06\xa12\xc5\xea\xac\xc2\xf7\xd7\xffelI\xb93\x06dR\xef\x9cϲ8\xe2\xaesQ\x883\xe7iN\\(\x1d!\t&\x11yBf\x81܉\x17$::\xaa/\u058cL\xc8,\xb8\x8a\x94S\xe7n\x19Ŕ\xb83\x7f\xb6\f\xf2\xe7\xdc=\xf1\xf0`p\x88\xe3\xe9\xe2\x1f\xa8\xea\xb3\xf5T\x88X\xdd\xd3!\x9957=`\xc9̏\x92\x90n\xff1w\xc5P\x05\xc0\x13\xcft\x02\xae\x139\v*hQM\x02W\x9a\xd1\x16X\x13\x19+3O\xf3\x80Q\xc3\xd4\x1b\xd0~0\x18\x92\xe3SPT|2\x1a\x11\x10\xb3\x8e\t\x9c\a\xe3ш\xf1`v\x93nh>\x8f\xd3;<\r\x82\xd1\xe9\xf9\xd9\xe3\xbf\xfc\xe5\xfc\x8bї\x8f\xbf8\xfb\xfcq\xa5\xda\x112\x1b\xb8#lh\xcetՂGީݭ2Pun`ЄZ$b\xcf\xf3<\xd8\xc9R\x16\xf6\xe1\xea\xbaE\x96\x8e5}\x16G3\xeaz\xbe욫P\xdc.劢\x02\xaciWJ\x9dJM\x99%t.\x06\xb5\x8aT\xa7hz\xad5\xd7v\x05C\xed/\x99T\xa5|\x91\xe4\xeaz\x905Ci\x9c\xb2\xbf\x06B\x9dy\f9\x03\xbd0\xdfe\x94L$l\xfc\xba\xf8\xc4:ղ\xd4\r\xddy\x06\xf5\xcc\rݡ\x04\xa7*u\xddBn\xf4JU\x1d\x13\xb7\xb6\x9e\xae\"n\xe5U$\x89\rȤ\x86\x03ov\x19\x1d\xab\x03\x1dj\xd9?1\x9a\x97\xd905z\xf6K\xcaX\xb0\xa8\x00\xacķ^\xe8\xeft\xc7\xc6\xeaX*l\xab\x10O\xdbW\xdaZ\f\xb5\xe6\x05a\xbf02aB<Z(\xb6\x91A\xea+\xf9,\xb1e\x9d\xc7`\xe0\xd3)\xa9L\x1b\xb2J\x94\xcbʬ\v+sҺW\x14u\xfd\x1fa\xaf \xa9\x17:\xbc\n\xfd\x84\xd5\xc1[N\xb7\\\x15\x8f&\xf4mNɄ\x8c8e|\xec\xfe\x1a\x1ey#\x91\xcf\xf3\x9d2S\x15\xbc\x80\xa7SW~j$y\x16\xa0^\x86z\xc6j\x8e\xa3\x96\xc5-hPT\"\xbeӹR\xbeB\x96J\x94.\xc6\xe2\xf4\xc5\x12\x01P\x97\x89\xef\xbdR\xfel\xe7\x7f\x1b\x13\xec(\xb3\brEP\x05\x86t\xeeYd\xdc\xea\x9dZ\xdb\xf5\n\x98Jۮ_b\xa8\x9d(\x94Z\x0e&\xe4ѺJW\xe6\xc2\xd26\xf3\x8c\x13\xf9\x16\xd6\xfe\x99\xd2'\x9bv\xa2\xd9{\xef\xd0+\x0e\x99\x00\x0fV\xcf5\x8dj\xf0s\x10G\xe1\xc0v{0\x90]\x1b\xa4\xa66\xa4\x14\xe8\xcb\\\x7f\x05\xb8\xeb\xca\xed`\xb8ň{\xac~b\xac<\xf2\xe8\x11qW\x92?!Oɩg\xbbA鋲*\xa5\xcb\xf6\xb1\xed\x0f&d\xb2\r\xcc#\x13\"\xfe\xbe\x7fO\x9c\xaf\xe1\x97c\xa4m:ƹ^\x1fj\xa7\xdb7\xf6 x\xddd\xee>\xac\x00X'DɂL\x88\xf3\xbd\xf8\xe9h\xf9\xc5<8z\xf2\xbc\xd0\xe8I\xba\xa8*\xf6\x84\x15\x85Zΰ\xedJ\x00*\x13\"\x12\a\xba<\xa6A=D)gX\x03\xf5\xfe=nSYY\x18\xc4\x15\x83U\xae\x14\"\xd9D\aP\xdb\xe9\xea\x1d\xf79\x98I\xb5ж\xc6,:\x17m\xe8\xa4f\xef\xd555\xa8\xff\x0e\x86\xfdS\x12LcJxJ\xe6\x14\x0e\x11\xa1+\x1d\xa3\xbe\x80\xe6\xb9\x01u?\xd1\xf9\xac0\xbd\xd3\x05>\x0f\xd1\xf6\xa6\xce`\x8bT\xa8\xf0\x02\xed4&\xe4\xf4\xf3z\xe7u\x8eF.\xaca\xc9\x06\x83\x06S\xf3\x01\xabn\x14x͖tv\xf3v\x16G\xb3\x1b\x1a\x96\xe29\xed \x81\x12փ\x02\xafc*\x8c\xfeB\xdfzӺ\x14\xa9\xb9\xc0\xaf\xd6\t\x98\x1e\x10\xb0\xf0$/\xa0\xb2\xef\xfb\xce\xc5'\xb6\x15w\xfa\xa8\xd2\xeb\xc7U\xbeN\x94C_\xec\x88^\xfa\xe8&i<\x94\x13\xe8\xc2薥\xaa\xc1@K/\uf08cF\x04~J\xf1g\x94&\xed\xbc'\xdc4\x85\xa1a\xb4\xa1\xae\xc3\xd9+<\xdeX\xdbu\xf3\x9d\xc5\xfeqTY\xec\n u#H\x10\x1d\x8e\x15\xb8\x92lӘ\xae\x86$\xe0<o\xd8݈\xb1E\xec5\x1a\x0e\xd9-\xa0t\xcc#psI\xe7X\x06\xc5\x0e\xe9\x14\xcc\x06js\xbb7l\x91\xbdu^\x80]Ys\x9cў\x93\x83}\x1f\xd7ɀ\x02fL\x9cI\x1d\xb0V\x98\xa3\xa8љԒ\x974\bюt\xe2(\xb4\xb3sQԦ?|e\xa2\xb9,\x85\xc6\x1f\xa6\xb5(8!)R]\xf3\x99Ra\x88\x83\x13\xd2X\x03cT\x15\xfc1\xc8\x03\x802x\x04\xa2\xb9\xc9\xc0\xccB\xae|\xf1\n\xc6\x1d\x80X\xf7\xf8\xe5\xcb\xe3\xcbˁ\x87\x02\xb0G\x00\xa5\xbb\x1eʀ\a\x9e\xd7\xc6\x18\xf6\xb1\xf0\xabab\xa7\xa5_\x176\xc2,VSe\x17\\\x97ݙ\xafx\xa1ǩz\x86\x17\xa0h>\xaf\x96\xa3\xd4}m\x86\xc4YEq\x1c1:K\x93\x909\xcauo^10/\xc1\xc8 \x982\x80\xf9\x84<>\x81#ʠ:\x8d\xe6\xf3bJ\x1dƮصc\x92\xafi\xc5«\xf0z\xb9\xbcZ^\xafVW\xab\xeb\xb2\xd2^\x1b\x12\nx\xb5\xe1T\xa8\xe5n<\xc4.\xa5\xe3IzWe7rWL\xceE\x92\xde\xf9\xf0\xd3])\xb9\xc1\"U(1\xa4DI\xa2\xa4\xc0lH\bO'\xba\xdeIV\x85\xbfN\x8b G\u008b\x12\xe2\x18&\xa7\xc4Ie\x87\x90#\xe2\x10\xd7!GX\xf7H[d\xd1\x17(\x02\xcd\x1f\x11\xc7s`\xf6\fdl\xc0\x19\xcc\xe3\xa0\x17\xf9\xba/\xb1~x\x87\x97\x1e,\xe1\x8b\x06\x87]\x9b\xa5u=\xeb\x05\xe5\xf5\xb6B\n˽Jv\xe0\xeb$|c\xa1PƝ!\x89\xd4C\xba\t\xe2\x06\x10OG\x17#\xb0J˯\xae\x12\xae\x91\xb9\x16\x0e\xe8hB\x06({G-\x84\xac\xd5u\x9b\xd3ǚ\xa4\xdfGɍm\xa0\xb0p>4\xe5\xc2\x7f^\x1fЖ\x8bp1R\x1ak\xea\x04dp\xbf\x8e)|\xb9N\xe0XFKc?J\x12\x9a\xbf\x11\xcbXI\x8e\f\x05\x97\xb9\x10\xdaH\xf99\xa8S\x15SC\x94\xa0ߥy\x1c\xce\xe2tv\x03b\x87\r\xcd9\x15\xef\xe8\x9eE,\x9d8\xed\xa0\x8f&\xd5nC\xba\xfa\xf2\xe5\xe5\xe5\x9bo\xbf]\xad\x1c\xaf\xb3\xa6\xf3(;\x9d\x9cXZ(n\xf5\xf34\xff:\x98-݆\x9d\xa4\xb6'\x86ĺl\xcdF\x81\n\xb87䈜!Y@\xa9\xca\xc6܋\xbdu\x14t\x85\x93\xe4Ҹ\x13\x13\xf6^\x7fFi\xc0\xd9\xeb(\x99\xfd{I\f\xb6\xf8\xf1hL\xb5QV>\xa8o~H\xef\xdc\xc6i}М\xa4ị\xec\xf7\x9a\x93\x02ը\xdcz\xf0\xf7\n\xac\xcdd\xbb\xee;\x92\xc5\xc1\f3\xc7d0M9OW\x83\x83\x86\xe0p\xf6}\x94P\xe7w\x1a\x01\xce8\b\xb1B\x98\xf6 \xa7A}]\xd0\xfa9\x902_,.\xbeL\xe5\x00\x10\xbb\x8c6d\"\xab\xe8EʎA\xb9o\xa3\xc5\x12\rJQHgC\x1b,I\xa5ͯ\x9c^\x9f\xcd@r%\x93G\xa4\x98vaP!%\x7f\xa3_\x93\xd1\xc2+$yG\xe4\xd4r\xa4\xfd\xb6^edB\xb0\x13䘜z\xe43\xa5Q\v\x8a\x8a\x0e\xbcI3\x17\xaa{\xad\xa5Lx.&\an\xa9\xa1\xeb\xe0\xf43\x12F\x1b\xc7\xf3\xe9m\xd5\x13?\bC|}\xe1:\x90\x86w]\xa7\x95K\xd6&\xf8EL\x83\xdch\x01\xd2l^\xc2\xf6s\xbaJ7\xf4\xb0F\x8d\x84\x01p\xb6\x93.(\xbd43\x12\x1b\x1bi\xd6\x11h\xf3Q\t\xa9\xc3ٛ`\xfa{m8d\xa3\x82\n\x99/\x9a\xdbQJ\xbfT\x01Ȇ\x9bf\x02\xa6\x88n\xb8?\xe3y\xfcw\xba\xb3MV]&d\xe7:F#\xf2f\x191\x121\xc2R\u0096ќ\x1f\xa3\x9d)\x99\x05\t\x99R2\vְ\xe7xJ\xf2uB\x02\x02\xef\x83\t<\xdf$8iPq\x16\xc41\rQ\xfbn\x82ϗT\xd4ʂ\x05\xb5\x8eH\x93\xe7\x81P\x1e\x12\xb1?\x1fe\x9cŋ#\xa5)\x1bP|%\xf3ױ\x9dA\xd8p\x1f^\x01҄_\n;'\u05fb\xb0\x96\x86\xd5\a\xea\xc8\x03A\xac\xdaK2\x1e\xa0y\x1c\x0f\xa4Ef\x94&\xaf!\xcd^\xad\x00L&d#-0\xc0\xa8\v!\xe1\x9d\xfcW\x0e\\n\x99'2Z\xe1\xe9M\u05fa\xf3u\x12\x92\x89\xec\xe8\x119\xb5\x03\xb2-N9ǧ\x9f\xdb'\x19Тٓ\a\x8d\xae\xb4qr]\x9d0c\xca\x1ft\x99\xb1\xdazj\\\xe5\xf6Zq\xc0\xa0-\xb6\x9e\xfa\xf0\xf3;i\xd93\xf85\x19x\xed+\xa8Y'A]a\xa0\x84\x90\xc4)\xfb\xe8\x11\x19]\x91_\xf9\xf5\xc8\xe7\x94q\x97\xad\xa7Wѵ',\x97Z\x17\xa6\xbd\xcfwL\xf6X\x0e\x15\x1a\x1f\x92\x88\x1cc7\xbc\x0f\xd9\r\t\xec\x86;\xf6;n\t\x84_\xd8p}\xf4c*\xa6\xafӜ\v\x9d\x1d\x17柺~N&~\xc4\x13\xac\x00\xd9af\xfa\x10\xd92\xcf\xe7\xd0I\x96\xe6\x9c\xe6\xae\xc5\x185\xcd\xf9\xf7\x11\xe3cb\xba\xff\x97\x83\xf4\xba-Q\x8d\xd3g\x17\xb8\x97\x8e\r\xfe'ʔ-\xce\x16\xfe#N\xfe\xa3\x8a\x93-{8ZQ\xeb\x05\x0f\x05\xc1\x85\xf49\xfc\x1c\xa7Q\x8e\x13Wa\xf0\xe9/ǟ\xae\x8e?\r\xdf|\xfaρ\xfa\xf0\x85\x85\xd3o\xda\xeb\x8d>]\x8d>\r\x8f\xabze\xebY\x903\x8a\xa6\xbf\xcc fVq\x00\uee6a\x8d\xf0\xbe\x12\xf3\x06\xf9\"J4\x1b7\x9efcrzRaj\x0e\xbc\xbb\x9e$.\xc8c\xf2\xb9\x92\x16\xd39\x1f\x93\xb3\xf3\x13U\x97\xfa\xd1\xe4\x19l)\xf4\xcc\xef\xf6\x17\x86\xdcY\x1a\xc7A\xc6\xf4'@\x91Ѣ\xbf\x82v\x15]\x93\ty\xa0\xa7XQ\xa4q\x83rPU\xfe\xb6r˲\xce`\xbfx\x96\xab\xb4\xc8ueq\xdb-\xe1AK\xfea\xac3\xac.M\xb8\xd4\t\x86\x9f\xfb\xf2\xa3\xec\x81\xf9&\xf7@\x16+L\x7f?\xb8\x1f\x05<8<\x94\x93(\x18\x92i;p\x12\x00\xcb/\x9f\x19\xc0^\x0er\xeaN!\xad\xc7[\x87j\xb5\xaa9\x90\xbf\xcc\xe2\x05d?\x94R\xfe*\xc8\xdc\x0e\x82\xa3\xf4uc\xe3\x1f\xf7\x16q\x86\xb4P\xbdok\xa5Ik\x9f\xb6\xa6A\xbe,D3\xe7''d$Gkev\xd4\n\xa8\xd4ZE\x89[&\x0e\xc9\x17\xe7^\x9fJ\xc1V\xadtzn\xe9\x1e\xdb,Jɑ\xd61\xf2\x99\x02\xf4H\xd2*\x9f\xa7Y\xf5!\b\x91\x19nٛ\xaa\x81c\x15\xc8q\x1f l\xb3\xf8\xdf\xf0r\xb1\x90\xa9\xe13F\x9b\xda\xe5N\x96,+\x95M\x00q\xac\xber\xb3\xc8\n@l_\x03\xba+\xa7\x01\x83o!\x87\xf5s|\xfb\x03/\xbc\xb1\xa5kK7\xb6Ϸ\x91\xdc\xf4\xf0H0\xd8F\xcc\xf5\x04 W\xc0\xf7\xfc4\x8fP\a!\xc6\xee\xd8Dct\x95\xf1\x9dk_8\xedi{)U5=m/_\x92˹i\xbe'/V\xa9\xaa_\xd5\xc6\axp0:C\">\x80\xd5Bm\x9f:\xc3G\xc4\x19:uTq<\xd3\xf0pf\x1a\xed\x94\x0f\x8c\xb7\x04\xa6\x8d\xf0\xf8\x18\xfev\xf5\xe3\x04[-1\xd5ܢ\x98z?LWA\x94\xb8W\xc6\r\x1e~\x8e{Ml\x83֗\x8c\x06\xb2 +\x87\xbe\xe4\x97\xd5\xfa\xb3~Wn\x85\xa9\x98\xa1\x7f\x8a\x96\xfb\x95Ms\xb2\xf7\x86ֱ\x05\xdb\x0f\x18[\xb0\xfdxc+\xb4\x95\x1fgx\xb6\x9d\x18\xd3\x05M\xc2\xf6M\"\xe4\xc95\xf4\xe3\xf1\xb1\xa8\xebX \x03mx[\x82\x17?j \x85\"\xb3\xe40\xdd\ua958g\x01*8\x9a\x1eP\x85\xc39۶\x92#u\xfc\xad/7\x8f\xf6\xa4\x18\x04\x8f.\xd2(K7\xe0A\x94\xa4\xdb\xea\x16m\x16\xaek\x10\xe5i\xaa\xe2\x06$\xed\f/\x9f\x8b\x7fؖ\xfa\x8eڟ\x069+\x9eQcm\xa9\xae\x90\x88\xd7\xd3C\xc1a\xd8\r\xeb\x8d\xfe#\xfc\xd7\xe8[Ɇy\xdd\x0e\v,\r\b\xda\xe3V; \x14\xbb\xdb\xebh\t\xf8\xdaH=\x87\x1bd\xbb\x91S\x10\xfa\x0f\xee`iP@\x8e\xef\xd1\xff4q\x9dU\xbaf\xa8(\xf1a\xc6ʯ\xb7\xdbz\xf6\xae\x7f\x7f\xd5=\"6\x83@\x123_\xaat\x06-\x14\x9dڋ\xfc\xdfښB\x19\x1elD\a\x9dq\xa1i\v\x9c.\x88*\xbf\xd9)\x97z\xa3q\x16y\xbaF}xt\xdd0&m\xab\x18\x85}\xcb?\f\xb2,\u07b5\x89Y\x0fz\U000ac39e\xe2\x9b\xf3\xc1\x9fA\x96\x10\x85\xdeEk\x05q}\xe9\x02K\x84\xa7I\x96\xc6`T\xbap\x9d$\x05\x1c\x0f\x87\x84z\x17\x9d5\xbb\x04ӤU8MP\xdc\xe6\x80$hH\xa6i\xb8s<\xa9|\x04\x15%\xf5\xd3\xf9\x1c\xdf\x02\xf8\xbaC\xa6\xfe'S\xbf;A\x93\xe6\xc5\xc1\x94\xc6%Ճ\xbbI\x93\xcc\xe1˛\x92\x0f\xa2[~\x1c$\xb3e\x8a.\xa6\xf1\xa0R\x88\xd3I\xf1\x11\u0097s\xec\x9f\xd3UY \x84\xdd\xe6\xf8gj\xda\xce\xe9rU\xa1\xaf\x00qa'\xf8\xe7\x9eJ\x9bL\xa3\x17[\xb4\xc7\xde.\xf8\x8c\xfbN!\xa3Y1\x7f\x82\xc3\xe98(\xee7\xe2\xd3\xee\x01\xd7\xe8\xf3\xa9q]\n\"}'X\xf1vb\xd9l\xa6\xec\xb8R\xccm3\x1fےI\xc1\xffFh\x81\xe4\x02C\a\x95]\xbe\x8c\x18x\x92\xb0 \xb5\xc2\xe848\x9a\xad\xe7\xf5\x7f\xccC4\xf5EÀt\xbe\xe2?%\x11Ƿ\xa6\x0e (\xd0j\xe7%\xfc\xf77\xf8\xef\r\xfc\xf7#\xfc\xf7\xb5s\xadؒ&\xf3\x15w\xd9\x10]\x92\f\t[\xcf\xe7\xd1vHҌ\x97\x82,\xf8M&\xe2\xcf\xfb\xf7\xa5\x04\v\x1aM\x84\x01\x06\xa3\xdf\xc4i\xc0݂!\x02J\x16\xb1\x1f\x82\x1f\xdc\x04_:I[m&,\xb5\xc5cm\xc7 \xf3c\xf5\x87~Ц\x9f\xa7\xeb$\xac\x84\xf2Iq?\xc7t7Q\xda|\x90x\r\x908 \xf2\x8c8'\x04N\x11\xf9=&Ήc\xe8\xec\xfb\xf7\xe4Aľ\x89\x92\x88S7\xf1\x1a\xe0\x9cc\xc5f4(z\x026\xb3j?\x02\xf2\xb4\xe6\x89\a'k\xbd\x9aҼ\xa83\x8f\xd34\x17&\xb7@\xc6\x03\x8f\x8cH\xf9\x05\x8b\xa1\xe2F@F\xb2Z\x96\u07b9b\xa9\x14(\x02\xb2W{N_`ĕȾn\b&\xc5TLH\xbd`9M\rt+\x87\x0e\xc3\b|\x9e~\x13mi\xe8\x9ekc\x7fBN\xe9\U00079dbc\xb2\xb4\xc9\xdf\x02\xce\f\x85\x8bxB\x9e\x90\x13X\xa9c\a\xd6\xc7\xd1$\xb0P䈸G\xb9\xa7\xf4n\xdf|J\x0f\xe8\xdc\xef\x1d\xbdI\xe8\\l\x06x\x9d3$\xb0\x8d\xde\xed\r/\xdf\xd5\x06\xa7;N\xd9\xc7h\xf1\xec\x8b!q\xbe\x82&\tbv\xe1\xf6\xb6\xab\xfd\x88\x7f\xbc槽\x9b\xd7t\v\xd2\xf5=\xe8\x06\xef\xa2$L\xef\x80\xcc\x00\xe8o\x8a\aL\x8a\xa2P\x94\x18\x02\xd6\xe9/\xe5~\x17y\xfe\x97\xed\xe2|\xa3\xb2\r\x0eA\xa3\xfeL4\xdc\xc8XЄ\xe6\x01OsC\xde4_\xb3%jo!s\x8a\xdaZS\x91\xafaƝ\xc9\x148\x11=\x9b\xe2\v\xb7\xaf\xa0И8\xff_-w\x15l\r\xad\xae\xa2Ī统)\x91*\xe4<\xc2*\xbe\x98\x0f \x99\xa7\xe7'M\x1b\xbd\x0f\x12_\xaa\xa2\xcbfΝ9y\xa7\xc8\x1e\x85\xd81\x8e\x12\xb4\xfa*$\x8f\x85,\xb7qZw\x89.\r\xa5\x8dB\xcav\xb1$v\xb2E\xb8\xb9\xab\t7\x01\x85A\xfa\x10\xcdn\x98[\n\xb2OO\x86\xc5\xe4\x8e\xc8ى'\nH\x96\xa2\xdaUf\xabɋOL\xf6IbAK<6qBh;\xe3\x049\r\x9c\xb1\xd5TN\x19\x97\xc1³Bx\x1a\xdc4\xb3\xac>~\x9b\xd0\xe1\xcbmU\xbc\xa2\xde\x00\xf6LU\t?]\xcf\xdfVB\xe4\xc4u0\x15\xe4\x00\xf0\x97\xd6\xefhА\xbf\xeb\xe2\xbf%Y\x11\xcb炏\xb7\x16\x83\x81\x12\xec\xb6'\xd8m\x01\x16\xfd\xcf\x19\x1e\x8e\xee\rk-\\\xb9\xf5\x94m\x1be\xd8\x1a\x9f-\xddP\x1a7)\x99@k\xbf\xbb\xd0[:x`5\xc1\x1a$)\xdehgq\x94\xfd\xa8:?\x8dBh\x1d\x92\x1d\xcbM\xa6\x1c\xbd\xf8ah\xd6\"Г\xf5\xb3\x14#\xea\x1c\xa3\xd1\x16J?\x838V\x04jQv\x8c\xeeX\x87\xc4\x01_#\x7f\x86\x94\xc6\xe8z\x89\xf3?X\x8c\xdf\xd1\xcaN\xb6b2\xcc\xe6K\x98yqo\xb4\n5\x1b\xd9\xcdq\x88\xfdV\xab\xa7\xe2\x8f\\\x1b\xc6w1u\x9d4\vf\x11ߩw?\xed\"\xa8\xe5\xd4W\xb2\x86\xc4\nҕ\xf0g\xeb\x9c\t\x19\x80\\FG\x97v\xed`Fޤ\x8bELM\x14\x15\xefuo\xba\xf6\x1aJ\x9e\rGH\x9c\xceJ1\xf5\x9b4k+\x0fe\x8b>\xcf\xe1\xb2\x05]\x16\xe7Å\x99\xf0\xc9\xc77\xbd\xa0\x97\x15\x1aM \xb3\xe5X\x87~\xf0\xb8E\xb5r\xf6c\x1a\xe0\xe4OS\xbe4\xb52K\xe34\x97\x8d\xe0i\x9c\xe6!<\xb9\xae\x8e\xf4\x06\xd5t\xfeL\xbf8\rNg\xceА\xf5\xf9_\xfeB\xa7_\x1a\xb3\xbe\b\x83\xf9\x17\x811\xeb\xaf_~A\x83ύY\xf3\xf9_\xe6''Ƭ\xe0\xf1\xf9\xe33s[\xf3\xbf|y:\x9d\x9b\xdb\xc2\x7f\xb5,\x13\xbb\x82҇\xad\xeeCS\xcbۙ\xf3\xd28\xb4\xd4Z\x82\xdf2+\x99+\xf70\x16\xabvh\x93\xfc%iB\xab\xfc0bY\x1c\xec\xaa\fK\xc3?\x02\x1c2\x11\x1f\xea15\xaey\x1c\x06\x849\xb7AyEgf \x1aɯ\xf9Q\xb6\xc0\x92;\xa8\tK\x8a\x12\x8b\xbd\x92&\xfc\x98aL;✞e[\x13\xc0y:[\xb3\xee\xb9\xc5b\xdds\xab\xc3\xc7J%\xd482\x94hȻ\\\x9b\xfd]&M\xb9\x14\xe1V\x93U+q/㍧\x05e\xfeN\xe4\x9b\x1c\xd6T\xa6\x80&\x8f?ſ0\x0f\xee\xbeGz\xe1\x1e('CF\x1b(\xf7\xff\xa1yj\xf6\xa9PN\x89Bፓ\xa2\xc2yP~\x18\xd8\xd7<\xb8\xeb\xe6I\xab1\x91\ty\xeb\xf3e\x9er\x1e\xd3\x0e\xbdB\xe1\xfe\x91UZM]v\vj\xc3B|[Ml/\xa5P\x19.\xe1\x87`\xd5\xd3\x00\b\xbb\xd2\x10\x0f\x1b\x15\xc1E\u05ec@\xb6\x11w\x8b\xf7@6C\x8dm\xd4\x10\xbf\n\f4\x94ǃR(y\x85\x1bWG\xbc\xa5\x86\x0fw\x1b\xd9T\xc7\x1cZ\x88\x14?\xa3#\xe4\xb4ͅWQr\x19\xa1y;\xb2\x16%\xcfe-\xfd\x03\xba\x8d\\E\xc9\v8Ϭ\xc5\xfe\x89e~\xb1\xce\x15\xe8\x88{\xac\xa8л\xc1\xf6\x9c\u008b\x7f\xee\x86\xfe%b\x83\xcd\xda\x1cE\xa0\xe1\x16\xa4\x96\xa2h\x87\xf5\x1c\xd6\xc1\x06\xb4\xe2\xf0\x98̢ٱ\xf6\x93j\x1c\x84\x85\xdc\xe8\xb4\t\x9b\xbc\x8a\xc2\xed\xb5}4\x19o\xeb;-\xd8\xe8\x88c\xd4B\xa4R-\n+*\x10Jl\x11rD\x9c\x12\xab\xa4\x14N\x00\xe8x\x11\x91\xf1J\x8b\xe0f\xdcz\x9dk\xd6\x03J\xba+\xeb\x9d^wV\b\v)3\xbb\u0379[\n\x8e\xa1\aǒx\x0f\xc5\xdbb%oW\xe4\xed \xaf\xa5\r1\xc1!yRl\x84.\x9de\xb5_2\x1e^t\x15\xfd'\x96\xdbv\x96\xfb\x05\xcb\xed:\xcb\xe1\xa2MH}\xf5,GS\xad\xf2\vɀ\"#*1\xc0;\xf4a\xcfޤ\xe7*X`\x00\xec\x1cB\xa9;\xbab\"ؒ\x85鼕\xc3\xe4\x97\xd7q\x98a\x9b-Xű\x95\\\x90\f\xb9!\xa7\xccV\a/\x19\xe2\xe5\xb5X\x19\xaf?\x00\xa4k\xec\x95\x14+bg\x9fJ\n<\"g\xb6\xf2_\xa1(NT\xf8\x85<UDgm]\xac\xae\x9aE\x8b\xcf\xc8\xf19\x19\x93sʹ\xa4\x80\xfe\x8c\x1c\x7fI\xc6\xe4\xb4\xcc\xd5\x15\xcc\x15\fT5\x931q\x84$\xd82\xcaDĂ\xab\xba\x03\t\xb6\xd3q:E7'!z\xc9\xfe\xea\xabt\xeb\xda&\x1f\x98cedө\xbf\x95\x0f\x80\xcb\x11M\xa7\xfeNM\xab\xecd\xa6~)X8\xab߬\xa7S\xbf8\t\xcflg8\x99\b\xea\xb25\xb3\x83\xdbb-m\xd8o\xb8\xb4\x98\xf7\x96`\x87\v#.\xc1\x0e\x17\xa3>u\x86d[~\x9d\xa9_\xbbSM\xaepf\x13\b\x15\x1d\xa6[N\x13\xfeZ<\xf1\xb2\x1f\xc4h%]\x15\xb5\x1fY\xa2\x10<\xeaz0!=\x80\x93*R\xce1\x12\xb4\x12\xc0EWy\xb7*~\x19\xcd\xe7\x85C\x99\xfe\x04\xad\x92\x17\xe0Vf\xdd\xec\xf9\x90\x9c\xd7\xcf9\xfd5\x02\xf0\xab\xb6G\bEġ\xba\x1b\x04\xa9Kj\x83\xdb\xceWK\x8azg6\x8b\xde\x0fIN\xe1b7$J|\xcd\xe2ߝ?\x8d\x84\xbcJ\xde\xfdڛj\xb35\xdaۮk\x02\xb6\x19^_\xc3n\xbck\x15\x85\x9fL\x9aQ4\xf4\xc9\xe8\xb3\xc3>\xd0N\\r\xf2=\xac\xc1Qɢ[~[\x9e}\xc8\xfb\x80eh\xe5}\xb5\xa0R\xa7}\x86\x89wu\xb3\xf1\xb7Q{\xc1\x8c\xc6)\xb6Q\t\xadNq|=6q\x81\xfd\xae\x94v\x81\x8e`\xff\x05\x87-~k\xee\x0e۔\x0ea\xe3^\xbf\xf7pu۟\am\xac\x0f\x83\xea>p7hl\xb1)\xae\x0e\x93\x8f\x82\x98\xc5~\xe9\xe5\xbbB̮\xad\xbf\xdd\xe2\x89\xc3\xde2m\x85ݼ\x16\xccO\xfd'\x8d\xdf\x0f\xbc\xbc\x13\x83\xf5\xfce\xadr_\xf3\xf2\x99Q\x94\xd3\xc6V\x8aty\x8bi5\x9a\xff\xb0a\x05\xdb?Ұ,\xf2\xac\a\xb0\x0fm}\x92{T\"A\x0f'Τ\xf1\xe0Bֵ\xb0W\xbb\x15\xe2\xd6\xfd\xb0\xe8\x830\xa8\x9cf\xdbm\xaa\xafY\xa7\x18F\xb0%\x93{b\xcd\aa\xccG\x1d\x86\xf46\xe7\xe2p\x8eqq<2\"\xe7'\x16\xd4\tѻ\x9fE\n*`\xf5:\xb1\xa0!r<\xc1:\x17\x86\xdc`K\x8el\xb9БR\xbci\xeb\f\x16\x82F\x9e\xda\xc9u\xd9\x11#\xbfN\xacLe\x19\xf6\v\xfb\xf9\xa4\xab\x81`{H\x03f\xa2\xbc\xab\x882\xf4x\x88`\xaf\xbb\x9c\xb8\xff\x80fr\x12;aim\x1d\x95\xe0A\xd2SZͬ\xa2\xfe.\xff,-\x06\xdb\xce\x16O\xd5\x16\x83m/\xdcѨ\xcd\xceJm\xa2y\xc3^\x04\xa3\xbb\xa3iH\x9b\x1f%\x7fw\"-\\\xdc\x13\xcf\xebˀ}\xc8c\x1c\xad\xfe\xce^\x7f\xd7R\xdfd>>@\xf1\xf6`H\x06;\xb4<\x1f\x14\xe9\xa5l\x05\xf2\xf2\x94\x03Kt\xfc\xd7\x13\xaf,\xb0\x83\xc8A\x9a\x1eZ\xa4o!ݭD\x13^\x91\x01\xe1\xb9\xc8\xe0\x94\xae\x06\xd2\x14\xfc\xad\xbfN\xa2[\x854\x1e\xfc\xf6\x16l=M\xa4\xcc\xf3\x7fK\xa3\xc4\x1d\\\x90\x81MJ.\xa3\xbb\xa3\xd5/_2\xdd\x06_\\\xb4\xffM*\x88\x03,\x97H\x1f\xeb%\xf8'G\xd7\x1eL\xb7\f\x13ړ\x8d!\xbd\x05w\xd5p\xebJ\x149\xb5\xba\xa0\xecwlߞg1\xdd\"]\xe6[\xff\x03\xa6\xb7\x0fA*\x87Х\xb5*\nv\x06`6\x8c\x03\xba#\xb9\x17\xaf兄*\xc7\xc5p\x00:a\xa3\x01\xa3B\xfa\x15\xe4},\xb2\xe4\xfd\xda\x15\\l\xf9b\xad\xe2X=\xcf*\rV_\x93 \xa5\x96F>\x82\xba\xe2\x87W\x0f\xa3l5\xbf\xaa\x1es\xa0\x9dA\x97\\\x85\x94\xe2\xed\xa6\xbd\xc1\x14|\xc6:\xe6I\xacZY\xf3\x0fjĤ\x91'\x867|꣔\xdesH|!\xa6\xabv\x7f\xb9A\xc0\xf6d\xaeS\x85\xe3\xcaZ\xca\xf1O\xcfΕZ\xcb \xa3\xc79MB\x8a\x8f/\x86ę\xe5\x11˾\x0e\x17f\xedl\x8f\v\x8b]=o{\xb4B\xca\xf8\x90\x16yh\x95\xd9\x10cVY\x97\r\xf6\xb5\\<i\xc4iա\v\x00d\"\nʹ5\xed_\xa5\x87\"\xd0\x0f\x05Cy\x91j~\xebSv\xbbY\xfe\xd4^\xfeR0\xf7\x9a\xafoἶ\xaa+\xfdQk\xc9'\xd7\xc6G\xd0\xed\x16\x13\x15\xe7\xa6ؔ\xa3\xd3\xc3\x03E\xcf\x02He\xda\xdeGĭ\xd4\x11\x93\xd4!\xae\xeez\x9b\xd9e\xfe\xb1\x9a\xafZ\\\xe9\x1b\xb1\xa7X\xb66\xb9\x98\\\x86P\xba\x16.\x9cAAk\xf6p\xb5\xfd\xe3\xc1}\xbd\xcd\xf2?b4\xb8\x1e\x81\xddD]\x9fn\xb3\xfc\xa3\awk\xf4Ёf\x8a8jN\xb0Y\xb8\xb7\xee \x003\xaf\x80\xd3q\xca\xfcY\xb6~\xb7L\x19\x9f|\x86\xf3\xfd\xd9\x1e\xd8\xe8s\xe4\xcb\a\x9eG\x9e\x92/O\x1c\xafO\xc05\x19\xa6V\x8e\x0e\xbf\u07bf/\xbb\xfd\xb0r;V\x15¯f!\xe8\xb2%f[.c\xe0\x98sy\x00\x1a>GF\\qL\xb1\xe3\xcb\bW\xb5\xb87\xd0\xe6\xb3[[T\xb6r\xa6\x8f\x88#\x9c\xa5\xb5\x87o\x83\"\xa20\f\xb1\xa30\x17\xce\xf4\xbb\x83\xbc\x99\x82\xae\xf92FM#\xd6Ou\x03\xc1b\xff%>/\xcc\xc0\xde\xca8\x9eX\xf4M\x19œ\xa8\x81\xb3\xdf`\x91Ie\xa9d\x8e\xdf\xc36\x8b\xb7\xeb<\x86u\x10\x13\xbb\x807O#\x98\x02\xc4Au*\xa1\xf0\xb3$\xbd\x9b8\x85\x81\x85x\xa6\a\x0f\xff\xfd$\xbd+\xad\x8b<c\x98\x1f\x84\x8cF\xf5/\x80'p\xd5\xf9\xf0L\x11\x97\x1aHTn\xaa\x1e\xf1\xdfL\xb1\xdf.z\x81n\x8f}\xa4\xc7,\xfaD\xbdX\xf4\tTغ\xe1\x95\r\xa5\xee\xe1f%@\xd8*|V\xb1yk\xc1\xb3\x9a\xd5\x00u\xabj\xc5v\xaeWC\x82\xea\xebA\xd7\xf65\x1fw\xc5\x1a\xe6\xb4\x11\xff\xbaXf5\x18o\xdd!H^s43\x04\xb3+\xd3\xf3,\x19\xe9\xf5\xaaƦ\xd5\xe1\x85\xfe\xcf\"bw\x05s\x13\xc4C\u0099\xe9\xc8C\xc4\xcb\xe0\xd1\xcc\xd5\x11g\x18\xed\xbb\xebaK\xb9\xad:\xd4:&\xddI\xf3\fO\x84\xe5\x8e\xf3\xce\xe9\x1a\xd6\xdf\xc0K\x84:,\x1e,6C\u0083ōM\xc3\x03\xc0\xd5\x00\x8c\x16^'A\xa3\xa1\tq\x86N\x9f\x1bbQ\x1cZ.C7@g\xda\xe7\xadle\xef\x18\xde\xd8\x14$\xaf\xd9\xc3K|\xaa\x88\x82\x96F\x1e܃\xc7\b\xb9\xcd' \xa2!Zӑ\x89l\xa9-$\xa9(\xff\xbb\x87\xd2\xd3#K\x9a\x19)\x98\x9a7\xc1\xe25\x92\x11\x03\x15*SD\xa12]\x8b\xc4#\xf2\xe0q\xab[\x81\xfc\xb9\x1b\xe0\xcfVp?\xab\xc0^\x05\x9c\xfe#\x83J\xac\x03\xa6R\xd2\fZ)\xa0\xb6\x00'\xe0\xae\x036\x96qo\xd5I\x06KK?X,r\xba\x10\x12\\r\v7\x81[5\rX\x18\xb6^9\x17z\xad\x15\xe5y4\xabj\xc8o\x85\xe1)\x8b\xe6\x82y\x92\x05sI\x7fk\xd6\xd8e\xc9j\xa2\x94\nE\"\x10`z\xa7ς\x8aUX\xe5\xc1\xad\x0f\x17\xdcM\x00/\x93\xeb85\x1a\x91i0\xbb\x91\xbet)\xa9J\xa2\x13yr۠d\x0fʮ\x99\xa8\x03f*@&\xc4Y\x04\xeb\x05u:\x03\xcf\x02\xec\xfa\xa8\xfdY\xbaNjqC[Z\x92\xa5\x9d{\x04\xb95@\x83~8\xed\xd7\x17\v\xc0&0\xb9vJ\x1a`F\xb0\xe6\xa9cb^\x04\x00e\xd1CfD\xa5\x90I&\xbb,Vg\xb3ˢ<X(\xf0\xf0KbO\xb1۵\xe2\x8c\xf2K\xe6z\x86\xd4r\b\xba\xcb\x02\xdcN~\x96\xa7<\x05\x06S\x00h\r\xa5\xa9\x8e\x00\xfca\x88O\xcf8\x97\xe9]\u0082U\x86/\x91\xd5z\x85\xe3$\x99vq\xc8\xfa\xa80\x1d\xc7\x127\xd44,ua\xedq\xe6\x85.Ά\xd1/U\xfd\x93J\x16\xba\xe9@mǫ\x8bT(\x00j\bX\x9fS\x14\xfc\x0f\x00\xea`l\xde\t\xb23fOQ\x06y\xb7\x80(\xc7v/\xa0\xb6\x89:\xb8\xc2K\x9cw\xa3~\xafQ%\xa7\x8c\U0009f953\xfa\xd3\x03F\x8a4\xadc\x9c\x86\xf75\x06\x98{\x83+\x06D:\xedĤ\xb7kʺ\x8etY\xcam\x1ciE\x8c\x03\xe7ty\\\x85\xe8+\xb3\xab\xebc\xc1*\x8bNIx\n\xf6g\xf9:i\xc7\xfa\xb7\x00Q\xe2}Ռ\x16\xbd\xe0D\x84.P\x9b.\x9c\xd6b\xac\x02\U000ae56fU\xeb]E\xd7}≡\x96\x0e\x9a~[\xablW\xa1I\xef<\x9bV\xdd\xd9@x\xed\x19\xb4\xc7\xcex\xb0\xe9\xd2Մ4\xa6\x9c\x92\xdb\xe8\xea\xe6\xfa>\xf11Z\x14P\xa2\x9f\xd34\x8di\x90\xfc\xf1;*\x02\xd6v\xf4\xf3\x1fX\bxe\x88\xca\xd9\xc7X\xeew\xee\x7f\x8f8\x12*\xcf*\xb6\x95\xba\xbf\xf1\xfe\xf0J\x04\x1e\xef\x10\x86\xa2[\x99å\xa1\x96\x00\x15\x1d\x92\xd1!\xa9\x87\xb0($l%C\x8cdc\xc0\xd6(E\\E\t\xfe\t@u\x0f\xa2G\xf8\x13\xd2\r\xfc\xf9W\xb4*K\xad\x8a\x82Q\x02e\xaf5\x11H\xc8\xeaС\xe8\xc7l\x01\b\xf4۴<P\xaf\x06\xc0\x88\r\x86\x05Y\x1fV'\xd9P\x1e\x93z\xfdY\x90<_\xf3T\xf1\\\xdf[t\xfc[%\x11\xfd\x8d\x15\x81.Q\a!\x12\xa7\x8f\xbfP\x91\xf8\xb7\x9a \x19\xb2뎢\xf2\xf2p\xf8\x8d<#\xff\xeb\xf5?~\xf0\xd1\xfd\x98\xfb\x9bGƂ[(0N\x19C\x04\xb1g\nOe߁\x84\xb2\xec\xf52\x00\x97 h\x8azR\x93\xb9.6\xea1!\x931\xbeH\xf8\x16n\xf7\x86\\\xa0\xb6\xbb\xb7\x99\x92\xd1\x14\xe8\x88sF\xd2e\x15Aok.\xf4t\x98\xc2\xd9?\x8c\xb0\xb8̙ej\xf2\xf8+\xdaa\x95B\xe6a\xa1\xfa\t\x95|\x9a\x84Z.`\a2\xe3r\x11\xe4\xf7\x83\tq\xf0\x8cׅϹ\xd8\xc6U\xf12aB\x1c`e\x9cƢ\xa3%\\c\x90\xc2Z\xec\xa8*SW\n\x149\xc1\xd6T;ت\xb5\x83\xadZ[\x8d\xd7\xfav\x15d\x9a\x18e\xc0\x06c\xf8\xaf\x12\x9e\fV\x90\xb2RS\x96\x90\xb2TSBH\tՔ;H\xb9SS\x12Hy\xa9\xa6\xec e7\xa8\x87\xea\x8f\xd8+\x1a\x93\t\x19\xfd_\xf7\xd7\xf0\xc8s\x7f\xbd\xf3\x80sy8\xba\xa8\xb3<\xf1\x9b\xf4\xf9\x94\xb9+k\xb4\x8c\"\f2D\x18\xe2y0\xe3\xae\xe2\x9co\x05\xbaġ6\x17W\xab\xab\xb3\xeb\xebR\x89\xa5m\xb7\xb2\xdd\xe7S\xf6&}Ec\xdd\x7f\xd8h\xf4C\xca\t0\x053\x8e\x1b\v\xc4\xc3\xe9\x9c\xf0%E\x97\x872\x9c\x88O\xbeIsB\xb7x\xf9\x18\x92\xdf\u058c\x93\xc1\xd9\xc9\xe9\x17\x03r\x17\xc51\x99R\x90*F\xa1\xc6X\xf1Z\b\x98a\xf1%MLd\f\x98*rgCHō#y}\x17d\xf8\xba\x97\xd5\xefg\x0f\x1aw1\xf3\xbc\xea\x13\xa5\x9f\x87E\xf0\x1a\\O\x9fn\xe9L{\x17\x03ͬ,\xad(Kk\x82,K)\va\x90\xbf\xbdFn\x0e\x86g\xbf\x8aֈD9\x1fj\xba\xd7\x10\xfe\v\x8aQ/L\x93P\x97y\xcb\xf4\xe7a\xf8&\x98vu\xa1 \xc9:\x91k\x04t\xa8e\xa3,\xba\xa4\x81\xc6\xe6\x19\x15\xa1\xc6\xec\x91Tj=\x88LP\xfeF\xf9\x9b`\xf1\xf7\xafv/\vY\x97\x02\f*\x1a\x00\xe2\x89q\x85\xb9\x05\xa9\x16\xd2@\rA\nxu\xea\x8eդ(MG\x9a\a\"\xd1\"!\x95\xe7\xf3\x95(dvy\\\x17\xb6+\x9a\xa3\x9a\xba\x10\xce5\xd4i\xc96{h\xef\xcaK\x87mP\x06\xdfTB>c\x12\xc8\xc0\xbfB\xa2\xf8V\x96\xab\x87\xcc1ݰ\x14\x9d\x83\xf1fU\x9e\x04R\x1bh\xbc\x10i\r_\xb5\xf8\x8fFy#\n\x96l\\x\x05A\x94\xbb\n\xaf\xfbZ\xfd>\x90\xe5\xbbA;\xbd4\x12\x02\x97\x7ff\xa8=B\xdcm\x93\x19\xd6\x19\x16\xd1\xfd\x86\xc2\xe8\x86ZC\x00m\x82\xb8\xb5\xeb7tw-\"\xa5\x1cr\xbb\x10\xeb#1\x02\xfe\\\xd4e\xbb/\x83\x1bJ\xd8:\xa7\x04\xf4\xfe$b$\x88\xef\x82\x1d\xc3#i\x1e\xe5\x8cC=ߨfP\xb8\xbaj\a\xabw/\\닞u\xfb\xc6\n\x12\xeeR\x81W\x82\x1e;\x1df\x81ǧ\xbd\xe3\xb9\x03\xe0io\xc0\xa7\xfd^VɘF\xb5xFm\x91\xf1zh\x9fIS\x03\xed\xfc\x84\xc6I\x84\xa7dN\xf9l)i\x11\x13\xaf\xf6k\xfai\xb5\xbd:1[Q\x1e\xc0^\x1f-(\x7f&\x80L\xeeG۪K\x90A\xd0\xd5PF֬\x9e-\xfbAn\x15\xbf8a&\xb2_ \x1f\x86tᵠ\x90\xcd\xdbe3E\xcf̔\xaa}CY\xcf\x10\x99ҵ\x96\x1d+Is\xc3J\xed\xab+\x81\xf1\xf0o\b74\xbeB\xe7Q\r+\x9eG3\xe7\x00\xa3\x13\x89[\xf2T\xb8\xb7\xd5Do\x9c\xdd׃\xe0\x95\xd4\xf9\xa6\xa0\xcej+\xcd\xe3y\x83\xc73\xaa\x96\xf1W\x1b\x1f\xd1\x17\xc5!M\xd0,3~\xa8\x9c\xcd\xd5͵6Y\xff\xee\x8d^c\xe9\x17\x94\x9bD\xc1\x95\x9c\xa0!\x13P\xb2KFX\xe5\x7f\x9b\xa5\x04\xff[\xb1\xbdvs\r}1T\"\x90\x99\x82a>\xc8|3oGz[H\xdcjB\x81̳2[\xe2촜\xa76N\xac>\xbe\x06+`\x11B#m\x03Bvc\xa3[\x92+Bd\xda\x1cJ\xb6jB\x14q-\xb8\xf5Z\xcd\x16r\x15\a\xb4\x8bS\xa1@\xb7\v\xfa\x01YUD\xbb\x0f\x02\xdc\x1a\xb6\xb7zk\xd4xϦM̓\xd5\xfdp\xa4\x9b\x9d\xbb\xa1;\xb4\x93\xb1\xad\xe3\xea\x8a\a\x8b\xeb\x0f\x7f\x03-\xe5\xd1y\xa5\x92\x10\xa4\n\xf1\x00\x9ahc$\xaa߹P̸\xad\xf6[\xd3\xc7_\x14\xa6b(#\x14\xba\x8bh\xbess\xaf\xdd`LȻ*\xdb/\xf1M\x9e\x91u\x12\xd2y\x94А\x8c\vQX+ )\t\xab \xc9\x04p\x01\x83\x9212\xae`z\xb5K\"J\xc3\x1a\xaf\xff*Y\x99G\x9e)\x923ű>\x19\xa3\x95څA;k\x05\x17lUp\xc1\xb6\r\\s\x9c\xab(\x11N{Zgc\x15`L\x8e`\xdb\xd3~\xae\xa2\xdf\xcd}\x87ۡN\x02\x9a\xfeʚ\x06\xbd0\x17\xa5|\xb3\xbe\xbe\xce#\xf1\x139ć\xae\xf3g|\x8f\xe1x\x85_\r%\"\x81z\xf8\xb8I\xfaJ\xd8Fj\xe7\xb6\xd4$\x00g5\xa3\xb1\xab\xea<j\"\xa1\aF\x00\xc4hz)\v:6\xe9\x13\x8c@*dի\xa1\xe9\xbel\x9e?\xe3\xd5\x19zY+~\x15]k6\x1f\x13i\xdfa\xa2\x12e\xa7\x8e&r\x92\xf3\xc2\xd08\xea\xb68\xe9\xbd\x1d\x9cG\xab(\xe9\xb0H\xd6\xf7\x8a\xba\xa4\am\x15\xe7\xd1*\xd8v5\x15l[\x9a2\xdbn\x9b8>Ĝg\xd0\x16\x105[\xa3FJ'\x96\xcc\xc3g[\x12͏\x94\xd5\xc0i=\xc2=ٓM4\xdai\xbf\xa6͘\xb4\x88\xd7Zic\xdcvQ\xe0.\xc8\v\xfc\xfe!%\xaf4\x1bw\xf3ib1kj\xc2k\x85\xd2Ӟ\x9c\xb4\x19A\xdb\x18[\x83\r\xe7ZS\x96\x05S\xf6S\x1e\xd7\xd9m(\xb3\x06\xd9=\xe3\xb9{2$k!\xa4\xfc\xc7\xdcu\x9e9\xb8\x8e\xce3\xa7^\xe5hR\xa1F\xa59\xebZsc\xff\x85}\xfb\xfa\x83\x19\xfb\x1a\xeb\xde9\x85\x9d\xb6\xe4\xc4lO\xae\xe2\x9b~ʚ\xf0M%\xc2dR\xd2\xe8\x1e\x11\xdd`;\x1a\x1c@\x91¯U\xd3!\xbf-h\x93v\x89\xb9\xf8\xa4\x00\x8e\xfc\x84\xa7\xbe\x0fj\x84X\xf91\xcd\xd6Y[\x9c\x17\xf5\xe4\x13j\x991\x84z\x1a\xb6\x87:Y\xe7\xb15P\b\xa7\xab,\x86\xe74\xc4y2]s\x9e&\x04\x9f\xccN\x06S\x9e\x90)O\x8e\xa5>f\x80{\xe7\x18\xc2\xc2M\x060Q2!\x8b\x83\x19:\xea\x9a\fD<\x8c\xc1S\xba\x9a\xd2\xf0\xc9H\x80{\xea|hT\x12\xd9-\b\xb2\xe7\x88ߎ\xa8\xd3\xe6\x12\f\xa1\x01\xb2\xab\xb3\xb9έR\x9a\a\x96<r\xb0\x0f .\x1cJ;O\xa2$[s\f\x8b5\x19@․\xc9\v\xf08?\x19H\xe3C|\x06\xea]\fHN\x830M\xe2\xdddP\xfc\x1a\x88(ӓ\xc1\xa3\x98_\x04d\x99\xd3\xf9\xe4\xd1\xed:\xe5\x17@\x03`\x13\xc3\v\x1dLx\xb4\xe0\x17P*Z-\b\xcbg\x86b~\x96,&Y\xb2\xd0ˏ\x02\xf85xj\x100\x8bi\xf6\xb34\x83\xf7\xb0\xaeE,\x95&\x9c&|\x8c#\xee|\xcfo\xb4\"\xb1G\x1b\xc2حߖa\xeb{\xec\x88\x02\x93\x7fBl\x1feAΣ f#\x8c\x85)\xe3\xc9\xfb\x80\xbeN\xa3u\x9bm\x8al\xff\x0f\xf5VO\xc6d\x7f\xa7\x88\xd8\xea^\xb7\xe4\t\x01\xe2{\x15\xa5\xeb\xd7Ī\x98vݯ\xef\x02h\xf0j\xd3ԓ\xe8t\xaev^c\xa5\xaa\x01\xbdvũgA\x1e\xacXM\f\x7f\x83\x91\x1d[\x1c^ȥw\x82\x1b\x1b\xaf\xb4\xf1J)\x1f\xba\xbbp\x1e9\x96\xb7s\fC\xd9\"\xf3%:ӇQ\x12\x8b\x05\xbb\x97\x86o\x11\xbf\xea*\xacNy\xf1\x90\x047Fq\x11\xce\\ps}OqQ\x11\x01\xb86\x7f\xc6\xc3r\x89\xe1iu=\xfc\xd2\x14P\xbb\xbe\x97\xeb\xa3ۘ\x02^/-\xd1\"\xa3\xb9\x8c\x12I\x9e(\xddm\xf7E\xbd,\xe2\xec\x92IU\xe7\n\xa1\\c\x87{kM\xba\xe1+\x93\xe1\x1d*\xb4\xaa!\x05\xac\xa3\xf1E\x8f\x1c\xc1X\x99\x80\x9cnhΨ\xeb\xd9\xde\xf1\xeck\x97Ju\xcb\xd4\xda\xf5\xaa'O'\x16\x16KD\b\x96d\x11m{4\b=\r\xcf\xeb<\xf1\x0f)<\x85\x9c-\x81\xfdC\n\xce\xc87\x10g\xaei\x8dީj\xb0\xa9\x19\xf6^\x9f'\xd6ߦ\x8c\xffaȶ\x1c\nj,K+*\xf8\xeax^lx\x1f\\\xe4\x06S\xb0f\x1b\x00\xf5b\x03\xad\\T{%(\x93\xe7̜^\xe9F\xea\x19\xc0\xaci\x12ӕId\xaa\x88\xdeݚ\x84K\x17[+\x99\xb7\xd5뢕\x9aZ\x98\x02H\x8d\xe6X\x9b\xb8\xbd*\x1e\xb4\n\x85\vA\xb0\xc5j\xa4n\xb4\xd2\xf1\xfe\x9c\a\x10+\xd1`-#\x16\x83\xeb\xad\x18\xf5T#輪ā\xef{\xeb\xae`\xd1K\x9bv\xef\xe2\x93N\x8d(h\xac'\xd0\xe4#\xd0\xeft\xc83\xb0k\x87\xf5-\x90X\xa5*\xd6.\x9a\x82\xb8R\xf0vzr\xa2\xc4V\x9de\xeb\xb7\x16$¬\xba\xee\xa6\xda\x14\"[1\xf4/\xfbVa\x9cN\xaa\xc4D\x8e\x89#\xfc\x06\xd4b\xefT\x82\xaeq\xf5\xecJ/\x02\xd89&\xef\xd0𠆚\n\x8dƟ\xd7\xe6\x95Q\xe4<`+k[\x8d\x9a\x8c\a\x87\xaa\bx\xfa,\x90\xf07X\x89n\xcc&i&\"\xafT\xba:\xb9\x96\nj\xe2\xfcH\xf3\x19M8\xf9\x89Ѱ)\xe3\x9aek\x93\xa8HE\x85\x15]ٖ\x1a\xb3\xecK-\xb2\xb5\x1doZ\xe3b}\a)<^\\\xf9<\xe5A\xacXfv-\xdf\xde\xf3>\xa8\xbd5\xa3\xe1}\x9a\xfb8H\x82\x9d\xfeoA\x92\xd3\x12I\x06\x80\x1c\x83\x8b&\x95X\xbd\xc5\xc5(\xc2U\x80\xd8Tx|\x01\xbdð\x8er\x97Mww\xa1\xb9k$\xd4\\\x16\xed=\xcf\xd48P\a\xbd\xc3\x1d\x04\x14\x15\xf0\xd1<\x98\xd1Q\xca\xfc\x84r\x1f\xc3\xff>CO#\xf7\xa7\xe4\x18\xe4\t\xa0\xb2\x86nݢa\xacj\xa8\xacId\xf4\x0f\xa0\x9e\xfe\xc5C\xefw\x96\xd7\xe2Q\xdbSq\x11\xaa\x99\xbf\xc51\xbfm9\xe2\xe1\x9fRо\x81M\x85M\x94\xbb\x8b\x82\x9bv^\xb9:\x03\xb3gb\xf4\x14\x83WO{\xbe|<\a\xbbUR~Y\x83T\xcf\xe9\xc6\xe4T\x95\x9a\xf5:\x17\x86\x04\x91hL\xa2!\x91\xb2\x8d4\x19\x93\xc1g\x03c\xac\x10-\xa9\xa6(\xfd8DBY\x80\x03IE_\x92q\xb8\xc4\xcctQ\x96\xc0{\xb9\xd3\x13.\xf4\xaa E5\u0091\xf5\xf0\x06|\x15fW'\xd7C\x12f\xe0Q\xf53\xf2\xe5\x01\xbe\x81\x8bI\x11\x0e\tKQ\xfe\xa0\\\xefI\xba\xe6\x03\x0f\xdeV\x1c\x9f\xb6\xf5\xe5C\xc6\xd12\x96\xe3\xd3\xeb\xfb\xf9\x80\fK\xaa\x0e\x038\xd8\xc3\xef\xbb\x1e\x80\xa3\xa47\\SO\x1b\x14\xaf\x9cA\x9b\xca\xcadn\xd0z\b\x84\x11\xbb\x813\x00\xfe\xfas\xe6\xb3,\x98Qq\x98\xdd\xfb4\xe8\x92\f\x99ɻ\x10\x9a\x80\x0ev\x14\xd2͈-W\xce\xfd\xed\x89\xe6\x9dt}ދ\xa0\xcfY\x1fF\xc9D\xb6\r\x13j \xe0-\x94\x15\x00\x8cI\xd4\x10\xc7x\xbfO\x0fk\xcc\xdd\xc7\xeb\xa0v;\xff\xa0s\xfb\xe3\x1c\x11\xf3?\xd2\xd9`f3\x9d\xda\x1dD\xc5\xec\x8f\xcdg\xb6\xf2\x9b\xd6E-\xaf\xb7o7؛\xda8\xb0a\x16G3\xea\x1e\x9fzW'\xd7F\xa8\x00 \x13\xb7.D?2\x91\xf0Fr\x94\x18\xb9\xc0J\x16\x15\x8c\xf2\x8bY\xc1\xbf\xbdj\x14=ǿ\xbdjԺ\xaa~\xf6\xaa/i\xb7\x99S\xef\"\xe0\xdd\xf2\xc0\uf02dK\x82\x98\xb5\b\x05-ҿ\xdaK\xe1\x95\xfe\xf2*4(&\xdc\x10\\\xbf\xd1Ǟ\xcf\xd3o\xa2-\r\xddS\xd4\xeb\x93Ua\xf3`\x11\x19EE/\x9f%\x93\xb3\x13\xe7\xd0\xcb\x05Լ\xaf\x91\xb3Ѓ\x98\fvK\xd0F;\xe7\x1eS\xcf\xe9\xeaC\xa6\xfd\xbf\xdb\xfc\xdb>3]\xf6\xdfF\x9eB<\xd6\xe8\xdf{(\xff;\xf4\x1d\xc1\xdaWT\xfa\xc8\xeav\x91e\xf5\x90\xa5\xba\x03\xb8\xfc\xb1\x03\xd0\xe5\x8ff8\x97?\x16`ZQ\xec\xc7u\xbb\xb0\xbf[\xbe\xaf\t\xf5+\x8bx\xbc\xa0J\xc3\xe9\xebJ\x98\x15f\x92}\xba\xfcQ$\x86\x99\x7fC&\x96\x87\xa4\xc08\xc9\u05ec\xba_\x80\f\xc1\x87\xd9\xf5\xc5\xff\xf0\x87\x0e\xc5\xeb\xd4\xf5t\x15\xf1V+k\x83\vC\xc5~\xbdM\x83\xaa,J\x97u:Z\xa6\xfb7\"\xf4\x94\xd1\a\b\xda#o|a\x99\xeeo\xac&\x8a\xddV\xe0aֻ;\x8f\x1eٺ\x83S\xc0T\xe7\x05\x8a\x12r\xe3\xdf\f\x89\x82C\x05Z9\xfft\x8cޡK\x7f\x8e\xed\xd2\x13\re,\x92\rxg̃U6&\x9c\r-\xc1\x0fQ8\xa2\xbc@\x87!\xb6\tJ\xe0\xffnc\x11\xf3ۯ\x86}\x19C\x8c\xe3\xf0\x85\xe3\xf6\xfd\xa6pZ\ue79a9Z\x8b5\x9f؇Yʊ\x8d\x98\xa1\x97\x11\xdcs\x86\xbd\xd8m\xd2k6$T\xfa\x85̏\xd8=\\\xe3m\x0f\xb4\xcd\xebm\xbc\x88\x7fŗ\xbf\xa2\x8c\x05\vj}ĥ>\xcdZ\xb4n\xee8`\xea}\x11\xf6\x98\xf2[\x89\x1f}\xadk\xb2\xa1\x9e\xd8\x1f\xf8kc\x19\x1d\x02)/qo\x82\x85\xcd/M\xd5\xe3\xcb\x1f\x0f\xe8p\x98\x95\xfd\r\xb3\x0f\xe8\xae\xf1\x84(\xfei'\x05\xeeo\x01N\xdf\xe2A\x18\xba\x0e\xa3\xb34\xc1w\n\xa7\xe7\xe5\xaeo\x1c&\xb5I\n39Ga\xd61=m\xcf\xdf;ާݨ\n\xcdÞ\xa1\x19\xc3\x04b\xc1\xfb\xc93Z\xcf\xea6\xe3\xf8\xbe\x8f\xc9y\xf5t\xaaIl9.\xa6\xf5\xady\x03q[\xdc\xc7\xff;\x9f\xd7)L\x1eg\xe1T\xe0\x93ɏ\xbe\xd3\xc1x\xbdZ\xc7\xf4>\xbe\x9b،\x1e귉\xcd\xee\xed\xd7^ش\xd4<\x0f\x894\xf4,i\xa8Q\x18\x13*&\x172\xa5*+]\xbd\xd7\xfd\xe2K\x98\xf8\xa7\xe6\xb3\xfe\x9d\xd4/\f\x87\xa7{\xdd\x7f}\xc3s\xfd\xa9\xaa'\x17l|ӱM\x02\v\x17\x0f\xc6\xe4D\xf5R#l\xe3\acr\xaa\xa4\xce\xf2\x88G3,|f \x06t\x15Dq5X\xf1ip\x81/'\xe1\xed\x02\\17'G\xa67+\x82\x17\x97Km>\xcb\x14s\xe17\x9a5L\x99b\xe8R\xaaÕߦ\x82:L\xf9m(h\xb0\xb41\xfb\xe5ǫ\xf1Fܺ\v\xcfDU\xda\xfb\xf7\xe4\\+^x\xe5QJ\x97I\xd2\xf5\xf8E#B\x82@^C\\\x85\x12\xab\x1d\xf1\x83S\xc6ɻ_\x13\x14\xf9\xfdIA`\xc8(\x92\x01\r\xc8D\x10\a\xc0\xd5#\xe2Ȭ\xbdcx6Y4\xa15\xd9\x12&B\xdd7\xb8/\xea\xe9}\x83F\xa8\x80\xec\xd1#*\xb0\x1d\x10\xca\xdf\xfa$\xb15\xda\xd0\xc1\xa6z\xe7\x7f\x0f\xe7\xf0k\xdci\xfb\xfd\x18RЂ\rŎ\xfb=I\x13HB\x17\xe4x'\xdf\xef\v(\xd34ܑ\t\xf9\x7fO\xb2\xa7BN[\xabZ\x94{\x92=}\x03,p\xf9́t?->\xff\xf4\xee\x1d\x06K&\x0fo\x86\xe4ᆌ'D4WA\xf8ӟ\x9e\xf0\xfc\xe9\x13\x1e>}\xf7\xee\xe1\xcd~\xffd\xc4\xc3\xe2sS|\x8ex\xae¤I\xa8ta$\xda\xfc\x7fm\x8b\xaeL\\}.u{\xaae4\xe7_K\x9f\xa6\xf7\xf1\a\x0e,\x94LGX\x7fo\xfa\x1b\x91\xd9Y\x8e\x7f/\xc5S\x04\xdb\v\xf6\x9a\"\xc3\xc4\xf4\xc03\xc4\xe7\x12\xa9\xd5\xe3GZ?\x1a.\xc5\xc5\x16P?\xd9UY\xa3\xc6\x1b\xce\xd2d\x1e\xe5+\xd7\xf9>\rB\u0097\x94\x04\x8c\xa5\xb3(\xe04$Iʣy$N\xab\xd2\\\x9d\xb8\xe8#\v\xec\xec\xef\xf2\x88S\xac$\xe7\xbdY#\x889\xf5\b>\x142\xf3\xc8\xd5\xda\xd5RJ\xde\x16;\x84\x10\xd5a\\\xb7\xcf\x1b\xa7L\x9f\xb26\xf1\x81\xd3re\xd3m\xc8\x1bϹT\xc1\x80\xe1\xe1.L~->\x04\xa6\xb5\xbf\xf7-\xa6\xa0V\xb3Hn\xaf\\\x9cS\xd5#_\xf5\xe4\xea\x8c2Q\x9c\\z\xf57Ѫ_uq\x9aU\x95\xabӭGU\xbd\xdd\xea\xbc\xeb\xae\x1aL\x95z\xb5\x13\xb0\xb5fy\xfa9C\"\x1fF\xd6\xcfJ\xafW\x17\x8a\x83\xb1\x01\xa7\xc8\xe8\a\x06\x19\x99j,%_\xd3=\x05\x1aG\xa3\xccF\x83ө\x83\xb2>\xfbD\xd5k\x9e\xae\xf4\xeb_\x1d\xa9\x8e\x88C\x94\xdbU\x81-58<5B\xe1\xa9\t\x06Ok\x10\xf0\f\x05\xc8~\xc4~\x06\xcf|n\x83\x9eȎj\xeea\xf4\xfa<\xb5\xd7\xc6\xee\x01\bkm\xbdut\xeb\xdf\x06\xb0\xec\x8e\xd5@_\x7fN,\xe3)c+\xf0\xdb\xe5im\x06K|ԧET4\xb8\xdeUy\xbdS\xaby<\x80@\xe5c0e\b\xcb#O\xc8\xe3\x13\x19f\xbc\r\xe6YO\x93{\x8d\xe7\xac\xef-\xdbt\xa8A\x8d\xf2uL\x85^\x18Ig\x87M\xb0\xe4>1\x1c\x94D\xfd\x8e\x1a\x15O\x06\x95`\x05l\x15pu\n\xb9Fy\x0f\xf5dk\xa9\xad\x1aO\xad\x95ʉ\xb0\xd5U\xa8\x10\x94Gz\xd01\x1e,\xa3π\xd8\xfc=\xe7A\x146>\xff\x867\x87\x87\xbd\xcbf\xb4T\x06\xf9\xaf)7\xbfa\xae\xbf\xed\xc0\xd2\xea\x83:\xbdV\x96\xa73\xf9$[4\xfd\xb1\xdf\x06\x1f\xf2\xf4\xb7\xe2\x14\f^\xb8,σ\xf7\x96\xb8L\x8d\x81\x19\x14d\xe5\r@L\xa9\xf8lPs\xc9\xe2\x83\x10\xc2\xe7\xf9\x9a\xf1\xe7\xec[\xbe\x8a\x11\xaa\xffU\x1a\xee\x9a\a\x80\xd4t\xd4\xec+\xb0\x82\bY/\x8c\x12\x1cB\x1c\xcf*\x96\xc6\xe2_\xc3\aka\x9a\xb0\xd4\xff\x16\x9f\xcc\xc0гez\xa71o\x8cj\xfc9\xa3\xbc(\xe3\x00\x83\x1c%\v\xa3$\xddr\xa6\xfd1\x89\v\f\xaav\xec\xddk\xe3\xb5\xec\x8eb\xeed\xb6\xba/\xc1\xc0\xa4\x11\xdd\xee߿\x9bL\x0f饏\x1e\xb7Xu\xafK\xf2\xff\xaf;\xdd\xe4\xc1\xf4\x16s\xe3\xe7\x14_\x82\xbb#\xf7j\xf8n\xefz\xd7\xdeh\x01\xa2\xbe\xd3_\xd7g''S\xc7\b\x9a\xcd@\x92\xa8A\x8f\xb4KX\x98\xce\xd6x\xda/(\xff:\xc6w\xe6_\xed\xbe\vE\xf46\x8c\x94\x12\x85\x9e\x04\xf3]\xc2ӟ#\xaa\xb9\nVv\x80\xab\x90Ы(\xbc\xb6{\x96\x15GD\xab\xe6\xe0\x0f\xcb\xc7\x01G\xda\xcaI٭\xfa\xbb\xf9&lOp5\x87\x00\xedɲ\x00\xf4\xaa\xe8\x13rֿ\x15\xec\xf6h\x82L\x16\xf9\x8c<>\xd1g9,L\u0080+\xc3\x1f9\xbcJ\x14\xccި\xea\x9fW\x1bkH\x9e4cƅ6\x06\xb0)\xe5\v-(vY\x15\xf9\x0f\x8a}\x14\x14SE\xab\xb5u\xa8-iQа\xb2\xf6&\f\xf2\xde\x16|*\xdb\x10\x01@Ɉ<>\xf1\xbaȍ-\xb8k)\xbf9\xc4\x10\xa4|\x93^\xb1~M\x06\xa2\x84\\\x06O-\x12\x1aE\x15\x99Q\t\xb4J2\x1a\x83\x14Ҹn\x03\xb1\xd7QL\x93\x19\xfd\xa3=\xd7-̟E\xa65Ȃ̮\xc7XP\x10\xb2&\xea\xbf0\t\xd8U\xa5T\xe3\xd10\xd3_\r\xb3\v\x83\x95R\xa9\xaeX\xe8\xd94\x8c\x14\xf8\xf0\xa5e\xaf\x19Jos\x1a\x84/\xd2\xf4&\xa2\xee \xc09=\x86\x9c\x81\xea\x8dM\x195\xdc\xd6\x1b\xe2\x98w-\x84\xd09]:6מ\xad\xcaf&p\x03\u07b2:\x87^\x95DU\xf6\xb1<\x99\xb6\xfa*]T1,ձ\x01\xa7\xdf \xecr\xc5T\x8b&\xa93\xf4Y\x16G\xdcu\x86N\x8d\"\xaa\xc8P\xeff\xa5]vLo\x12\x98\x04:\xba\"\xc3\xf7\xd7G\xa3\xc2#\xc6{\xcdzh\xaf=\\\x94\u07b7\xfdy\x14s\x9a\xbb-\xceA\n\xf6\x8f<\x98\x90\xc1\xc0\xec\xac@\xb1\xfb\xd2+\xe3\x96\x1ak\xfbM\xb7\x1c\xa2I8Vv\x9b\x9eY\xa0\u05f8\x8eoz1\xdcRcm\xbf\x99\xde\xf5\xc2\xffrf\x86Ǹ\tv\xcdX\xddPz6씱\xba\xa1\x86\xb5\x87\xc7h_\xa3X\\\xe1w5U\x8d\xd7\xeb\x8a\xf1^\xf5\x94:\xd9\xd5h\x11\xe0LE|\x94/U\xa7XWv\xabdD\xf9\xae\xc8F\xa1n\xa6dR!\xb0\x1e,h\x89Z\xaa\x0ee@\x181\xd04\xbd\x10\xfa\x10\xdd\xfd\x8b\xea\xb9fעH\xa8\xb9\xa5\xac\x9be\x15\xb4\x81Q\xd0\t`\xa7\x0f\xb2B1e\x10BJ\\%\x8e\x9b\xa4\t\xf5\x9c\xb1\x90E\xd4_\\\xecmJ\xa8\xd7\xffN\xca\xd3KA\xd3\x10o#\x06UR\xed\x12\xa1\xba\x05\xeaIXU\x93xw\x880\xbf~6\xf4\xa9^\xa8}\xb43\xb3OED\xf3\xaab\x89\xf5=\x14 \v\xa6j@\x16\xec\xc0\xd8\xe0\xc5^)ѿ\x97欆\xf0F\x8c\xd2\xcb \xd6+ͨ\x01\x99\xaa|y\xca+$\xaa^B\x12\xa5\xaaP\xc3*p\x96ӀS\x13\x930\xd4h_=\xe6}\xbfm\xfbq\xe5\"\v\xca\xcd\xcb\x11\xd3 o\x916 Y\xb8\x8b\x920\xbd\xf3KM\xee\v\xac\x84a e\xd7\r\xea\xd7Ϋ\x83y\x89?\xfa\xa4\xe2\b\x9d!yG\xa2pL\xa2ph8\x9dl'\x12\xd9\xff\xbb\xd6A\xba\xba\xb1\xf0\x15\x85\xb7liM\xbf\xf1ꪞ\"l\x93\xd5&r\xdf\xeae\xf1\xf9\xec\xe6oR\xa9\xd7í\x9cѣb0\xbbA\x8f\x8a\xc3Z\x94\xf5t\x9d\x81\xe9\xddDm\xa4\xe6\xa3pI\xc3uL\r\xb5a\x14A\x12\x86\xc2\x19c\xbb\xb7Ɔ\x8f\xbb\xd9\xcdBX\xa6\x80\x7f\xbb\x0fq\xb8X\x05\x8b\x98ݼ\x96\xc6\x01d\"\x93\x83\xd9\r\xbeA\xfd\x81Ґ\x91糛$\xbd\x8bi\xb8@\xb9\x9cc\xf2Ƙ\x05\t\x8d_\x80C\xc9\x12\xc8\xc3,\xc8Ѧ\xa3\xcc2U\x04E}\xa3\n$\x9a\n\v\xf1o\xa3\xb8H6U\x00y`b\x88:4\x1a\x91 \x8e\x02F\xa44Sĕ\xe1\xc1B\x18\xba3\x12%$H\x04O\t>\xeeH\x9a\x8bUoF\xe0\x1f\x8d\xc8]ė\x00 \xcaI\x18\xb1,\x0evX\x8c\xf9\xe4\xfb(\xb9a$HBR8BX3a\xf9\x91\aw\xb2-\xdf\xd0oѹI\x9b\xbb\xbe\x92a\x84\xa2\xb4\x9a\xf6\x02\xf3\xe0\xf2\xa6\xa7\xf8\xcfEQs\b\xa4\a\x1b\x94\xf7Hp\x1d\x0f$7\aD\x99\xd1D\xc6\xff\xf7\xdd~8\xf9\x95]\x1fy\x13\xf1q}\x84\xf2\xe3j\xa0\xab!\x01#ikHW\x1c3\x99\x14\xe3\xb6\x06D\x95\xad\a0\x0f\xc1\x15\xdf\\\xc3եr\xfc\xfeL\x84\xdd\xc0[\x94\xc8\x1e\xabN\xaaL\xf7\x1b\xd2|\x8c[\x9c\xfeq\x1cd\x8c\xdaÔ\x19\xb0RĀ|\xa0\xa7\xf4i\f<\x8b\x1a\f\xb1\x8c\xcfɛ}t\xa1\x94\x19\x03j&Z\x06\x04\x9a-\xe9\xec\xe6\xbbp\xabMe\xdbBa\x05\x85\xb0\b\xcaye\x01{\xed\xcb\xf2\x17V\x80\x85\xb0F<\xfc\x8d\x12\x18\xce\xd0\xd6M\xcf\x0eG\xc8t\x8a\xe7\xc3\xf7\x81\xa2ٚ\v\xa1\x11؛O\x00\xb4\xd5\xd0\\\x13\x81\x93\xc9Ķf\xea\xbfY\x9a\xf0(1\xc5\x03\xb2o=}\xed\xe5\xacG\xe5\xfc\x92\ti\x9d\xe9}\x8f\xddmC\r\x1cӅ\xa5\xf8:\vk\x8aE+\x9e\v\x8b5\r\xd1e\x9fM\x13f\xb2\xfcW\a\xdf\xf5\x02\xe0\x9e\x93\xb5\xff\xf0q\x8a\xa2֛C\xe3\xbc~\x11\xa7\x8c*'\xb69TTY\xfc\x9b4_P\u07b3|\x90씒\x968\xf0\x1fc\xaa\xa1\xfa\xa2N\x15\xa2k\xbbߕ\a\v\xbfe\xf5\xfbm\x95}˪\xeb#7\xcfQї\x85\xff\x1cyL \x90\viI\x8cAu\xd7\t0J\x89\xd3\xd6E\xeb:Zf\xdb\xdeqѕ\xfb6\xdf\xc0\x8b\x83\xda\xdfw\xe3\xf5j\x1d\xf3H\xdct4\xe4\x06?\xd96^\xa6\xb40\xc0\xb2Ϡ(\x1e\xd0\xf0\xe3\xa2ӥ\x90\x8aL*G\x81)\xb6I\x11\xc8\x05%\xfa \x98ͧE\xc7*!x\xd4\xce\xfc\xdd\x1cj\xb1\xf8\x87\x8e\xbc\xc1\x8d\xe2\r\xdd\xd9\f\x1ej\xd0\x0e\xe9L}\xc6\x04\xa8\x17\xcb(\x0es\x9a\f5R\x1b\xc5\xe1\x87\xf6\x14\x81t\xf6Ի\xe8\xe5\x06HL=\xb4ۃ\xa8V6Q\xedTUE:Y\xe7\x99\xf3\x1fL\xfb\x0f\xa6u`\x9aٱ\xfd<\x98\x01\x06\xa1ԕ\xaf\x99Pr\x96\xaa\xcd[]\x93)5\x98\xb7\xaa\xcar\x16̖T\xb9\xaeʞT\xb5t_\xe2e\xa4\xde[\b\xa6Ms\xb7\xa6MBp&\x1f\xe3\xb7~NY\x1ao\xa8R\xa4\xaf'h\xb3_u\xbb\x8b\xf6\xe0\xc6;ĻP\x0f\x87\xea\xd68t\x1b\xffM\xba\x06\x86T\x11*\x15I\x9e\xcdѷ\xa8\xf8:I\xd3\x7f\xd1\xf0\xa7\x84G\xb1Z[Mo\x05\xd1\xcbUz[\xe7\xe5\x00T\xdf\xe4\xae\xf8nmwo\x1d\x92|\xf4\\\xb9Q\xaf;a\xaf=y\xaeӒ\r\xb29i\u0084C\x03\xf9\xd1\xe2q\\o^\x94\xff>]\x90I\xf9Ť;$\xaf\xf2\x82~\xd1\x02\x02^j=/8\b\x05\xe2\xd5\xc9\xf5!\xb4H\xe0\xb85:\xa1g\x8a\x1dݶ?\x88\xaaၢ`J\xda\x16\xeb\xfd\xd6\xcf\xf2t\x151\xdaGj\t\f\x1dE\xd2QR\x11\xa3\v\x1d̬\xf9\xd09,V\x86\xd0\b|$Ib\"\x9cv\x15\xaaC\x95\x1c\x9b\x0ehi~j\xe4\xb5\xe5\xd8\\\x13,\xcf\xe7K\x9a(t\x84\xf1\x169\x8b\xd4o2n\xbb\xf0T\xdd0\xb0\xbf\xfb!\xb1z\xad/\xfe\xe1Db\xe6\xfd\x9a0\x86\xb69\x90k\x06\xf9\xe4\xc4h\x1a[\xae\x8c\xfd\x9433\xdb\xe4H9oo\xeaKh\xbe\xaf\vJ\xd9\xc9r\xc9v\xd5\x15z\xf4H\xfd\xd4h.\xb8\x0e\x98s\x9a\xbb\x85\xcb\x1d\xafwW\xb4\x9e\xac\xa2d\xcd)\xb3M\xa1\xd1l\xa1\xf8\xf7f\a\x8a\x01G@u\xcc>O~B\xed\x8bռ\xc6\\鲴l\x90݃i_YZ\xf8;ݱ1\xb9\xaa\xd6\xf4\xbaIʚ\xab\xdcP \x89n\x1d\xe0\xe6İ\xa5,\xa7e\xe1\x0e\t\x9cZ\xc8\x01\r\x89#\x7f9F櫟\x1e\xaa\xb1\xd9\xd2ܻ\xa7\xa8\xb6\xd5\xc6Y\xe3\xd47\x1d\"\xf0~\xf1\xfa\x0f5\x9bn\xe55[U]\x1f\x14N\xacE\xdfd\t\xa5\xd4\xe8\x02\xca3~\x97N\xcc\x00r\xdfn\b\xb9\xc6\xefҏ9\x82nv\xe4\xff\x1f\x00\f%\x82\xc8\xc36\x01\x00", size: 79555, local: "web/static/js/bosun.js"},
 
 	"/js/config.ts": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff|TAk\x1b=\x10=\xaf\u007f\x85>\x13\xb2Z\x12\xd6\xe4\xba\xc6\xf9(\xa1\xd0@\xa0\x90\xd0^\xd2\x10d\xed\xd8\x11h%3҆\x9af\xff{5\x92l\xabMۋ%\xbdy\xfbf4ode<\xe0FH`\xb77\xd6l\xd4\xf6A\xda\x1d0\xf8\xee\xc1\xf4\x8e\x99m{\x9b\x90\x1f\xb3J\x8e\x88`|ǜGe\xb6\xcbY\x85\xe0F]\x02\x80h\xb18˨\xf9\xec\x83^\xc9ꕷ\xf8y\xe7\x955\xaec\xc2\xec\x8f\xe0\xe1$m\x0f\x83\"\xb5;+z\xe8;\xc6\vB\xc3V\xd7\xecժ>0\x1d\x04i^\"Z\x19\xe8\x98\x19\x875\xe0r6\xcdfk\xebF\x13\xae\xe7\xd1j\r\xe8Zy\xdc\xf3:]\xfbƣ\xae/\xd9c}\xe6\xe8\xbaa[\x9f\xbdx\xbf\x8b\x1bm\xa5\xa0Z\xe3\x01\xed\xe8)\xbe\x19\x8d$\x90\xa7/\xba_\x1ax\xc9\xe2\xd7]lৰ{\x00|U\x92\xf0\x83X\x8a\xdd\xe5\xd3)\x1e\xf5c0\xee\xda\xdb{Zr\xbc!\x1f^\x052\a\x02\xe5\v[\x9d\xf4\xda\x04\xf1f\x99\x18٭@I\x81\xb6\xf0\"S\xa8Q\xcf\b\x81\xb2\xf0\xe0|ǿ\xf5\x17\xcd\"\x04=\xee)Qu\xd2\x10ޮy>R\x86)X$|\xa8\x80\xa7\x9a\nj]\xa7\xb8\xda0\xfe\xdf\xe1\x93ȡ\x9c=l\x0e\x9c*\xf6\xa8݂\xe7\xf5B\xec\xd4\"UX7!T\xb5n\x94\x12\x9c\xe3\xbc\x17^D{I\xa2\xaa\x92\x00\x81$QM\x89\xbdQFh\xbd\xe7\xbc`\xbekM]\xb4 8\xb8\xf6V\xf0 \xd74Y\x89\x16\x04?\xa2I7HΖ\x8d\v\x99\xf3\x8d\x96\xc7p\x18\xc0\x00\x9f\x12g8\xbd\x8d\x101\xa3\xd6\xcb\x13NM/\xd1?\xf6\xe0\x99\xfc\xf8\xbfH\xbc\xaa\xd9\x05\x03C\xcf\xe2\xcb}\x98\xb4agM\xa8\x82\xbf/\xb1\xf9w\xfb\xc8\x15\x82\xd8j\xc5\xe6\xf3&\xa3\uf29e\u007f\x15Z\xf5\xf3ؘjb\xa0\x1d\xfc\x8dz\xb4\"\x19<d\xa8\x1dh>x\x9e\xb1&3(\xbd0\xdbQ\vl\x95\xfb\x80(\xf6|h\xd8\xf99\xe3C\xab\xc1l\xfd\v\xbbfWͱ\xb0\xdf\xda6<^=e\xa9iv\xfc\xcdC\x10\xff}8\x8fK9\aI!\xc2A\"\xadoo\xac\xfeH\xbb\xfad\xfeTzJ\x0fiz\n??\x03\x00\x00\xff\xff\xd9H\x05\xd8#\x05\x00\x00", size: 1315, local: "web/static/js/config.ts"},
 
@@ -193,6 +193,8 @@ var data = map[string]*file{
 
 	"/js/host.ts": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xb4W_o\xdb6\x10\u007fV>\x05k\x14\x95\xdcyr\xf368\xf0\xf2\x90\rh\x86\xb5ْu/\x81\x110\x12ek\x91)\x85\xa4\xbc\x04\xad\xbf\xfb\ue394D9\xb2\xb7vI\x1e\"\xf2\x8e<ޟ\xdf\xfdH\xe7\xd2\b\x95\xf1D\xb0\xf3\xf7\xa56WIY\t&\x1e\x8c\x90\xa9fr\x19\x9f[\xc9\xe7\xa3 \xa9\xea\x19\xe3\xf2\xf1\xe4(X\xc1\xca\x19\xd3F\xe5r\tS\x93\xaf\x85?\xe5\xb7\xdel-`\x94\xe8Fr\xbd@Y\x91˻\x19\x8b\u058dt\xcc\xe6?\xb2Kq_\vm@\xaf\x85\xf9\x03\x8d\xec,ؔy\n\xda<\xe5\x867\xaedڟ\xad\xc5\xda\x1bޘ\xd2\xf0b\xc6d\xbd\xbe\x15\nw6\xc1\xf6\xdd\x11J\x95\xcasY\xd5R\xc2ȓdy\x01;?\xf4C\xb1\xc1q\xef\xfc\xed\xd1\xd1m\xa9kyVJ\xa3ʢ\x10J\xc7I;\x8eB\xcc\xf0\x99QE8a\xd7\xe1k\x8d\x99\x85a\xf8zeLE\x83\xa2L\xb8\xc9KI\x13U\xd6\x06\xf5Y-\x13\x14Fv\xc7̫Ԅ\xd1\xde\x19U\xea=\x8c\xae\x84\xda\xe4\t\xca\x1bSV\xf7\xab\x9buz\xb2NJ\x1a\xc5\xe7\x97\xf8q\xfa1\x16|\xc3\x15ӂ\xabd\xc5杽؊\xa21\x84o\x1d\x8a\x11\x0e\xb0\xc4*h\xd6\xe9\x10\x1b\x9d\x0eg\x9e\x8e\xdfz*\x98|\xf9\xc2F\xdap\xa3G\xdd\"\xaa6,\xa3J9\x99-\xfa\x8e\xd0\x01mW\x8aP\x03\xd9\x0e\x94 <\x8aO\x81J\x8a\xbf\x1b\xecQT\xa4\xb8w\x8a\xdfk\xa1\x1e\xad\xf8\xde\x1d\x01\x9a\xb5\x9d\x1b\xbe\xc4\xf3>\xb3\x10\x83\x0eg\xccO\xc8\x16ר\x18\xec\xaa\\踪\xf5*\xba'CJ\x98ZI\x86\x88\xdcv\x9eZЃ\xb9\xb6ަs\x19\xfd}R\x82\x10r\x06\xf80d\xb4\x97SӘFt\xc4Ka\xa2pʫ|j\x03\x98\xa2\u007fӐ}\xe7\xfb;\x06\x1b\xb1\xae\x13h\x0e\x1d\xb5.Xp7\xbdb\xfdx\x9ap\xaa\x06\x14\x8f\x12\x1fl\xc7\xc3'S\xa7LAt\ny\xbb\x9b\xe3\xa1o`\xb4\x99\xa3'B&e*>]\x9e\x9f\x95몔B\x9a\xc8w\xae\xe7\x1dyՖ\xd1s\x87;X\xe0\xa7\xf5\x04\xab\xc9kS\xa6\xe8i\xf8\xc6\x0e\xe7\xc7\xefޅN\t\xc4v3\x84\x03\x92ǀG\x85\xe8\xf6\xf0\xdc\xea\\m\x11rpZ\a\x17r˦g\xc6\xc2\x12H\xa0\xaa\xc3\t\nSذ\x81\"n\xa0\xf5¤\xac\x91\x90\xac\x06\xb14\x03(Yn\xed\x01iB\xa1\x1c\x05\x8b\x81\xbc.\x15\xafV\xa7\x98\xc1\xf0/]\xca}\xb9\xfc\xe5\xea\xe2cl˘g\x8f\x11\xb9?\x1e\xc3Z\x9b\x8eC\xe9\xcd3\x16\xbdBQ|E\xd1:\x108\x14c\x9a\x83-\x85\xd6-\xb9~\xb7\x88?rj\xfc\xf07\xa1\x12\xf0\x80}\xd2\"\rO\xbcz\x81\x0f\xaeTnW\xafbH\xdfCE!\xf9\x9e\xa2X]\xaf\xe1\xfa5iJ2\x82\x92\xc0\xe2\x98\xee\x87\x11\xa6\xf7p\xf6\xb7\xe3\U0007762f!\xe4\xffl\xfdy\nK>\xbe`a\x8f\xdb\u008e\xb0\xa0\xa3\x93^\x03\xba\x1b\x17\xb4\x1f\xb8Y\xc5k\xfe\x10\xf3\xaa*\x1e#Y\x17ń\xed \xe4'\x9c\xaey\x05~\xd1\xfdi=c\x8e\x1dS8\xeb\x84\xd9\xdc\xf8G`\xb3\xf5=\xdaO:\xc8.\xd3\x1c/\xfc)\x94D\n\x13\xdf>\x1a\xa1O1\xf5\xf3C\xfc7\xcc0\xdd\xf3\xc1㘀\xcbe]p\x15g\xa5\xfa\x99\x03+?Y\xec\xdd\xe0\xf9\x84\xe5\xe9C\x93h\xff\x86\xbb\x06\xf9\x02\xef\x12\xd2\x04\x98\xe4\x19ˉ\x19\x90\xc9\xe9\x8b}\x01A\xdcP\x10\x83\xdd\x11 \v\xb5\v\xf6\xb4\xc9\u03a2>\x89\x05Ox,\xe8\x03\xbb\xcd\xe2hⴊ\xe3C¨Z\xf8\x92\x8b\n#&\xd8;\x9esk\xa0\xc0p\xd5\xfdɋ\x1av\x1d[v\v\x0e\x11 \xe4\f\xf3\x88\xe9`i\xaeDb\x9f5\xa3\xb7\xa3v3\xb2#\xfc-lt\xcf\xd3J^\x8ev\x1a*؏\x94\x03m\xd5ﬦ\xb9\x06\x00\xe4\xed\xf5\xa0\x93zvR\xea\x1d\x04\xa1\xdfD\xd5@\x17]\xa7\x15\xb4\x1a䭂Fy\xfb\x03uTs>y\x9aR?\x03ZS\xf1p\x91E\xa36\xc1sx\x0e\x8e\xc6\xec՜}\u007f\xec\x1d\xfd\xf5gۣ\xc1J\xff\xf0 m\x99\x04Oj\xe5[&\n-z\a6\xebr\xe9-k\x92\xd8Z|\xd2M\xad\xa3\xfd+\xa6\xdbd?\xfb\x99#\xcd\xf5\x1d\x12\a~\xe1\xcd\x19\xeb\n`h)\xee\xab)d\xa8\xc8{\x89\x01\v\x93\xb39ܞ\xd3Tl\xa6z\xb5\x0e\xdb\x12\xf8\x18ڶ\xb4\x90\xed\xe5\x83\xec \x11d\xfa\xf0\x95\xb6\xd3\xfc\x03\x99hh\xe0@\xfb\xe2.\xe8ަ[\x1bN\xff\u007f\x87\xb7\x97뷝\xdd\xfb%\xf1/\xe4\xfb|\x9c\x92\xbd8\x99\f_\xd6a\xf7\xfar\x80y\xa9\x8bڙOn6d~\xc7\x1d\xb2\xa4\v\xf8\x85\x19\x01\xab\x80m\xdc\xebm\xab\xecc\x91\x8a\v\xbb\xad\x95\xa9s\xf6-\x83G\xfbN\xb7{\xf5\x8b\x9b\x90\xe8{`]\xe3\x1b}\x0f\xac\xdbqƟ\x1e\xd8\xe5Hg\xe8\x8d2\xc4<\xdb\x05\xfc\xfb'\x00\x00\xff\xffBc\xdc\u007f\x85\x11\x00\x00", size: 4485, local: "web/static/js/host.ts"},
 
+	"/js/internals.ts": {compressed: "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\\\x91\xb1\x8e\xa30\x10\x86k\xfb)\xfe\"\x92AA\xe4r\xc5\x15 \xee\x8aH\xa7M\x1dm\xb5\xda\xc21\x93\xc4\x12\xd8\xc8\x1eVYE\xbc\xfb\nPHv\xbb\x81\x99o\xfeOc\xeb\x98\xc2I\x1b\xc2~?\x96N7\xf1`|G\xa0+\x93\xab#\xdc9\xdf\xcf\x7fnR\xd8\xfbL\x01\xed>K)\"k\xeec\x81\xc8\xc1\xbas)E\x1b\v$u\x01\u05f7G\n)\xaa\xbfKo\x90\xf2\xe8c\xefv\xdeq\xf0MC!\xe6f\xa9\x13\xb5\b\xec84*ÛZ\xc51XeP\xab\vs\xa72\x9czg\xd8z\x97̭\xe2\xa7v\x86i\xb2\x98\xb4_\x98\xbb\x03\x85\x0fk(\x1d\xedg&o#\xaaǢ'כ\x14\"\x10\xf7\xc1!\xa9\xb1\xc1\x96\xfe\xa49\xfb\xff\xf6Ju\xb2M\xb1\x86B\x1bU)\xc5PJ1\x05\xe5g\xe2Dmtg7\xcbm\xfe\xb9\xea\xf7/\x95J!\xf2\xd8\x1bC1&\x8f4\xcdz\x0e\xba\xdb,\x18*\x8c\xddR\n1L0\x85\xe0\xc3\x03\x9d>\xbf\xb3\xf3\xf5QA\xbd:}l\b\xecq\"6\x17<=\x94\xc2\x1a\x13;o.\xe5\xf0\x9e\x96\xf2k\x00\xfbN\xf3\xe4\xf9\x01\x00\x00", size: 505, local: "web/static/js/internals.ts"},
+
 	"/js/items.ts": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xacR\xb1\x8e\xab0\x10\xac\xcdWl\x11\xc9D/\"}\xa2W\xa5\t\xc5U\xd1UQ\n\xc7Y\xc0\x12\xb1\x91w\x89N:\xf1\xefg숀ҝ\xae\x81e\xbc3\xcc\xec\xdaXF_)\x8dP\x96\x8cw:i\xd7!\xe0\x17\xa3\xbd\x11غ(\x13\xf2\x9d\x89;\xb27\x9av@\xe1m\xeb\xf3e\x9f\x89\xc6\x11/\x91ʴA\xf1c\xd9;\xe1\xc7y\u007f@\x89\x15\xf73`Ȳ\xab\xa3\xde\x1e\x9ce\xef\xda\x16=\x15z\xaas\x19=\x1eطr\x03g\xb9\xa2\xd1[(\xe5\xaaa\xeeBQ\xf5V\xb3q6OG\xbby\xaa\rĮ]Lu\f\xd5\t\xfd\xc3h\\\x8f\xe1\xe2QQ#\xe7r\xab:\xb3Ma\xe5:\x13\xa2\xa0^k$\xca'\xf1\x9bb\xf5\n\x1d\xf9B\xa4?\x16\xcf)\xc1\u007f\x18\xbbBD1D\x11\xf4\xde\xf9\x97D\xfc\\2\xd3,\x02Q~Zum\x11\xd8A\x85\xac\x1b\x98&/\xe1\x1fDf\xd2ݿ\xfbfU?\xb6\xe3V~a=.\xf3O\x8d?\xafǛ\xed\xe1\x12\x1e?\x01\x00\x00\xff\xfff|\xd9M{\x02\x00\x00", size: 635, local: "web/static/js/items.ts"},
 
 	"/js/jquery-linedtextarea.js": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xfflTMo\xe36\x10=ۿb 8\xb0\xe4\x0f)A\x0fE\xad8@\x80\x1eR\xa0M\xdb @\x0f\xc5\x1e\x18il1\xa1D.IY\xf1.\xfc\xdfwHɊ\xe4\xecE\xb6\x86o\xde<>>*Y,\xa6\xb0\x80\xfb\x9c)\x8b9\xec\xb4,\xe1\xf5\xdf\x1a\xf5\x11\xfe\xe4\x15U\x9e\xf1\xdd2\x8d\f\xfe\x11\xf5\x9eW\x0e\\X\xab6I\xc2\x04\xab\xe2\x17!\xf7\xeb\x8c\xdbc\x9c\xc92y\xfd\xea:\x85k\xb4]_\\ؒ\x9a\\\xdf\x13\nd\x868\xeb*G\r\xb6@\xf8\xeb\x8fg\x9a\x93aep3\xa0n\x9a&\x96\x8a\xaa\xb2\xd6\x19\xc6R\xef\x13ѢLRr\xbb\xee^bU(\xeaJ\xa6ᮮ2\xcbe\x15\xce\"\xf8>\x9d\xcc\xe2]\x15\x8fd\xc0\x16z\x8c\x87L\x92\x05=h\xe4\x03\nEjΫ`%\x94\xec\r\xc1\xd4\x1a\xbdF\xc7\x03U]\xbe\xa06@\\\xc0DÎ\x06\xdePY\xa8\x155\xb4D\x0e\x9b\xd5Zce\xc1\x1c\x8d\xc5\xd2\xd7\x13z\x1e\x18\r\xe0B\xfc][\xe7\xaa\x19\xaaq\xf4\xe6w~XA\xb1\xf2\xb3\x1ee+p\xd2\x14\\ \xf4\x80\xb8@\xbe/,ɿ\xddB\xd1a&\xfd*SdX\x1e\x06\xb79?\xdc\x05\xb0\xec\xb8\xe8Op\x9b\xf8Z\x94\xf6\x1d\x8fr\xb9\xf4o'\xf7\xd0hk]u\r\xae|J\xa7Ӿl\vnbdY\x11^8\xe8\xb7\xd5M\xd9\xc2Mz.\r<\x9f\x85\xae;\xf2t\xe48\xfc\xa7\x99\xf2F9\fx\x10\xaf|\x81\xb2Q\x92s\x06\x1a2\x1b)$\u07b8I\x1f\xa3\x86:\xdb\xcdA&\x981\xdb\xf9\xe8|\xe7`\xecQ\xe0vޚ\xb4\xb9\xb9\xbe\xbeJA\x1eP\xef\x84l6\x05\xcfs\xac\xe6w#'z\xee̘0hxn\x8b`\x05\xc1o\xbf^]\x02\x14s\xa7\x1aF\xb1\xd2\xf8\xe1\xf2P\x88\xe9\x05x\x9e\xcd/W\x17\xc3\xce^\xb9\xb3\"c>S\xef\xb8\xe3\xf5\xa95A\xe7\x98k2\x99\x96B\f\x13c\xab\xf3\xe1\xbb\xf5\\\x96\xee\x8eޏ\f\xff\xff\xfaK\xda#Z\x86g\xa9h}\x80\x8e\xfb\xfa\a4\x13\x9c\xd4<x\x0f/\xd0åt\x1c=\xe7_+h2/\x99\xa6\xcf\xc4\xdaJ5\x87\r\x84\xeb~H䒨\xde\x03\x8f;\x8d\xb2\xe867\xb8\x1d\x83+\xf1!}9Ҷj\xa7u\xfd-\xd9)\xedB\xf6Da\xb5\xee\x1e\xbb\\u\xf6\xe1\xc1]ˋL\xb5ka\xfbӒ\xcc\u0086\xceA6Q\xac\xd1\xf0o8\xca<\\vF)t;q٦\xeb\xcfhT7QV\x19:\x11,\xcf?\u007fF~.\xc4SyB\xda\xcb)\n\xdb\x0f1\xbd\xff\b\x00\x00\xff\xff\x8fgK/\xa6\x05\x00\x00", size: 1446, local: "web/static/js/jquery-linedtextarea.js"},
@@ -215,9 +217,9 @@ var data = map[string]*file{
 
 	"/js/rule.ts": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xe4\x18]s\xe3\xb6\xf1Y\xfe\x15\x88\xc7s$c\x85\x92\xaf\x93<Ȗ39\xfbZ\xbb\xbdKg\xce\xd7t:\x8e\xe7\x02\x91\x90\xcd\x1eE\xa8\x00(\xc7Q\xf8\u07fb\x8b\x0f\x02$%;}\xe8Lf\xe2\a\vX\xec\x17\xf6\x1b,*\xc5Ēf\x8c\\\u007f\xa8Kv\x93\xf15#\xecgŪ\\\x92\xeb7\\֕\x81m\x0fF\xf2\xa1X\xaa\xb7H0#\xf1\x11۰J\xcd\b\xad\x9e\x122?'\x1b^\xe4\xa7\a#Z2\xa1\xa4\x06\xc3N\xb1պ\xa4\x8a\r\x003\"\x95(\xaa{G\x11\xec\x15]\x04\xbb\xa5\xe0\xab\xcb.\x81\xe2=\x00\xe2|,V]\x9c\x1e@\u058b\u007f\xb3,\x94\xc3V\xb4(\x83\xfd\x82\xe7O\xc1\xf6\x91\x8a\nV\x0er{\x87L\x98\xbfZN\x15\xed\xdf\xeaӽ\xe0\xf5:\xbc[U\xac\xb4\xae\xb1\xb6\x91\xc1\xfe\xe5\x11\x98\xc4\x1b\x87\xa6O\xbc\xe2L\xaa.\xba\xcc\x04/\xcb\x01\x85\xb5\xb7\xf6߆\x96\xc0\xb2\xaaW\v&P\xb5ZPU\xf0*\x00\x81\xe6\xd7\x16\xd3q\xb7\f\xe0\xe4\xb2\xc5\xef\x9c0!\xb8\bM\xf8\xc0\x1f\x8d\x1a\xbb|\xfe顐\x8a\x8b'g\x13QW\xc6~\v\xceKF+\x00\x95\x9c\xe6\xdf\x19oǟw]\x86J\xc93\xad\x8a3ssp\xb0\xa1\x82(\x99/\xfe\xcc\x05ؒ\xccI\xf4/\xf8\x9b\xbc\u007f?\xb9\xbc\xfc\xea\xeaj\xb6ZE\xa7\a\a\v\x8c\xd4\v^)4\x16\x132\xcd\xdau\x1cad_(QFcr\x1b\x1dI\x8cgXFG\x0fJ\xad\xf5\xa2\xb4b\xf5\x06|\xa8̹\xcc\xf0wYW\x19\x1eƆr\x16dʘh\x1e`\xe8\xfb\xf4\xfa\nV7`\xe2\"C\xb8ci\xce\xdeٝ?\xd7R\xf4\xa1^\xa5\xd7\x1f\xf0ǟ\x83lCzs\xf1\xd6B\x13LB4\x87dTd\x0f`\x8aVJj@qrj0\xb2Z\bH\xcfO\xda5\x80H\x15_\xc4\x06'5\xb0_\u007f%Q\xd4Gw\x81\f\x14\x16\xd9A,\"\xfby-z\xec4\xc8p\xd3?ts\x1f\xff'>\x84\x9f\x19\x84\x15\xdbf\xbc\xc6\xc8\x1b\x8fO\x9a\x19\a\xbf\xac\xeb\xed\x03\x97j\xfees8&\x87_\xaf\xf0\xffa\x92\x90sr2\x8d\xac\x18\xa9\xa8\xaa\xe5\xa7\x15]\xeb8\x00\x89p\xf3\xd1a\x85!P\x1e\xce\xc8t\x8c[\x9b\xa2\xb0?\xd1\xfbL\x14\xaa\xc84\xc2k\x004\xc0̸,չ\xee/e\xb6Zg\x8f\xd3M\xe2\xa1\x05,\xbcG\xe5\xea\x93\xc7o!;0\xb1(u15\xa4\xaf\t\xefr\xb4\xfb\x01V\x97\x9b\xdd\xf7\xb1\xe8\"@\x81\r\x9e\v&\xebR\xc9\x00\xad-#\x80|l\xb1=\fh\xbe\xf6\xb8\xae\xbc\x04\xa8-\b0\xab\xba,\xb10-I\xfcE'\fu\xf8\x8ez\x91\t\x90Qd\xd6X\xfa\xc8\xf6\xc7*\"\xc7\x1a:\n\xa2\x11ς\x13\xf44V\x02rl\"\xf2\x98D\xfe\xb4\xc1{5\xad\xba.\x05:\x82\xb1Ҋ\xa7\x8eB\x814\x1d\xde}xb\xb8B\xbeA\xea\xc5,\xd9G\x1cY\xf1\x1d\x03\xb4L\xf6Pi\xc5\xdb\xed\xc0\x12\xb6{a\x1el\xd3wT\xaa\xf4FgH\xd3\xcc\x10\xa2\x8bj\xfa=]\xb1\xa6!\xe0\x05\x00\xfd\x05\x835\xc5Dk\x9a\x80\x1169\xe0\xf2\xd3\xd9\xfa\x1c\xd1\a\xd4\x01*\xa0|\xa4\xf7r\x16\x82 \x80Jv\x1e@Fۭ\xa0\xd5=#G\x9f\xa1fm\xc8lN\x8c\xe8\x0e\xab\x11P\x8a\xf33\x95\x9fo\xb7G\x9f\x9b\xe6l\x02K\xbbݸ\xed\x04P\xba\x9ca\x06\xe9j41\xf2\u007f\xda\xeb\xeb\xc0\r}\x1b\xfb\xf8\xf5c\f\xa0\xf9\xe2\x1e\xce3\xe8$\xf4\x9f\x01\xa6\x9f\xd9\xd3\x05ρ뜜\xfc\x89\xbczE,\\s\xfa\x1b\xb3\x04#\v]\v\xfd{ɖ\x14\xb2L\x97\xe4\x91WPZH\x13\xea\xddvƎF\x92\x95\xe0t\x96\xfbV\x89bza\x1dn\xe5mK\x83㊾\x02\xf4\xc1e!Vq\xf4\x0ed\x10\xf5\xc0\x88\xee\xb2\x05\x98$'\x15WŲ0-\x84\xb4Ƌ\x1f\x8b\xb2$|\xc3\xc4#\xe4\x19\xd3D֜C\nZbT\u007f\x1b%\xce\b\x03O\xf4 \xa8\xa4Q\xd9w\xfb@ﻁqt6\xcc\xedl\x12X@\xcf'.\xe1\x1cЎ\x1dX2D͂\x03\xdb,\xe0@\x8fr\xa3A\xeb4U\b\xfa\xfdBq\x1a\x87fM\x92\xdd\x04\xeeF=\x9a6\xd7\xf7\x90\xb9\x06\x11\x8dI\xbf\x89\xd8\xf2\xf9\f!\xd6\xf8.\xa1\xab\xfa\xcf\x10\x9a\x06\xe2\xc9|Cy\x96\xa8+\xcb\xf7\x97\xe7\x88\xe8\"\xa0赛=4m\x9f\x01\xca\x1b\x1d\xe8q\xbf+%/\x88u\xfdg\xc0\xc1\x1d\xbc\xc4@O\x04^\xf3v@x\uea9d\xb9 \xb8\xf5`^\xf0LlP\x99W\x81\xa9\x038\xee\xa0\x1b!0W|\x85\xf5\xa3VY\xdc\x0f\fhp\xba\xdb\xf5\xfc\xderP|'\xbdut\x8f\xdax2q\x15\xe2\v\xe4\x96\x16\xf2\aZ\x16y\xec\xf2\xd8ꤸ\xcdG\x83\xaa\xf8\x00Q\x8bF\xec\x0eb\x97'\xd6\xcc]\xb4\xad\x90\xae\xf6\xae@\xea\xab\xe5\xc5ri%\xa4\xb8\x8e\x15o\xaf\xdd\x06\x88\xbb\x8bA\x9e\x93\xa9\x15\x10\xce5'\x9a+a\xa5d\x04q\xdfS\x05\xc3\xf0Bj\xa2\x84\x9c\x91o\xa6\xe4K\x18B\xa7HL&\x13rBVE\x05Cy\x9f\xd1\xeb\x80\xd1@\xcaq?vû\xd4\x02\xa7\xd0hB\xd7\xc5D\xc0#\xe2[\xd7\xc7t\x9d\x99\xeby\xa6ʠ\xd5\xfc\xe3\xc3\xf5\x05_\xady\x05&\xe9\xd6\"K\xf0ʅ\xd9\vD~\xf0\xb0th\xc7}4\xda\xc6K\xfdΊ\xfd\x93+\xf12\xf9>J\xf0\xed3t\xad-\xf6\x91\a\x89nIt\x06\xbep7\x8d30\x88ɻ\xdfh\x16\x83l\x92\x13_r\xe9=S1x)A\x9e\xa9\xac\xb3\x8cI\x19\xc7\xf8\xd8o\xdbO\x9b\xc7\xf89\x00܉\x87\xe9\r\xacO\xc3\xc3Σ\xd8a\xe9\x1e\u007fe`\x06{-x\xf6\xc1\xd4G#E\x83\x1b#_w\xb88\xd6?C\xf1\xae\xff\xe9ߐlYT\xb4,\x9f\xe2xH\xe3\xdb\xe3\x12\xac\xcd:\x1a\x83V\xeb\xd8\xc9?5\xef'7\x8c\x90\xbe\x9e\xc1\xa0\xe4\xc8\xdb\x19\xd5\x18\xc4l\x83\xb2ggO|٦М\xa5\xfaN^\xa9U\xa9٥o\xe00\xac\x91\b\x04\xe4\xbf\xde\xfc\xfd\xfb\xd4L?\xc5\xf2ɠBI\xa3c]T\xe1\x8dNH\x14\x929\x9bhķ\xb8\x91;g\x00}\xfeO\xb3\x95\x9d\x11\x12\xbfr\xe0\xb4\x01\xce\xf5\x1f:\xf0\x9a\x00p\x87\x11\xcek@\x99\xa6i\xf4\\]\xff\x9d\xa7;ި\xed\x04\xffC\xf8\xef\x8eYm \v\x0e\xb3\xe2vz\xe7\xc0\xff\xdf\xd8\x0e\xe2w\x94\xc38\t\x9ep>\xebE\xb5%\xf8\xe5\x11\x15\xed}WC\x96\x82\xa9ZTd\x93\n\x06\xd6\xccX<\x89o\xc7\xdb&N\xee\x92\xc9=~\x18:\xf9\xb1~=\x9d.\xa2\x1eC\xf3\xa9\x0ey\x16\xf9\x80iγZw8\xb0\xf1ے\xe1\xf2\xcd\xd3u\x0e\x83\x04\xb8\xe0+tQ\x91'\x96\xc5u\xa5\xf8\x0f\x05{\x8c\xc3\xd8Ƌ\xc4A\xe9\xb9-\xf2\xbb\xbe\x02\xfe3_gb\xfe\xdd\r\x198\x16\xed\x1a\b\x8c\xe5\u007fK\xfb\xd7LM\xe7\xdeC\xf9bWF\x16\x1e錼\xde\xc9J+0\x99\xeb\xc1\x00\xe6\x83o\xa6\xce\x1c9\xf0mg\b\xbd\x80^R\xe5f\x06\x99x\xf1I\xabo\x0eBN\xac\x90\xbc\x9dH\x82\xa2翬\xe4\x03\xc7^\xfa\xc3?\xb8c\xc3\x0fP=˵\x96v(\xde\xe0]>;\xbe{=\xe3˖\xddĄ\xc1\x04\xc2 ٟ{:m}A5\r\xa0}\xffFX\xc9\xf6\x15\xd7\xces\xbe36\xc8\xd3\x1d\xcf\xeb\x16\xe5\xa3\x03\x84X\xc1\v\xbbe\xe5A\xda\x14\xc9\xe9A\xff\xdbD\x83U\xe5\xbf\x01\x00\x00\xff\xff\xa8\xac&d\x18\x1a\x00\x00", size: 6680, local: "web/static/js/rule.ts"},
 
-	"/js/silence.ts": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xacV\xc1n#7\f=\xdb_\xa1,\x16+\x19k\x8cѫ\x8d\xb4\x87\x14E\x03\xf4\xd4\x1c\x17{Pf4\xb6\x8a\xb1dH\x1ao\x17\x89\xff\xbd$%\x8d\xe4\xc9\xc2E\x8a\x9e,\x92\"\xf5H>ңMP\xae\x97\xadb\x8fOzP\xa6UO\xad=)\xa6\xfe\x0e\xcat\x9e\x99}\xf3\x185/˅\x8f7\xfc\x96I\xf3}\xb7\\(\xe7\xac\xdb2\x1f\x9c6{\x90}\x90.T2D\xa8\xa4nt2hk*\x95\x1cԕ\xc3\xc1\xfa\xe0+9\xc8}-\xaaN\u05f7\x83\xf2\xe1i\x06\tu[&V\xec\xfegv\xb6\xba\x03UkM\xaf\xddq\xae\x1d\x94\x04\xecBO\x10\xaf\xac\ai\xf6j\xe6\xd2i/\x9f\a\xf5\x90\xe3=[\vA\f\xbe\xaa\x8fx\xf9L(\xc8%\x83\xbc,\x97\xcf֏\x06\x9c\x82\xb3\x03\xe4\xeb\x9bv:\v\x9e\xf0?\x047\xf05\xfb\xc2?z,6\x1c\xf9\xc7C\b':\f\xb6\xa5\u0091\xe0\xec\x18\xd0ޏ\xa6E\xa5\x88\x1e\xdb\xeb\xfe\xad\x19\xb9o\xa9\u007f\xbf\xc3\xe9I\xb9\xb3nQ\x9f\xa3E\xdb\x1fI*vz\x80\x8ctj\x1e\xffğd_!\r\xce\xd21\x0f\xd5k\x0f\xec\xbe\xc4k\xa2J\xac\xa0\x1e\x11SC|\x80;\xd1\x12\xc5b\x05v\x14\x1b\bŒ\x99R\xccYS\xee\x10u\xca\x05\x12\x8b\x95\x88T\xac$\x16+Ҫ\x18Q\xaaP\x01\xc7*X \x81M\xf7L\xdcU\xb0?}bw3\xacT\x99\x1f$\xc0\u007f:p\x88pY.r\xc7\xd8^\x05\x91\xaec\x93\x1a\x94\xf9F\x9e\xf4&\r\xd8\x064|\x05\xf6E\xe3\xc7\x16\xd8\xed\x85\xe8d\x90\xc4,\xf4\x9b\x1e\xca\x13\t\x0f\xe1\x85\x1d\xda.ѓ\x86S\b\xfay\xebHj\xf0\xa2\xdf\xe4\x16a\x12\xba\xdd5\xdc_!v\x82\x8c\xcdO\xf5\x13u9__\x19\xe7\xabƟ\x06\r٬9\x86\xa0\xba\xd5\x1d\x89!h\xac\x9b\xd3\xe8\x0f\x82\xa3\xfa\x9e\xb3Ϭ\xbe\x96\xa2l\xbe\xb0\xf5\xeb\xd7ϛU\xf3\x97\xd5F\xf0W\xbe\xa2\xa8\x00r\x91 P\xa4^\x0f\xb0Ą8\xc74\x99Sat\x86\x9d\xd9\xdd=\xfb\xf0a\xc7(3\x02\x8e5\xa2\x19\x05WB\x926V\xcd\xd75\xeais\x95\x86\x93\xae\xec\xafY\x97ɚVY\xcd\xceu\xceu\x1bq\xc6,\xa04\xf1\tZf\x15\xebP{A\xa4\t\u007fj\xe8%\x0e\\\x04]O\x12\x96\xbcLO%M\xec+\xaa8-E\x8e\xf3Q\xe44\x034\xd9A\x06\x05OMm/\xb3\x11\xb7\"v~\"T.\xc5\xd5j\xc4ƸQ!\xf84<\xb4\x16\xab\xfb\x99~f\x1c\x86\xdd4\b'@5\x9b\x04\x0f\x93\xb0\x8e\x98n\x0f\x04\x8dh\xd4E\xc5\x02\x05\xec3\x17\xc6\x1a\xb5\xe2[\xd6\xcb\xc1+*q$\xd1\x04\xa7\xfe'\xf9\x1fG\xa9\x8a~]\xb4\xf9\xce\xe4\xd4PH\xf4jqB{\xb0>\xc4\u07b7.\xd0\xf3\xe2\x90\bp\xe3z&E\xf1\xa9irÑ\xa8S\xbc&&\xddp!v\x15\x97\x89l7\\\x90\x80\xc5#o\x94\xe2\x10\xff\x8b\x9c\x1a\xac숒\x97\x8a\x96\x13\xeb\xde\xf0\xf2\r\xcf~\xd8\xefl%\x9aU\xe18\xb2\x98\xbf\x8f\x9f\xef\xe7\n\xb9\xf5\xda\xc8a\xf8.`\xea\xe6\xd9\xe1\x87\n\xe66\xfbRyI\xfb\xf5\xee\x9b6\x9d\xfd\x96a\v\xfe@\x0e\xe1\xa0=K(\u007f\x81\xc5\x19q\xc4Ւ\x97\xe8{\x87\x91\xa0@\xba/\f\xb1\xe8\x8e\xfd\xe7\xf9\xf8\x97\x9c\xf1{\nS\xae>\xa8\xf2\u007f\x0f\xf6\xe5h\x8f\xca\x04\xd8\xf7\xcd\x18ZbCޙǦ\xb7\xee(\x83\xc0\b\xbf\xd11\x85\xbe|\x85\xc3?\x01\x00\x00\xff\xff 7\xd81\xee\n\x00\x00", size: 2798, local: "web/static/js/silence.ts"},
+	"/js/silence.ts": {compressed: "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xb4V\xcdn\x1b7\x10>k\x9fbl\x04!\x17ٮ\x9d\xab\x04\xb5\a\x17E\r\xf4T\x1f\x83\x1c\xe8ݑ\xc4vE\n$Wi`\xeb\u074b\x19r\x97\x94\xec\x18\b\x90\x9c\xb43\xdf\f9?\xdf\f\xa5M@\xb7Q\x1d\xc2\xfd\x83\x1e\xd0t\xf8\xd0\xd9\x03\x02\xfe\x17\xd0\xf4\x1e̶\xbd\x8f\x9a\xa7jᣅ_\x822_W\xd5\x02\x9d\xb3n\t>8m\xb6\xabj\xe1\x83r\xa1\x90\xd1\xf4\x85ԏN\x05mM\xa1R\x03\x9e9\xec\xac\x0f\xbe\x90\x83ږ\"\xf6\xba\xb4\x1e=\x96\xb7\xef\xd1{\xb5\xc5\xd2\x1d}x\xb8\x88\x99tK\x905\xac\x7f\x85\xa3\xd5\xfd\xaaZt\xd6l\xb4\xdb_j\aTn\tR\xcf9\x9c\xa1;e\xb6x\xe1\xd2k\xaf\x1e\a\xbc\x9b\xce{\xb4v@e\xe8V\xbd'\xe3#G\xc1.S\x90\xa7\xaaz\xb4~4w\xd6\x04g\x87\x01\x9do\xbb\xf9[\x8a\x14\xff]p\x83h\xe0\x93x\xe7\xa9\x1b\xa2\x01\xf1n\x17\u0081?\x06\xdbqeYpv\f\x84oFӑRF\x8f\xe5y\x83\x1b`\xf7%7\xf8\xcf\x10\x0e\x0f莺#\xfdtZ\xc4\xfeJR\xc6\xf9\x02\x06\xf9\xab\xbd\xff\x9b~\x12^\x13O\x8eʁG\xe5\xba\x1d\xac\xf3ymT\xc9zU-bL-\x13\x06\xd6\xc98\x8a\x19E\xd3g\fM\x9f\x91\x89J\x19\x9e4ن\xb9\x95\rX\xcc(3-\xa3,f\x94x\x97A\x922F$\xcc\x18I\x19#F\xc2\x1a\x1c\xaa\xfe\xce\xda\x7f5\xcak\xc5M\xf8\x85\x90k\xca\\o@^\x15\t\xbe\x7f\x0fW\x17Yq\r_IU|܉U\xb58U\x8b\xa9\xb7\xb0\xc5 \x939\xb5\xb3%Yܨ\x83\xbeI\xb3z\xb3\xc5 \xeaj\xb1X\xb4~\xec:\xf4^\xca^\x05\xc5\x1c$\xbf\xf9\xa2\xe4@y\x93\xc1\x8a\xb0S\xf4\xe49\x97\x92\x7f^:\xb2\x1a\xd6\xc0\xbf\xc9-\x86\xc9ѭ\xce\xc3\xfd]\x05\x95B&\x9a\xa4J˲\xf0\xcf\xcf D\xdd\xfaà\x83\x14\x8d\xa0#\xb8ne\xef\xe2\x11\xbc!\xda\xc3\xe8wR\x90z-\xe0\x03\x94f锛O\xd0<\x7f\xfepS\xb7\xffXm\xa4x\x165\x9fz\xaa\xe2\x11\xb0\xe6Hڍ\x1e\x02:)\x8f1Mp\x18Fg\xe0\bWk\xb8\xbe^\x01gƁS\x8dx\x9aa\x1d#I˯dvCz^\x82\xb9\xe1\xac˫\xf0\xa2ˌ\xa6\xadX\xf2\xb8\x99r]\xc68c\x16\x8d\xa8\xe3\x15\xbd\xce\xf6$\xb06\xaeǂ\x99\xac\x9d\xb7d\x02\x92L؉rK\x19'\n\x9c\xe20\xc74\xcb)\xa5&\xe5\xc9,\xa4\x99\xafY\x15'1\xcbq\xf6\xb2\x9c\xe6\x8b.\xf2A\x05\x84u&J\x9e\xad\xb8q\x89+3\x05\xa7❭]j\xa5\x1b\x91\x82O\xe3\xc6+\xb7\xb0\x9f\bk\xc6aXͣs\xb0\xferv<\x06\xd1Ę\xde\x1e!\x1eꨋ\x8a\x05\t\xc4\f!\x8d5X\x8b%l\xd4\xe0\x91K\x1ci7\x87S\xbeR?p\xf8\x8a\xd3ϋv\xb9\x8f\x057T4gԥ\xf6P}\xeaի.h\xfa\xec\x90\b\xf0\x86\xf9D\x8a\xecS\xd2\xe4\rG\xa6N\xf6\x9a\x99\xf4\x86\v\xb3+\xbb\xccd{Å\b\x98=\xa6\x1d\x94\x1d\xe2;\xe7p\xb0\xaagJ\x9e\nZά{\xc1\xcb\x17<{\xb5\xdf\x13\xca4+\x8e\x13\xc4b\x91\x91\xf4\xb6\x14\xf3\x9c\xb14\xc4\x19N\n\xb2\xe8\x1c\xaa\x80\xaf=G\xcd\xd9r\x80\x8f\xb7\xb7\xb7\xf5\xf7\xcd\xc3\xf7s\x93\xdd6ڨa\xf8*\xb7\x18.\xab9\xa0\"\x97\xcb\x7f]O\xe9\x05\xb8\xfa\xa2Mo\xbfLe\x92\xe2\x8e\x1d\xc2N{HQ\xfe&\xea4\x85q\x95Mk\xfe\x1bM\xf9\x81\xe5\xe1\xe0E\x03O@\xd1뾁\x97+\x18\xbe\xb1\x7f\xe1\xf4\x93*J\xff<a}\xf6\xd7sz{\x89e{\xbbG\x13\xe4\xb1n\xc7\xd01\xb7\xa7\x17`\xdfn\xac۫ \xe9\x84?\xf83\x1d}\xfa\\\xaf\xaa\xff\a\x00\xe9\x00\xf9\xe09\f\x00\x00", size: 3129, local: "web/static/js/silence.ts"},
 
-	"/js/state.ts": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xecW\xcbo\xdb6\x18?\xbb\u007f\x05\v\x18\x95\x84xr\xbac2\xb7\b\x82n-\xf6\x00\xb6\xae'\xcf\aF\xa2e\xcd\x12)\x93\x94\x9a,\xf0\xff\xbe\xef#)\x89\x92\xec\xceXw\xdc%\xa1\xa9\xdf\xf7~\xf2A\xa8\x9a\xdfUU\x9c\xe6\x92%:oX\x18hu\x97\xec\u007f\x90\xa2\xae\x82\x05\xd9\xd6\x1c\xae\x05\x0f#\xf2\xfcb&\x99\xae%\xc7\xd3L%\xa2b7\xe68\xa3\xc9\xfe\x86\x04\xab`\x81?2\xa4T\xf8\xdbg\x84_T\xb2ci]\xb0\x1e\xab\xf3\x92Q\x9e\xa6T\xf7\x97G\xfc\xa3YY\x15p\xfbI\x16\xf0aYQ\xa9sZ\xa8%H2\xfc\xe3\x9d.\v\x03/r\x0e\xc2C\xa7\x0e\xe5O\v\xc2\nV\xba#\xd5Z*s\x8e\xc8\xea\x8d\xd5\xd6@\xe3\x84r\xd0\xee#`\x13\xcdR\xb2\"\xf6\x1a\x04\x90Պ\x04\xbf0\x96*\x02\b.>\x17,\xcdXɸ\x0en{\xfa\x8arV\xdc\x17T\xa9\x8ev\x0ej\x02\xca\xfb\xe4\xe1\x1f\xb4\xa0\x13$^z\x18\xc6\x13\x91\xb2\t\xca^{8\xb5\x13\x9f9\xc0\x9e\x8f\xdee\"\x8a\x82V\n\xc9\xc3|d\xb2O\xb7\xce7\x00y9\xbc1|\x06܊\x1c=A\xc29k@\a\xe7\xce<}\xbc!\xbc.\x1f\x98\x9c0o\xe5\x87\x00\x8a\f\xa7Y\xbem\xe9AP\xbe\xd5?\xb2'\xf2ꕳ\xae͆\x18\xfe'\xfb\x0f\xe9#y\xb9\"5O\xd96\xe7,\x8d\x1c\xefYC%1\b/H6\xc5\xd6g\xf8lb\x87\xbf\xed9(\r\x19\x04\xf4?S\xbd\x8b˜\xa3\x92\x8bs\x8aD\x1e!\xe3iGF\x1f/\"\xdb\nIB\xa4\xcdQa\x14|\v\xc7\xefV\xc8\vNWW\x9dm\xc6A9\xe6\x1b\xfa\xac\xbb\x9d%\x82\xeb\x9c\xd7\xcc1\x9c\x1d\xdd\xff\x81\xf5yg'\x88\x19Zl\xf1G?\xf2\x13g\x1b\x99\xb7\x1e\xa4\xae\xb0\f\xc3h\x92\n\xca\xd4\b悓rC\x1e\x84(\xa0p\xbd\x1c\x18\x18}m\f\x1e\x04+.\x18\xcf\xf4nh\xff\x85\xf6\x1c/\xd1\xd2~@-\xa7\x99I\xf9}!\x14\xf3\x8a]\xcbֻ\x1d\xe4{!3\xa6\xbf\x80\x81\n\xf0\xben\xa1\x1d\xb9\xcf\xff\xcav\xc4g\xe3\x8cv\x85h\x13\xe3e\xd6:\xa4O\x8dQf\x1c}?\x0e\xf5\xeb\xb57̲\xf8δw\xac\xbf,\xfe\xa8\xa9\xae\x15\x16\\Pslq<\xe8e\x9cu\x9agr+ٲ\xbe\x8c\xdd\xc4\xc1S~\xc7qT˺\x80\xc6o&\x10\x86V?a\x93WZ\xe6<\xf3\u008c\xbe\xace\x01\x88`i\xc1o\x11\xb9\n\xc8\x15\xc1\x83\x95AyV\x17T\xc6\x10\xaew4م\xbe\xe7\x17`\x06\x1e<\xa6.\x06f\xdaL\xe2`\a\xe1\t_\x18\xf8]\xc1$6\xbb\x1e\x8f\xda]\x81z\xaf\xf6\xecɨe{\xfa\xa7\xdf>܋\xb2\x12\x1cZ\xe4\x98v\xc8|\xac\xbc\x05\xdf\xef\xf2\"\x85\x19\xb1\xc0ڄ\xa3\xaf\xfeE2\r\xd5T\xa6;\xb4\xff\xdd\xd8\a\x86\x93\xb2\xdb\xe5J\v\xf94\xaa;? \x0e\xf16\xf8?\n\xff}\x14pY\x82\x03B^t\xbb\xdc\x16j\x00<\x1e\x06\xca\xd4%lq\xeb`\xbe\xd3\x1a\xf7\xb9`~\xf0\xb7:s\r#=\x8b?\xbc\x87\xd3G&\x9b<a\v2?\xd8\xcb_ݍ\xf1\xa1\x19\xc5`\xb6ݳ\xda\xfdéձ\xa4\xfb\xbeB\xd1\tHu\x00\xf0\xfc\x10\xc3lg\xd2\xf6n\f\x93ᵦ\xfb\x8d\x8b\xd0!\x96L\x89\x02v\xd0\xfe\x8b1\x126:\xd8k\f\xc6(\x1cC\x1f\t\xa1\u052b|iM|K\xf7\xe7|K\xf7Qd\xdc\x17\xab:I\x98R!\xcc\t\xeaEh\x1cS\xfc\f\x91l\x16d?\bd\x13\xff.j\x1c\xa3`K)p\x1d\f\xbb\xab(\xaeu\x12\xb6\x81\x9b\xb0l\xe2\xf7\xb6\bN\xf1Eư\x06\x0f\xb8\xc2\xef\x11\xcbcwjb\xd8,q\x1cw\\\xd7\xdd\xc9M\x1a\xf2\ry\xddN\x12\xe3\xe9\xc6t\u007f\xc1\x15\xb6\xff\xeeG\x8b~C\xae#_\x9d\x9f\x80\xff]\xdbs;\xf4\xfaz3\xdeGl\x98\xf6\xb8O6\xa3\xa4=\x1bL\x84\xd8x0)\x85\f\x11\xf7'L\x04\x1b\xe9\x17]\x9a\x1f\xe2J\x8a27\x03\x023|3H\xf1\xc1s\x05\xc7\x0f3Y\xde%|\x9f\xe0\xf6\xca\xed\xc3\xc3'\xcc\xd9G\x06\x16\"ұ\xf13\xa3c{\xc1s\xc3{kpZ\xf6[\xfd\xb0ؽVZ\b\x9aB\xd5\xf8\xb3\xdbi\x1f\x9e\xa2\x8cb\xbdc\xa0\x89\xf6\x92\xc9-l\xdanBJ\xdf\xfa\xd7=\u007fo\xf0\x1eA\u007f)\xfdr@\xf6!\xdcE\x17\x10G\x9e\xfa\x17Mih\x82du\xaaL{G\r\xbb\xdd\xe9\x81\x0e\xff\xfa\x86\xbaw^\xf4\xc7\xd2_\x9f\xf1Y\x166'\xd40\x03\xa5\xe9\x12\xbe\x95\x13\xf8\xbb\xa6\xbbl 7!C\x12\x16.\xc3\xf5\xe2\xf9\x18F\x9bh\x99a\x13}\xfdG\xfd\xed\xf5\xf5C\x10M[\xf1\x17\x12\x15\x9e\x93\x90\x9bݠ\xf4R\x11j\x05\xf4L\xe0\x9d\x15\xbc\v\xfe\xe1\x01\xdc%\xe5\xa4\xf1\x0f\x84\x99\xed\xedk\xc5%\xc8\xe4B\x81v\xbf\xfbZ\x89[\xc3e,\xf2\xef\x00\x00\x00\xff\xff\xe5_\xb0\xb3\xa8\x10\x00\x00", size: 4264, local: "web/static/js/state.ts"},
+	"/js/state.ts": {compressed: "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec\x18Ms\xe3\xb6\xf5L\xff\x8a\xb7\x19\x8fI\x8e\x19\xda\xe9Q*\xb3\xa3q\xd3fg\xb7\x9di7{R\xd4\x19\x98|\x92P\x82\x00\r\x80\xf4*\x1b\xfd\xf7\x0e\x00~\x80\x94\x94h\xba=\xe6bC\x0f\xef\xfb\x1b|\x16\xaa\u1afaN\v*1״\xc5(\xd4j\x95\x97\x7f\x93\xa2\xa9\xc3\x04\xb6\r\xcf5\x15<\x8a\xe1\xcbM Q7\x92\x9bS\xa0rQ\xe3\xc2\x1e\x03\x92\x97\v\b\xb301?v\x86R\x99\xdf>#s\xa3\xf2=\x16\r\xc3\x11W\xd3\n\t/\n\xa2G\xe0\xd1\xfc\xd1XՌh\xfc$\xd9\x02\u0087\x9aHM\tS\x0f$/-\xfft\xaf+f\xd1\x19\xe5\xe5\x02\xa2N\x1d\xc2\x0f\t ê;\x12\xad\xa5\xb2\xe7\x18\xb2\uf776\x165\xcd\t_\xe5\xe5Gd\x98k, \x03\a&y\tY\x06\xe1?\x10\v\x05\xab\xbc\xe4\xe2\x95a\xb1\xc3\n\xb9\x0e\x97#}M8\xb2'F\x94\x1ahok\"\x91k\xef\xca\xc3\x7fւ\x9c`\x1a\xa0\x87\x83<\x17\x05\x9e`9\xb0\x87\xa7\xf6\xe2\x95C\x06_\x8e\x16\xf8\xf0\x00\x84Q\xa2@b\xcdH\x8e\n\xf4\x1eA\x93\x1d\xb4\x845\xa8\x80r \x1c\bC\xa9\xa1\xc4\x03\b\t\u058b\xc0I\x85\x1d\x87W\xaa\xf7\x86\x8eJ(\xa8\xaa\x199\xd8[\x95\xc2\a\xcaK\x05\x84\x17\xf0Ҡ\xa4\xa8\xa0QhEH\xf2ډHG\xe5\x9c*\x19D\xed\x02\x94\x96\x94\xefF\xd7\a-\x91NW\x1c\xdd֧\x05\xdc\xdd\xcd \xe9ʡZ+\x03\xba\x85\xe8M\v\xbf\xfe\no:\x16qǵ\xcf\xcb\xd6!\x1eo|X\xday%z\x88\xd6\xff\xferL\xb2\x9f\xd5\xe6>\xce\u070f\xcd}\xfc\xb0K \xaaz]\x13(ǣ>g\x82\xb3\x01\xb2ގu\xb9YN\xb4 \xc6\x10\xb2\xd6\xed\x06\xded\xd0\xf0\x02\xb7\x94c\x01o\xa1\x84{\x93\xe5p\xdf]/\xa0\xea4\x8e\xed\xff\xa3\x17\xe3\\0Fje\xb2!\xa2\xb3\f\xf6\xd3`M7\x90\xc1\x9b)\xe4\x94\x1b\xa3&\xb1!\xba\xc5\x16\xb9\uea83\x16\x9f\x17\xc0\x9b\xea\x19\xe5\t\xf3^~D\x8b\xcf\xf1\x18\x00G\x9f\xaa=\xdd\xea\xf7x8\x13\xb3|\x8fy\xf9\xae\xf8<1>\xf6\x9dg1\xbc\x9as\x1dc}\x81\xcf&\xed\xf0\x97#\a\xa5\x89Ԑ\xc1߉ާ\x15\xe5F\xc9\xe4\x92\"\xb1G\x88\xbc\x18\xc8\xc8\xe7\xabȶBBdh)dN\xf0\x12(\xfc93\xbc\x96@\xef\xef\a۬\x83\xa8i\x1f\xc6g\x034\xc8\x05ה7\xd81\xec\xf23\b&\xd6\xd3\xc1N\xc8`j\xf1\xd1\xcb\xeaKζ2\x97\x1eJS\x9b\xae\x1a\x9d&\x96\xb2-\xcf\xe4B'e\x01\xcfB0$\xdcˁ\x89я\xd6\xe0I\xb0R\x86|\xa7\xf7S\xfb\xaf\xb4\xe7x\x8d\x96\xee\xc2hy\x9a\x99\x84?1\xa1\xd0\xeb\xddZ\xf6\xde\x1dP\xfe*\xe4\x0e\xf5o\xe0\x10~\xf0n\xb7\x84)\\\xfe\xef\xb6\x1b\xfc\xdd<\xa3i\xdf\x19l\xeb\xda\xf5\x0e\x19\x88\xe6\x99q\xbc\t.\xe97jo\x99\xedҕ\x9d֦\xfev\xe9GMt\xa3L\xc1\x85\r7\x13\x8b\x87\xa3\x8c\x8bN\xf3L\x0e\x8e>\xeb\xeb؝8\xf8\x94\xdfq\x1eժa\x9a\x12\xbbP\x98\xd0\xeaC\x8dg:\xac\xf1e#\x19d\x10>8\xe4\xb7\x06\xd36Nsp2\b\xdf5\x8c\xc8t+\xe4\x0f$\xdfG\xbe\xe7\x13\x88\xec\xc1c\xda\xc5\xc0@O\xe3\xe0:\xf7\x19_X\xf4\x95\x19\x9c\xef\xf10\xe2\x1b\xed\xee3\b\xefJ<X\xb5܈\xfe\xf4\xafwO\xa2\xaa\x05G\xae\xe7\xb4S\xe6s\xe5\x1d\xf2Ӟ\xb2B\"OLmRV\xf8\xea_%\xd3R\x9dʌ'S\xa6\x9fS\x8dd'e\xb7\xa7J\vy\x98՝\x1f\x90\x0e\xe3m\xf8G\x14\xfe\xffQ0\xbb\xefqycPn\x86\xd5|Kr\xe3\xf1(T\xb6.\xc3\x04\xd6\xe1\xed^k\xb3\x9e\x87\xb7/\xfe\x92n\xc1\v\xe0\xbb\xf4ݏZ\xd7\x1fQ\xb64\xc7\x04n_\x1c\xf0\x9f\x1d\xc4\xfaЎb\x92\xef\xdd\xdaܯ\x93\x9dZ\x03KR\x8e\x15j\x9c`\xa8^ \x83ۗ\xb4\xc0-J\u05fbM\x98,\xaf5)7\x0e1xI%*\xc1Z\xf4n\xac\x91\x80L\xa1ñ\n\xa7;\xd4Q\xf8@j\xfa\xe0L|K\xcaK\xbe%e\x1c\x1b\xc2 UM\x9e\xa3RQA4\xf1\"4\x8f\xa9\xb9N j\x13('\x81lӟDc\xc6(dP\t\xb3\xddG\x03(N\x1b\x9dG}\xe0\x826\xfdȅ\xf8\x05\x8bO\\S\xe6\xe3\xfb\xf0\x19\xd1\\\x8f6\xfd\xd1U\xce9e\x8c6\xb4\u0089*\xb4\xc2\x19ˣ\xa7\x11#\xca\xcc\xf0\x81\xebz8u\xe3\t\xbe\x85\xef\xfa\xf1c\xc3\xd3ڑ!\xb823c\xf8\xd1c\x7f\x0f\x8f\xb1\xaf\x8e\xbb\xfe vVH\x8f\xac\x18\xcd1\x8aS\x89-J\x85\xa3jA\x9b~ J\xaf\xfa\xd6\xee1X?n拏ˇ\xd2,\xae\xed\xac:.f\x8dA\xb1\xffR\x94R\xc8\xc8\xe0\xfd\as\xedR\xeaf\xa8\xa7\x97\xb4\x96\xa2\xa2v\x12\x99R\xdaLji\xf2\xcc5s\x0em9\r\x955\x14\xd6XR\xee\xaeۘ\xcf\x17\xd8\xfcm|\xf1\xf5jZ\x82\xe1\x87\xf3\xf7\xeb \xee\x8aw\xac\xf7\x885/\xb4a阶\x1d\xaf\xa93A\n\xcaw\xfe\x16\xd1Y\x15\x9d\xa3\x8cS\xbdG\x1e)\xede\xa8ó\xaa\x1byz\xe9\x83G\xfe\xde\npL\x00\xa5\xf4\vӰ\x8fP\xca\xf8\n\xe2\xd8S\xff\xaa}\xc1<k\xb3s\rctԴ\xef\x9e_-\xe0\xdek\xede\xe7\xc5\xc9\xf6\xec*~6 ;\x9e\xbe\x97\xee\xee\xfc\x9f\x93N\x91R\xb5\xdaj\x94QW\xecq|A\x8c\x91RQ\xdehTg\xdei\xc6l\xd7\xfc:@\xf0\x93\xf5O\xe8\x88mv\x05A\xf0I\xa1\\\x80DR<\tQR\x8c\xbeq\x86\x7f\xdb(\x94\xdf\xc4\x1d\xd6_\x1aI\ft\x01\x9d@㉪\xe7\xf1\x1e\x0fj\x01\xebї\x1bw\xe1T\xee\xbax-T\xdfƝ\x840\xb1\xfa\xb9\xb2\x1d\x1bv4i}\x97\x9c4\xf6®\v\xa6\xa4(zo$\x10v\xa7p\x1c\xaf7\x81\xd7\x1e\"\xfbo\"iH@!g3\xd9\xf7\xfc/\xaf\x17?^\xb8\x0f\x10\xf3\x8f\x0ea\xf8{_\x1d\x92/\xc7(\xde\xd8\x0f\r\xe1\xedw?7\x7fz||\x0e\xe3ө\xff\x1b\xadj\x95\x97a2\xa6\x9c\xd7k$\x1a=s\xbd\x80\xf0\x87\xf0w>\x9d\r]\xe7dǘ\b\xb3\x0f\x85\xaf\x15\x97\x1b&W\ntO\x89\xaf\x95\xb8\xb5\\\xe6\"\xff;\x00\xf6\xeaZX\xe2\x14\x00\x00", size: 5346, local: "web/static/js/state.ts"},
 
 	"/js/underscore-min.js": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xbc[{\x93\xe36r\xffߟ\x82\xe2\xe54d\x04i\xa4\xb5+\x17\x8b\v\xa9\xec\xbbݪM\xc5\xde\xd4\xdaN\xfe\xd0(\x13\x0e\x05\x8d\xb8K\x812\b\xee\xec\xdcH\xdf=\xddx\x11\xa4\xa8\x19ߣ\xecr\x8d@\x12\x8f\xeeF\xa3\xfb\xd7\xdd\xd8\xeb\xeb\x00\xff\xfb\x85o\x98\xa8\xb2R\xb0\xc9\xc7*\x98M\xfe4\x99~e>\xed\xa4<̯\xafk\xd7\xe3c5)Ž\xfd\x1ceq\xf0j:\xfdv\xfcj:\xfb&\xf8\x0f&\xd8\xfe1\xf8\xae\xda}b<\xadH\xf0\x972\xab\xf7\x8c\xcb?\x17e\xbd\tR\xbe\t\xde\xf1Ϭ\x92\xf9}*\xf3\xcf,\xf8\xc0\x0e\xa5\x900s0\f\xdelrY\x8a\xea\xab3\x9a\x82}\xfa\x18ܱ`+\x18+\x1e\x83M^I\x91\xdfՒm\x02EV w,\xf8\xe1\xdd\xcfA\x91g\x8cWl\xf2U\xb4\xady&\xf3\x92G\xf1\xd3\xe7T\x04\x9c\xca]^\x11I\xf9\xe4\x96\b\xfa\x9d\x10\xe9\xe3\xe4 JY\xca\xc7\x03#\x8c\xbe\xbf\xfb\xc82齪\xe9[3\x85\xf72\xa7br\xa8\xab\x1dI\xa1Q\xe1j\xa4\x84VV\xf2,\x95\xa4\xa0l\"˟\x806~O2xإ\xd5\xfb\a\xfe_\xa2<0!\x1f\xc9֬\x9bW\xea\x97Tv\xd5O\xec\xb1\"\aZO\xeer\xbe!;\xea\x88\xe7\xf1\x93`\xb2\x16<\xe0A\xce+\x99\xf2\x8c\x95\xdb`\xb7\xe4s\xe4\xa7\xfd\xees\x99o\"|=\xb9}\x10\xe9\xe1\xc06\x94\xc7s\xce\x1e\x82\x1dLtJB\x94\xd56\xe7l\x13\x0e(\xb2\x03\xa3\xd8\x17\x14\u007f\xb5\x8c\xfa>\xee\xcbM]\xb0\xe1P\xffNL\xdf\xe102-\xda\xfe@w11\xcd\xc9-<\xcc9\xfe\x90\xdd\xe4\xbf\xdf|\xf8\xe9\xdd\xfb\x1fi\xa8\xf4*LpC\xee=&\x89$\"~ʷ\x91\xa4\x94\"\x17\xc14\xb6l'\xd5C.3`\xa0.\nJ\xc5\xf2\xeb9t\xcdҊ\x05\xb3\xb9\xe9\xe3&\x12\x8d\xb4&YZ\x14\x11\xce{JT\xefW\xe7\xbd\t\xeb\xe9\x0f/͈\xaf\xfbF\x90\xbaw\f\xbc6\xa3\xbe\xe9\x1fE\xf2\v\xe3\xe0\xc3\xe9\xd4\x1d\xe2\xf5\x85},\x1e\xa1s*\xee\xd59\xaa\xa0{\xb2\x9b\xe4pdR\xc9ؙ\x14\xed@%.\xbe\x84\x9e\x1b\x18\x95\xcb\xc794\xab\xb7\x8db-\xef\xcd\x10\xf5A+\"\xbe\xdeM\xf6)\b\x9cU\xf0\x00\x9f\x0eFyQ\x83`+Y\x9a\xed\xe8n\xb2-\xc5\x1bl\xf5\xec\xa1Y\xb9\xd9@I\xef\xd5F\xa8m\xc7s\xc5'\x05\xe3\xf7r\x97@\xef\x1av|T\xc70_\xc4\xe84\xa9\x17,a\xa3Q\f\x94\xac\xd8\x1a\xe4\xc3\xe3\x84\x15\x15Sg8\x87\x85\xf1\xa4\x00)\x89\x19\x00\xb3\xe5v6\u007fl\x0e\xa3\xd7\x04\xff\xc2\fV\xbc\x1c\x19ا\a\x98&+\x8b\x02\xf8}\x99\xfe\xd5\x1a\xe8oĭ\x19\xc1Ż\xcc\f\x80\x0f\xdb\x1e\x0e\x1d\xa1`1\xa2\xfax\xe4\xb1\xf9\x04\x86C\x9d\xfd(\x8f\xc1rL\x93|Q&%\x10\xcdh\xbd\xacW\xe5z^\x92\x14~\xa8/\x00C~zR\x12\xfcL\xc3\x0flSg,\xc0\xe3\xbb?\xc8\xc7 \xc5\x19\x038'\xbb\x80\x97`\x13r\x99\xa7E\xf09-j\x16\x82\xae\b\xd5]\xedZ\xb1)\x90\x1b\xfe\xf1\x9cy<\r\x86w8ᜮ\xd61\xd1[\xc7\xc87z\xf3@Y_\xe47\xa5Q\xee\xf3\xab\x98\xdcFN}\xcd\xfb\xd7_+a\x0f\xd2X\xeeD\xf9\x10\xa0\x95\xfa\x19\xac\xce\x1b!P\xb8\xc04\x85]\\\xe6+\x10\x0e\b\x05\xfe\x9cP\xeaIj\xe4\x05\xfb\x8e\x1fQ^\x02\x84%\b_\xd5k8M\x8d\xb8\x04\xee\xb6f\xfdC~\xbf\x93\x86\u007f\xf1\xbb\xb0\xfd\x0f\xb1<\x1e\xa7\xeb9\xfe1,\x8fǉ\xe1\x17ާ\xcf\xf2\v\x06|\x03\x8cn\x98\xec\xd3n\xa5\xb3\xb6\xff\x99Z\xc3\xf0\xaa\xdc3\xe8\xec\x8d\x13\x9e\xc1\x93\xe6y\t'\x8f\x93\xc14\x9ek[}\x02\xb3\xaf\x17/`>\x98\xb6b\xbd\x87K-\x0f\x02N:6\x8aͣ>b\xd0\xd4\xf4\x10c\xa9\x18\x0e\x99r\xc5h\x98b\xb4\xdaj\xbb{\x14\xdb\xd1o)\x84\u05fb\tg\x00@X\xe4/\x1b\xc7\xe8.p\xe5\xcfL<\x02E`\xa6_\xb6\x10\x83i\x8f\x850\xd6\xe1\xefP\x1ck\tSc͔\x8dĽg\xeb9#\x03\xb4\vf\xe3\xed\xfa\xb3\xc4\x12r2[\x88\xa4\xf3\xc7\xdf@\xfa\xecw$\xbd\x87\xf2\xa9\xa5|\x86\x94\x03\x88\x92)\x00\x1ae\xa0\xb2\xa2\u07b4\x9dۙk\x1b\xcc\xe6Q?\x89p\x8cw\x13e\xfe\x90N\xd4%8\x16\xec\xcb\xfb\xad\x9agA\xa7j\x97s\xfe\xb9\xfc\xd4]\x03\xb9\x174\xd5.ڝ`\xf2\n4\x8c\xb6\x1c\xa8t\aO\xf9\x94\x96\xa2ZR#\xb6\x94s\xbe\x92\xebظq\x8e\n\xa6\x16?\x14u\xf6\xa9\x9f?;\x9f\xe7{A5q\xd0\xc3\x0e\xd0\xf5\xa5A\x9en[\an\x86\xa1Q\xf8\x9f\xe7\x87\xf2M\xdf\xc0}\xfa\xa5\xff\x10+\xf5\x18Ϯ\xa7\xa0\x03\xf8\x938Ւ\xc3!6\x06\xa0cO\xdci\x10\xf5\xb6\a\xa0\xab\xb79Η\x82\x9f\x00\xfc\xec\xa0A\xe1\xfc\"\a+O\xd8\"\x87m\xcd)\x1cs\x84\x03\xbdƫ\xcf^\x80q\xaf\xa94M\x12Ջ\xf4xDȁD\x0f\x87\xb9i\xc5jn\x0e\xcc\x00\x8cs\xfb\x9a+\x11\xe4\xfc\x19\x11h\t\xfc\x1e\x02\xc8\x17\xec\x9f!\x80t\x01`\x04\xe8\x05z\xea\xe1P7\xf2gدv\xf5v[\xb0V@b\t\x86U\xd1g\xbe\xcc\"\x1c\x1da\xe1@m\xe0\x0fCd4M`_\x93\x1c1\x1b\xb0# 8,\xf7\xd1\x14\x101\x91p\xa0q\xc7\xebU\xbe\xa65\x9e \x82\u007f\xa9\x80gK`\xad\bL\xf7\x87\xe2\x05\x14,\x8fG\xb1\xfc\x8d\xa6\x82\xaf\x1c!\xb6\xd3x\x16\xaf\x11\t\x1bY@7\x1d\xf9\x01\xa5?\xa4R\x9d\x12h\x9a#SA\xf4\xf3\xfd\xb9\xe9}\xc6\xe7*K\x10\x9d\x1b\x11\xe2\xc5'O\x8a\xc29'ʎ\xcd\x05\xc9\x04N\x93\xa7s\xbb\xb5\xa7S\xac\u058e\xfa\xcc\x19D\x1c\xa6?l\x85t\x0f\xa8\xb4\x02\xc4\xc1\x94k\x10\v\x06r:\v\xc0f؋-\xc4\xf1Ⱥ\xdf\xc63\x87\xac\xb5\x81\x1dK\xfd\v\xfe8\xd4\xe83֘u\xdf\x17ѺW\x06\x85)\xa8E\x9fN\x0e\xd0\xf8\xc2R\xeakT\\6Rb\x18W\xe1@\x88\xc7\xf1\x01xNxT\x03vK\x11\x15\xd4*V\xba\x17e}\x80M\xd9G\xddm\xd9a\x84\xae\xac\xf2\x92\xaf\xc4Z\xe3\t\t\x81+<Pз\x93\xf3\x1d\xbd\xc3U7\xa9:ee\xcd\xe5oYc4ҳ\xcfN\xb1Q\x17\xb6y\x87+\xf4\xe0Ү\x04Ȭ1\x1750,\xd5!\x82s\xeb\xacF\n\xe7I\v\xa4\xa4\xf9(],\x16\x80\x0e\"\xb4 \xf1\xebz\x99\xd3r4\x9b\xa7\xb4<\xf9g\\\x96\xeaH\xf6&\x1d0~\xd4\xc9\n\f\r\x8d[ĤBߡo\x1c\x97\x8d9c\xdf\f\xccWkuB\xf2\xbf\xb2\u07b5\x8c[\x9f\xf6On\x1bs\x87?\xcc\v\xe5MS\x01\xcb\xc1|g\a\xef\x02\xbe\x01\x14\n\xa6\xa8\x81\xa2\xfd\xc6\x13t+\x92\xa6\x05(\xb5\x8e=\xc0\xb9\x02\x0f\xb0\xd6\xdeUT\xb8ʎ\xa5\b\xbde\xfa\xe9Š\\\x9f\xa2\xb9g\x9d\xf8j\xba\x9eO\x17r\xb9\x02\x84o\xa4LШh\xa0\xa2\x02\xbaK\xb3z\xfd={\xe4\xacW\xe4-3\x9b\x83\x99Rs\x16iu\x11'?Gfc\x14=Bݲ\xee\xab$\xd3\xd8 r%\x1c\x00v\x18xn\x00м\xc8F\x87^\r\r\xf7\x87\xb4\x05\xec\xfb\x81\x8f\xd3;mv\xbav\x98hK'\x11\xc4*\x8c\xaf\ai\x05\xb7\xa84(\rXS\xb1ws\xdc:\xe7\xacNj\xf0[ꨁ\xd7\x06d\x9b4G\xa5\x88\x8fG\xfdd\xf0#\xbcY\xcae\xee&.\xe29\xf42$\xcdѶj\xcd*\\\xae\x82\xa1\xe1\xda\x16\xa9\x94\x8c\xf7öG\xc5\xd3`F bU\xe0\x10\xc2\xff\xb2\xbe \xa3M\xbe\xdd\x02\x00\xe4\x19\x86wg\xe8v\xa6\xf7\xaa\xe6\xf9\xafT\xff\xd4]\x1d6\xa2;K\x8e \x9bߗe\xc1R\x84\xc4\xc7#\x04\x00\x02#T\x88\xa1\a\xb3\x98h($\xc0ϞYs_\xb6p\x1as\xfc\x93\x82\x94\xcbF\xca\xe5\"MR_\xca\xe9\x1a\xbd\x91\x8cS\x8d\xdd\n\x00sVp0A\xa1RE\x01\xfa2=$\x03\x1bY\x90\x14\xf7\xb1\t\x01r\x92ů\xa7\by\xf4\xd0\f܄\x9dĀ\xab\xa6s\r[\x85\x9d\xeb\xee\xfe\xd4F`\xbe\xc9\xf1\xe4\x8d2\x8c\x1e=\x11\x0f\xa6\xf8?l\x959ј\xf1fY\xc7d\xf5\x8b\xd8\xe1-\x94\x10\xc4&\x9d\xb4\x02\xd1i0\xdeM\x83\x99\x94\x19\xa6\x91Ph\x83&\xc0\x02;\x98\xc7\r\x8e\x03\x00\x9b\x88E\x8a\x87\xc2\xf5p\x8b\x80ȡ\xb3څ$\x05\x91\xc3VJ-\v/o)\x91\xa7F\xc5Z\x1ci\xcaA\x14\xe7J\xd7\b%9?\xcc\xe7j\xdc怛@\xea\xaf\xf9\xe1o\x90\xa06S\r\x11\xa1\x16Z\xe8\xd2U\xa64\x80\xae\x15CYi\x84)@\x8a\xd4b\xb5f8kg_ʻ\xb3\xf4C\x0fA\x80p,A\x02\xe0Υ\xfd\x93K\xb1\xc2\xcd\x03\x84\x81\x01\xb4~\x007\xb1V[\xba\x9a\xad[K\x1b\x85}9\xe6\x1fό\a\x9cv2\xb1\xbas\xc8\xeb\xfd\x1d\x13M\r@X\xb3\x88\xf1\xaf\aW\x14s\x04I\x01\xad\x90\xe0\x1dab\x98t!\x96\x9e\x13\xaaG\x02̛Nc\xd5MV\xc0\x8erS'\x0eP\x1a\xd7\xf4\xeeof\x87\xfbY\b\xcb\x05u\\`\xd1BQ\xc7F\x020\x90\xa61G\x00\t\x8f`\x8b\xc6c\xb6\x80\xfd~\x99:\b\x0e\xee\xcf\x1d\xfcY\xae\x8f\xce`EI\xf9\xf1\b\xae\x98NcP,0\xf3\xb3&uL\x9d\x98T#cy\x11Er\xcc\xe3k\x88\n\xa0\u007f\u007f\xa0D\xf8\x88\x8aXEE\xbc\x89\x83\x94\b6\xbe!B\uf075\xa4\xbe\xec\x06a\xb8\xdd\a\x8c\xdcT\x17J\x0f\x96Ճ\xcbS\xf4\xf9\bcFZU\x84\xde\\f\xf8=\xcc\x1b\xec\xebJb\xd1\x0e'b\x9b\xa0\x04\x17\xef0\u007f\xd8\x1c\x9c\v\xc9\x16\x8f\x19\\6ꖻ\x98\xf3\xd7M\x8d\xc4\x16\xe2Ό\r\x80\x9edӔ\xf1\xa8W\xd2\xd3\x19^\x8a\fl\xda}@\xcf\x12cC\xcd\n\xf5\xf3+8#\xe6\xca)\x00\x1b\xf39\x04!\x16\xa2\xb6@\x9c\xb5\x8d=\xa2N\xce\vB\x8dɘ\xaa N\x87\xa0\xa8'\x100S\xe6\x0e\xb2\x01&\f\x10\t\xec,F\xb8\xaa\xd9\x18t1\x1a\xad\xb5\xefM\xc4\xeb\xae\xde&\xb1A\"\xdd\xfe]Yc1\x15\xa3N\xa2\xf5컢\x873D\rg\xee\n\x95h\xb6\x80\x98\xb3Q\x1c\xa34f\x1e\xa77\x87\xb4\xaa@o\xec\xb4\x01O\xf7\xac\n5\xe9\x12|\x16[\xc8D\xa2m\xf6\x98\x93k\xa2\xa2+MU\x84m/E\xae\v@l_\xb6C\x90&Pn\xe2J\x1b\x8e\u0086C`\x80\x85_\xb9\x94>\xf3\xcd\xce\xcfY\xb3\xf3\x18\xeaaI\x0f\x80\x90>\xa4\xfdC0\x9b\x91\xaf\x9bHW/\x82\xce@\x19\xf3\r+\xd2\xc7ߚ\x98\xb4\x93TL\xfe\x9c\xef\x19@\xc0\xe8r\x1d\x11\x95Z%\xb95\xae\xde0p\xd9\x17\x10#\xd2`F\xedȊ\x93\xd9\xfa\x92\xee\xa3mP\xd3\xe1\x96Jٓ\x8eq\x193\xc4\xd0H\x02V\x85\xc0\x1eF\xe8\x00uHV\xf8'\xbeT\t\xa3t\x93\xf3{P\xe2\xc1\f\x82\xc2݄\x97\x0fQl'h\x0e%\xd6^I\xaa\x90g\xad\xbeŧ\x9e\xe3\xa3᠙$)!\xb8\xb0\v\fp\x01\x18^\xd2LS\xb2\xa5r\x1ce\xe3\xd2I\x96\xe9\xbb\x03u\xa3gd\xba\xa0\xdb\xe3q\v\x01[\x94\xc1<\xc2J>\x8d\x1b\x0e\xb3g\x89\x8c\xe7)\x12!\x05DF\x96M\xf8\x9eRo\x1f\v\xb2\x05U\xc9Oz\xab\xeeʺ\r\xae\xcedKJ\xd2\x12c\x8b\xe9q\nh&\x1b\x0e\xb3\xc5t\xc9ګ\xc8q\x16\xcf#m\xf2\x88P\xb2\xb0\x84\xe7H8\x83W\xb9#\xbcW\xbe\xf9\xb9\x8cR'n\x83ŇÁ;*LɢM\x05\xf0\n\xf4u\x17w\v\x93R\t\x02\xaf6\\Jd\x1b#\xab\xe1\xa1+\xf2<\x9b|r\xe8\xf2\xc2Q=\xd9\xf8\xb3\xacXW\xb6\xdc\xe3V\xd2&4~\xd6|K\x82)]\xb9\xee_.\x11X\xed\xc3\x1ebm\xee\thS\xeb\x04\xa7\bJ\xb7\x92\x89~)\x9cq6\x1e\xf3׳\x8b\xc6˔\xf0\x94!g@e\xafa\xeca\xc8\xcd\r\xea\x04L]\x98]\x1a\x9dR\xf3\x97\\U\xa1\xed&\xd9\x05\xd1\xdf\xeb\xa4N\x17\xc8\x0fZ7\x13\x1a<\x0f\x9f*\xeb\xfdU\x0e݆G\r\xb0\x06\xa8\x10\xf0\xb8ɽ\xb9\xb0E8I\xaa\xa8Eg\xa7\xfa\xf3\xdb\xcdu\x03\x8ch\x9bpK\x033\xa1|/DN\x9eÅ}\x85\x9fu\xb3W\xca\xf1\xe7⟶\x80\x9a\x9f\x98e\xda\xeb\xe4\xfc3\x13\xf2\xc2B\xe8Y\xbc\xd54\xfa\x17g!\a\xc4\x18\x18\xe9\xac1\xd1\xce\xd6-A\xd9y\xb1<\xb7grWn\xaa\x1e,ӷ\x05>^\x04\xad\xee\xdd\n\x9d\xbb\xd6\xc5\xd7/\x92\xb5\x80\xebeU\bx\xe2\x15#\x80\xab\x99o\x80\xce\x02b\xe9\x01\x05\xa6\tUD\xca8k\xae\vaI@\xa5u\x91\xd4\xd6͑Cީ\xd8y\xd6\x17\xd3\xd6\xe7w_j|ש\x1a\"\x19\xf7\xcd5\x12\xa6\xa5\xe4\a\xeb\x00\x1bս\x0fU\xfe\x80P\x04\x03mw\a\xa6\xa06=\x85\xe9\x92>\x90\xce\x15\x96u\xa2r\x12\xca`Ϸ\xb4pa\xd2\"K2U_*Vٚh:\xec\x92HG;\xd5Q\xee\xf3\xde\xdb2]\xeeP\xa7Mi]zWw\x18\xd5\xc9\xe1\x17\x88'\xfa\xee\\\x9ct\x83\xe8\xf3\f\x00C\bsj,?l\x8e!\xcb\x00\x9b\xb4.\xe4o\xb3(\xbe\x1a\x81\n\x9d\xa7X\xe0\fj\xa8ix\xf1\xf1f\x93\xc5B\t\xb2\x98k\xe0\xadͪR&xƳ\xdbV\xa6\xac(y\xafc\xea^\xc6\xf2\xd2\xef܂\xfe\xb9=%\x11\x9ck\xcc\xc6+\xf0u\xc9-\xe2D\x84\xeb \xf1\xeeBZϏw\xa7\x80\x888V\t\xf1\xed\xecZz\x8a}<\x9a䬓\x1c\x0eL\xdaw\x13UQ\x8d\xbb;\x881\x91\xdd\xcf\x12\x8c\x9d\xfbl\x02\xb0\xc2\xd6\x16\u052d0 \xc1\xbc\x90\xde=\as\x15\xb0\xd6\x17\x00Õη\x04\x1f\xd8\xfd\x9b/\x87u8o\xbdժ\x04o\xf5\xf00\x1c\x01\xb1\xf0W&\xadn?\xaaL\x81\xeb6\xe2\xaa>\xb8\x1ca\x05r$\xe7SU\x84XήGF\x1as\xd5\xe8\xce\xf2\x17P\xf8.\x01&-\xeaM\xad\x06\x9e0ˢ\xbb4Y\x16\x90\xec\xd9;\xd90nu,olv\x8e8\x01\xd36\x18fx6\x87\xe9g\x99\xe8\xd4\x1e\xc7\x13SIQg\xb2\x14\x00J\xa5\xff\xacn)\x01\x9f\xe5p\x18z\xafCm\n\xba\xaf$\xe0\xb7\xf6yO\xc1F\xa5\xfe\xe6\xaa$\xa2ס\x84\x0e\xa5ߡ\xf4o\xad\xd8j\na\xb6\xf0\xa6\x91\"\xd9\"aN\x8eJ\xff\xd7!\x96\xac\x95\xb2f\x0ei\x81=ːW\xf3\xa8n\x11&\xd9x\f*\xb6\xa5wp\xf4\xc0\xb0I\xfc\xa3\xf3\xcdq\xd2\xd8Q\xbcn\xdb\xdc&T\xb3\x1e\x9aY\xcd\x17\x197\x95\xa8̟\xbd\xa2\a\x9c\x16;\"\xc0\x90\xa4\x02Fq\xc1\n\x17\xac\xbc\x05]\x84w(\x0f\x91\xe2T\xfdn\xd5\x1d\xce\xeaͯu\xa7\xae\xe3N\xed\x9d:\xa0`'Mz\x1f:\xe3]\xbf\xe7s\x9d\x83\xa9\xf58\xd6h\xe8B\x84>\v\uec69K46\x10\x15\xbd\x95CS\xe6P{'5\xa7FR\xf2µ# \xaf`8g\x8fM\x1bD\x03\xb4'hV  \xd80L\x14\x19\x9eL\xe5\x11B\xa9\xb3Q=\xfb\xefl\x84\x1e\xfc\xbe\x9bn\xb5(\xc4\x1e*Ca\xe8\xb2NTՕ\xecI\xa3\xea\xb6\xc6`\xc0\xed\xc5\xd6h\x15:ф$\xb4j\fM-@hhs\x01\r<\xf1\xf0\xa3mO\xb8n\xe5\xabw\xab0\xaf\xc0ެ\x1bښ\x8duv\r\x96w<B\xe7Q\xb8\x0eO\xba\xe0\xecmP\x83\xa5!`j}\xeaw\x1ez\x97B\x95s\xc3\xf2{L\x1a\xee\xade\xb9\x9e\\\x83\x0e\xfb\a\xb5g._h\x8d\x91\x1a\xcc\f\x85o\xb1(\xd9\xeb\xc0\xec\xb7\b\x11\xcc \xaf~L\u007f\x8c\x00\xf2W\xecmQ\xa6\xca\xeb\xea݃\xf7\x97\xfc\x9f\x16\xb2\x9a@\x9bc=\xc2\x18\xd4\xde\xe21\xc6\xcfSpO&\x8e>7\xc2\xe7\xea\xf3c\xddIZ\xb5ʟ\xd4,\xfa\x8b\xbd\x16\u007fiY\x13@\x11%\xfb\xcb7\xd6\x06xW&\xb35N\x9d~\xe1\xe5\x9fK\xbe\x05\x9f.{\x8aHxy^\x12\f\xa8N^e\xb3\x97\ns{\x0e\xadlo\xfd\xafg\xf2\x93^\xdf/\xc7\xc6O'\xef\xce\xd9\xf376\x1ap\xb1\xe2\xebө\xb9:\xd6s\x1aM\bԎr\xce\"\xca^s&.\xa2Y\xa6\"#\xaf\xd2U\xab2\tf\vVS\x15!֫\xd9\x1aM\x0e\xf8C\xd0\xdb(צ\xccY\xaf\x93\xb3^\n<\xe5{V]\xb8\xb0\xaaM\xa9_Z\x8fc{\xa9\xbd}#c\x9ap?X\x93\x80TZ!\x9a\xbeX\xf4ܭF\xa9\xab\x06\xbad\xc0Gj\xd1mQ\xc2\n\xaain&\xc5\xff\x8a\x95\x82\x91)\xdb\xf2\xf2\x81\xa25\u0086gH\xdd%D\x14 ~\x8f'\xf7:\xdd\x12\x99\xf2\xf8-}\n\x87\xe1<\x1c\xa6\xfbC\x02\xb6\xec5\xb6\v\x89\xcd\x056\xef\xb1y\x15^A\xf3\u05faT\xef\xaf\xf0\xfd\x1f\xbe\xbc\xfa\x13>\xfc\x9f~\xf8\xb7i\x12\x9e\xc8\x03\xb5!ht\x1b\x93\x8f=zУ=\x98\a\xc1\xc84\x8c\x96\xf3p\xd4\xdc\xec\xf8X\xe6<\n\x8fa<\n\xe3\x10\xe2:meuDl\xdb$\xbc\x0f\xcf\x13\xe5\xfeٴ\xf7\x18B\xa0\x13\f,8_\xc9*\xa9\x91\xb4`\x87\"\x05,]\x13\xbc\ue8ee\t\xb1*K\x0f\x8c~D\xfa\xb1\x82\xeb\x9e\x1fb}\x91\x01\x02\x8a\x97j{\x81\xb6\x06\x89\xb9w\x85\xf1\x81g\xd7\x1c.Rw\x81\xe4:\xc2\xe2\x98\xea\xfb\x05t\xc7V\xde\xdfmz\x847\x1a}\x19\x85\xa1Kd/9`S\x15\x97K\xb6\aN$\xfb\x89I\t.\xaa\xa2O\fs\x1a\xf0f~\xfd\xfa\x8f\xd1ꦺ\xf9i=Z\xc6\u007f\\\\\xdf\x13Uw>\x94\x85\xf9J;\x9f5\xc7\xf8e\xdc\xfe\xa2\x89\xfc\x8e^G\x93\xf8\u007f\xaf\xc9'P\x1cԄ+P\x82\x9b\x1bh\xc0\x1fh\th\xa1k\xbc\xe1\xd0@\xafyS\xbf\x9a\xbe\xfawxп\xe6ŷ\xe6ŷ\xa05\xef\xe9\xf5\xcd\xcd\xf1\xeax#\x8e7\xfc\xa8\xfb\xeb\x9fo\x81\x9e\xb7}~\t\xd6\x1a}B\xab\x934̟\x9d\xdc\x01\x1c$\xa1Β\xc0\xeb\xfcM@\x88\x11\x93\xec\x11\x9b\xbd\x17d\xb4k\x15I\xa3\x0f\xc7\xe3wx\xb3\xae\x16\x19#\x91\x9cx\x12\xec|\xb1b\xf7^\xaf}E>\xfeK\xa8T\xd6Tl\xc2\xdb\xdbÈ^\x85I\xa3\x8b\x8c\xb4\xaf\xc4aB\xb7q\xab#j\xe3\xbf\x1a^\xbbA\xef\xc9[\x9c\xb2\x1c\xb9\xfc\x91XB\xdf\xf0jtã\xe8\xf6V\xd2(\x1c\t8G\x807PW\x97WW\xf3[\xc3\x1b~\x8ec\xe8\b\x9b\xc9\xceG\xb1\xce(\xe8\xac\xfb\xa6x{\x02{'\xb0գt\x04;n\xb8\x01a\x03@p߈\x9c\x80X\xf3\xf4\xae\xd0)\xe4\x10/\xa9D\xe0\x9a\x8fǧS\xfc\x84\xa3\xf3Qx\x82_\xcc\xf3\x86\xca(\xddJ\x02\x93ѫ+\xf8\xf9\xd8\xfd\x87zJ\xa0$\x1c\x85\a\xc0c-\xaf\xa9\b\x80\x11ݬ\xc2\xd5\x15\xa0\xf0Ĭdd\t}\xf1M\"ţ\xb9<\x886\xb2IcxD#V\x84}\xbb\r\xf1\xdfie\xe8\xe3 \xa8yҵ\xab\xd2l3\xcdIy\xea\xd40x\xf7^\x93ʌr\xb2\x03\x93\x9dѳ\x15\x12\a\x0e͔\x0e~\xc1>d\xb0\x0fNV!)\x94\xbbߥ9\xefs\xb6\x11\xf7\xb2j\xb7\xba\xdb`\nۢ\x14\xfcM\x1f\x85\xfa\x9f\f\xaa\xaeK\x1c\xaf'\x8f\xd0.&X%\xff\xd2Yɀe/'\x88\xbe\xdd7\xef\xda\xfc\xed\xf0Ʈ\xb2\x81\xbbf\a\xf1\xddY\x1a}\xd5\xfaW\x8b\xcef\xe6M9\xda\xc1`\xf2Ɠ\xa7p\x05*\x84\x95\xfaB\x88\xa27\xda\xc5\r\xa6\x87\x90\v\xb6\x10CL\xf8\x11x\xf1\xabB\xe8^\xed\xf2\xad\xc4\xdfR\xa8\x9f\x03\x1e.h\xd4\\\u007ficz-^\x01\x86\xa7\xc5\r?\xe3F\xd0\x163\xcdfhR\x85ϋ\xa1a\xa0\xfe\xf1\x91\xa5@\xa7_0\vc\x83\xfd\xe3q\xc3\n&Y \x00۴\x05\x10\xeb\x90\xc1p\xaa\vt\xc0\x03\x1e\x13\xe4IM\xf8w0b\x88\xf6\x97\xf2S\xfc\x8e;\xbf\xa6\xa9)i\x8e\xaaJ\xad\xf7Lښ\xe0Dz\x02\r\x8d\xb8\x87C\xfd;I\xf7\x1b\xdb\xd6\xffLU\xff\xa3\xe0\x10\xa3\xe3\x9e\xcbW\xa0\x06qCw\x9c|u}\xfd\x87@\x1f\xab\x1f`M\xb0\xf6\xbf|\xf8O\xda\xcc3\xde\xe7\x1c\x93\x93\xff\x1f\x00\x00\xff\xff\xe6>\xe5\x1e\n=\x00\x00", size: 15626, local: "web/static/js/underscore-min.js"},
 
@@ -227,13 +229,13 @@ var data = map[string]*file{
 
 	"/partials/ack.html": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\x1c\xcbA\n\x830\x10\x85\xe1\xab\f\xb3\xb1]\xd8^\xc0\x11z\x81\xdeᙌ6\x18c\xc9\x04J\x11\xefn\xc8\xe2\xc1[|\xff\x00r\x11f\xc2SIT\xd7\u007fsؐ\xff\xed\xdbƔ\x96\xde\a\xc3\x14\xd5\v[A\xd1\xc7[տ\xdcJ\"4#\x9a6\xf4\xc9:\v\x1f\a\\\t{\xbaupkw?O\x1e\xabL\xfb\xaf\xe6\x8b\x0eO\x8cW\x00\x00\x00\xff\xff\f\xd8ٝr\x00\x00\x00", size: 114, local: "web/static/partials/ack.html"},
 
-	"/partials/ackgroup.html": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xa4TMk\xe3:\x14]\xe7\xfd\n=S\x88\x03\xcf\t\x94\xae\x1ev\xa0\x14\x86Y̮\xcba\x16\xd7\U0008d949\"\x19In\x12B\xfe\xfb\xe8ˎ\xd3&\xedb\x16\xc6\xfa8\xba\xe7^\xddsT6\xfc\x8dP\x01\xc6TY\a\x12E\xd1j\xd5w\x19\x91ma\x98\xdaWY\x98\x9bl\xfd\xcfl\x8a\xa5\x02Ao\xf8\xc1\xaf\xcfJ\xf6\xe8\xf15\x97M\x95\x01\xddfc\xc4^\x88B\xe0\xc6f\xebr\xc5\x1e\x03\xd68\x9a\xab}\xcd[fC\x9c\x99A\x81\xd4\xfaQY\xebu\xfc\xf7֪\xf1@m%q_\xd1\xe0\x06za\xc3\xd8\xecB\xb6Tp\xba\xad\xb2\x18\"\xb7\xba\xc7E\xb6\x06!\xcaU\f\xf1W\xe16 \x8c\x8f'\x95\xc4i\xc0r\xe5\xcb\xf9\xa4.\xb2\xe7\x96\x151\n6\x93k\x05y|\x1dVCj\x1eH\x06\xe0\xd5\x15\xc0\xfbt;\xcdw\xa0\x8f\xd3t\x99\xc6M\x95\x9dN;W\x06\aj\xb9\x92\xf9ܵb\xbe8\x9f\x03\xa0\xe1\x06j\x81\xaeA\xffR\x90\xcft{aw0\xa9\xf6n\xafu\xb5\xc1\x1d\xce=h\xc9e\xfb%'\x15\xca\xe0\x1d\xd6\x17\xbfw\xe1\r\xd0\xfb\x8c\r\xc8\x16\xf5\x97\x84\x1b\xa5[\xb4w\x18\xbf\x85\xcd\ve\x04\u007f\xc2\xf9Q\b\x03)\xe3\xc6*}\xcc=\xd1\xfa{\x9c\xa4@\xa3\nʕ\xb3\xc8;\xa7\x04W%I]\x16^\xfc8\x0f\xdeZ\xbeZ\xb0\xbdY\x04\x8c\xc6\x0e\xc1&\xd7\x11.I\xb2\x9f\xdf㒻\x1d\xde\x1cHE\x1e\x9c\xd70\xb9\uf0c5\x19B\xe3z5\xd51UB@g0w\xc7\x17Qp%{\xba>f\xb9\x15\x18\xf7f\xa5\x80\x1a\xc5\r=G\x85&\u052c\xe4\xb2\xeb-\xb1\xc7\x0e\x1d\aC\xba\xad\xd5!\xbbao\x9f\xcaN5(Ri\xcb\x00N\x8e\x18r\xf4\xbf\xfc\x01\xdfP\xda\xffR\x85\xf1V(\xf3Z\xa8\xb2\xbek\xc0b\xbe\x18\x92\\\x85,\xd3\x04\x88oՐ\xd8Ԏ\xad8v\x8cS%\xa7m8\xcd\xc7\xe5\x02\x0fnq\a^P\x85᭜\xff\x1f\xef}\xf9\xecD\xf6\x86g\xff|\rF\x1f\x83\x8f/^\xeab_\xff\x0e7s\x03z\xe3\xb9;\x9d\xe2\xb1\x1f(\xcfg\x02\x02\xb55ӓ\xa3FW\xec)j,J\xebF\xbbk\xd5\x1c\xc9\xfbǛ;\xd1\xfa\x97F\xfet\x1d\xff\x95:\ue386\xe0W\xd2\f+7\xe5i\x9c0q\xe9\x86v\xd4\xe8\x00\x1etJ\x19\x17ͨ\xd3募j\x94\x11gM\x11B\xa4B\x86\x02\x06\x93\xc4\u007f\xfc\xfd\t\x00\x00\xff\xff\xcf\xcfw\xbf\x88\x06\x00\x00", size: 1672, local: "web/static/partials/ackgroup.html"},
+	"/partials/ackgroup.html": {compressed: "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xa4TM\x8b\xdb0\x10=\xa7\xbf\xc2\x15\vI\xa0N`\xd9S\xb1\x03\xcbB顷=\x96\x1e\xc6\xd2ĞF\x91\x8d4\xce\a!\xff\xbdH\xb2\x1dg7\xd9=\xf4\xa4\xaf7\xef\xcdHo\x94)\xda%R\x83s\xb9h\xc0\xa0NK[\xb7\x8dHL\x99\xba\xaa\xde\xe7\"\xac\x9dX}\x99\x8c\xb1R#\xd85\x1d\xfc\xfe$\xab\x1e=\xbe \xa3r\x01r#\x06\xc6V\xebT\xe3\x9a\xc5*[V\x8f\x01\xeb\x1a0W\xe7\x96ʊ\x03\xcfġF\xc9~\x96\x15v\x15ǖ\xb9\x1e\x02\n6I\xc1&U\xb8\x86Vs\x98\xbbm\xc8Vj\x92\x9b\\D\x8a\x19\xdb\x16\xe7b\x05Zg\xcbH\xf1_tk\xd0\xce\xf3\x99\xda\xe0\x980[\xfar>\xa8+\xd9\x13WidA5\xbaV0\xc7\xd7~7\xa4\xe6\x81I\x0f\xbc\xba\x02x\x9bnci\v\xf68N\xb7\xb2\xb8\xce\xc5\xe9\xb4m5\x13H\xa6\xda̦ 7\xd3\xf9\xf9\x1c\x00\x8a\x1c\x14\x1aU.\xbeJ0\xcfrsQ\a\xb91\xf5^\xa3*1[\xc2\x1d\xcd=XC\xa6\xfcTS\xea\xda\xe1\x1d\xd5\x17\x7fv\xd1\r\xd0\xfb\x8a\nL\x89\xf6S\xc1umK\xe4;\x8a?\xc2\xe1E2\x82?\xd0|o\x84^\xb4\"ǵ=μ\xd0\xeag\\tD\x83\v\xb2\xa5\xa2ݛN\t]\xd5Y\xea\xb2\xf1\xe2\xe7\xb3\xd0[\x8bW\x06n\xdd<`,6\b\xdcu]B&\xe9\xdaϟ\x91!\xce\x05\xa9C\x92'\x0fd\x14v\xdd\xf7\xae\x85+\x04E\xa6\x1c\xfbX\xd6ZC\xe3pF\xea0\x17\xb1\xfa\xea\xe9:\x8c\x895ƳI\xa6\xa1@}\xc3\xcfѡ\x1dj\x92\x91iZN\xf8\xd8`.d\x85rS\xd4\a\xf1>,\xa4\xb2\xad\x15ꮴE\x00\xa3\xba\xca\xd1\x0f\xb3\aܡ\xe1o]\x85\xf1Vd彐\x8b\xb6Q\xc08\x9b\xf7I.C\x96\xdd\x02\x12\xffT}b\xe3v,\xf5\xb1\xa9H\xd6f\xfc\f\xa7鰝\xe2Aj\u06027T\xea\xa84\xd3\xef\xf1\xde\x17ϒi\x87g\xff}\xf5\x8d>\x90_~<M0\xbce[\xfcE\xc9\xf3\x9b\x117~\xbd\xd3)\xc6\xfdBs>'\xa0Ѳ\x1bG\x0eV]VO\xd1j\xd1a7^\xbd\xa8\xd51y\xfb\x87\xd3:\x17\xfe\xc31\xbfI\x1d\xfet\x0f\xafh\x17\xc8\xc7\xe1\"\xec\xdct\xa9c`\\hp<X\xb5\a\xf7v\x95\x15i5\xd8u\xf1\xe2\x97\x16MıK\x03EWH_@7\xe9\xc68\xfc\x1b\x00\x98\xf4Dd\x8f\x06\x00\x00", size: 1679, local: "web/static/partials/ackgroup.html"},
 
 	"/partials/action.html": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xb4\x93\xb1N\xc30\x10\x86\xe7\xf2\x14\x96'\x18B(BL\x8e\xa5>\x00\x13\xe2\x01\x9c\xe4\x92Xu\xec\xc8w\xa6\x94\xa7\xc7v\x9a\xaa\x15\x1d\x8a\x80!\x8a\xad\xf3\xff廳\":\xe7G\xd6\x18\x85X\xf1\xb4.\x06\xe7\xf5\xa7\xb3\xa4\f\x977+\xd1\xea\xf7\xb3r\xef]\x98Re%\x8c\xaa\xc1,\xc5ƙ\x02\xc7\xe2\x9151\xeb\xe3&W\xb9\xdc4\xa4\x9d\x15e\xde\xe6\xd8\t\xf1\x10zμ\x95\x98ξ\xb4\x80\x90\x14\xe9\x863\xdb\x17\xb5\xb6m\xc5i?\x01\x97\xa2\x9c2\xae\x8c\xbc$\xba\xbc\u007f+\xfc\x86\xe0\xad\x1a\xe1:em\xa7@,\x19E/\xf8 ~\xa1\x83\xac>\xba\x16L\xc5C\xa4\xf3\u007f\xf1~\x01D\xd5_\xa9\x9dT\x95\aż\xdb\xc5\xea\xfa\x81_\x1a\xfd\xa9\xf88\xe3\xd3ܗ\xf0O\xdb\xf8\xae\xe3\xba\x0e\x81r/gzu r\xf60V\f\xf5\xa8\xe9(X\x93e\xf1)Z\xe8T0\x94\x1d\x1b\xa3\x9b\xedr\xf2\xf6\x8e\xcb\u05fc\x12\xe5\f\xfa{\xd18\xb0\xd9tx\x92\x1b\x03\x9e\x04N\xca&\x15\x1cܮ\xe2[\xd8\xe3\xbd\x01\xdb\xd3\xc0$[s\x89\xa2L'\xe2\xf4b\"'\xc3\xf1F\x8dF*\x82E\xda\x1bhgn\xbcs\x9dh\x1e&P\x14yL[\x96\xa0'\xbf\xc16݅\xd13\xad\f\xe6B\x97\xa2L\xadɯ\x00\x00\x00\xff\xff)y\xef\xe5\xe5\x03\x00\x00", size: 997, local: "web/static/partials/action.html"},
 
 	"/partials/alerthistory.html": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\x94T\xcfo\x9b0\x14>ӿ²&\xd1j2Ѧj\x87\rr\x89&\xed\xbeI;L=\xb8\xf0\x02V\x1d\x1bن.\xaa\xfa\xbf\xcf\xcf\x04b\x12\xba&'\xde/\xfb}\xdf\xf7\xfc\xc8+ѓRrk\vj\xf43]\xdf$q\xa8Ԓɚ}\xfaL\x9c\u0601\x14\n(q\x96\xa1\xc3\xd0[\xe7+_\xbd\xbe\x19?\xff\xbf\xad\xe5\n$\xab\x8d\xeeZJT\xcd\f\xb4\xc0]AA9\xb3'B\x114\x04ؐ\x14J\xf8\x94\xe2; EH\xec\xb3'\xd8\u007f#|r{.;\xc0\x1e\xe7M\xc8Ъ\x82-\xef\xa4\v5\vH\x1a\xe0\x95P\xf5\xa1\xd8\t'!\xb4.\xa5(\x9f\x02y\xc9[\v\xb7i\x80\x9c\x92\x8f\xe4\x83P\x15\xfc\xbd\x1b.Lr\xebO\xe2\x81G\x1f\x1e\xb0R\xaf\bFケg'%3\xa2n\x1c=\x9e\xe1\xd9\x0fa\x9d\xf6l$\xa8\xda5\xbeGJ\xa0\xf7\x04m:\xbb\xeb\xa0\xf0\"\x8fG]\xed\aͶ\x05\xb5\x8d~V\u007fN!?\x8c\x90\xdf\x18ǐ=O\x1f\xf48\x066h\xdf\xf6\xd9O\xc7]g\xeffs\xecq\x86\x13\xa3\xf1η\x85_\x16;\x94\x04\xe4-7^\x88l`\x80ʰx\x06Dx\xfd^^.\xac\u007f}\x9d\xf0$ys?\xc73\x8c~\xca'9'\x8d\x81\xed1\xb04jl\xf0\x95`\x87Q\x8d\xf9\xf0\x93\xf7^\x00Nʋ\x96\xfd\xf2\xdb4\xad\xd5,\x00\xaa\x9a\x82\xdfU5ĕ\xc6\xd5\xf3\x929\xd3\xc1y\xcb|ŏDW\xcd\xfd4\x85\xe9\x01]\xfc\x86.S\xf6!\x12\xf6\xe4\xd7aw\xecK,+\xa6#⿹Q\x81g\xa9wm\xe7%\x14Z\xd91\x91m\xa2 %sm\xf0\x01\x81)h\x8a\x95$\xae\f[\x131MN\xbc\xeb\x10n\x8cp\x8b\b1q\x19B\xac\xbc\x02a\xecDv\xb4\xfe\xa35\x1a\xf3_\xf0\xbf\x00\x00\x00\xff\xffj\x99\xec\xfd\xd1\x05\x00\x00", size: 1489, local: "web/static/partials/alerthistory.html"},
 
-	"/partials/alertstate.html": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xb4V\xcfO\xdb>\x14?\x97\xbf\xc2\xf8\xd0\xc2!\xadЗ\xaf\xa6\xa1$\b\xa1I\x93\xc6v\x81\x9d\xa6\x1d\xdc\xc4M\xbc:vd;Ь\xe4\u007f\xdfsl\x9a\x94f\x1a\x85r\x80<\xde/\u007f\xde\xf3\xc7\xef\x11\xa6\xec\x1e%\x9ch\x1d\xe1\x92\bʃ\x9c\x92\x94\x89\f#\x91\x05\tg\xc92\xc2:\x97\x0f(B\xc7\xf6\x8b\xe3\xa3Q\x98\x9fo\xc7\x18f8\xb5\x96QHP\xae\xe8\u008a\xa3P\x83զ\xb1q\x11\xe6\xd2%\x8eOn\x9ct\x1aάG\xcf\xd7'\xcdx]\xe6,\x91\u0083h\x95\xeb\xc9F\x1d\xd0\x15(\vb\x18Țebr\x81\xb4!\x86N\xc1\xd5LoA\xac4\x1a\x8f\a\x94\xc7\x11\x9a\b\xa9\n\xc2'\r\x8e_\r\xe0^\U000aa801\\,\xec\xd1INӊ\xd3\xe9-\xe3T$4\xfd\x91䌧\xd3+N\x95\xf9B\xeb\x9fC'A\xde9\x13i\x84\x9d\xefm5\xffE\x13\x83\x1e\x1f\xd1v\xf0\xdfA\x96\x15\xe7\x81bYnp\xd7d\x1f\x9cI\x8c\x8c\x86\xde\x00\x9c\xbe\xb2\x97,\x9c\x11{\x95\xb3\xfc<>\ng@\x03\xf8\xec\x90a.Ӻ\xcd\xce\x16\x9e\x06\x000S\xb2*\xa7\xd76\xa9\xa2b\n5g&GQ\x84\xceZr\xf4\x92$\x92\a\xba\b\xfew\xd4\xe8\x19\x94#\xd2h\xc8\xfb?d\xe8\xca\xf8\xca\xe2P\x1b%E\x16\u007f#\x05\x05\xf0\xee\x0f\x8fw8\xfe#\xee\x9a\xfb\xfbA\x9f\b\x88<\xc5]L'\xbc\t\x90#\xd4\xfe\x90v8\xf9\x0e\xd0쵿\x18\x19\xf0İ\x82n!\xbb\x03\xc5\xe1q\xdd\xc9\xca>\x95\xd7\"\xf3\xe1\x87\xc7\xf5\x99i#U\xfdR\\\xad\xc5N:\xb8S;\xec\"<\xcb]\x86\xcb%\xad\xa3\xf5\x1a\x86\x80L\xa9#^\xd3\xf4\xee\xdej,|\xe2\x92\x1f\xb8\x8cO\xabR\xbd\xa1\x06\n\xe1\x97\xf6\x17T07\x92\x9c\xb8\xae۬\xdbU\xd8Gճ\xedSP7\xa8\\\x82\x1b \xdbUb\xe7\xf8\x9e\xb5\xda@\xe4\"\xf7+y\xbd~~\xf2\xf4\xae.i\xd3 ב흵\xe3\xfb]S\x85\xe3y\x8d\x06\xf2X[\xd3\xf4\xc6\xf5\x88\x18\xe4\x12>cr\xffp\xffҺ\xa0\u007fA\xf8J\xb5&\x19\x04]\f\x81\xf0\xd6-\x1c\x87\x9f/\xed\x9eۛk\xde07\x02\xc1O\x90\xd2\x05\xa9\xb8i\xe5\x95\xc6=*jw\xc0eZ\xa9v\xc9Gg\xf9\x98\u0605\x18=UܮǦ\x19\x1b\x92\xe9\xee\xc5\xf99\x01:\xcb\xd8\xf8\f\xe5\xb2R\xef\xf5\xdc\\\xc3_\xbc\x01|\x17\x80\t$Y\x82\xb3\x176ڄKM\x9dމ\x1b\xcbB\xaa\x8c\x9a\xa7-춯\xffw\x06\x96\xee\xa4\x12K!\x1f\xc4\x04\xbb`\xe7=\\\xf1\xe6\xbb\v\xf0C;n\x13Y\x94\x95i{\xae\x9f\xa8w\xdd\xd3mf\xaf\xff\xfc\t\x00\x00\xff\xff\xe4\x89\x0e\xe4?\n\x00\x00", size: 2623, local: "web/static/partials/alertstate.html"},
+	"/partials/alertstate.html": {compressed: "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xb4VMo\xe36\x10=;\xbf\x82\xcbCl\x1fd\xd7mҢ\vIA\xb0-P\xa0i{H\xf6T\xf4@Kc\x8a\rE\n\x1c*\xb1W\xd1\x7f_Pdd9\xf6\x02\xb17\xb9H\xfc\x98y|\xc3yC2\xce\xc5\x03\xc9$CLh\xc5\x14Ȩ\x00\x96\v\xc5)Q<ʤ\xc8\xee\x13\x8a\x85~$\t\xf9\xe0\xfe4=\x1b\xc5\xc5Ů\x8f\x15V\x82\x9b\x19Ō\x14\x06V\xae9\x8a\xb1b\xca\xc18\xbf\x84J\xed\x81\xd3ɍoM㹳\x18\xd8\x06P.7U!2\xad\x02\x89n\xb0\x19\xf7\xc3\x11\xac3\xc9Jf\x85V\x11\n\xae\xc6\x1f\tZfa&\x19\xda٭e\xb6Fr~~`\xf0CB\xc6J\x9b\x92\xc9qKӓ\t<hY\x97\x10\xe9\xd5\xca-\x9d\x15\x90\xd7\x12f\xb7B\x82\xca \xff7+\x84\xccg\xd7\x12\x8c\xfd\x136\xff\x1dZI\xf1h)T\x9ePo{[/\xff\x87̒\xa7'¤`8م\x98~\x9b\xabdK\x90\xa4\xfbFB\xad4\xdd\xee\xb8\xc7\xf8\r*P9\xfe\xa3h\x8auU\x19@\x84\x9c,7\xa4i\x86K\xf5fӶ\xfd\xd6ZU-ed\x04/\xec\xde*\xd7\\Sb1B\xa12\x18\x0e\x0e\x88\xc7s\xe6\xd43/.ҳx\x9e\x8b\x87\xf4l_\x7fK\x9do:t\xb1\n\xca{z\"\xdc躚}r\xa0\x06\xd4L\x82\xe2\xb6 IB\x16\x9d\x1e\a \x99\x96\x11\x96ѥW\xe3`\xc2x\xed\x8e\x0eY\xffD,\xacm\x88,\x8d\xd1\x1a\xadx\xfa7+!\x9e\x87N\xe0{\xd8\xffW\xba\xcd\xe7\x97G\x9c\xf8}U\xac\x84\xe9\x94n]\xb7\x8d\xef\xe2\xe5\xa5|<\xb3\xbdjx\aj.\xfb\xafff1\xb2\xa2\x84\x1dfw\xa2\x84\xb7\xe7u\xa7kW\xa4\xa72\v\xeeo\xcf\xeb\x0f\x81V\x9b\xcdkyu3\xee\x8cU<r\xc7lB\xe7\x85G\xb8\xba\x87M\xd24\xa02\x9d\x83W^\xdb\x0er?P$M}\x1d\x0e\x83x\x9bh~_W\xe6;B\x81ue\xae\xdc'i\x9a\xa5\xd5l\xe27ߡ\xee\x06\xe3Jl0wL@\xdbc\xcb\x03\xdc0\xb4י\xbbH\x8e\x8c\xd5;\x91\xd3\x12\xe8\xa6\x14\x8f\fT\xc0lB\x19\x11*\xdcU\x1e\xf6F\xf3`:j\x1a6\xbb\xdbTж\xbe\xff\xe2Re\xb3\xcf\b\x86\xa6\xfe8\xef:;\xc7\xf7h\xc4,\xf1>\xbd\xa6Y_d\x03\xb3=ܿ\x00\x91q\xa0\xe9\xc7\x0e9tw\xc1\aѾ\xf9A\xd2]\xa5G\xab)L,\xad\"K\xab\xa2\x1cV\xac\x96\xb6k\xaf\x91\x0eĆ~\x81\xab\xbc6\xdd;\"Y\x14\xe7\xccݶIӄT\xb8^۞[\xc6q[Z\xe1@`\x1c\x9d&\xd3\x05)tmޫ\xa0n\x95\xd6_\x8e݄\xddDb\a\x91O\xa64\xad\x95\x15\xf2\xa5\x18|<~\xa1\xfc\xb3\xb3腱\x93\xe9\xd7l\xed\xf3s\xb1\x03\x9b,.\xa74]\\\x92R\xa8\xda\x02>\xef\xd1)P?\xff0}\xb9է\xc0\xfcx\xe1p.:\x1c|\xaf\x9c\xf9\n\xc6#\x93f1b\xd9}\x1a\xcfC\xa3\x1fͤF\xf0\xe3\xbe\xd9Ϭ\xb4\xe1`\x9f_J\xfe\x85\x14^\xb9IBƵ\xbaW\xfaQ\x8d\xa9w\xf6և#\xee\xff\xfb\x04\x7f\xe9\xee\xc2L\x97Um\xbb:\xc1g\xc9|\x1a\x8c\xf5\x17c\xf8}\x1d\x00\xe4\xe7g\xadV\f\x00\x00", size: 3158, local: "web/static/partials/alertstate.html"},
 
 	"/partials/close.html": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff$\xcb1\x0e\xc20\f\x85\xe1\xab\x18/\x81\xa1\xe5\x02M%\xc4\xce\xc2\t\xdcԔ\x88ԑb#\x86\xb6w'\x94\xe1I\xdf\xf0\xfe\x8e $R\xf58\x98@]\xf3\xa1\"Q\xa6\xdd:#\xc8ԌQiH<zT#\xe3\xb6\x06\xd6\xde+\xdf\n\a\x0fNr\x99)9XW\xf8\x1fṇ^\xc2k\xaf\x9f\x85\x1f\x1e\x97\x85\x82\xc5,G\x17RVv\xa7m\xc3\xfe\xfacw\xa6\xfe\x1b\x00\x00\xff\xff\x1fNuч\x00\x00\x00", size: 135, local: "web/static/partials/close.html"},
 
@@ -253,15 +255,17 @@ var data = map[string]*file{
 
 	"/partials/host.html": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xb4U\xdfo\xa38\x10~N\xff\n\xcb\xd2)\x89t\x94\xabT\xf5!\x02N\u05eb\xf6\xadU\xa5\xb6\xfbZ\x19p\x827\x06#\xdb$\x8a\xb2\xfc\xef;\x83\x1d \xe9n\xdaj\xb5\x0f-\x93\x99\xf1\xfc\xf8>\xf3\x11\x15W\xa4Z\x05\xa9\xa8\xf2\x98\x16\xcaX\x9aDaq\x95\\D\x8d$\x99d\xc6Ĵb\x1b\x02\u007f\x81e\xa9\xa1\xc9\xc5$\x92\x02\xcf\xf8\xe8\x9eeVl\xf8\x82@\x98\xc41\x99\x1aˬ\x99\xb6P\x88\x91B\xf3\xa5\xcb\x15\xd9:\xa6\x86\xdbg\x96\xce|ʜ&\x8fZ\x94L\xef\xc8\x13:\xa2\x90As)\xdeoQr\xabE\xf6N\x93C\x12\xb4\xf9oÄd\xa9\xe4\xe4\xde9?Պ\xe5̲w{\xb9,hv\xef\xed\xa1G\x146\x12\xfe\xe7bs\x80\x14\xaa\a\x99\xaa,\xafl\x87\xe8I\xa8f\x15\xa7\x1f\xc2\xf8brtX\xabm\xe7;rfJ\x06r\x15ܸ\xc8$*\xae\x93\xff\x1f_\x80\xe6k\xef\x18\xe7\x16L[J\xac\tV\x9a\xd5\x05\xc1E\xc0[7x/ \xcf\x15\x1f\xac\xf3m\xeey\xa9\x80\xddȔLJ\xdc\xc7\x14j\x1bӒ\x97\xafVY&i\xf2\x8c\x8f\x05\xd9\xef{\x1f\xf9Nҝ\xe5\xa6m\xa3\xb0;\x97|fR(C\xddy@R7\xfc\xa7c\x0fƇ\xa1\xc3\xe15\xaf9\xb31\x15DTD`\xbfѪ\x0f\xdcn\x95^\x93[aC\x83\v\x89ˊ\x95\x1c\xb783\xfdx\xd2\xd3U\xc4\xe5\x1d\xb6 +^qͬ\xd21\x9d2\xcd\xd9\xf4O\xec\xb44\xb8\xd4Ҝlu'̚<\xd5,\xe3\xb8\xd2\xd2\xf8\x9d\xde0\n\x91\xecu\x83\x84κ\xb4\xee\xc7\xc0$\t\xdd\xe9\x13\x82\xe7\xce[s\x9d\xc1\x8b\xf0\xda\x18\x9eC\xb0jʔ\xeb\xc5?m\xfb\x17y\x01ׇ\xae\xc19 \xa1E\x87\xe49\xd8\xfa\xe7g\xdfđ\x14a9\x90\xce^bPF\x8f\x87]*]\xc2h\xaa\xa9=-\xa2\xaa\x1b{\x14EU\xd0JRRK@\xbdP2\xe7@\xfc\x17!-\xd7\a\xf5\xea\x06*U\xce%\x1c\xea\"\x87\xc0\xf1M@\xd5\xc1.N\xe1\x0eT\xbb\x89\x91n?;`\xee\xaa,N\x8ay\xbd\x8bi\xd8\xc1\xf9ozs\x1d\xef\xf7\xa9Ul\xf6ͨjVJQ\xadg\xae\xca|>o[:|K\x9c\x171\xef\x95v\xe2t\xf0\xb7\xb0\xee\xb5x\x00ۋ\xadG\xdbv2?T\x95\xdcCm\v\xcer\u007f\u007f\xacv\x06z\x93\a\xb8\xd2Q\b\xc6\xe0zf+s\xe2\xfa\xcad3J\x03K\xfb\x9b4\x14\x8el\xaa\xf2]\xdfc\f:\xce\xe8!\xef\xe6\x05̕\x06jow\x8b)\x0e0\xa5}\xaf|\x00\x113/1\x8c8\xda|H\xf1\xd6d\xf4\x95\x96\xc2ؠ\xa9\x8c\xddI\x9e\x1f\xaa\xbd!\u007f\xb6\xfe\x9bl\xe68IW\x1b\x17\xa5\xa4;\x13\xd3m!,\x0f\x8c{\xdb+\xb5\x05·:\x93\xfd~ݶ\xa8\x02\x9b\xb6\xed\x8b{b\x9d\xed\xaf[\x87I\xfe\xcbu: \xc7\xfb\x8c\xa1<\xe0\a&R7\\\x16\xf7\xf8\x11\x00\x00\xff\xff\xdd\xf97Ю\b\x00\x00", size: 2222, local: "web/static/partials/host.html"},
 
+	"/partials/internals.html": {compressed: "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xac\x92\xc1n\x131\x10\x86\xcf\xe9SX{j%vWP\xa9'g%T\xb8\xf5\x00\xe4\t\xa6\xf6\x10[8\xe3\xca3\x9b\x00Q\xde\x1d\xad\x93%\xdd\x10\x17Dz\x89\xe5\xf8\xffg\xbe\x7fv\xb4\xf5ke\x020ϫ\x147\x95\xa2e\xcd.n\xe6\x15\vH\xcfUw5{\xae11\xd4aY\xbf}\x97\x95\x8f\x9e\xecQ\xa9[\xeb\xd7\xdd\xd5x\x14+{\x12L\x04\xe1lq^\xd5w\xea\xd0\xe5n\x10̴\xbb\xed\x16ơ\xed\x03\xea\xd6\xdd\xe6\xff\x04\x1e\x03\x8e\xbe\xfd%\xff\xd6&\x92Eb\xb4\xd9;Ӓ:-\xae\xbb\x8f\xf4\xd5/u+\xae\xd3b\xbb\xed\xf67D\xb3\x7f\xda\xedt+\xb6ӭ\xa4\x89\xf1\x01XT\xc2\x10\xc1\x9eu?D\xb0hK\xee{\x87\xe6\x9b2?L@>\xdf|xzٝz\"O\x05\xf6A\xf1e/8\xa9\xa2\xdb<\x90q\x82\x9f{\xec\x91\xffg~ÇK\xf8\x84 \xf3\xea\x9a`\x85o\x94\xc5'q7ʓ:\xa2|B\xb2\x9e\x96U&\xdfn\a\xe1n\xf7\f:{^f\\ $\xe3\x94'\x8b\xdf_\x89\x94\xfdO<\x01\xddw)r\x0e\x8e\"\xe6a\xb7\xffukC\xdc \x8b\x82\x80I.\x9e=\x9c\xc4\bq\xf3>\x17\x1e\xa3@\x93\xef\x934+\xbe\x86\xe6C\x9f@|\xa4\x9b1W~\x82f!\x90\xa4\x985G\x98.\xf0e\x01\xcc4@^}\xae\x0e4fJ3\u009b?\xe1\v\xbb# \xa8\x18֗s\xf2ɠa}\xc4\xe4\xf3\x98\\\x9817\x1fS\xfa\xdb6\x1d\x8e_\x03\x00I\xe5\x84R\x8b\x05\x00\x00", size: 1419, local: "web/static/partials/internals.html"},
+
 	"/partials/items.html": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xbc\x90\xb1n\xfa0\x10\xc6gx\n\xcb\xc3_0D\x88\xbf*\x86*\x86\xad\xea\xd2g@&1\x89+ۗ\x9e\x9d2м{}6\x88\x00\x15R\x97\x0eɝ\xee\xfb\xee\xb3\xfd+k\xfd\xc9*#\xbd\x17\x1c\xe1\xc0\x99k\n\xdf\xc2Ap\x1fd\xe8=_O'cO\x05\xa60M\xb1\xfc\x9f\x9c;\xedꋳ\\D\xe3zz.7\xc9?\a\xadh>)\xdb\xe5\xfaM\x05ԕ/\x17\xb1\xa7\xd1\x1e\xd02\x04\xa3\x04\xa76\xf9\xae\x12hZ4\b}\x97\xb5I\xa9]ׇ+\xb9\x02\x17b\x06g\x9d\x91\x95j\xc1\xd4\n\x05\u007f\xd1&(d\xa7\x13\xd3K,\xd4\xcaĥ\xa4\x9c\x85|d~\x0e5\x14\x99\xba\xded\xc9h\xdaE\xd5)\x19\x04\xb7i\x8di\xc7r\xe7\xd9\x17ˁ\xcf7\xb9\xa5d-\xaa\xbd\xe0\x8b\x06e\xd7nv\xab'q<\xee\x02\xc8ٻ\a7C\xf5\xb1\xdd#ح\x9d\xe5\xa8\xf9|>\f#\xe4yJ\xc8e\xfc\x8c\xce\xf7K\xd7:\xdf\xf7!\xecW\xf0\xe1/Q\xa7\xf3\xeeA\xe7\xf1/1\xb7q\x89 S\xbdC|J\xbc\x00&׆~\x110\x95a\xf8\x17\xb4UbY\x17\xb2\x81\x11R\x12\x1f\x01=\x95\xef\x00\x00\x00\xff\xff\x10u\x04\xf61\x03\x00\x00", size: 817, local: "web/static/partials/items.html"},
 
 	"/partials/put.html": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xe4\x95?o\xdb<\x10\xc6g\xbf\x9f\x82 \xde!\x01\xaa*m\x80N\x94\x81\x16\x01:\x04\x05\n4\xe8\x12t\xa0$F\"\xcc?\x02yr\xe2~\xfa\x92\xa2\x18\x8b\x8e\x1c\xc7\x0eҥ\x83-Jǻ{\xee\xe1O6\xa9\xf9\x1aU\x82Z[`\xa3\xef1RMf[}\xef\xeez\xa5\xb8j\xf0\xf2\xbf\xc5tS\xa5E&\x9a\xec\xc3G\x1fH\"T0\x03h\xf8θ\xba\xd3C\xad\x92\xabzR\x8b\xe4.\xc1W\f\xd7x\xd9+\xc2\xf6UŬ=MDL\xde\xeax,w\xac\x0ef\x8c6\xa7\xa9\xa8\xa9j\x98\x99\x88\x18k\xbdL\xc2LK+\x1f[\xdei#cȯ\xb3V\x1b\xfe[+\xa0b\xd80\x97\xfb)D\x92А\xdb\x18\xddwcpA\x04-\x99ئ*0~\xda\xf00\x14r\x1a\xbe10\xbc\"\xf9\xf0<f\xceȽ\x88e\x17\x84\xab\xae\a\x04\x9b\x8e\x15\x18\xd8\x03\xe0D\xc3\xd8hpKꚉ\x02ˡ\xc7\xf0Dw\xc0\xb5r{%r\xbb\x91D\\\xa1\x10\x0e\x87\\\xb5\xde\xeb\x02\u007fepC\x9b\xeb/\x9b\xa0\xef\xec\x1c#\xc1%\x87\x02_^`T\xda̷\xa7-\xa3u\xd4<\x9eE\xb2\x9c\xac\x9eq\xb1\xec\x01\xb4\x8a\xd1\x12\x14r\x9f\xac3\\R\xb3\t\xaa\x04\xafV\x05\xfeїN\x82Ӳ\f+\x92\x87Դ\x17ɽ\x0f\xaf\xc4b\xaf\\\xd2^.\xaf(\xd0Ns\x05\x96\xe4\xeevw\xbe]\x8c\xfc\x04\x869\xbb\x9c{g\xabwh}\xee]\xaf;;\x83ю7\a\x10{\xc2X\xa0\n%\xb0\xe1\xe5\r\x97,%\xec\x00b\x91\xb1Y\xae\xa6\xe0m\x19s\xe3ܮ~\xbd_%\x14}\xae\xeb\xab\xef\xfe\xbcb\xd7-$\xf3\xc0\xbc\xbd\x17?\xa9\xe8O3\xe3\x88\x17n4c\xfd:3&D\xc7ųX\xba\x17\xf6x \xc1\xb3\b.\xf3o\xc1x\xcd6om\xbf\x1f\xe7\xf6\u007f\xf7O\xc1\x1ef\x88t6\xfd\x83H&\x9e<\x01\xf3\x90'{\xe4\xbc\f\xdb\xe4w\xf8O\x00\x00\x00\xff\xffO\x04`\xe4.\t\x00\x00", size: 2350, local: "web/static/partials/put.html"},
 
 	"/partials/results.html": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\x9cR\xdfk\xdb>\x10\u007f\xce\xf7\xaf\xd0W\f\x9a@\x1c\xb3Q\xf6Pd\x0f6ƞ\xc7`ﲭ\xd8Z\\\xc99Ii\x8b\xe3\xff}'\xd9r\x9c\xd2R\xba\a[\xf7\xf3sw\x9f;V\xc9\x13)[nLFA?P\xa2\xea\xc44\xfa!\xa3\x02@\x03\xcd\xff[-CJ\xdd&m\x9d|\xfc\xe4\x1dW\x1e\xde\n\xb0$\xfc\x93\x8a\xabZ@\xc0*\xa4\xaaf,\x96b\x82G\x1c\xdf\xf8\xbc\xda\x028\xa5\xa4\xaa\xff\xad\t\xa9\xf6\x9a\xe6\xdf\x1fE\xe9,\x82ܑ\xbe\x9f\xf0\x86Ὅ\x1c\x9d\x00)\xcc\x1b\x8d4\xb7\xf9\xcf1\x90\xa5(\xcf\xf0\xaf\xe4xx\x10\x9d\xe06\xa3k\xb3%\xc7\r\x91\x8a,J\xad\x18'\r\x88}F\xd3\x1ax\xd7|)>\xdff}_X\xcd\xd7\u007f\x8cV\xeb\xe3f3\f\v\x9a\x8d\xa7\x98\xbf\x87`a\\k_\x1a\xcb\xdc\xcfcY^\xb4\"\xfa\x82\x12\xec\xe8h\x04\xaf\x82\x882\x8c\x82\xb7\xe65hױ\x14\xa5\x8bm,\xf5\xccX\xea\xfb\xceYn\xa5V\xe6\xe2BiD\xf3\xa6X\x82\xd9BWOs\xb5%y\xe0y\x1b\xf1əh\xa8\x04|}\xba\xbbI~\xf3։\x1b:כz]\xad\xfa\xe9e\xa6\xe3\xeaj\r\x87-9\x855\xc0\xee\x87\x1f\"&cN\u007f\x18\x06d\xff\x84\xd7\x13\xd3\x1aY\x89\x8c~@f\x90\xc3-K\xbd=&\\k\xc3\xd4C:7\xb1h\x87\x15\xceZ\xad\"ŅU\x04\xbf\xa4\x12{\xeeG\xf2\U000a3857\x82~w\xe4|&\xffK\xf3+\x1c\xcb\x1ava\xd6M\b*[Y\x1e\xa6\xa8\x8cXp\xb8/\xaf\xb0t\xac3\x97\xed@\xf8x\xb9\u007f\x13r<\xafps\xb3\x1dO\r\x01\xf2\x97\x06#\xd6$\xcb\xd5\xe2\x8av\xdf\x16\xbaύ\xf1\xcbe\xc7\r\xa3\xe8\xcf\xec\xf9!\xff\r\x00\x00\xff\xffVU\b\x05\xac\x04\x00\x00", size: 1196, local: "web/static/partials/results.html"},
 
-	"/partials/rule.html": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xe4Y_o\xe36\x12\u007f\xf6~\x8a\xa9\xaeh\x1c \xb6\x9b\xed\xf5\x0eHe\x1fzݽ\xb6\xc0m\x1f\xba\x8b\xdecAK\xb4\xc5F\x12\x05\x92\xb27\x97\xfa\xbb\xdf\f\xffX\x94\xa28\xde\xdd\x1c\xfa\xd0\x02\x8d%\xcep\xe67\u007f9Ԧ\xdaܕ\x1c\xcc]×\x89\xe1\xef\xcd\"\xd3:Y\xbd\x98\xcck\xb6\x9b\x19\xb6\xd6p\xffb2\xa9\x98ڊz\xb6\x96\xc6\xc8\xea\x06\xae\xbfn\xde\u007f\xd3-\x1b\xd9\x1c\xd7\x0e\xb8\xd5\xf0\xaa)\x99A\xb1l\x8d\xc2I\xc0Z\xaa\x9c\xab\x99nX&\xea\xed\r\xbct\x02\xfcr&˒5\x9a߀\xe6\rS\xb8\xf5\x81\xa4\xfc\n\xa2\xb7\xc2\nmX\x9e[i\xd7^u\xba\xb0\xe6\xac^\xa4\xb9\xd8AV2\xad\x97\x89\x92{2(^B}\xb3r;\xfb\x1b\xad\xf7\b\x1b\xa9\xaa\xd9Vɶ\xb1\xa4IZ\xb25/\xbbm\xb5Q\xb4\x95\x16\x93շ%W&]\xd87ǭy\xc93\x03\xf5vVɜ\x97\xcb\xc4-\xf0\xfcWF\xbc\tQ\xb2\x82\xd5[tv)Yn%L\xfbL\x97\x96K6F\xc8\x1au\xde\x02\xd3p\v\b\f\xa6\xb7W\xb0\xbb\x04Q\x83e\xd4I\x0f\xb5\a\xe7pOR'\x00v\xaclQY\xb2\xfa7\xaa\x03\xab\x0f^\xf1\x8d\xa8\x05\x91Ӆcs\xe8\x17\x0e\x88\xf5\xc9\x02\x9d\xf2\xa4s(_\x98\xe2l\x14\a\xa0\xdfq\xed\xfa\xeb$\xf2G\xe7\x86[~\x97\xcb}\x8d.*\xc4Ƽ\xae\rW\xd3\xcf\xf9\x8e\xd7\xde\x01\x84p\x99p\xc4?\xc5\x05\xa3)\x17W\xe9\"\xa8\x8cA\x1e\u007f\x9f=\xc2\xef|\u009d\x19䐟\xbd8\x1f\x93v\t\xe3\x8cQ\xa8\xfbQ\x0e\\\x1f\x11\xe8\x00\xfc\x8f\x8cu\xcf\xc8\xe7\x0fw\xf89\xabү_\xbaD \xd4=\vD]\x8a\x9a{\x1bO\xd8\u007f:O\xfe\xa5d\x15\xe7\xc8$\x15uӚ\xa8\xad\x8e\x87\x10l\xbbZ&{\x91\x9b\xe2\xe6\xfaK^\xc5\x0eܠ\xd4W\xc3t\xd2\xdc\xfcH\xde\xf3\x9eB\x0fg\xbc\x90%v\xd1er\x87\xffͪj\x96\xe7\t\xac\xf5,ǽ\x8d\xc8n\xb9\x02|d\xf6}F\xfa\x99\xf1\xbco\xdeXގ\xea\x00k\xa3\xb0\xadz\xa7\x84\xecx\xc2=\xcfe\xf0;Q\x9do\xf0\x0f?ܼy\xf3a8O\x96\xbb|\xfe \x1a\xf9\xa7\n\xa1\x91\u007fd\x00\x83\x1e\xfdx\x1c\xeb\xb6Zs\x95@%\xb0\x19\xbd$kx\x83\x9dk`\xd6\xdfɪQ\xeb;SEPv\xcaZ\xa4\xe4B\xd3\x18\x94/\x93\xcfBE\xc3\xef\xbf\xc3g!3\x8c\x94\xa5\x11\r\x18a\b\xc0O\x16 \xc8\rP7l\x99=\x1c`\xcd͞s<\x13л8D\xd5\xf3\xe7s\xda[\xf4\x00\xbcj\x95\xd5\x04\xd3\xea\xf2L\xe7]\u007f\x8a\xf3r\xafo軀\xe3\xe3|gM\t\xa2\xe9\bE\xa0-\x1e\xa0G\xf7\x1dc\xf6\x81\xfe[\xb78\xf8ց\xbe65\xe0\xff\xb3F\t\x9c\x80\xef\x9c\r%\x96)Ս6\x88\x1d'\a\x8d\xa3\xa1\xdb\xd6ה.H\xfc'\x9ec\x8b\xe6\xffy\x9c\xbd\xae\x98(\x1f\xcf\x02N\xe4s\x9a\xc3\xcbAsp\x1b\x9f\xaf]\x87!\xe7{\xda\xf1\xe9\xad{\b7\f0\xbf:D\x0f\x92\x8d\x1b\xd8\x17\"+@qݖ\xa8MB\xab\xb9\x1d\xe2L\xc1\x8f\xe3\x1b\xac\xefp\xf23\x06\xfb1\xb0\x1ad\x83C\x8f\xce\xd7`\xa5\u0094\xdd2\xbc'm\x91\xc3\r}\x85\x95\x80\r\x9eZ\xc0\xedrwU-\xe5\x1c\xdeѲP\xda\x04e\xa4\xc5i\xa7\x1d\xba\xe1\x99\xd8\b\x9e{\xa9B\x03\x03ݮQ*\x89aa\x17\xae#\xc4|\x0e?n\xa0\x96~\x15y\x15\xef\U0004f23c\xa2E\x0f/Ɓ\x12\xb3Bj\xfe\xa0#\x9d\x97\xe7'\xd3\xfc-͊3N5KΥ\xdarȹ@\x1c\nh\xecB3\x14\xbc\x93ք\x061!\xf7\x15\xecEY\x82jk\xeb\xa5Z\xee\xed.YG\x1c\xe8^S\xd0\x1f꧆U\x8de\xc1\xfbm\xd1\xf1t\f\xd4p\x81\x0e6\r9\xdf0\x1f\xecJ\xe4\xb5\xd8\x16\xe8\x03r\xa6\xa1 ϟ*kJ/]\xc8=\xbe\xb5u\xed\x8f\xe8\x13\x85\x1eQ\xec\xdd\xc9]\xfd\xb0\xce7\xd2\xf9\xfbg'f>\x9f\x9f=\"w\x18\xb8RR}\x1c\x82\x9cZ\xb6\xb2\xb2֢Ώ\xb2>\x14\u009e)\x8b\xbf\xe4\xf5\x16\x9d\xbf\x82/\x1f\xc7C\xbb\x14o8\r?{*\x16\xbf\x19\x8cb\xd9-\xd5\xd9\xe7\b\x85\xbf?\t\xdc\uf250\xef\x1fE\xdd\x1e[O\xcdv\x10\xbe\x88X\x80\xa5p]\xdfR\xefYfĎ\xdf\xd0\a\x0fX.\xe1\xc2\x17\xd6\xc5\x01E3(\x14\xdf\xc4g\x041u<\xc9\xeag\xf7\x94.\x18\x02)\xc5\xd3\xe2Ck9)\xff\xc8\x14\xdfc\xcfր\xc9NG\xc9i\r\x81\t5\xf8\xc7NC\xbah\xcb~\xe0q\x97\xed\xbcX\\\xc3\x18\x13\t\x8b\xcc\x0f\x8eg`\n\x01ƛ\xa2\vk!\xb4\x91\xea\xce\xde%7\x1ea\xbc\a\xbe\xf8\x02\x8e#\x04>\xfb\tb\x18\xf9\x8f@\x15\xc5b\x90v\xb4\xb7\x04\xfbw\xe6\xfb\xc6ãّ\v\xce\xf2\xe3\xc0>I\x8b\xaf\xfad{\xe4\xe0\xa8֮\u007f\xc3[{\xba(\xbe:q\x8a\xba-k\x99\xdfA\xef\x0e\xee\xb2];\x19\x9d\xe5\xe3\x1f\x00\x9e\x1d\xfb?\x11\xcf'\x00\x9f\x15\xa6\xc23\x99\x88\xa3Ћ\xbf\xae\xbe\xdd\xe1xa\xbf8\xfe\u0094\xa0\a,)\\'26\xf5\xce\x05tY\"!\xb8\xf8)\x91\x8f\x8a|\xe0<?\xc0M\x1e\xf41]\xf9OR\xf4E\xc5B\xed\x14\x96~n#\x12\xb9Կ\xe0\x9b\n\x8fD\xb1\xa5\x96.\xf0!^\xfcN\t#2{\xf3\x19P\xfe\xe3\xfa\xddC\xc2O4e\x8clxM\x8d\xbc\xb7\x8c\xcf\x01\x02-w\xd8RC\xf1\x88\x80\xc6\x1d\x9af\t\xec\xd1\xf8\xa3\x93HA\x1e\xfa\xc92\xf9K\x9c\x96xxvWG\xd7ft\x86CZ9uM\xfd\x92\"F\xcd\xc5䱴\xbe\x84\xe0\x06\xba$\\\\\xc4\xd1\xebQ\xff\x01\x17\xee\xfc\xba\x80\x1b\xe2{B\xaaw\xe1\xb8\xd0@D\x99\xfeh9O\xa8s\xff\xb8LOC\x91\xba\xcd2\xae\xf5y\"m\xe4\xc6%:\xd2I\xbb\xfbQ\xee\x02\x8b/\x94\x9c\xc3\xe9n\xa4g\x84\x93\xfd\xd1\f\x18)\a?f\xd8\n\x06\x81\x86PǞ\xdd\u07fb\xa0\x1f\x0e!u(7n\xe0\xfe>\xe4\xc9\xe1\xe0\xa1j\xf4T\xb9\xcaB\xfe\a\x9e\x10\xeb\xc3\xe1*\x8c\tG\x9a\x0f\x19\x91jW\x04\x81\xe2\x1cO\x04;\xce\x1c\u05ed\xfb\x0e\x87t\xe1\xb4y\xd5\xe3\xb7\xc20!ҳv\x17\x8aB\xe4\xeez;\xf7G}/\xcbq\x0e\x9a\xd2\xf8?\xa8\x86\xb7\xb8n\x83I\f\x17\x83\xebp`\xa0\x10F\xb7\xccIhx\xe3\xfd\xa5\x1b\xbbb,gu\x1d\u007f\xc7\x1a\U0010adc6\x99v\xa4\x85\xbc\xa7!Zۯ\xce\x03\x92S\xfa\xb0\u007f\xc9\n/l\xeec\xc7\a\xb4\x9e8۔ϵ\x9e\x8f\xddwe\u007fWY\x82\xf2\xc4o\xfc=\x89V\xbe\x8f\xee\x9bC\xbbs\xfbe\x1bO\x02\xfb\xad\xe8\x18\x9e\xff\xee\xf5\xd4\n\xb8\x1c)\xc9\xd1\x1d\x0e\xc1\xdc\xf9+.N{\xe6}G\xcf\xd3\x1e\xcf\x15\xd6\xe7\x98t?\xdfx^\xca\xf2\xe4\x98\xfcg1\a\xfa\xe0L\xfcM\xcbz\x1a1\xce\u007f\xa1\u007fP\xb8\x8c/,\xb1\x94px\x1e\xa3\xf4\xa4b\x1aղ(\xca=\xea<\x8e\xff\xa3m)\x9c0\x91h*\xe5\xfe\xe9\xe2\x8b}\b\xe9\x1c\xbbIأv;MO\xd8\xfd\x98\x8dV\xf2Y6\x9e\xdfz{w\x95\xff\x05\x00\x00\xff\xff\xf1\xa4\x9e9\xc8\x1d\x00\x00", size: 7624, local: "web/static/partials/rule.html"},
+	"/partials/rule.html": {compressed: "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xe4Y_s\xdb6\x12\x7fV>Ŗ\u05c9\xe4\x19S\xac\xd3\xf6n\xc6%\x95\xe95\xb963\x97>4\x9e\xdec\a$!\x115\tp\x80\xa5\x14\x9d\xab\xef~\xb3\xf8#\x912-+\xb1o\xfaЗ\x98\xc2.\x16\xbf\xfd\x8b]$5\xb8\xad9\xe0\xb6\xe5Y\x84\xfc#&\x851\xd1\xe2\xc5d.\xd9:F\x96\x1b\xb8{1\x994L\xaf\x84\x8cs\x85\xa8\x9ak\xb8\xfa\xb6\xfd\xf8\xdda\x19U\xbb_۽\x98̑7m͐\x03\xb2\xbc\xe6V@\xaet\xc9ulZV\b\xb9\xba\x86WN\x80_.T]\xb3\xd6\xf0k0\xbce\x9a!\xbf'\xa9\xbc\x84ޯ\xca\nmYYZiW\xfe\xe84\xb1\xea,^\xa4\xa5XCQ3c\xb2H\xab\r)\xd4_*T\x1d\u05eb\xf8\xef\xb4> ,\x95n\xe2\x95V]kI\x93\xb4f9\xaf\x0f\xdb$j\xdaJ\x8b\xd1\xe2\xfb\x9akL\x13\xfb\xcbq\x1b^\xf3\x02A\xae\xe2F\x95\xbc\xce\"\xb7\xc0\xcb\xdf\x18\xf1FD)*&W<\x8bj\xc5J+a6d\xba\xb0\\\xaaE\xa1\xa4ɢ[`\x06na\xa94\xccn/a}\x01B\x82e4\xd1\x00\xb5\a\xe7pOR'\x00֬\xeex\x16E\x8b\x7f+V\x82=\x0f\xde𥐂\xc8i\xe2\xd8\x1c\xfa\xc4\x01\xa1\x1fiR\x8a\xf5\xa3ơxa\x9a\xb3Q\x1c\xa0\xd5\xc6d\xd1շQ\xcf\x1e\a3\xdc\xf2m\xa962\x8bL%\x96\xf8V\"׳/\xf9\x9aKo\x00B\x98E|\xcd\xea\xd9E\x04h(\x16\x17i\x12\x8e\xec\x83\xdc\xff}v\x0f\xdf\xf8\x80;\xd3\xc9!>\a~\x0e\x8b\x90\xc18c\xcf\xd5C/\a\xae\xcfpt\x00\xfeg\xfaz\xa0\xe4\xf3\xbb;\xfc9+ӯ^\xb9@ \xd4\x03\r\x84\xac\x85\xe4^\xc7\x13\xfa\x9f\x8e\x93\x7fi\xd5\xf4cd\x92\n\xd9v\xd8+\xab\xe3.\x04[\xae\xb2h#J\xac\xae\xaf\xbe\xe2M߀K\xad\x9a7\xc7\xe1d8\xbe#\xebyK\xb55+x\xa5\xea\x92\xeb,\xdan\xb7۸iⲌ 7qɐ\xb7\xa2\xb8\xe5\x1aJ\x86\xcc\xfe\x8e\xe9|\x86\x9e\xf7\xfd{\xcb{\xa0:\xc0\x06\xb5\x90+o\x94\x10\x1d\x8f\x98\xe7\xb9\x14\xbe\x11\xcd\xf9\n\xff\xf4\xd3\xf5\xfb\xf7\x9f\x86\xf3d\xba\xab\xe7w\"\xaa\xbf\x94\vQ\xfd\x99\x0e\f瘇\xfd(\xbb&\xe7:\x82F\xc8,zE\xda\xf06\x8b\xae\x8e\xd4\xfa\ai5\xaa\xfdAU\x11\x0e;\xa5\xad\\ť0\xd4\x06\x95Y\xf4E\xc8h\xf8\xe3\x0f\xf8\"D\x06*U\xa3h\x01\x05\x12\x80\x9f-@PK\xa0j\xd81{9@\xceqù\x04\x14\r7-\x93\xf3\xe73\xda\a\xe4-\xbc\xe9\xb4=\tf\xcdřƻz\x8a\xf1J\x7fޱ\xed\x02\x8eϳ\x9dU%\x88\xa6+\xb4\x11\xb2C~0\xdf\xdeg\x9fh\xbf\xbcCT2\xd0s\x94\x90\xa3\x8c[-\x1a\xa6\xb7N\x87Z\x14\xb7\x947\x06g\x17\xd49\x18L\x13\xb7mxR\x9a\x90\xf8'\xdecI\xfb\xff\xbc\xce\xde6L\xd4\x0fG\x01'\xf29\xc5\xe1\xd5Qqp\x1b\x9f\xaf\\\x87&\xe7G\xda\xf1\xf4\xd2}\f740\xbf9D\xf7\x82\x8d#l*QT\xa0\xb9\xe9j\x04T\xd0\x19n\x9b8\xac\xf8\xbe}\x83|\v\x86#\n\xb9\x02&A\xb5\\\xa2)s\xb0Ra\xc6n\x19 [\x19\x8e\xae髬\x84\x86!\x95\x80\xdbl}\xd9dj\x0e7\xb4,\xb4\xc1p\x18\x9d\xe2N\xa7\x1d\xa6\xe5\x85X\n^z\xa9\xc2\x00\x03\xd3\xe5\x86[1,\xec\x12\x86 \x96sx\xb7\x04\xa9\xfc\xaa\x01\xa6\xf9\x80\x7fD\xe4%-zx}\x1c\xc2@Q)\xc3\xefU\xa4\xf3\xe2\xfcd\x98\x7f\xa0^1攳d\\\xca-\x87\x9c\v\xac\xb8\x06j\xbb@*\r7ʪ\xd0jn\xb8\xc4K؈\xba\x06\xddIk%\xa96v\x97\x92=\x0e\x86\x80\x15CWO\x915\xade\xc9\x15V\a\x9e\x03\x03\x15\\\xa0\x8b\xcd@ɗ\xcc;\xbb\x11\xa5\x14\xab\nA\x901\x91\x9c<\x7f,\xad)\xbcL\xa56Y\xa4;)\xfd\x15}\"\xd1{\x14;;\xb9\xd1/\x16r\xa9\x9c\xbd\x7fqb\xe6\xf3\xf9\xd9-\xf2\x01\x03\xd7Z\xe9\xcfCPR\xc9\xd6VV.d\xb9\x97\xf5\xa9\x106L[\xfc5\x97+\xac`\x01_=\x8c\x87vi\xderj~6\x94,~3\xa0f\xc5-\xe5ٗB\x96\xfc\xe3I\xe0~O\x0f\xf9\xe6A\xd4ݾ\xf4H\xb6\x86\xf0\"b\x01\xd6\xc2U}K\xbdc\x05\x8a5\xbf\xa6\a\x0f\xc82\x98\xfaĚ\xee\xa2Eʠ\xd2|ٿ#\x88\xe9\xc0\x13-~q_i\xc2\x16iR\x8b\xc7Ň\xd2rR\xfe\x9e\xa9?Ǟ}\x82h8]%\xa7O\bL\xd1\xe2\xc6\x7f\x1eNH\x93\xae\x1e:\x1eYn+/\x97x\xecc\"\xb5L\xfa\xc6\xf1\fL\xc1\xc1hb\xe7\xd6J\x18TzK\xfb\xc52\x8b\xee큗/a\xdfB\xbc|\t\xbe\x83X\x9czD8\x0fU\xcf\x17GaG{k\xb0\xffƾnܿ\x9a\x1d\xb9\xe2\xac\xdc7쓴\xfazH\xb6WN\xb4\xf8\xd0\xe5\xbf\xf3\x02Ӥ\xfa\xfa\xc4-\xea\xb6\xe4\xaa\xdc\xc2`\x06w\xd1n\x9c\x8c\x83\xe6\xe3\x0f\x00ώ\xfd\x9f\xaa\xdc>\x01x\\aSg\x11\x11G\xa1W\xdf,\xbe_3Q\xdb\x17\xc7_\x99\x16\xf4aҤ\xfaƒ[\xcd\x0f&\xa0a\x89\x84\xb4\x9a?\xc5\xf3\xbd$?2\x9eo\xe0&\xf7\xea\x98i\xfc\x93\xd4d\x92\xba\xc7\xd1Á\xb5\xefۈD&\xf5?&)\xea\xf0I\x14\x9bji\x82\xd5`\xf1\a-P\x14v\xf29\xa2\xfc\xc7ջ\xfb\x84wr\xa9\xee\xaf\xfeL\xbdǈ\x98\xb7T\xde\a\xcbi\xb2\a\x96&}\xc4)\x92\x97z\xf0\xfbu\xdbp\xa4\xcam8\x9a\xa8w@\x19\xaaL\x16\xfd\xad\x1f\xac\x1c燁\xd2\x15\x1fShU\xd73W\xea/\xa2\x85+9X\xf6\xa5\r%\x04\xe3\xd0\xe80\x9d\xf6}:\xa0\xbe\x86\xa9\xbbզpM|\x8fH\xf5\x86\x1d\x17\x1a\x88\xafa\xea/\x9c\xf3\x84\x92S\xc6%Z\xcak\x98\xd2\xd5\x7f\x9e,\xe7\xcaqi\x9e\xf6\x1a\xa6\xa6+\nn\xccy\"m\x14\x8cKt\xa4\x936\x1cF\xcc!H\xd2Ć\xffq\xff8R\x95B\xef\xf0`4\x8d$\x9codl\x8d\x00Qf\x11\xdd\t\xf1ݝ\v\xa0\xdd\xceS'\x14g\xd7pw\x17bn\xb7\xf3PM\xc3\xeazQ\x84\f\v<!nv\xbb\xcbЈ\xeci\xde\xfdD\"\x7f\xed\xd7ɉ\xb4(]\x96\x85e\xe7\r\"\xd8.j\xbfnm\xbaۥ\x89\x83\xe0\xf1\x8c\x0f\xa3\xa11\xa5o\xe3\xe6\x98J\x94n\xaa\x9e\xfb\x0ec\x90F\x95\xda\xcch\xea8J\xb7\x0f\x95\xdaX\x0f\x13\xc3\xf4h\n\x0f\f\xe4\xd7\xdep;\tuv\xbc\xac\x1d\xba\xbd>\x96\xb3\x8a\x9d\x1f펊\xd1\ad؍Ԩ\x8fԻ\x1b\xfb\xd8}Dr\x87\xde/\x9b\xaai;to,\x9fP\xdb\xfa!\xa8}\x00\x0el잳\xfd\x88\x94\x81\xf6\xc4\xef\xfcxF+?\xf6\xc6\xdcc\xbdK\xfb\xa0\xde2\xf7D\xb5w\xcf\x7f7ff\x05\\\x8c\xe4\xe9\xe8\x0e\x87`\xee\xec\xd5\xcfX{\xd5\xfe@߳\x01\xcf%L\xa7\x17\xe3U\x80\xda*\xcfK\xa1\x1f\xed3\xe2,\xe6@?\xba\x8a\x7f7J\xcez\x8c\xf3_\xe9\xff1.\xfasR_J\xb8\xb3\xf7^z\xf4`@\x13\x17=/\x0f\xa8\xf3\xbe\xff\x1f\xacU\xe1\n뉦\xfc\x1e^_\xbe\x02\x1cC:Go\x12\xf6\xa0\xde\xee\xa4G\xf4~HG+\xf9,\x1dϯǃ\x11\xe9\x7f\x03\x00!S\xeaL?\x1e\x00\x00", size: 7743, local: "web/static/partials/rule.html"},
 
-	"/partials/silence.html": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xb4W\xdfo\xe3\xb6\x0f\u007f\xee\xf7\xaf\x10|\xc0\x17i\x11\xc7i\a\xdc\x01\x81\x93\xc3p\xe8v/\xc5\x1e\xd2a\x0fE1\xc8\x16m\v\x95%O\x92\x9bf\xbd\xfc\xef\xa3d9?ܴ\xd8]\x97\x02\x89\x19R\xa2>\xfc\x90\xa2ٔ\xf1G\x92\vj\xcc<\xd2j\x15\x11YƦR\xaby\x04Z+\x1d-\xfew\xb6\xbf$W\"\x16e|y\xe5\f\a\x16*@[\xe2\xbfcFe\t\xda\xfbʸd[_i\x82\x1b\x9c\xc7\xee\xd9?\n\xa5\xebލ\x93\xe3Ji\xfe\xb7\x92\x96\x8a\xe1\xf9\xde\\j\xd56\x1d\x00A3\x10\xfb\xe0L\x1d_\x91\x1c\xf7j\x87\xd4Y\xa3\x85\xb1\x14\xa11j!M\xbcj\x88=l\xfc\xe8}\x9e\xa5\\6\xad%v\xdd\xc0<\xb2\xf0d\xa3\x83Ӄs\x1f]\xad\x18\x88y\xe4\x0f\xf0\x8a\xbcr\xa1\xa3G\xff\x1c\x9d\a\x8fM\xef\xa1\x02\xd1ęP\xf9C\xb4\xf8\x05\xbdQ;#k\xfc\x8b\xeb:f\x8c|\xfd:\xbb\xb9\xb9\x9b-\x97\xf7\xe4\xeefy\x9b\xc4\xd3O\xd3\xe9\xfd\x84\xfc~\xfb\x85\xf0\x82HE,\xaf\x01\xa9\x01\xd4\x190\xa8Y9C&\xa8|\x98\xa4I\xe3#;d\xf9\xfd\xfc\x81d\xa7d\x0fݿ\xce\xdd\u007f\x1e\fk5\xb5\\\xc9\x13\x05ӻ\xff\xeejX6\x90\xf3bM\x80\xdb\n4\xe99'J\x93\x94\x92JC\x81\xeb\xadmfI\xa2\x1a\x90ְl\"\xc1&L\xe5&\xc9Z.XR\xd9Z$\xad\x01\xfdg\xd9r\x06\xc9_-\xe8u⼘\x89\xb3}\xd0 \x10\xdc#\xec\xb3@\x17'\xab\x1b\xdf\f\xde\xe6\xf9r\xfa\xa3D{\xe7\xdf\xcd\xf2o\x8d\x8b\x9a\x8a\x93\xc5\\)cͩb\xf6\xce\u007f<f\xf2\xabP\x99\x19\x13\x03\r\xc5\xf4\x03#\xd9\x1a\xf1\xd755d4>\x1f\x93\x867\xd8SF\xdfPĲ3\rͱr\xc8\xf5\x13\xad\x1b\x013\x92\xe6\bc!\xd7\xf1\n\xb2\xe9嘠\xa4\x81qs\x91&\xder2R\x95\xbf\x12\x96\x96'c\xd6\xf9~\a\xb1\xd7O3\xd2(m\xe7\x1f\u007f\xfa\xf4y\x9c\x8b\xd6X\xd0\xf3\xcb1/\x90¹P\x17\xdfxq1q\x11<\x1aB5\xbc\xb8ҥ\xc2\x0e^N\x94.\x93\xe6\x01?\xd4VI\xc1\x05x\xe1\xc3\r\xb5y\x15-J\x97>wc\a)ܦ\xedE\xae\xb6\x98ޑ\xa1\x974\xab\xa20`}\x9a\x0e\x9ae\xd6Z\xabd\xbf8\xb3\x92\xe0'fP\xd0V\x84\xab*x\xfe\xe0Rb\xac\xa3\xd6=Ӥ\xdbv\x04V\x9a8,\xf8|uFq\x0e\x96H\x93\xc4B=6\xaa\xb8\x9a\b\xa3Ju\xb5\xf8\x83\vA2 \xa6\xdb\xc1fi\x82\xda\xe3,\fv\xb7\xdb\x1a\x15\xdcظ\x95Ʈ\x05\xb0\x10\xb8\xe0\x0e\x92\x86\x06\xa8\x9dG\xa3\xe0\u007fLh\xee\xba\xed9\xe1\x92\f\x91\xbamx\xc1\xe4nF\n\xbbܔ\xe4\f\xfbk\xfa\xa3\xfd]\xf1\xdfq\xa3yM\xf5z\x8f\x8b\xee0\xec\xba\xfe\xb9\xe7\x04\xef\f\x0fA\xfc\xbb`\x8f\xe7q\xffĐG\xbcH\x05\xd75\xa6\xd2)\xb1\x13\xd0\fI\xc1\x97`'}\xe9\xcc\xd1\"\xac;Hu\x1f|\x186\x19\xdf]\xd2\xfd8WTK.K\x1cBp\x05\nD\xb7\x02\xc8\xf3\xb3\xfb\xb9\xd9l\xa3\x1cL\x94\x83\x82y;\\\xeb\xb0\xf66\xff#\"\xd6\xc4^\x8a\x8d\xbbA\xd1\xdd\xdd\xe5xz\u007f\x1fҍ݈\xb2\x90\x1f\xab;\xc1i\xbbA3MP\xda\xe9\xf0E>Є\xd7\u206e\xebm\x87\x1b1\u009d\n%\x1d\xb2\xb9;>\xb5\x99b\xeb-\x92\x83\"\xe4\f\xbb\x84/=sXv\xb8\x92\xf9\xf8p\x92Ī\x9b,\xfd\xf0\x8aUg\xd9\xf1\x05\xd78\x9f\rͻ\xaa\x9d\xfc\xec_\xc4o,\xb8u\xbdu`\x0f\xd2\x19\xf6\xc1\xe3e\xe6姮'w\x9d2\tq|\xf6,ϟ\x9f\x1d\xbeQ\xc0\u007f\xbe\xd9\xfc\x1f\x99\xdei\x11\xb4\xd3y\xaeQ\x1b`\xa2\xc61\x8d\n\xf4\x84\rqԡ\xf3\xbb\x91n\xd4s\xb6\xd9D\x81{\xbaE\xf9Jg\xf3\xff\xe6\xec#\xed\xef\x85\x00\xaa1\x03\xd8⼸_\xf8]\n\xd9ˬ\xf6\xa9D\xd1Uް\xa8\xff\t\x00\x00\xff\xff&6@ҩ\r\x00\x00", size: 3497, local: "web/static/partials/silence.html"},
+	"/partials/silence.html": {compressed: "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xb4W\xdfo\xdb6\x10~N\xff\nB\x05\n\xbb\xb0L'\x03Z\xc0\x90T\fE\xb6\xbe\x04\x03\xe6\x14{\b\x82\x81\x12O\x12\x11\x8a\xd4H*\x89\xea\xfa\x7f\x1f\x8e\x92\x1c\xd9\xf9\x815\x9d^L\x9aw<~\xdfw\xc7\x1f\x8a\xb8\xb8%\x99d\xd6Ɓ\xd1w\x01QEhK}\x17\a`\x8c6A\xf2\xe6d\xec\x92i\x19\xca\"<=CÁ\x85I0\x8e\xf8ߐ3U\x80\xf1\xb1R\xa1\xf8>VD\xb9\xb8ň];4\xb96\xd5\x10\x06\xfba\xa9\x8d\xf8\xa6\x95c\xf2x}o.\x8cn\xea\x0e\x80d)\xc818[\x85g$\xd3\xca\x19D\x8a\xd6 \xb1\x8e\x19G8s\x10Q?t\x8c\xbd\x9f\xf8\xc1\xc7<\x89\x84\xaa\x1bG\\[C\x1c8\xb8w\xc1\xc1\xea}pϮ\xd2\x1cd\x1c\xf8\x05\xfc@V\"\xf58\xe8\xdaټ\x8fX\x0f\x11J\x90u\x98J\x9d\xdd\x04\xc9o\xdaṰI۶mXU!\xe7\xe4˗\xf5\xc5\xc5\xd5z\xb3\xb9&W\x17\x9bK\x1a\xae>\xaeV\xd7K\xf2\xf5\xf23\x119Q\x9a8Q\xc17\xad`I\xbeZ\xb0D\xe9;4\xa4\x92\xa9\x9beDk\xcf\xecP\xe5\x9f\xd7\x0f\x14\x9fR=P\xfcy\xed\xfew2\xbc1\xcc\t\xad&\"3\x84\xff\xe1j\xd8Ԑ\x89\xbc% \\\t\x86\f\x9a\x13mH\xc4Hi \x8f\x83ҹzM\xa9\xaeA9\xcbӥ\x02G\xb9\xce,M\x1b!9-]%ic\xc1\xfc]4\x82\x03\xfd\xa7\x01\xd3R\x8cb\x97h{k@2'na\xac\x02K&\xab\x1b\x7f\x18\xbc\xac\xf3\xe9\xea\xb5B\xfb\xe0?\xac\xf2\x1f5\xb2fr2Υ\xb6\xceN\xc5\xd9\a\x7f=g\xf2\xbbԩ]\x10\v53\xcc\x01'iK2]U̒\xd9b\xbe \xb5\xa8\xc1\x92\xd9\xf7\xf9\x02\xcb\xce\xd6,\x03\xbb$\xe7\xf7\xac\xaa%\xacI\x94i\x0e\x89j\xc3;HW\xa7\v\xa2\xda\xd0\x00\x17\xf6}D\xbde2Q\xb5\xdf\x12\x8e\x15\x93)\x8b\xb1\x7fB\xd8\xf3\xfb5\xa9\xb5q\xf1\x87_>~Zd\xb2\xb1\x0eL|\xba\x109\xcb \x96\xfa\xfdw\x91\xbf_\"\x83[K\x98\x81G[\xbaВ\xa9b\xa9MA뛂\xd6̕4\x17\x12|\xe7\xed\x05sY\x19$\x05\xa6\x0fw\xecQ\n\xf7i{\x94\xab=\xa6\xa93\x84\xa7\x8eb\xd5TW\x04\x86\x9f\xe6:\xa8\xc0ZV\xc0TuՇ\x7f\xa9\x8a\xfe\x04f\xb5\"9\xee8pN\xa8\x027_&\x81\x19\xec3b\x85\x04\x95\xc1kr\xf7\x98\x8a\xces\v\xce\xebp\x90\x92\xb4qN\xab\xc19u\x8a\xa4N\x85\x1cr\xd6\xc8\xfe\x98\x95\"\xbbA\xda\xd6\xe1\xb6\xc06\xa2ݴ'`E\x14\xb1$o\x9e\x7f_b\x80MG\xcd>\xf5̴\xd5\xfe\x99Y\x9e%\x7f\t)I\n\x83\x18|\x1d\xd1\xf2\xec\x19\x15\x8ef7\xfb\"\x90º\xb0Qֵ\x12xO\\\n\x84d\xa0\x06\xe6\xe2`\xd6\xc7_\x10\x96\xe1M9'B\x91c\xa48\xcd\xd6L=\xbco\xfbY\xf8\xc2E\xc3\xd8gX\xda\x17\xa3\xff\rk#*fڑ\x16\xddbAҵ\xa3 \x11\x95\xa2'\xf1\xdf\xc8>\x9d\xc7\xf1\x8a}\x1e3\xadra\xaa\xd9\xdc\x0fraY*\x81\xc7A\xdf\xfbܙ\x83\xa4\xf7;H\xf5@\xbe\x03\x0f\\<l\x841\xcf;f\x94PE\x90\xa0\aֲi$\x90\xed\x16\xff\xeev{\x96G_\x03G\x05\xf32]\x87X\a\x9b\xff\x13\x10gC\xdf\v-\x9e~\xc1\xd5\xd5\xe9bu}ݧە\xc0x\x9f\x1fg\xba\x0e\x8ev\x1f\t\x11u\xe5h\f\x14?\x1a\xe9\x9f4\acݽt8\x91\x8b\x91WD\xfb\x95\"\xfa\xb0|\xe4R\xcd\xdb=\x92\x83\"\x14|A\xac/={Xv'\x91㞟\xa8 \x0e\xecr\x83\xa0\xb1\xea\x1c\x7f\xda\xe1\\\xf1G懪]\xfe\x8at^r\xb8\xc4{\xf1\xc8\xde\xf7N\"\xf6L\x99\xf9\xfe}w\x9fv\xb7\x1c\xedy|\xf2*\xc7\xdb-\xe2\x9b\xf5\xf8\xe7\xbb\xdd;P\xfca\xf4\\q\x1c\xf3Z\xc7\xdbm\x0fs\xb7{\x87J\xc7\xdb-(\xbc\xccf\x1d:?\x9b\vt\x14|\xb7\vz\xed\xd9\x1e\xe53'\x9b\xffD\x1d#\x1d\xf6\x05\x1e\xbc3\xc1\xe7A\xe2\xbb\xe3\xc2\xefR\xc8\x1fguHeD}\xe5\x1d\x17\xf5\xbf\x03\x00\xeegv\x9ae\x0f\x00\x00", size: 3941, local: "web/static/partials/silence.html"},
 
 	"/templates/index.html": {compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xb4X\xdbn\xdc6\x13\xbe\xb6\x9f\x82\xbf\f\xfcN\xf0\xffZ\xf9\x90C\xbd\xd6.\xe0\xd8F\x1b\xa0@\x82&7\xbd\xa4\xc4Y\x891E\xaa$\xe5\xb5\x13\xe45\xfa \xbd\xee\xd3\xf4I:\xa4(\xad$o}\x02z\xe1\x159\x9c\xf9\xe6\xc0\xe1\f\xe9\xf4?\x17\x1f\xce?\xff\xfa\U00052536\x12\xcb\xdd\xd4}\x88,bZ\u05cb(S\xa6\x91gu\x1d-ww\xd2\x12(\xc3\xefNj\xb9\x15\xe0x2.\xd9\"\xda\xf7\\\xe4\xbf\x15\xa3\xa6<%\xfb\xe4\u007f\xc4sD\xcb4\xf1\x03/$\xb8\xbc\"\x1a\xc4\"2\xa5\xd26o,Ṓ\x11)5\xac\x16Q\xb2\xa2\xd7n>ß\xc8\vT`)\x91\xb4\x82Et\xcda]\xa3PD\x90Â\xb4\x8bh͙-\x17\fP\bb?\xf9?\xe1\x92[NElr*`q8;\x886\x9a\x83\x16c\xa9\xe5y\x92\x1b\x93dJYc5\xadg\x15\x973\xa4D\xc1<{+\xc0\x94\x00\xb6\x15\xf7s7ڙIz\x9dQ\x1d\xb7 \xb1U5\xf9\xe6\xe8;\x15\xd5\x05\x97q\xa6\xacU՜\x1c\x9e\xd47\xa7n\xe1\xbb\xfb\xd9\x13\xaaP\x81\xb1V\x06MTrNhf\x94h,x\xbe\x1d\x84B\xb1\x83;b\xb1\x85\x1b;V\"`e\xe7\xe4\xf8\x87\xc0\xbb\xb3\u0088Ć\u007f\x8599ꉹ\x12J\xcf\xc9\xde\xe1\xea\xf5\xd1ɛ\x96&\xc0Z@\xe3k\x9asY\xccI|8T7\xb34C\xb71\xc8\xc8\xe3v\x1a\xf4\x99\xc9\xc9\x16r̥\x04=\x9fg\xb0R\x1a\x82qa_\xe6d\xff\xaf\xdf\xffؿ\x17\xf6\x02\x9e\x89\xfbg\x87\xeb\x81k*AxQ\xf4\xa6\xe3n\xb4q~\u05ca\xa3\x98>%\x1d\xa1\xa4\x92m\x8c\x124\x0313  \xdf\x1a\xdcã.\x8e\x81\xec\xb7'>\x1a\xc5k\xcdm\x19\xb7\x18\xc0\xc6(\x9a\x17%¼\xeeP2\xa5\x9d\u007f\x81\x8ca'\xb8\xf7\x9c\x91\xbd<\xcf[\x8e\x9a2\xe7\xc6T\xb2u\xd5\\w\xfe\xb9\xbdn\xf3\x84\x18*\r\xaa\xd7|5\xb0\x89\xdepCj\xea\xceB;\xc6\xd4\a\x1c\xafT\u07b4\x93\x0e\x88\v1'Rɐ\u007fx\x0e\xd4\x15f\xd0\xde\xc1\xc1A\xa0\x94\xb4\x86X\x83D\xcb}\xba䚛\xfa\x92\x15`\x06\n\x1f\x84l\x8f&\xda<\xeb\xa3q\a\x996V\r0o\xfd\xf6\x8c=>\xda\xe6q\x9b_\"\x1ez\xddM\a\x86M\f\x19榈\xa5\xd2\x15\x15#\x17\xf6\x8e\xf3\xb7o\x8e٘\x0f\xbd\xc7#OE\xab\x02\xb4Vz,tv\xf2\xeaի\xa3\xb1Кj\xb9I\xce\xc0\xb8\xa2\x90\x85m\xef\x19\x1by%\xd5Z\x8e\x19\xd9\t\xb0\xd5\xdb1\xa3\x80\x02\xe36\x88M\xbc\x866e2%\xd8\xe9\xd6bt0I\xc3P\x1b2A\xf3\xab\xd1B\x88\xd00\xcdӤ\xab~i\x12\xca\u007f\x9a)v늿;\x9aZ\t\x01z\x11\xbds\x1d\xe0\xdcjіLƯI.\xa81\x8b\xa8-\x99$TN\x06+\xda\bK\xee\x14R\xac\xbd\nk\xb6c\xe7\x05u%\xd2#\x8d\xa0\x9cB\x8a\xfb\xaaۥ-jB%\t\xebhj\x831\x90\xc4\xde\xd6\b\xddN\xa2\x89\x84UE\x81\xad\x8a0ji\x988MB\xd0\xda\xf4d\x8c(`\xcf\xd9\v2\xfdrЃ=\x02\xabQ\alt\xac\xa4\xb8\x8d\x96\x9f=\x1aٸ\x84\xd1D\xbe\xadB\xae\xf5ň\xedz\xe6\xbf\u0094&\xad\xffݔN\xe2\x90i,\x91}3\xdex\xe6ḇɻn\xe4 q\xbeu\xcdw\xaah\xe9\xaf\x02C\xae4\xa1a\xb76\xc4-\x1bׅ\x94lB\xef\x90\xff!\xe0i#\x06\xf2]6\xe1gc\xb7\xe0>G[&\x9a[~\r/\xf6\xb9\x85\xca\xec\xbfD?h\xe7\xa9'E\xcb\xf7\xee\xe3lM\x13\xc1\xef\a)\xf0\xcaP\x8eA<)Z\xfe\xe8>\x8f\x03\x81\x9bZ\x8f1\x1c%Z^\xe2/\x18\xe3\x93\xe518\xba\x110\xc6q\x94h\xf9\v\xfe>\x0e\x01\xd3eŋ1FK\xc3\f\x06cɹ\x9f<\x0e\xccp\x012\x9fX\x14\x88\xd1\xf2S;x\x1cT\xdd\xd81\f\x12\x10\xa2\xc9*n\xc9\x05\x1e\xcb\tL\x9a4\xe2\xa1\xf4膾\xcd\x0es\xa5\x1b\xf6%#\x88gV\x12\xfc\x9b֭\x8el\xaa\xa8\xb5\x9c\xe7W\xe1^\v\xf2\xc5KO,9\x83@s\x17P4\xdd\r\xc9\xcf8\x9e\x9cE\xd4\xca%z\x17\xea\x94?H\xd3\xd3\xd9+$x9\xaa\xba\xdaK\xbc\x9c7Ý\x96\x8d2g\x00\xce\xd6#\x03:w'\xa1\x0f\x9a\x98V5\xc3&4\b\xf9^4]\xdc^.7\xa2?\x81\xa8I\x9a\xf5\x15\x9bjw\x8bF\u007f\x97}\x1d\x18\xefO\x0f\\\x81lz\x13\xdb=\xe9\xed(\xad\xad\xe7I\xe2k\xcbL\xe9\"\xe4g\xa3}U\x9d\xb9\x17K\xb4<\x1f\xd2\b\x163\xb29K\xe4\xd3-\xb6\x8e\x9bi\xe2=\xa4\x86\xd6<\x80\x9f}|O.%\xf3\xf7\xca;eb;\x8c\xaa\xf1\xdejX6\x93`\x13\xa6r|p4\\\xb0\xc4\x01&\xf8n\x82\x9b\x80\xfd\x01\x19?\u007f\xbaxG.\xf0\x96\x86a\xb0\xa1WL\x94\f\xf2{gk\xdao\nl?\n\x83\xdd{\xfb\xe8pI\xab\xf5\x96\xe6\x8a\xe57\x16E|x\xe4\xf3\xca=\xc6\x1e\xd2\xe8z\x12^\x99jK\x8c\xce7Ϯ/&\xf9\xf2[\x03\xfa\xd6?\xb9\xbe\x18ߣ<\xdf}\"\x8d\xbb)\x9a\x1c\x1f\x05\xf1SĨ,\x1aA\xf5\x93T\x05\x99X+|\x9a=K\x12o\xa9xS\xfc\xfaLa\xff\x1c}\x9e\x94\xadœt\x8e\x9f\xbf\x8f\x95\xaa\x94\xcb\xd0g\x88\xc4,\x1c\xcd\xd8\x150\xfa4\bv<\xbb>~\x92D\x9bf\xb1{\x060WP\xb1\x10\xd1'\n\x0f\x9f\xa8O\x8c\xac+ S\xeeo\xdff\xefe.\x1a\x06\xe6\xfbww\x9fv\xf7\xe8\xe5n\x9a\xb4\xffw\xf9;\x00\x00\xff\xffT\xa2\xaf$\x88\x11\x00\x00", size: 4488, local: "web/static/templates/index.html"},
 }
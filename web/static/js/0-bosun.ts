@@ -22,6 +22,11 @@ bosunApp.config(['$routeProvider', '$locationProvider', function($routeProvider:
 			templateUrl: 'partials/dashboard.html',
 			controller: 'DashboardCtrl',
 		}).
+		when('/internals', {
+			title: 'Internals',
+			templateUrl: 'partials/internals.html',
+			controller: 'InternalsCtrl',
+		}).
 		when('/items', {
 			title: 'Items',
 			templateUrl: 'partials/items.html',
@@ -142,6 +147,7 @@ bosunControllers.controller('BosunCtrl', ['$scope', '$route', '$http', '$q', '$r
 		switch (status) {
 			case "critical": return prefix + "danger";
 			case "unknown": return prefix + "info";
+			case "info": return prefix + "info";
 			case "warning": return prefix + "warning";
 			case "normal": return prefix + "success";
 			case "error": return prefix + "danger";
@@ -18,6 +18,10 @@ bosunApp.config(['$routeProvider', '$locationProvider', function ($routeProvider
         title: 'Dashboard',
         templateUrl: 'partials/dashboard.html',
         controller: 'DashboardCtrl'
+    }).when('/internals', {
+        title: 'Internals',
+        templateUrl: 'partials/internals.html',
+        controller: 'InternalsCtrl'
     }).when('/items', {
         title: 'Items',
         templateUrl: 'partials/items.html',
@@ -107,6 +111,8 @@ bosunControllers.controller('BosunCtrl', ['$scope', '$route', '$http', '$q', '$r
                 return prefix + "danger";
             case "unknown":
                 return prefix + "info";
+            case "info":
+                return prefix + "info";
             case "warning":
                 return prefix + "warning";
             case "normal":
@@ -1632,6 +1638,16 @@ bosunControllers.controller('HostCtrl', ['$scope', '$http', '$location', '$route
         });
     });
 }]);
+bosunControllers.controller('InternalsCtrl', ['$scope', '$http', function ($scope, $http) {
+    $scope.ms = function (d) {
+        return (d / 1e6).toFixed(1) + ' ms';
+    };
+    $http.get('/api/internals?n=20').success(function (data) {
+        $scope.internals = data;
+    }).error(function (error) {
+        $scope.status = 'Unable to fetch internals: ' + error;
+    });
+}]);
 bosunControllers.controller('ItemsCtrl', ['$scope', '$http', function ($scope, $http) {
     $http.get('/api/metric').success(function (data) {
         $scope.metrics = data;
@@ -1902,6 +1918,7 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
     $scope.hosts = search.hosts;
     $scope.tags = search.tags;
     $scope.edit = search.edit;
+    $scope.user = readCookie("action-user");
     if (!$scope.end && !$scope.duration) {
         $scope.duration = '1h';
     }
@@ -1927,7 +1944,9 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
             duration: $scope.duration,
             alert: $scope.alert,
             tags: tags.join(','),
-            edit: $scope.edit
+            edit: $scope.edit,
+            user: $scope.user,
+            message: $scope.message
         };
         return data;
     }
@@ -1960,6 +1979,9 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
         $scope.error = null;
         $scope.testSilences = null;
         state.confirm = 'true';
+        state.user = $scope.user;
+        state.message = $scope.message;
+        createCookie("action-user", $scope.user, 1000);
         $http.post('/api/silence/set', state).error(function (error) {
             $scope.error = error;
         }).finally(get);
@@ -1969,7 +1991,8 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
             return;
         }
         $scope.error = null;
-        $http.post('/api/silence/clear', { id: id }).error(function (error) {
+        createCookie("action-user", $scope.user, 1000);
+        $http.post('/api/silence/clear', { id: id, user: $scope.user, message: $scope.message }).error(function (error) {
             $scope.error = error;
         }).finally(get);
     };
@@ -1993,6 +2016,18 @@ bosunApp.directive('tsAckGroup', function () {
             scope.btoa = scope.$parent.btoa;
             scope.encode = scope.$parent.encode;
             scope.shown = {};
+            // alias replaces the tag values in an alert key or group name
+            // with their display names. Links and queries use the raw values.
+            scope.alias = function (v) {
+                var aliases = scope.schedule && scope.schedule.Aliases;
+                if (!v || !aliases) {
+                    return v;
+                }
+                return v.replace(/([^{},=\s]+)=([^{},]+)/g, function (m, k, tv) {
+                    var a = aliases[k];
+                    return a && a[tv] != undefined ? k + '=' + a[tv] : m;
+                });
+            };
             scope.collapse = function (i) {
                 scope.shown[i] = !scope.shown[i];
             };
@@ -2080,12 +2115,14 @@ bosunApp.factory('status', ['$http', '$q', function ($http, $q) {
             $http.get('/api/status?ak=' + encodeURIComponent(ak)).success(function (data) {
                 angular.forEach(data, function (v, k) {
                     v.Touched = moment(v.Touched).utc();
+                    v.SnoozedUntil = moment(v.SnoozedUntil).utc();
                     angular.forEach(v.History, function (v, k) {
                         v.Time = moment(v.Time).utc();
                     });
                     v.last = v.History[v.History.length - 1];
                     if (v.Actions && v.Actions.length > 0) {
-                        v.LastAction = v.Actions[0];
+                        v.ActionLog = v.Actions.slice().reverse();
+                        v.LastAction = v.ActionLog[0];
                     }
                     cache[k] = v;
                 });
@@ -2095,7 +2132,7 @@ bosunApp.factory('status', ['$http', '$q', function ($http, $q) {
         return q.promise;
     };
 }]);
-bosunApp.directive('tsState', ['status', function ($status) {
+bosunApp.directive('tsState', ['status', '$http', function ($status, $http) {
     return {
         templateUrl: '/partials/alertstate.html',
         link: function (scope, elem, attrs) {
@@ -2112,6 +2149,22 @@ bosunApp.directive('tsState', ['status', function ($status) {
                 var key = encodeURIComponent(scope.name);
                 return '/action?type=' + type + '&key=' + key;
             };
+            scope.snoozed = function () {
+                return scope.state && scope.state.SnoozedUntil.isAfter(moment());
+            };
+            scope.snooze = function (minutes) {
+                var data = {
+                    Type: 'snooze',
+                    User: readCookie("action-user"),
+                    Duration: minutes + 'm',
+                    Keys: [scope.name]
+                };
+                $http.post('/api/action', data).success(function () {
+                    scope.state.SnoozedUntil = moment().utc().add(minutes, 'minutes');
+                }).error(function (error) {
+                    alert(error);
+                });
+            };
             scope.zws = function (v) {
                 if (!v) {
                     return '';
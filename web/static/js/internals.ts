@@ -0,0 +1,18 @@
+interface IInternalsScope extends ng.IScope {
+	internals: any;
+	status: string;
+	ms: (d: number) => string;
+}
+
+bosunControllers.controller('InternalsCtrl', ['$scope', '$http', function($scope: IInternalsScope, $http: ng.IHttpService) {
+	$scope.ms = function(d: number) {
+		return (d / 1e6).toFixed(1) + ' ms';
+	};
+	$http.get('/api/internals?n=20')
+		.success(function(data) {
+			$scope.internals = data;
+		})
+		.error(function(error) {
+			$scope.status = 'Unable to fetch internals: ' + error;
+		});
+}]);
@@ -8,6 +8,8 @@ interface ISilenceScope extends ng.IScope {
 	hosts: string;
 	tags: string;
 	edit: string;
+	user: string;
+	message: string;
 	testSilences: any;
 	test: () => void;
 	confirm: () => void;
@@ -26,6 +28,7 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
 	$scope.hosts = search.hosts;
 	$scope.tags = search.tags;
 	$scope.edit = search.edit;
+	$scope.user = readCookie("action-user");
 	if (!$scope.end && !$scope.duration) {
 		$scope.duration = '1h';
 	}
@@ -52,6 +55,8 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
 			alert: $scope.alert,
 			tags: tags.join(','),
 			edit: $scope.edit,
+			user: $scope.user,
+			message: $scope.message,
 		};
 		return data;
 	}
@@ -86,6 +91,9 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
 		$scope.error = null;
 		$scope.testSilences = null;
 		state.confirm = 'true';
+		state.user = $scope.user;
+		state.message = $scope.message;
+		createCookie("action-user", $scope.user, 1000);
 		$http.post('/api/silence/set', state)
 			.error((error) => {
 				$scope.error = error;
@@ -97,7 +105,8 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
 			return;
 		}
 		$scope.error = null;
-		$http.post('/api/silence/clear', { id: id })
+		createCookie("action-user", $scope.user, 1000);
+		$http.post('/api/silence/clear', { id: id, user: $scope.user, message: $scope.message })
 			.error((error) => {
 				$scope.error = error;
 			})
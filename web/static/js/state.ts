@@ -13,6 +13,18 @@ bosunApp.directive('tsAckGroup', function() {
 			scope.btoa = scope.$parent.btoa;
 			scope.encode = scope.$parent.encode;
 			scope.shown = {};
+			// alias replaces the tag values in an alert key or group name
+			// with their display names. Links and queries use the raw values.
+			scope.alias = (v: string) => {
+				var aliases = scope.schedule && scope.schedule.Aliases;
+				if (!v || !aliases) {
+					return v;
+				}
+				return v.replace(/([^{},=\s]+)=([^{},]+)/g, (m: string, k: string, tv: string) => {
+					var a = aliases[k];
+					return a && a[tv] != undefined ? k + '=' + a[tv] : m;
+				});
+			};
 			scope.collapse = (i: any) => {
 				scope.shown[i] = !scope.shown[i];
 			};
@@ -101,12 +113,14 @@ bosunApp.factory('status', ['$http', '$q', function($http: ng.IHttpService, $q:
 				.success(data => {
 					angular.forEach(data, (v, k) => {
 						v.Touched = moment(v.Touched).utc();
+						v.SnoozedUntil = moment(v.SnoozedUntil).utc();
 						angular.forEach(v.History, (v, k) => {
 							v.Time = moment(v.Time).utc();
 						});
 						v.last = v.History[v.History.length - 1];
 						if (v.Actions && v.Actions.length > 0) {
-							v.LastAction = v.Actions[0];
+							v.ActionLog = v.Actions.slice().reverse();
+							v.LastAction = v.ActionLog[0];
 						}
 						cache[k] = v;
 					});
@@ -118,7 +132,7 @@ bosunApp.factory('status', ['$http', '$q', function($http: ng.IHttpService, $q:
 	};
 }]);
 
-bosunApp.directive('tsState', ['status', function($status: any) {
+bosunApp.directive('tsState', ['status', '$http', function($status: any, $http: ng.IHttpService) {
 	return {
 		templateUrl: '/partials/alertstate.html',
 		link: function(scope: any, elem: any, attrs: any) {
@@ -135,6 +149,24 @@ bosunApp.directive('tsState', ['status', function($status: any) {
 				var key = encodeURIComponent(scope.name);
 				return '/action?type=' + type + '&key=' + key;
 			};
+			scope.snoozed = () => {
+				return scope.state && scope.state.SnoozedUntil.isAfter(moment());
+			};
+			scope.snooze = (minutes: number) => {
+				var data = {
+					Type: 'snooze',
+					User: readCookie("action-user"),
+					Duration: minutes + 'm',
+					Keys: [scope.name],
+				};
+				$http.post('/api/action', data)
+					.success(() => {
+						scope.state.SnoozedUntil = moment().utc().add(minutes, 'minutes');
+					})
+					.error((error) => {
+						alert(error);
+					});
+			};
 			scope.zws = (v: string) => {
 				if (!v) {
 					return '';
@@ -3,8 +3,13 @@ package web
 import (
 	"bytes"
 	"compress/gzip"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"html/template"
 	"io"
 	"io/ioutil"
@@ -12,11 +17,13 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/bosun-monitor/bosun/_third_party/github.com/MiniProfiler/go/miniprofiler"
+	"github.com/bosun-monitor/bosun/_third_party/github.com/StackExchange/slog"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/collect"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/metadata"
 	"github.com/bosun-monitor/bosun/_third_party/github.com/bosun-monitor/opentsdb"
@@ -24,6 +31,7 @@ import (
 	"github.com/bosun-monitor/bosun/conf"
 	"github.com/bosun-monitor/bosun/expr"
 	"github.com/bosun-monitor/bosun/sched"
+	"github.com/bosun-monitor/bosun/search"
 )
 
 //go:generate esc -o web/static.go -pkg web -prefix web/static web/static/
@@ -41,7 +49,95 @@ func init() {
 	miniprofiler.StartHidden = true
 }
 
-func Listen(listenAddr string, devMode bool, tsdbHost *url.URL) error {
+// Mode selects what an instance serves.
+type Mode int
+
+const (
+	// ModeAll serves the UI and API of the local evaluator.
+	ModeAll Mode = iota
+	// ModeEvaluator serves only the API of the local evaluator.
+	ModeEvaluator
+	// ModeWeb serves the UI and API from a read-only copy of an evaluator's
+	// state, and forwards requests that change state to the evaluator.
+	ModeWeb
+)
+
+// forwarded lists the API paths that change state, which ModeWeb instances
+// forward to the evaluator.
+var forwarded = []string{
+	"/api/action",
+	"/api/action/bulk",
+	"/api/archive/purge",
+	"/api/archive/restore",
+	"/api/metadata/put",
+	"/api/put",
+	"/api/quiet",
+	"/api/reload",
+	"/api/reload/confirm",
+	"/api/run",
+	"/api/silence/clear",
+	"/api/silence/set",
+	"/api/sources/forget",
+}
+
+// A standby's state is replaced by the active instance's on each reload, so
+// it rejects the forwarded requests that change that state.
+func standbyRejects(path string) bool {
+	switch path {
+	case "/api/put", "/api/quiet", "/api/reload", "/api/reload/confirm":
+		return false
+	}
+	for _, p := range forwarded {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenRequired returns true for the forwarded requests that need the
+// apiToken. Data points and metadata sent by collectors do not.
+func tokenRequired(r *http.Request) bool {
+	switch r.URL.Path {
+	case "/api/put", "/api/metadata/put":
+		return false
+	}
+	if r.Method == "GET" || r.Method == "HEAD" {
+		return false
+	}
+	for _, p := range forwarded {
+		if p == r.URL.Path {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenGuard rejects requests that change state without the apiToken or the
+// cookie of the web UI.
+func tokenGuard(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if tokenRequired(r) {
+			if err := checkWrite(r); err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func standbyGuard(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if standbyRejects(r.URL.Path) && schedule.Standby() {
+			http.Error(w, "standby: make changes on the active instance", http.StatusServiceUnavailable)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func Listen(listenAddr string, devMode bool, tsdbHost *url.URL, mode Mode) error {
 	var err error
 	webFS := FS(devMode)
 	if devMode {
@@ -59,38 +155,81 @@ func Listen(listenAddr string, devMode bool, tsdbHost *url.URL) error {
 	if err != nil {
 		log.Fatal(err)
 	}
+	if mode == ModeWeb {
+		evaluator := schedule.Conf.EvaluatorURL
+		if evaluator == nil {
+			return fmt.Errorf("web: evaluatorURL required in web mode")
+		}
+		rp := httputil.NewSingleHostReverseProxy(evaluator)
+		for _, p := range forwarded {
+			router.Handle(p, rp)
+		}
+		log.Println("forwarding changes to evaluator", evaluator)
+	}
 	router.HandleFunc("/api/", APIRedirect)
 	router.Handle("/api/action", JSON(Action))
+	router.Handle("/api/action/bulk", JSON(BulkAction))
 	router.Handle("/api/alerts", JSON(Alerts))
+	router.Handle("/api/alias", JSON(Aliases))
+	router.Handle("/api/archive", JSON(Archive))
+	router.Handle("/api/archive/purge", JSON(ArchivePurge))
+	router.Handle("/api/archive/restore", JSON(ArchiveRestore))
+	router.Handle("/api/catalog", JSON(Catalog))
+	router.Handle("/api/circuits", JSON(Circuits))
 	router.Handle("/api/config", miniprofiler.NewHandler(Config))
 	router.Handle("/api/config_test", miniprofiler.NewHandler(ConfigTest))
-	router.Handle("/api/egraph/{bs}.svg", JSON(ExprGraph))
+	router.Handle("/api/config/routes", JSON(ConfigRoutes))
+	router.Handle("/api/config/search", JSON(ConfigSearch))
+	router.Handle("/api/config/warnings", JSON(ConfigWarnings))
+	router.Handle("/api/egraph/{bs}.{format:svg|png}", JSON(ExprGraph))
+	router.Handle("/api/embed/graph", miniprofiler.NewHandler(EmbedGraph))
+	router.Handle("/api/embed/sign", JSON(EmbedSign))
+	router.Handle("/api/embed/status", miniprofiler.NewHandler(EmbedStatus))
+	router.Handle("/api/exclusions", JSON(Exclusions))
 	router.Handle("/api/expr", JSON(Expr))
+	router.Handle("/api/expr/export", miniprofiler.NewHandler(ExprExport))
 	router.Handle("/api/graph", JSON(Graph))
 	router.Handle("/api/health", JSON(HealthCheck))
 	router.Handle("/api/host", JSON(Host))
+	router.Handle("/api/incidents", JSON(Incidents))
+	router.Handle("/api/internals", JSON(Internals))
 	router.Handle("/api/metadata/get", JSON(GetMetadata))
 	router.Handle("/api/metadata/metrics", JSON(MetadataMetrics))
 	router.Handle("/api/metadata/put", JSON(PutMetadata))
 	router.Handle("/api/metric", JSON(UniqueMetrics))
 	router.Handle("/api/metric/{tagk}/{tagv}", JSON(MetricsByTagPair))
+	router.Handle("/api/oncall", JSON(OnCall))
+	router.Handle("/api/quality", JSON(Quality))
+	router.Handle("/api/quiet", JSON(Quiet))
+	router.Handle("/api/reload", JSON(Reload))
+	router.Handle("/api/reload/confirm", JSON(ReloadConfirm))
+	router.Handle("/api/render", JSON(Render))
 	router.Handle("/api/rule", JSON(Rule))
 	router.Handle("/api/silence/clear", JSON(SilenceClear))
+	router.Handle("/api/silence/cleared", JSON(SilenceCleared))
 	router.Handle("/api/silence/get", JSON(SilenceGet))
+	router.Handle("/api/silence/overlap", JSON(SilenceOverlap))
+	router.Handle("/api/silence/redundant", JSON(SilenceRedundant))
 	router.Handle("/api/silence/set", JSON(SilenceSet))
+	router.Handle("/api/sources", JSON(Sources))
+	router.Handle("/api/sources/forget", JSON(SourceForget))
 	router.Handle("/api/status", JSON(Status))
+	router.Handle("/api/stream", JSON(Stream))
+	router.Handle("/api/summary", JSON(Summary))
 	router.Handle("/api/tagk/{metric}", JSON(TagKeysByMetric))
 	router.Handle("/api/tagv/{tagk}", JSON(TagValuesByTagKey))
 	router.Handle("/api/tagv/{tagk}/{metric}", JSON(TagValuesByMetricTagKey))
 	router.Handle("/api/templates", JSON(Templates))
 	router.Handle("/api/put", Relay(tsdbHost))
 	router.Handle("/api/run", JSON(Run))
-	http.Handle("/", miniprofiler.NewHandler(Index))
-	http.Handle("/api/", router)
-	fs := http.FileServer(webFS)
-	http.Handle("/partials/", fs)
-	http.Handle("/static/", http.StripPrefix("/static/", fs))
-	http.Handle("/favicon.ico", fs)
+	http.Handle("/api/", standbyGuard(tokenGuard(router)))
+	if mode != ModeEvaluator {
+		http.Handle("/", miniprofiler.NewHandler(Index))
+		fs := http.FileServer(webFS)
+		http.Handle("/partials/", fs)
+		http.Handle("/static/", http.StripPrefix("/static/", fs))
+		http.Handle("/favicon.ico", fs)
+	}
 	log.Println("bosun web listening on:", listenAddr)
 	log.Println("tsdb host:", tsdbHost)
 	return http.ListenAndServe(listenAddr, nil)
@@ -126,6 +265,11 @@ func (rp *relayProxy) ServeHTTP(responseWriter http.ResponseWriter, r *http.Requ
 		return opentsdb.MustReplace(s, "_")
 	}
 
+	if len(schedule.Conf.RelayRules) > 0 && r.URL.Path == "/api/put" {
+		if !relayFilter(responseWriter, r) {
+			return
+		}
+	}
 	reader := &passthru{ReadCloser: r.Body}
 	r.Body = reader
 	w := &relayWriter{ResponseWriter: responseWriter}
@@ -147,7 +291,9 @@ func (rp *relayProxy) ServeHTTP(responseWriter http.ResponseWriter, r *http.Requ
 		tags := opentsdb.TagSet{"remote": clean(ra)}
 		collect.Add("search.puts_relayed", tags, 1)
 		collect.Add("search.datapoints_relayed", tags, int64(len(mdp)))
+		schedule.CheckQuality(mdp)
 		schedule.Search.Index(mdp)
+		schedule.Heartbeat(clean(ra), mdp)
 	}
 	tags := opentsdb.TagSet{"path": clean(r.URL.Path), "remote": clean(strings.Split(r.RemoteAddr, ":")[0])}
 	collect.Add("relay.bytes", tags, int64(reader.buf.Len()))
@@ -155,6 +301,52 @@ func (rp *relayProxy) ServeHTTP(responseWriter http.ResponseWriter, r *http.Requ
 	collect.Add("relay.response", tags, 1)
 }
 
+// relayFilter applies the conf relay rules to the datapoints in r, replacing
+// its body with those that are kept. If all are dropped it responds to w and
+// returns false. Bodies that are not datapoints are left unchanged.
+func relayFilter(w http.ResponseWriter, r *http.Request) bool {
+	orig, err := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	r.Body = ioutil.NopCloser(bytes.NewReader(orig))
+	if err != nil {
+		return true
+	}
+	body := orig
+	if gr, err := gzip.NewReader(bytes.NewReader(body)); err == nil {
+		body, _ = ioutil.ReadAll(gr)
+		gr.Close()
+	}
+	var dp opentsdb.DataPoint
+	var mdp opentsdb.MultiDataPoint
+	if err := json.Unmarshal(body, &mdp); err == nil {
+	} else if err = json.Unmarshal(body, &dp); err == nil {
+		mdp = opentsdb.MultiDataPoint{&dp}
+	} else {
+		return true
+	}
+	kept := mdp[:0]
+	for _, d := range mdp {
+		if ok, rule := schedule.Conf.Relay(d); ok {
+			kept = append(kept, d)
+		} else {
+			collect.Add("relay.dropped", opentsdb.TagSet{"rule": rule}, 1)
+		}
+	}
+	if len(kept) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return false
+	}
+	b, err := json.Marshal(kept)
+	if err != nil {
+		slog.Errorln("relay:", err)
+		return true
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	r.ContentLength = int64(len(b))
+	r.Header.Del("Content-Encoding")
+	return true
+}
+
 func Relay(dest *url.URL) http.Handler {
 	return &relayProxy{ReverseProxy: httputil.NewSingleHostReverseProxy(dest)}
 }
@@ -169,6 +361,9 @@ func Index(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
+	if schedule.Conf.APIToken != "" {
+		http.SetCookie(w, &http.Cookie{Name: uiCookie, Value: uiToken(), Path: "/", HttpOnly: true})
+	}
 	err := templates.Execute(w, struct {
 		Includes template.HTML
 	}{
@@ -183,6 +378,43 @@ func serveError(w http.ResponseWriter, err error) {
 	http.Error(w, err.Error(), http.StatusInternalServerError)
 }
 
+// checkToken returns an error unless the request carries the apiToken as a
+// bearer token. Any request passes if no apiToken is set.
+func checkToken(r *http.Request) error {
+	token := schedule.Conf.APIToken
+	if token == "" {
+		return nil
+	}
+	got := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+		return fmt.Errorf("missing or bad API token")
+	}
+	return nil
+}
+
+// uiCookie names the cookie by which the web UI, which does not know the
+// apiToken, is allowed to change state.
+const uiCookie = "bosun-ui"
+
+// uiToken returns the value of the uiCookie: a MAC keyed by the apiToken, so
+// that serving the UI does not reveal the token.
+func uiToken() string {
+	mac := hmac.New(sha256.New, []byte(schedule.Conf.APIToken))
+	mac.Write([]byte(uiCookie))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// checkWrite is like checkToken, also accepting the uiCookie of the web UI.
+func checkWrite(r *http.Request) error {
+	if schedule.Conf.APIToken == "" {
+		return nil
+	}
+	if c, err := r.Cookie(uiCookie); err == nil && hmac.Equal([]byte(c.Value), []byte(uiToken())) {
+		return nil
+	}
+	return checkToken(r)
+}
+
 func JSON(h func(miniprofiler.Timer, http.ResponseWriter, *http.Request) (interface{}, error)) http.Handler {
 	return miniprofiler.NewHandler(func(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) {
 		d, err := h(t, w, r)
@@ -193,29 +425,65 @@ func JSON(h func(miniprofiler.Timer, http.ResponseWriter, *http.Request) (interf
 		if d == nil {
 			return
 		}
+		buf := new(bytes.Buffer)
+		cb := r.FormValue("callback")
+		if cb != "" {
+			w.Header().Add("Content-Type", "application/javascript")
+			buf.WriteString(cb + "(")
+		} else {
+			w.Header().Add("Content-Type", "application/json")
+		}
+		if err := json.NewEncoder(buf).Encode(d); err != nil {
+			slog.Errorln(err)
+			return
+		}
+		if cb != "" {
+			buf.WriteString(")")
+		}
+		if r.Method == "GET" || r.Method == "HEAD" {
+			h := fnv.New64a()
+			h.Write(buf.Bytes())
+			etag := fmt.Sprintf(`"%x"`, h.Sum64())
+			w.Header().Set("ETag", etag)
+			if etagMatch(r.Header.Get("If-None-Match"), etag) {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
 		var tw io.Writer = w
+		w.Header().Add("Vary", "Accept-Encoding")
 		if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
 			w.Header().Set("Content-Encoding", "gzip")
 			gz := gzip.NewWriter(w)
 			defer gz.Close()
 			tw = gz
 		}
-		if cb := r.FormValue("callback"); cb != "" {
-			w.Header().Add("Content-Type", "application/javascript")
-			tw.Write([]byte(cb + "("))
-			if err := json.NewEncoder(tw).Encode(d); err != nil {
-				log.Println(err)
-			}
-			tw.Write([]byte(")"))
-			return
-		}
-		w.Header().Add("Content-Type", "application/json")
-		if err := json.NewEncoder(tw).Encode(d); err != nil {
-			log.Println(err)
+		if _, err := buf.WriteTo(tw); err != nil {
+			slog.Errorln(err)
 		}
 	})
 }
 
+// tsdbProvider returns the time series backends for an evaluation started by
+// an API request, with a new trace ID that is also set on the response.
+func tsdbProvider(w http.ResponseWriter) expr.TSDBProvider {
+	trace := sched.NewTraceID()
+	w.Header().Set(sched.TraceHeader, trace)
+	return schedule.TSDB(trace)
+}
+
+// etagMatch returns true if the If-None-Match header value inm contains etag
+// or is *.
+func etagMatch(inm, etag string) bool {
+	for _, v := range strings.Split(inm, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" || strings.TrimPrefix(v, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
 type Health struct {
 	// RuleCheck is true if last check happened within the check frequency window.
 	RuleCheck bool
@@ -227,6 +495,21 @@ func HealthCheck(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (
 	return h, nil
 }
 
+// Internals returns check cycle, alert evaluation and state save timings,
+// queue depths and search index sizes. n limits the slowest alerts listed;
+// it defaults to 10.
+func Internals(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	n := 10
+	if v := r.FormValue("n"); v != "" {
+		var err error
+		n, err = strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return schedule.Internals(n), nil
+}
+
 func PutMetadata(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	d := json.NewDecoder(r.Body)
 	var ms []metadata.Metasend
@@ -261,10 +544,85 @@ func MetadataMetrics(t miniprofiler.Timer, w http.ResponseWriter, r *http.Reques
 	return schedule.MetadataMetrics(), nil
 }
 
+// Quality returns the data quality problems found in relayed data points in
+// the last window (default 1h).
+func Quality(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	window := time.Hour
+	if v := r.FormValue("window"); v != "" {
+		d, err := opentsdb.ParseDuration(v)
+		if err != nil {
+			return nil, err
+		}
+		window = time.Duration(d)
+	}
+	return schedule.DataQuality(time.Now().Add(-window)), nil
+}
+
+// Incidents lists incidents, most recent first, that were active in the last
+// window (default 1d). alert limits them to one alert. id returns the single
+// incident with that ID.
+func Incidents(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if v := r.FormValue("id"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		i := schedule.Incident(id)
+		if i == nil {
+			return nil, fmt.Errorf("no such incident: %d", id)
+		}
+		return i, nil
+	}
+	window := time.Hour * 24
+	if v := r.FormValue("window"); v != "" {
+		d, err := opentsdb.ParseDuration(v)
+		if err != nil {
+			return nil, err
+		}
+		window = time.Duration(d)
+	}
+	return schedule.Incidents(r.FormValue("alert"), time.Now().Add(-window)), nil
+}
+
+// Catalog lists metrics with their metadata and index statistics. q filters
+// by substring of the metric name; offset and limit (default 100, at most
+// 1000) select a page; active (default 1h) is the window for counting active
+// tag sets.
+func Catalog(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	offset, limit := 0, 100
+	if v := r.FormValue("offset"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil || i < 0 {
+			return nil, fmt.Errorf("bad offset: %s", v)
+		}
+		offset = i
+	}
+	if v := r.FormValue("limit"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil || i < 1 || i > 1000 {
+			return nil, fmt.Errorf("limit must be between 1 and 1000")
+		}
+		limit = i
+	}
+	active := time.Hour
+	if v := r.FormValue("active"); v != "" {
+		d, err := opentsdb.ParseDuration(v)
+		if err != nil {
+			return nil, err
+		}
+		active = time.Duration(d)
+	}
+	return schedule.Catalog(r.FormValue("q"), offset, limit, active), nil
+}
+
 func Alerts(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	return schedule.MarshalGroups(r.FormValue("filter"))
 }
 
+func Aliases(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.Conf.AliasValues(), nil
+}
+
 func Status(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	r.ParseForm()
 	m := make(map[string]*sched.State)
@@ -282,18 +640,42 @@ func Status(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 	return m, nil
 }
 
+func Summary(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	n := 10
+	if v := r.FormValue("n"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+		n = i
+	}
+	return schedule.Summary(r.FormValue("tag"), n), nil
+}
+
+// Action acknowledges, closes or forgets the alert keys of a JSON body with
+// Type (ack, close or forget), User, Message and Keys. Acknowledging stops
+// renotification. GET returns the actions taken on the alert key key.
 func Action(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if r.Method == "GET" {
+		ak, err := expr.ParseAlertKey(r.FormValue("key"))
+		if err != nil {
+			return nil, err
+		}
+		return schedule.ActionHistory(ak)
+	}
 	var data struct {
-		Type    string
-		User    string
-		Message string
-		Keys    []string
+		Type     string
+		User     string
+		Message  string
+		Keys     []string
+		Duration string // Of a snooze
 	}
 	j := json.NewDecoder(r.Body)
 	if err := j.Decode(&data); err != nil {
 		return nil, err
 	}
 	var at sched.ActionType
+	var snooze time.Duration
 	switch data.Type {
 	case "ack":
 		at = sched.ActionAcknowledge
@@ -301,15 +683,27 @@ func Action(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 		at = sched.ActionClose
 	case "forget":
 		at = sched.ActionForget
+	case "snooze":
+		at = sched.ActionSnooze
+		d, err := opentsdb.ParseDuration(data.Duration)
+		if err != nil {
+			return nil, err
+		}
+		snooze = time.Duration(d)
+	default:
+		return nil, fmt.Errorf("unknown action type: %s", data.Type)
 	}
 	errs := make(MultiError)
-	r.ParseForm()
 	for _, key := range data.Keys {
 		ak, err := expr.ParseAlertKey(key)
 		if err != nil {
 			return nil, err
 		}
-		err = schedule.Action(data.User, data.Message, at, ak)
+		if at == sched.ActionSnooze {
+			err = schedule.Snooze(data.User, data.Message, ak, snooze)
+		} else {
+			err = schedule.Action(data.User, data.Message, at, ak)
+		}
 		if err != nil {
 			errs[key] = err
 		}
@@ -320,6 +714,76 @@ func Action(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 	return nil, nil
 }
 
+// BulkAction acks, closes, forgets or silences a set of alert keys at once.
+// Keys are given explicitly, or selected by alert name and tag patterns as for
+// silences. With Preview set the affected keys are returned without changing
+// anything. Either all keys are actioned or, if any cannot be, none are.
+func BulkAction(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	var data struct {
+		Type    string
+		User    string
+		Message string
+		Keys    []string
+		Alert   string
+		Tags    string
+		Preview bool
+
+		Start, End, Duration string
+	}
+	j := json.NewDecoder(r.Body)
+	if err := j.Decode(&data); err != nil {
+		return nil, err
+	}
+	var aks []expr.AlertKey
+	if len(data.Keys) > 0 {
+		for _, key := range data.Keys {
+			ak, err := expr.ParseAlertKey(key)
+			if err != nil {
+				return nil, err
+			}
+			aks = append(aks, ak)
+		}
+	} else {
+		var err error
+		if aks, err = schedule.MatchKeys(data.Alert, data.Tags); err != nil {
+			return nil, err
+		}
+	}
+	if data.Type == "silence" {
+		start, end, err := silenceTimes(map[string]string{
+			"start":    data.Start,
+			"end":      data.End,
+			"duration": data.Duration,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return schedule.BulkSilence(start, end, aks, data.User, data.Message, data.Preview)
+	}
+	var at sched.ActionType
+	switch data.Type {
+	case "ack":
+		at = sched.ActionAcknowledge
+	case "close":
+		at = sched.ActionClose
+	case "forget":
+		at = sched.ActionForget
+	default:
+		return nil, fmt.Errorf("unknown action type: %s", data.Type)
+	}
+	res, err := schedule.BulkAction(data.User, data.Message, at, aks, data.Preview)
+	if err != nil && res != nil {
+		errs := make(MultiError)
+		for ak, reason := range res {
+			if reason != "" {
+				errs[string(ak)] = fmt.Errorf("%s", reason)
+			}
+		}
+		return nil, errs
+	}
+	return res, err
+}
+
 type MultiError map[string]error
 
 func (m MultiError) Error() string {
@@ -341,14 +805,9 @@ var silenceLayouts = []string{
 	"2006-01-02 15:04",
 }
 
-func SilenceSet(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
-	var start, end time.Time
-	var err error
-	var data map[string]string
-	j := json.NewDecoder(r.Body)
-	if err := j.Decode(&data); err != nil {
-		return nil, err
-	}
+// silenceTimes parses the start, end, and duration fields of a silence
+// request. start defaults to now; end defaults to start plus duration.
+func silenceTimes(data map[string]string) (start, end time.Time, err error) {
 	if s := data["start"]; s != "" {
 		for _, layout := range silenceLayouts {
 			start, err = time.Parse(layout, s)
@@ -357,7 +816,7 @@ func SilenceSet(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 			}
 		}
 		if start.IsZero() {
-			return nil, fmt.Errorf("unrecognized start time format: %s", s)
+			return start, end, fmt.Errorf("unrecognized start time format: %s", s)
 		}
 	}
 	if s := data["end"]; s != "" {
@@ -368,7 +827,7 @@ func SilenceSet(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 			}
 		}
 		if end.IsZero() {
-			return nil, fmt.Errorf("unrecognized end time format: %s", s)
+			return start, end, fmt.Errorf("unrecognized end time format: %s", s)
 		}
 	}
 	if start.IsZero() {
@@ -377,11 +836,61 @@ func SilenceSet(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 	if end.IsZero() {
 		d, err := opentsdb.ParseDuration(data["duration"])
 		if err != nil {
-			return nil, err
+			return start, end, err
 		}
 		end = start.Add(time.Duration(d))
 	}
-	return schedule.AddSilence(start, end, data["alert"], data["tags"], len(data["confirm"]) > 0, data["edit"])
+	return start, end, nil
+}
+
+func SilenceSet(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	var data map[string]string
+	j := json.NewDecoder(r.Body)
+	if err := j.Decode(&data); err != nil {
+		return nil, err
+	}
+	start, end, err := silenceTimes(data)
+	if err != nil {
+		return nil, err
+	}
+	if id := data["extend"]; id != "" && len(data["confirm"]) > 0 {
+		return nil, schedule.ExtendSilence(id, start, end)
+	}
+	var length time.Duration
+	if data["recurrence"] != "" {
+		d, err := opentsdb.ParseDuration(data["length"])
+		if err != nil {
+			return nil, err
+		}
+		length = time.Duration(d)
+	}
+	var match search.MatchFlags
+	if len(data["icase"]) > 0 {
+		match |= search.IgnoreCase
+	}
+	if len(data["substring"]) > 0 {
+		match |= search.Substring
+	}
+	return schedule.AddSilence(start, end, data["alert"], data["tags"], data["recurrence"], length, len(data["forget"]) > 0, match, data["user"], data["message"], len(data["confirm"]) > 0, data["edit"])
+}
+
+// SilenceOverlap returns existing silences that overlap the silence described
+// by the request, which takes the same fields as SilenceSet.
+func SilenceOverlap(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	var data map[string]string
+	j := json.NewDecoder(r.Body)
+	if err := j.Decode(&data); err != nil {
+		return nil, err
+	}
+	start, end, err := silenceTimes(data)
+	if err != nil {
+		return nil, err
+	}
+	return schedule.SilenceOverlaps(start, end, data["alert"], data["tags"])
+}
+
+func SilenceRedundant(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.RedundantSilences(), nil
 }
 
 func SilenceClear(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
@@ -390,20 +899,210 @@ func SilenceClear(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request)
 	if err := j.Decode(&data); err != nil {
 		return nil, err
 	}
-	return nil, schedule.ClearSilence(data["id"])
+	return nil, schedule.ClearSilence(data["id"], data["user"], data["message"])
+}
+
+// SilenceCleared returns the silences that were cleared before their end,
+// with who cleared them.
+func SilenceCleared(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.SilencesCleared(), nil
 }
 
 func ConfigTest(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) {
-	_, err := conf.New("test", r.FormValue("config_text"))
+	c, err := conf.New("test", r.FormValue("config_text"))
 	if err != nil {
 		fmt.Fprint(w, err.Error())
+		return
 	}
+	fmt.Fprint(w, strings.Join(c.Warnings, "\n"))
+}
+
+// ConfigRoutes lists the known alert keys that the config_text parameter, or
+// else the config file on disk, routes to different notifications or
+// templates than the running config.
+func ConfigRoutes(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	var c *conf.Conf
+	var err error
+	if text := r.FormValue("config_text"); text != "" {
+		c, err = conf.New("test", text)
+	} else {
+		c, err = conf.ParseFile(schedule.Conf.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return schedule.RoutingDiff(c), nil
 }
 
 func Config(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) {
 	fmt.Fprint(w, schedule.Conf.RawText)
 }
 
+// Stream long-polls for alert state transitions after the cursor parameter,
+// waiting up to wait (default 30s, max 5m) for one to occur. Each response
+// includes the cursor to pass on the next request.
+func Stream(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	var cursor int64
+	if v := r.FormValue("cursor"); v != "" {
+		c, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		cursor = c
+	}
+	wait := time.Second * 30
+	if v := r.FormValue("wait"); v != "" {
+		d, err := opentsdb.ParseDuration(v)
+		if err != nil {
+			return nil, err
+		}
+		wait = time.Duration(d)
+	}
+	if wait > time.Minute*5 {
+		wait = time.Minute * 5
+	}
+	return schedule.Stream(cursor, wait), nil
+}
+
+// Archive lists the saved state of alert keys whose alert was removed from
+// the configuration.
+func Archive(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.ArchivedStates(), nil
+}
+
+// archiveKeys decodes a JSON list of alert keys from the request body.
+func archiveKeys(r *http.Request) ([]expr.AlertKey, error) {
+	var keys []string
+	if err := json.NewDecoder(r.Body).Decode(&keys); err != nil {
+		return nil, err
+	}
+	aks := make([]expr.AlertKey, len(keys))
+	for i, k := range keys {
+		ak, err := expr.ParseAlertKey(k)
+		if err != nil {
+			return nil, err
+		}
+		aks[i] = ak
+	}
+	return aks, nil
+}
+
+// ArchiveRestore restores archived alert keys, such as after an alert was
+// removed by mistake and added back.
+func ArchiveRestore(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	aks, err := archiveKeys(r)
+	if err != nil {
+		return nil, err
+	}
+	return nil, schedule.RestoreArchived(aks)
+}
+
+// ArchivePurge deletes archived alert keys.
+func ArchivePurge(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	aks, err := archiveKeys(r)
+	if err != nil {
+		return nil, err
+	}
+	return nil, schedule.PurgeArchived(aks)
+}
+
+// Reload reads the config file again and evaluates it once without
+// notifying. If it would send no more than reloadThreshold notifications it
+// replaces the running config; otherwise it waits for /api/reload/confirm.
+// GET returns the canary of the pending config, if any.
+func Reload(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if r.Method != "POST" {
+		return schedule.PendingReload(), nil
+	}
+	c, err := conf.ParseFile(schedule.Conf.Name)
+	if err != nil {
+		return nil, err
+	}
+	ca, applied := schedule.Reload(c)
+	return struct {
+		*sched.Canary
+		Applied bool
+	}{ca, applied}, nil
+}
+
+// Quiet returns whether the schedule sends notifications and whether it is
+// the standby of an active/standby pair. POST with quiet=true or false
+// toggles quiet mode; a quiet active instance hands over to the standby.
+func Quiet(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if r.Method == "POST" {
+		q, err := strconv.ParseBool(r.FormValue("quiet"))
+		if err != nil {
+			return nil, fmt.Errorf("quiet must be true or false")
+		}
+		schedule.SetQuiet(q)
+	}
+	return struct {
+		Quiet   bool
+		Standby bool
+	}{schedule.Quiet(), schedule.Standby()}, nil
+}
+
+// ReloadConfirm replaces the running config with the pending one.
+func ReloadConfirm(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if r.Method != "POST" {
+		return nil, fmt.Errorf("POST required")
+	}
+	return schedule.ConfirmReload()
+}
+
+// Sources lists the collectors seen through the relay with their last seen
+// times.
+func Sources(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.Sources(), nil
+}
+
+func SourceForget(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	name := r.FormValue("source")
+	if name == "" {
+		return nil, fmt.Errorf("missing source")
+	}
+	schedule.ForgetSource(name)
+	return nil, nil
+}
+
+// OnCall lists the addresses currently on call for each roster.
+func OnCall(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.Conf.OnCall(), nil
+}
+
+// Circuits lists the circuit breaker state of notification transports.
+func Circuits(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.Conf.Circuits(), nil
+}
+
+func Exclusions(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.Conf.Exclusions, nil
+}
+
+func ConfigWarnings(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.Conf.Warnings, nil
+}
+
+// ConfigSearch returns the sections of the config file with lines matching
+// q. q is a literal string unless regex=true; icase=true ignores case.
+func ConfigSearch(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	q := r.FormValue("q")
+	if q == "" {
+		return nil, fmt.Errorf("missing q")
+	}
+	if r.FormValue("regex") != "true" {
+		q = regexp.QuoteMeta(q)
+	}
+	if r.FormValue("icase") == "true" {
+		q = "(?i)" + q
+	}
+	re, err := regexp.Compile(q)
+	if err != nil {
+		return nil, err
+	}
+	return schedule.Conf.SearchDefs(re), nil
+}
+
 func Templates(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	return schedule.Conf.AlertTemplateStrings()
 }
@@ -0,0 +1,101 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bosun-monitor/bosun/_third_party/github.com/MiniProfiler/go/miniprofiler"
+	"github.com/bosun-monitor/bosun/conf"
+)
+
+func TestETagMatch(t *testing.T) {
+	const etag = `"abc"`
+	tests := []struct {
+		inm    string
+		expect bool
+	}{
+		{"", false},
+		{`"abc"`, true},
+		{`W/"abc"`, true},
+		{`"x", "abc"`, true},
+		{"*", true},
+		{`"abcd"`, false},
+		{`abc`, false},
+	}
+	for _, test := range tests {
+		if got := etagMatch(test.inm, etag); got != test.expect {
+			t.Errorf("%q: expected %v, got %v", test.inm, test.expect, got)
+		}
+	}
+}
+
+func TestJSONNotModified(t *testing.T) {
+	h := JSON(func(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+		return map[string]int{"a": 1}, nil
+	})
+	serve := func(method, inm string) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(method, "/api/x", nil)
+		if inm != "" {
+			r.Header.Set("If-None-Match", inm)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+	w := serve("GET", "")
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || etag == "" || w.Body.Len() == 0 {
+		t.Fatalf("expected body with ETag, got %d %q %q", w.Code, etag, w.Body)
+	}
+	if w := serve("GET", etag); w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+		t.Errorf("matching ETag: expected empty 304, got %d %q", w.Code, w.Body)
+	}
+	if w := serve("GET", `"other"`); w.Code != http.StatusOK || w.Body.Len() == 0 {
+		t.Errorf("mismatched ETag: expected body, got %d %q", w.Code, w.Body)
+	}
+	if w := serve("POST", etag); w.Code != http.StatusOK || w.Header().Get("ETag") != "" {
+		t.Errorf("POST: expected body without ETag, got %d %q", w.Code, w.Header().Get("ETag"))
+	}
+}
+
+func TestTokenGuard(t *testing.T) {
+	schedule.Init(&conf.Conf{APIToken: "token"})
+	h := tokenGuard(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	tests := []struct {
+		method, path, token string
+		cookie              string
+		expect              int
+	}{
+		{"POST", "/api/silence/set", "", "", http.StatusUnauthorized},
+		{"POST", "/api/silence/set", "wrong", "", http.StatusUnauthorized},
+		{"POST", "/api/silence/set", "token", "", http.StatusOK},
+		{"POST", "/api/action", "", uiToken(), http.StatusOK},
+		{"POST", "/api/action", "", "forged", http.StatusUnauthorized},
+		{"GET", "/api/action", "", "", http.StatusOK},
+		{"POST", "/api/put", "", "", http.StatusOK},
+		{"POST", "/api/metadata/put", "", "", http.StatusOK},
+		{"POST", "/api/expr", "", "", http.StatusOK},
+	}
+	for _, test := range tests {
+		r, _ := http.NewRequest(test.method, test.path, nil)
+		if test.token != "" {
+			r.Header.Set("Authorization", "Bearer "+test.token)
+		}
+		if test.cookie != "" {
+			r.AddCookie(&http.Cookie{Name: uiCookie, Value: test.cookie})
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		if w.Code != test.expect {
+			t.Errorf("%s %s token %q cookie %q: expected %d, got %d", test.method, test.path, test.token, test.cookie, test.expect, w.Code)
+		}
+	}
+	schedule.Init(&conf.Conf{})
+	r, _ := http.NewRequest("POST", "/api/action", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Errorf("expected no check without an apiToken, got %d", w.Code)
+	}
+}